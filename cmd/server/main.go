@@ -9,11 +9,19 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	_ "github.com/redplanettribe/social-media-manager/docs"
+	"github.com/redplanettribe/social-media-manager/internal/domain/archive"
+	"github.com/redplanettribe/social-media-manager/internal/domain/campaign"
+	"github.com/redplanettribe/social-media-manager/internal/domain/feed"
+	"github.com/redplanettribe/social-media-manager/internal/domain/idea"
+	"github.com/redplanettribe/social-media-manager/internal/domain/importer"
+	"github.com/redplanettribe/social-media-manager/internal/domain/label"
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/notification"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
 	"github.com/redplanettribe/social-media-manager/internal/domain/project"
 	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 	"github.com/redplanettribe/social-media-manager/internal/domain/scheduler"
+	"github.com/redplanettribe/social-media-manager/internal/domain/template"
 	"github.com/redplanettribe/social-media-manager/internal/domain/user"
 	"github.com/redplanettribe/social-media-manager/internal/infrastructure/config"
 	"github.com/redplanettribe/social-media-manager/internal/infrastructure/encrypting"
@@ -97,17 +105,59 @@ func main() {
 	projectService := project.NewService(projectRepo, userRepo)
 	projectHandler := handlers.NewProjectHandler(projectService)
 
+	notificationRepo := postgres.NewNotificationRepository(dbPool)
+	notificationService := notification.NewService(notificationRepo)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+
+	labelRepo := postgres.NewLabelRepository(dbPool)
+	labelService := label.NewService(labelRepo)
+	labelHandler := handlers.NewLabelHandler(labelService)
+
+	campaignRepo := postgres.NewCampaignRepository(dbPool)
+	campaignService := campaign.NewService(campaignRepo)
+	campaignHandler := handlers.NewCampaignHandler(campaignService)
+
 	postRepo := postgres.NewPostRepository(dbPool)
 	postService := post.NewService(postRepo)
 	postHandler := handlers.NewPostHandler(postService)
 
+	ideaRepo := postgres.NewIdeaRepository(dbPool)
+	ideaService := idea.NewService(ideaRepo)
+	ideaHandler := handlers.NewIdeaHandler(ideaService)
+
+	templateRepo := postgres.NewTemplateRepository(dbPool)
+	templateService := template.NewService(templateRepo, postService)
+	templateHandler := handlers.NewTemplateHandler(templateService)
+	postService.SetSnippetInserter(templateService)
+
+	feedRepo := postgres.NewFeedRepository(dbPool)
+	feedService := feed.NewService(feedRepo, postService, templateService, feed.NewHTTPFetcher(cfg.Feeds.FetchTimeout, cfg.Feeds.MaxFeedSize))
+	feedHandler := handlers.NewFeedHandler(feedService)
+
 	mediaMetaDataRepo := postgres.NewMediaRepository(dbPool)
+	mediaLimits := media.SizeLimits{
+		media.MediaTypeImage:    cfg.Media.MaxImageSize,
+		media.MediaTypeVideo:    cfg.Media.MaxVideoSize,
+		media.MediaTypeDocument: cfg.Media.MaxDocumentSize,
+	}
 	mediaService := media.NewService(mediaMetaDataRepo, mediaObjectRepo)
-	mediaHandler := handlers.NewMediaHandler(mediaService)
+	mediaService.SetSizeLimits(mediaLimits)
+	mediaService.SetStripMetadata(cfg.Media.StripMetadata)
+	mediaHandler := handlers.NewMediaHandler(mediaService, mediaLimits.Max())
+	postService.SetMediaRemover(mediaService)
+
+	importRepo := postgres.NewImportRepository(dbPool)
+	importService := importer.NewService(importRepo, mediaService, postService, importer.NewHTTPFetcher(30*time.Second, importer.MaxMediaSize))
+	importHandler := handlers.NewImportHandler(importService)
+
+	archiveRepo := postgres.NewArchiveRepository(dbPool)
+	archiveService := archive.NewService(archiveRepo, mediaObjectRepo)
+	archiveHandler := handlers.NewArchiveHandler(archiveService)
 
 	publisherRepo := postgres.NewPublisherRepository(dbPool)
-	publisherService := publisher.NewService(publisherRepo, encrypter, publisherFactory, postService, mediaService)
+	publisherService := publisher.NewService(publisherRepo, encrypter, publisherFactory, postService, mediaService, notificationService)
 	publisherHandler := handlers.NewPlatformHandler(publisherService)
+	postService.SetPreflightValidator(publisherService)
 
 	appAuthorizer := authorization.NewAppAuthorizer(authorization.GetAppPermissions(), userService.GetUserAppRoles)
 	projectAuthorizer := authorization.NewTeamAthorizer(authorization.GetTeamPermissions(), projectService.GetUserRoles)
@@ -119,6 +169,14 @@ func main() {
 		postHandler,
 		publisherHandler,
 		mediaHandler,
+		notificationHandler,
+		labelHandler,
+		campaignHandler,
+		templateHandler,
+		importHandler,
+		archiveHandler,
+		ideaHandler,
+		feedHandler,
 		authenticator,
 		appAuthorizer,
 		projectAuthorizer,
@@ -129,9 +187,26 @@ func main() {
 	publisherQueue.Start(ctx)
 
 	// Start the post scheduler
-	scheduler := scheduler.NewPostScheduler(postService, projectService, publisherQueue, &cfg.Scheduler)
+	scheduler := scheduler.NewPostScheduler(postService, projectService, publisherService, notificationService, publisherQueue, &cfg.Scheduler)
 	scheduler.Start(ctx)
 
+	// Start the feed poller
+	feedPoller := feed.NewPoller(feedService, &cfg.Feeds)
+	feedPoller.Start(ctx)
+
+	// Start the media processing queue, uploads return before the media are analyzed
+	mediaQueue := media.NewProcessingQueue(&cfg.MediaProcessing, mediaService)
+	mediaService.SetProcessingQueue(mediaQueue)
+	mediaQueue.Start(ctx)
+
+	// Start the media garbage collector
+	mediaCollector := media.NewCollector(mediaService, &cfg.MediaGC)
+	mediaCollector.Start(ctx)
+
+	// Start the trash purger
+	trashPurger := post.NewPurger(postService, &cfg.Trash)
+	trashPurger.Start(ctx)
+
 	// Start the Server
 	server := server.NewHttpServer(cfg, httpRouter)
 	server.Serve()
@@ -24,16 +24,21 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/media/{project_id}/{post_id}": {
-            "post": {
-                "description": "Upload media",
-                "consumes": [
-                    "multipart/form-data"
+        "/campaigns/{project_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the campaigns of a project, most recent first",
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
-                    "media"
+                    "campaigns"
                 ],
-                "summary": "Upload media",
+                "summary": "List project campaigns",
                 "parameters": [
                     {
                         "type": "string",
@@ -41,37 +46,20 @@ const docTemplate = `{
                         "name": "project_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "File",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Alt text",
-                        "name": "alt_text",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/media.DownloadMetaData"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/campaign.Campaign"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -82,37 +70,31 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     }
                 }
-            }
-        },
-        "/media/{project_id}/{post_id}/{file_name}": {
-            "delete": {
-                "description": "Delete media",
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a campaign to group the posts of a project working toward the same goal",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "media"
+                    "campaigns"
                 ],
-                "summary": "Delete media",
+                "summary": "Create a campaign",
                 "parameters": [
                     {
                         "type": "string",
@@ -122,26 +104,24 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "File name",
-                        "name": "file_name",
-                        "in": "path",
-                        "required": true
+                        "description": "Campaign creation request",
+                        "name": "campaign",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.campaignRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/campaign.Campaign"
+                        }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -152,20 +132,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -173,16 +141,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/media/{project_id}/{post_id}/{file_name}/meta": {
+        "/campaigns/{project_id}/{campaign_id}": {
             "get": {
-                "description": "Get download metadata",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a campaign by its id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "media"
+                    "campaigns"
                 ],
-                "summary": "Get download metadata",
+                "summary": "Get a campaign",
                 "parameters": [
                     {
                         "type": "string",
@@ -193,15 +166,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "File name",
-                        "name": "file_name",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
                         "in": "path",
                         "required": true
                     }
@@ -210,11 +176,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/media.DownloadMetaData"
+                            "$ref": "#/definitions/campaign.Campaign"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -225,37 +191,31 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "410": {
+                        "description": "Campaign not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     }
                 }
-            }
-        },
-        "/media/{project_id}/{post_id}/{platform_id}/{file_name}/unlink": {
-            "get": {
-                "description": "Get media file. This endpoint shouldn't be used. Use the frontend to get the media file directly from the bucket.",
-                "produces": [
-                    "application/octet-stream"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Delete a campaign. Its posts are kept",
                 "tags": [
-                    "media"
+                    "campaigns"
                 ],
-                "summary": "Get media file",
+                "summary": "Delete a campaign",
                 "parameters": [
                     {
                         "type": "string",
@@ -266,28 +226,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "File name",
-                        "name": "file_name",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No content",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -298,37 +251,37 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "410": {
+                        "description": "Campaign not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     }
                 }
-            }
-        },
-        "/media/{project_id}/{post_id}/{platform_id}/{media_id}": {
-            "delete": {
-                "description": "Delink media from publish post",
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update the name, goal and dates of a campaign",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "media"
+                    "campaigns"
                 ],
-                "summary": "Delink media from publish post",
+                "summary": "Update a campaign",
                 "parameters": [
                     {
                         "type": "string",
@@ -339,32 +292,30 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Platform ID",
-                        "name": "platform_id",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Media ID",
-                        "name": "media_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Campaign update request",
+                        "name": "campaign",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.campaignRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/campaign.Campaign"
+                        }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -375,20 +326,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "410": {
+                        "description": "Campaign not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -396,16 +341,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/media/{project_id}/{post_id}/{platform_id}/{media_id}/link": {
+        "/campaigns/{project_id}/{campaign_id}/posts/{post_id}": {
             "post": {
-                "description": "Link media to publish post",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Add a post of the project to a campaign",
                 "tags": [
-                    "media"
+                    "campaigns"
                 ],
-                "summary": "Link media to publish post",
+                "summary": "Add a post to a campaign",
                 "parameters": [
                     {
                         "type": "string",
@@ -416,32 +363,101 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Platform ID",
-                        "name": "platform_id",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Post not in project",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Campaign not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a post from a campaign. The post is kept",
+                "tags": [
+                    "campaigns"
+                ],
+                "summary": "Remove a post from a campaign",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Media ID",
-                        "name": "media_id",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "No Content"
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -453,19 +469,19 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden",
+                        "description": "Post not in project",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "410": {
+                        "description": "Campaign not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -473,32 +489,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts": {
+        "/campaigns/{project_id}/{campaign_id}/summary": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get available post types",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Count the campaign posts by status, and by publish status on each platform",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "campaigns"
+                ],
+                "summary": "Get the publish summary of a campaign",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campaign ID",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get available post types",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/campaign.Summary"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Campaign not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
@@ -510,24 +554,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{project_id}": {
+        "/feeds/{project_id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "List all posts of a project by its id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List the feed subscriptions of a project with the outcome of their last fetch",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "feeds"
                 ],
-                "summary": "List all posts of a project",
+                "summary": "List project feeds",
                 "parameters": [
                     {
                         "type": "string",
@@ -543,7 +584,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/post.Post"
+                                "$ref": "#/definitions/feed.Feed"
                             }
                         }
                     },
@@ -559,12 +600,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Project not found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -572,16 +607,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/posts/{project_id}/add": {
+            },
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new post with the given title, text content, image links, video links, is idea and scheduled at",
+                "description": "Subscribe a project to an RSS or Atom feed. New items become ideas, or drafts when a template is set. On the first fetch only the newest items are ingested.",
                 "consumes": [
                     "application/json"
                 ],
@@ -589,9 +622,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "feeds"
                 ],
-                "summary": "Create a new post",
+                "summary": "Subscribe to a feed",
                 "parameters": [
                     {
                         "type": "string",
@@ -601,12 +634,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Post creation request",
-                        "name": "post",
+                        "description": "Feed subscription request",
+                        "name": "feed",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.createPostRequest"
+                            "$ref": "#/definitions/handlers.subscribeFeedRequest"
                         }
                     }
                 ],
@@ -614,7 +647,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/post.Post"
+                            "$ref": "#/definitions/feed.Feed"
                         }
                     },
                     "400": {
@@ -630,7 +663,13 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "Post already exists",
+                        "description": "Feed already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Template not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -644,24 +683,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{project_id}/idea-queue/move": {
-            "patch": {
+        "/feeds/{project_id}/{feed_id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add a post to a project idea queue by its id",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Remove a feed subscription. The posts created from it are kept.",
                 "tags": [
-                    "posts"
+                    "feeds"
                 ],
-                "summary": "Add a post to a project idea queue",
+                "summary": "Unsubscribe from a feed",
                 "parameters": [
                     {
                         "type": "string",
@@ -669,11 +702,21 @@ const docTemplate = `{
                         "name": "project_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Feed ID",
+                        "name": "feed_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "No content"
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -687,6 +730,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "410": {
+                        "description": "Feed not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -694,16 +743,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/posts/{project_id}/post-queue/move": {
+            },
             "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Move a post in the project queue by its current and new index",
+                "description": "Set or remove the template used to auto-draft the feed items",
                 "consumes": [
                     "application/json"
                 ],
@@ -711,9 +758,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "feeds"
                 ],
-                "summary": "Move a post in the project queue",
+                "summary": "Update a feed subscription",
                 "parameters": [
                     {
                         "type": "string",
@@ -724,24 +771,27 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Feed ID",
+                        "name": "feed_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Move post request",
-                        "name": "move",
+                        "description": "Feed update request",
+                        "name": "feed",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.moveInQueueRequest"
+                            "$ref": "#/definitions/handlers.updateFeedRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/feed.Feed"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -756,7 +806,7 @@ const docTemplate = `{
                         }
                     },
                     "410": {
-                        "description": "Post not found",
+                        "description": "Feed not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -770,24 +820,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{project_id}/queue": {
-            "get": {
+        "/feeds/{project_id}/{feed_id}/refresh": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all queued posts of a project by its id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Fetch a feed right away instead of waiting for the next poll",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "feeds"
                 ],
-                "summary": "Get all queued posts of a project",
+                "summary": "Refresh a feed",
                 "parameters": [
                     {
                         "type": "string",
@@ -795,16 +842,20 @@ const docTemplate = `{
                         "name": "project_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Feed ID",
+                        "name": "feed_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/post.Post"
-                            }
+                            "$ref": "#/definitions/handlers.refreshFeedResponse"
                         }
                     },
                     "400": {
@@ -820,7 +871,7 @@ const docTemplate = `{
                         }
                     },
                     "410": {
-                        "description": "Project not found",
+                        "description": "Feed not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -834,38 +885,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{project_id}/{post_id}": {
+        "/ideas/{project_id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a post by its id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List the ideas of a project with their votes and comments count. Promoted ideas are not listed.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "ideas"
                 ],
-                "summary": "Get a post by id",
+                "summary": "List the idea board",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Project ID",
+                        "name": "project_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "queue (default) or votes",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/post.PostResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/idea.Idea"
+                            }
                         }
                     },
                     "400": {
@@ -880,12 +937,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Post not found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -893,51 +944,50 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/ideas/{project_id}/sort-by-votes": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a post by its id. We might or might not want to implement pagination and filtering. For the time being, we will keep it simple.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Reorder the project idea queue putting the most voted ideas first. Ties keep their order.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "ideas"
                 ],
-                "summary": "Delete a post",
+                "summary": "Sort the idea queue by votes",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Project ID",
+                        "name": "project_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
-                    },
-                    "400": {
-                        "description": "Validation error",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/idea.Idea"
+                            }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Post not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -949,24 +999,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/ideas/{project_id}/{idea_id}/comments": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update a post with the given title, text content, image links, video links, is idea and scheduled at",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List the comments of an idea, oldest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "ideas"
                 ],
-                "summary": "Update a post",
+                "summary": "List the comments of an idea",
                 "parameters": [
                     {
                         "type": "string",
@@ -977,24 +1026,21 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Idea ID",
+                        "name": "idea_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Post update request",
-                        "name": "post",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.createPostRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/idea.Comment"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -1009,7 +1055,7 @@ const docTemplate = `{
                         }
                     },
                     "410": {
-                        "description": "Post not found",
+                        "description": "Idea not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1021,16 +1067,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/posts/{project_id}/{post_id}/archive": {
-            "patch": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Archive a post by its id",
+                "description": "Add a comment to an idea",
                 "consumes": [
                     "application/json"
                 ],
@@ -1038,21 +1082,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "ideas"
                 ],
-                "summary": "Archive a post",
+                "summary": "Comment an idea",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Idea ID",
+                        "name": "idea_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Comment",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.commentRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/idea.Comment"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -1067,7 +1130,7 @@ const docTemplate = `{
                         }
                     },
                     "410": {
-                        "description": "Post not found",
+                        "description": "Idea not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1081,43 +1144,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{project_id}/{post_id}/dequeue": {
-            "patch": {
+        "/ideas/{project_id}/{idea_id}/comments/{comment_id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Remove a post from a project queue by its id",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Delete a comment of an idea. Only its author can delete it.",
                 "tags": [
-                    "posts"
+                    "ideas"
                 ],
-                "summary": "Remove a post from a project queue",
+                "summary": "Delete a comment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Project ID",
+                        "name": "project_id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "project_id",
+                        "description": "Idea ID",
+                        "name": "idea_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "comment_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "No content"
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -1131,8 +1198,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Not the comment author",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Post not found",
+                        "description": "Comment not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1146,43 +1219,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{project_id}/{post_id}/enqueue": {
-            "patch": {
+        "/ideas/{project_id}/{idea_id}/promote": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add a post to a project queue by its id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Create a draft post from an idea. The draft keeps the idea author, content, platforms and labels and links back to the idea with idea_id. The idea is archived and removed from the idea queue; media stays with the idea.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "ideas"
                 ],
-                "summary": "Add a post to a project queue",
+                "summary": "Promote an idea to a draft",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Project ID",
+                        "name": "project_id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "project_id",
+                        "description": "Idea ID",
+                        "name": "idea_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/post.Post"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -1196,8 +1269,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "409": {
+                        "description": "Idea already promoted",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Post not found",
+                        "description": "Idea not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1211,24 +1290,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{project_id}/{post_id}/platforms/{platform_id}": {
+        "/ideas/{project_id}/{idea_id}/vote": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add a social media publisher platform to a post by its id",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Add the vote of the current user to an idea. Voting twice has no effect.",
                 "tags": [
-                    "posts"
+                    "ideas"
                 ],
-                "summary": "Add a social media publisher platform to a post",
+                "summary": "Upvote an idea",
                 "parameters": [
                     {
                         "type": "string",
@@ -1239,22 +1312,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Platform ID",
-                        "name": "platform_id",
+                        "description": "Idea ID",
+                        "name": "idea_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "No content"
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -1268,8 +1337,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "409": {
+                        "description": "Idea already promoted",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Post not found",
+                        "description": "Idea not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1288,17 +1363,11 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Remove a social media publisher platform from a post by its id",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Remove the vote of the current user from an idea",
                 "tags": [
-                    "posts"
+                    "ideas"
                 ],
-                "summary": "Remove a social media publisher platform from a post",
+                "summary": "Remove a vote from an idea",
                 "parameters": [
                     {
                         "type": "string",
@@ -1309,22 +1378,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Platform ID",
-                        "name": "platform_id",
+                        "description": "Idea ID",
+                        "name": "idea_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "No content"
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -1339,7 +1404,7 @@ const docTemplate = `{
                         }
                     },
                     "410": {
-                        "description": "Post not found",
+                        "description": "Idea not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1353,36 +1418,58 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{project_id}/{post_id}/restore": {
-            "patch": {
+        "/imports/{project_id}": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Restore a post by its id",
+                "description": "Create posts in bulk from a CSV or JSON file sent as the request body. Each row has a title, content, type, platforms, scheduled_at (RFC3339, \"queue\" or empty for a draft), media_urls and media_ids. In CSV files list columns are separated with \"|\".\nEither every row is imported or none is. With dry_run=true nothing is created and the report tells what would be. Invalid files return 422 with the row-level errors.",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "text/csv"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "imports"
                 ],
-                "summary": "Restore a post",
+                "summary": "Import posts from a CSV or JSON file",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
+                        "description": "Project ID",
+                        "name": "project_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File format, csv or json. Defaults to the Content-Type",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate the file without creating anything",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "Dry run report",
+                        "schema": {
+                            "$ref": "#/definitions/importer.Report"
+                        }
+                    },
+                    "201": {
+                        "description": "Import report",
+                        "schema": {
+                            "$ref": "#/definitions/importer.Report"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -1396,12 +1483,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Post not found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "422": {
+                        "description": "Invalid rows",
+                        "schema": {
+                            "$ref": "#/definitions/importer.Report"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1411,67 +1504,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts/{project_id}/{post_id}/schedule": {
-            "patch": {
+        "/labels/{project_id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Schedule a post by its id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List the labels of a project",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "labels"
                 ],
-                "summary": "Schedule a post",
+                "summary": "List project labels",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "Project ID",
                         "name": "project_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Scheduled at",
-                        "name": "scheduled_at",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.schedulePostRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
-                    },
-                    "400": {
-                        "description": "Validation error",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/errors.APIError"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/label.Label"
+                            }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Post not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1483,16 +1557,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/posts/{project_id}/{post_id}/unschedule": {
-            "patch": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Unschedule a post by its id",
+                "description": "Create a project label that can be attached to posts",
                 "consumes": [
                     "application/json"
                 ],
@@ -1500,28 +1572,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "posts"
+                    "labels"
                 ],
-                "summary": "Unschedule a post",
+                "summary": "Create a label",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Post ID",
-                        "name": "post_id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "Project ID",
                         "name": "project_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Label creation request",
+                        "name": "label",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.labelRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/label.Label"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -1535,8 +1612,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Post not found",
+                    "409": {
+                        "description": "Label already exists",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1550,40 +1627,65 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects": {
+        "/labels/{project_id}/posts/{post_id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "List all projects that the user is a member of",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the labels attached to a post",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "labels"
+                ],
+                "summary": "Get the labels of a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List all projects",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/project.Project"
+                                "$ref": "#/definitions/label.Label"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Post not in project",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1591,40 +1693,48 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/labels/{project_id}/posts/{post_id}/{label_id}": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new project with the given name and description",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Attach a project label to a post",
                 "tags": [
-                    "projects"
+                    "labels"
                 ],
-                "summary": "Create a new project",
+                "summary": "Add a label to a post",
                 "parameters": [
                     {
-                        "description": "Project creation request",
-                        "name": "project",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.createProjectRequest"
-                        }
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "label_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "204": {
+                        "description": "No content",
                         "schema": {
-                            "$ref": "#/definitions/project.Project"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -1639,8 +1749,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "409": {
-                        "description": "Project already exists",
+                    "403": {
+                        "description": "Post not in project",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Label not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1652,26 +1768,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/projects/{project_id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a project by its ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Detach a label from a post",
                 "tags": [
-                    "projects"
+                    "labels"
                 ],
-                "summary": "Get a project",
+                "summary": "Remove a label from a post",
                 "parameters": [
                     {
                         "type": "string",
@@ -1679,13 +1787,27 @@ const docTemplate = `{
                         "name": "project_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "label_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No content",
                         "schema": {
-                            "$ref": "#/definitions/project.Project"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -1700,8 +1822,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Post not in project",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Project not found",
+                        "description": "Label not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1713,24 +1841,20 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/labels/{project_id}/{label_id}": {
             "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a project with the given ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Delete a label and detach it from every post",
                 "tags": [
-                    "projects"
+                    "labels"
                 ],
-                "summary": "Delete a project",
+                "summary": "Delete a label",
                 "parameters": [
                     {
                         "type": "string",
@@ -1738,6 +1862,13 @@ const docTemplate = `{
                         "name": "project_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "label_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1760,7 +1891,7 @@ const docTemplate = `{
                         }
                     },
                     "410": {
-                        "description": "Project not found",
+                        "description": "Label not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1779,7 +1910,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update a project with the given ID",
+                "description": "Update the name and color of a label",
                 "consumes": [
                     "application/json"
                 ],
@@ -1787,9 +1918,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "labels"
                 ],
-                "summary": "Update a project",
+                "summary": "Update a label",
                 "parameters": [
                     {
                         "type": "string",
@@ -1799,12 +1930,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Project update request",
-                        "name": "project",
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "label_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Label update request",
+                        "name": "label",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.createProjectRequest"
+                            "$ref": "#/definitions/handlers.labelRequest"
                         }
                     }
                 ],
@@ -1812,7 +1950,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/project.Project"
+                            "$ref": "#/definitions/label.Label"
                         }
                     },
                     "400": {
@@ -1827,8 +1965,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "409": {
+                        "description": "Label already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Project not found",
+                        "description": "Label not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1842,14 +1986,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/add-role/{user_id}/{role_id}": {
+        "/media/gc": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add a role to a user in a project",
+                "description": "Find the files of the object store without metadata, older than the grace period, and the metadata whose file is missing. Orphaned files are only deleted when dry_run is false.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1857,37 +2001,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Add a role to a user",
+                "summary": "Reconcile the object store with the media metadata",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Project ID",
-                        "name": "project_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Only report, without deleting anything",
+                        "name": "dry_run",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Role ID",
-                        "name": "role_id",
-                        "in": "path",
-                        "required": true
+                        "default": "24h",
+                        "description": "Grace period for files without metadata, as a Go duration",
+                        "name": "grace",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/media.GCReport"
                         }
                     },
                     "400": {
@@ -1902,8 +2039,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Project not found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1917,24 +2054,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/add-time-slot": {
-            "patch": {
+        "/media/{project_id}/branding": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add a time slot to a project",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the logo, and its placement, stamped on the images of the posts that opt in",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Add a time slot to a project",
+                "summary": "Get the project branding",
                 "parameters": [
                     {
                         "type": "string",
@@ -1942,22 +2076,13 @@ const docTemplate = `{
                         "name": "project_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Time slot request",
-                        "name": "time_slot",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.addTimeSlotRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content",
-                        "schema": {
-                            "type": "string"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/media.Branding"
                         }
                     },
                     "400": {
@@ -1972,8 +2097,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Project not found",
+                        "description": "Branding not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1985,16 +2116,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/projects/{project_id}/add-user": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add a user to a project by their email",
+                "description": "Set the logo, and its placement, stamped on the images of the posts that opt in when they are published. The media stay as they are, only the files sent to the platforms are stamped.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2002,9 +2131,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Add a user to a project",
+                "summary": "Set the project branding",
                 "parameters": [
                     {
                         "type": "string",
@@ -2014,20 +2143,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "User addition request",
-                        "name": "user",
+                        "description": "Branding",
+                        "name": "branding",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.addUserRequest"
+                            "$ref": "#/definitions/handlers.setBrandingRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/media.Branding"
                         }
                     },
                     "400": {
@@ -2042,14 +2171,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "409": {
-                        "description": "User already exists",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Project not found",
+                    "422": {
+                        "description": "Logo is not an image",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2061,26 +2190,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/projects/{project_id}/default-user-platform-info/{platform_id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get the default user platform info for a project",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Delete the project branding. The posts that opted in are published without it.",
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Get the default user platform info",
+                "summary": "Delete the project branding",
                 "parameters": [
                     {
                         "type": "string",
@@ -2088,21 +2209,11 @@ const docTemplate = `{
                         "name": "project_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Platform ID",
-                        "name": "platform_id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/project.UserPlatformInfo"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Validation error",
@@ -2116,8 +2227,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Project not found",
+                        "description": "Branding not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2131,24 +2248,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/default-user/{user_id}": {
-            "patch": {
+        "/media/{project_id}/library": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Set the default user for a project",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List the media of the project library, with how many post platforms use each of them",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Set the default user for a project",
+                "summary": "List the project media library",
                 "parameters": [
                     {
                         "type": "string",
@@ -2159,17 +2273,31 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Only media in this folder",
+                        "name": "folder",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only media with this tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in file names and alt texts",
+                        "name": "q",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/media.LibraryMedia"
+                            }
                         }
                     },
                     "400": {
@@ -2184,8 +2312,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Project not found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2197,26 +2325,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/projects/{project_id}/disable-social-platform/{platform_id}": {
-            "delete": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Disable a social platform for a project",
+                "description": "Upload a media once to the project library. It can then be attached to any post of the project with the link endpoint.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Disable a social platform",
+                "summary": "Upload media to the project library",
                 "parameters": [
                     {
                         "type": "string",
@@ -2226,18 +2352,36 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Social Platform ID",
-                        "name": "social_platform_id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "File",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alt text",
+                        "name": "alt_text",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Folder, like brand/logos",
+                        "name": "folder",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated tags",
+                        "name": "tags",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/media.DownloadMetaData"
                         }
                     },
                     "400": {
@@ -2258,8 +2402,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Project not found",
+                    "409": {
+                        "description": "File already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large for its media type",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2273,14 +2423,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/enable-social-platform/{platform_id}": {
+        "/media/{project_id}/library/uploads": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Enable a social platform for a project",
+                "description": "Get a presigned URL where the file is sent with a PUT request, straight to the object store. The upload must then be completed before it expires. Folder and tags only apply to the library.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2288,9 +2438,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Enable a social platform",
+                "summary": "Initiate a direct upload",
                 "parameters": [
                     {
                         "type": "string",
@@ -2300,18 +2450,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Social Platform ID",
-                        "name": "social_platform_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Upload request",
+                        "name": "upload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.initiateUploadRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/media.Upload"
                         }
                     },
                     "400": {
@@ -2326,14 +2478,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "409": {
-                        "description": "User already exists",
+                        "description": "File already exists",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Project not found",
+                    "413": {
+                        "description": "File too large for its media type",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2347,24 +2505,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/remove-role/{user_id}/{role_id}": {
+        "/media/{project_id}/library/{media_id}": {
             "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Remove a role from a user in a project",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Delete a media of the project library. Media still attached to a post, or used as the logo of the project branding, can't be deleted.",
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Remove a role from a user",
+                "summary": "Delete a library media",
                 "parameters": [
                     {
                         "type": "string",
@@ -2375,25 +2527,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Role ID",
-                        "name": "role_id",
+                        "description": "Media ID",
+                        "name": "media_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "No content",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Validation error",
@@ -2407,8 +2549,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Media is still used by posts or by the project branding",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Project not found",
+                        "description": "Media not found in library",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2420,16 +2574,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/projects/{project_id}/remove-time-slot": {
+            },
             "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Remove a time slot from a project",
+                "description": "Change the alt text, folder and tags of a media of the project library",
                 "consumes": [
                     "application/json"
                 ],
@@ -2437,9 +2589,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Remove a time slot from a project",
+                "summary": "Update a library media",
                 "parameters": [
                     {
                         "type": "string",
@@ -2449,18 +2601,28 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Time slot request",
-                        "name": "time_slot",
+                        "type": "string",
+                        "description": "Media ID",
+                        "name": "media_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Library media update request",
+                        "name": "media",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.addTimeSlotRequest"
+                            "$ref": "#/definitions/handlers.updateLibraryMediaRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/media.LibraryMedia"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -2474,8 +2636,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Project not found",
+                        "description": "Media not found in library",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2489,24 +2657,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/remove-user/{user_id}": {
-            "delete": {
+        "/media/{project_id}/status": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Remove a user from a project by their email",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get whether uploaded media are still processing, ready or failed. Unknown ids are left out.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Remove a user from a project",
+                "summary": "Get the processing status of media",
                 "parameters": [
                     {
                         "type": "string",
@@ -2517,17 +2682,20 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "path",
+                        "description": "Comma separated media IDs",
+                        "name": "ids",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/media.ProcessingStatus"
+                            }
                         }
                     },
                     "400": {
@@ -2542,8 +2710,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Project not found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2557,24 +2725,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/schedule": {
-            "get": {
+        "/media/{project_id}/uploads/{upload_id}/complete": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get the project schedule for a project",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Save the file sent to the URL of an upload as a media. The media may be returned with the processing status, its thumbnail and details are available once it's ready. Files that can't be used are removed and the upload has to be initiated again.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Get the project schedule",
+                "summary": "Complete a direct upload",
                 "parameters": [
                     {
                         "type": "string",
@@ -2582,13 +2747,20 @@ const docTemplate = `{
                         "name": "project_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "upload_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/project.WeeklyPostSchedule"
+                            "$ref": "#/definitions/media.DownloadMetaData"
                         }
                     },
                     "400": {
@@ -2603,8 +2775,32 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "File already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Project not found",
+                        "description": "Upload not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "413": {
+                        "description": "File bigger than announced",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "File not uploaded or not a valid media",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2618,14 +2814,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/social-platforms": {
-            "get": {
+        "/media/{project_id}/{media_id}/focal-point": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get the social platforms enabled for a project",
+                "description": "Set the point of an image kept in view when it's cropped to the aspect ratio of a platform. The renditions are made again with it when the image is published.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2633,9 +2829,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Get enabled social platforms",
+                "summary": "Set the focal point of an image",
                 "parameters": [
                     {
                         "type": "string",
@@ -2643,16 +2839,29 @@ const docTemplate = `{
                         "name": "project_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Media ID",
+                        "name": "media_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Focal point",
+                        "name": "focal_point",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.setFocalPointRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/project.SocialPlatform"
-                            }
+                            "$ref": "#/definitions/media.MetaData"
                         }
                     },
                     "400": {
@@ -2667,8 +2876,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "410": {
-                        "description": "Project not found",
+                        "description": "Media not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Media is not an image",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2682,24 +2903,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{project_id}/user-roles/{user_id}": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get the roles of a user in a project",
+        "/media/{project_id}/{post_id}": {
+            "post": {
+                "description": "Upload media. The media may be returned with the processing status, its thumbnail and details are available once it's ready.",
                 "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "tags": [
-                    "projects"
+                    "media"
                 ],
-                "summary": "Get user roles",
+                "summary": "Upload media",
                 "parameters": [
                     {
                         "type": "string",
@@ -2710,24 +2923,34 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "description": "Post ID",
+                        "name": "post_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alt text",
+                        "name": "alt_text",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/media.DownloadMetaData"
                         }
                     },
                     "400": {
-                        "description": "Validation error",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2738,51 +2961,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "410": {
-                        "description": "Project not found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
-                    }
-                }
-            }
-        },
-        "/publishers": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get available social networks",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "publishers"
-                ],
-                "summary": "Get available social networks",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "413": {
+                        "description": "File too large for its media type",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/publisher.Platform"
-                            }
+                            "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2790,14 +2988,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/publishers/{project_id}/{platform_id}/{user_id}/authenticate": {
+        "/media/{project_id}/{post_id}/uploads": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Authenticate",
+                "description": "Get a presigned URL where the file is sent with a PUT request, straight to the object store. The upload must then be completed before it expires. Folder and tags only apply to the library.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2805,9 +3003,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "publishers"
+                    "media"
                 ],
-                "summary": "Authenticate",
+                "summary": "Initiate a direct upload",
                 "parameters": [
                     {
                         "type": "string",
@@ -2818,34 +3016,54 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Platform ID",
-                        "name": "platform_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "description": "Post ID",
+                        "name": "post_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Authentication request",
-                        "name": "authentication",
+                        "description": "Upload request",
+                        "name": "upload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.authenticationRequest"
+                            "$ref": "#/definitions/handlers.initiateUploadRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/media.Upload"
+                        }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "File already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large for its media type",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2859,24 +3077,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/publishers/{project_id}/{post_id}/validate": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Validate post for all assigned social networks",
+        "/media/{project_id}/{post_id}/{file_name}": {
+            "delete": {
+                "description": "Delete media",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "publishers"
+                    "media"
                 ],
-                "summary": "Validate post for all assigned social networks",
+                "summary": "Delete media",
                 "parameters": [
                     {
                         "type": "string",
@@ -2891,20 +3101,51 @@ const docTemplate = `{
                         "name": "post_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File name",
+                        "name": "file_name",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
-                    },
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Media is still used by other posts or by the project branding",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2912,24 +3153,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/publishers/{project_id}/{post_id}/{platform_id}": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Publish post to social network",
-                "consumes": [
-                    "application/json"
-                ],
+        "/media/{project_id}/{post_id}/{file_name}/meta": {
+            "get": {
+                "description": "Get download metadata",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "publishers"
+                    "media"
                 ],
-                "summary": "Publish post to social network",
+                "summary": "Get download metadata",
                 "parameters": [
                     {
                         "type": "string",
@@ -2947,24 +3180,45 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Social Network ID",
-                        "name": "social_network_id",
+                        "description": "File name",
+                        "name": "file_name",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/media.DownloadMetaData"
+                        }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2972,24 +3226,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/publishers/{project_id}/{post_id}/{platform_id}/info": {
-            "get": {
+        "/media/{project_id}/{post_id}/{platform_id}/branding": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get publish post info",
+                "description": "Set whether the project branding is stamped on the images of a post when it's published to a platform",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "publishers"
+                    "media"
                 ],
-                "summary": "Get publish post info",
+                "summary": "Opt a post in or out of the project branding",
                 "parameters": [
                     {
                         "type": "string",
@@ -3007,21 +3258,39 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Social Network ID",
-                        "name": "social_network_id",
+                        "description": "Platform ID",
+                        "name": "platform_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Whether to apply the branding",
+                        "name": "branding",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.setPostBrandingRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Validation error",
                         "schema": {
-                            "$ref": "#/definitions/publisher.PublishPostInfo"
+                            "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Post not linked to platform",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -3035,24 +3304,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/publishers/{project_id}/{post_id}/{platform_id}/validate": {
+        "/media/{project_id}/{post_id}/{platform_id}/{file_name}/unlink": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Validate post for social network",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get media file. This endpoint shouldn't be used. Use the frontend to get the media file directly from the bucket.",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "publishers"
+                    "media"
                 ],
-                "summary": "Validate post for social network",
+                "summary": "Get media file",
                 "parameters": [
                     {
                         "type": "string",
@@ -3070,24 +3331,45 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Social Network ID",
-                        "name": "social_network_id",
+                        "description": "File name",
+                        "name": "file_name",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -3095,24 +3377,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/publishers/{project_id}/{post_id}/{platform_id}/{user_platform_id}/add-profile-tag": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Add profile tag to post",
+        "/media/{project_id}/{post_id}/{platform_id}/{media_id}": {
+            "delete": {
+                "description": "Delink media from publish post",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "publishers"
+                    "media"
                 ],
-                "summary": "Add profile tag to post",
+                "summary": "Delink media from publish post",
                 "parameters": [
                     {
                         "type": "string",
@@ -3130,90 +3404,49 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Social Network ID",
-                        "name": "social_network_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User Platform ID",
-                        "name": "user_platform_id",
+                        "description": "Platform ID",
+                        "name": "platform_id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Tag",
-                        "name": "tag",
+                        "description": "Media ID",
+                        "name": "media_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
-                    }
-                }
-            }
-        },
-        "/users": {
-            "post": {
-                "description": "Register a new user with username, password and email",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "users"
-                ],
-                "summary": "Register a new user",
-                "parameters": [
-                    {
-                        "description": "User creation request",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.createUserRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
-                        }
                     },
-                    "400": {
-                        "description": "Validation error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "409": {
-                        "description": "User already exists",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -3221,39 +3454,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/login": {
+        "/media/{project_id}/{post_id}/{platform_id}/{media_id}/link": {
             "post": {
-                "description": "Login with email and password",
+                "description": "Link media to publish post",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "users"
+                    "media"
                 ],
-                "summary": "Login",
+                "summary": "Link media to publish post",
                 "parameters": [
                     {
-                        "description": "Login request",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.loginRequest"
-                        }
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "platform_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Media ID",
+                        "name": "media_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/user.LoginResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
-                        "description": "Validation error",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -3264,8 +3510,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -3273,21 +3531,57 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/logout": {
-            "post": {
+        "/notifications/{project_id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Logout the currently authenticated user",
+                "description": "List the notifications sent to the project team, newest first",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "users"
+                    "notifications"
+                ],
+                "summary": "List project notifications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only return unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Logout",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/notification.Notification"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
                     },
                     "500": {
                         "description": "Internal server error",
@@ -3298,29 +3592,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
-            "get": {
+        "/notifications/{project_id}/{notification_id}/read": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get information about the currently authenticated user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Mark a notification as read",
                 "tags": [
-                    "users"
+                    "notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "notification_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get user information",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No content",
                         "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "401": {
@@ -3329,6 +3639,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "410": {
+                        "description": "Notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -3338,14 +3654,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/roles": {
+        "/posts": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all application roles",
+                "description": "Get available post types",
                 "consumes": [
                     "application/json"
                 ],
@@ -3353,16 +3669,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "posts"
                 ],
-                "summary": "Get all roles",
+                "summary": "Get available post types",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/user.AppRole"
+                                "type": "string"
                             }
                         }
                     },
@@ -3373,14 +3689,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/posts/{project_id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Assign an application role to a user",
+                "description": "Search, filter and paginate the posts of a project. Filtering on a scheduled date range gives the calendar view. Results are paginated with an opaque cursor: pass the next_cursor of a page to get the following one, keeping the same filters and sort.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3388,23 +3706,155 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "posts"
                 ],
-                "summary": "Assign role to user",
+                "summary": "Search the posts of a project",
                 "parameters": [
                     {
-                        "description": "Assign role request",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.assignRoleRequest"
-                        }
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "draft",
+                            "queued",
+                            "scheduled",
+                            "published",
+                            "partially_published",
+                            "failed",
+                            "archived"
+                        ],
+                        "type": "string",
+                        "description": "Post status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only posts linked to this platform",
+                        "name": "platform",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the user who created the post",
+                        "name": "author",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only ideas, or only non ideas",
+                        "name": "is_idea",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only posts with this label ID",
+                        "name": "label",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only posts of this campaign ID",
+                        "name": "campaign",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Free-text search over title and content",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or before (RFC3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scheduled at or after (RFC3339)",
+                        "name": "scheduled_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Scheduled at or before (RFC3339)",
+                        "name": "scheduled_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Published at or after (RFC3339)",
+                        "name": "published_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Published at or before (RFC3339)",
+                        "name": "published_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "updated_at",
+                            "scheduled_at",
+                            "published_at",
+                            "title"
+                        ],
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size, max 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/post.PostPage"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -3412,6 +3862,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -3419,14 +3881,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/posts/{project_id}/add": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Remove an application role from a user",
+                "description": "Create a new post with the given title, text content, image links, video links, is idea and scheduled at",
                 "consumes": [
                     "application/json"
                 ],
@@ -3434,23 +3898,229 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "posts"
                 ],
-                "summary": "Remove role from user",
+                "summary": "Create a new post",
                 "parameters": [
                     {
-                        "description": "Remove role request",
-                        "name": "user",
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Post creation request",
+                        "name": "post",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.assignRoleRequest"
+                            "$ref": "#/definitions/handlers.createPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/post.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Post already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/idea-queue/move": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a post to a project idea queue by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Add a post to a project idea queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/post-queue/move": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move a post in the project queue by its current and new index",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Move a post in the project queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Move post request",
+                        "name": "move",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.moveInQueueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
                         }
                     }
+                }
+            }
+        },
+        "/posts/{project_id}/queue": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all queued posts of a project by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get all queued posts of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Queue name, the default queue if empty",
+                        "name": "queue",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/post.Post"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -3458,6 +4128,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project or queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -3466,211 +4148,5142 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "errors.APIError": {
+        },
+        "/posts/{project_id}/queues": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the default queue and the named queues of a project with their size",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "List the post queues of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/post.NamedQueue"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a named queue, e.g. \"tips\" or \"promotions\", that schedule slots can draw posts from",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Create a named post queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Queue",
+                        "name": "queue",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.createQueueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/post.NamedQueue"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Queue already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/queues/{queue}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a named queue. Its posts are moved to the end of the default queue",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Delete a named post queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Queue name",
+                        "name": "queue",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/trash": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the deleted posts of a project that can still be restored, most recently deleted first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "List the trash of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/post.TrashedPost"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/trash/{post_id}/restore": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Take a post out of the trash. Posts that were scheduled or queued when deleted come back as drafts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Restore a deleted post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not in trash",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/{post_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a post by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Get a post by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/post.PostResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the post"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move a post to the trash of its project. It can be restored until the trash retention expires, then it is removed for good with its media.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Delete a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a post with the given title, text content, image links, video links, is idea and scheduled at",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Update a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Post update request",
+                        "name": "post",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.createPostRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the post the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/post.Post"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the post"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "412": {
+                        "description": "Post was modified since the given version",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/{post_id}/archive": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Archive a post by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Archive a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/{post_id}/dequeue": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a post from a project queue by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Remove a post from a project queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/{post_id}/enqueue": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a post to a project queue by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Add a post to a project queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Queue name, the default queue if empty",
+                        "name": "queue",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post or queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/{post_id}/platforms/{platform_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a social media publisher platform to a post by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Add a social media publisher platform to a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "platform_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a social media publisher platform from a post by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Remove a social media publisher platform from a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "platform_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/{post_id}/restore": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Restore a post by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Restore a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/{post_id}/schedule": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Schedule a post by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Schedule a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Scheduled at",
+                        "name": "scheduled_at",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.schedulePostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{project_id}/{post_id}/unschedule": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Unschedule a post by its id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "posts"
+                ],
+                "summary": "Unschedule a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List all projects that the user is a member of",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "List all projects",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/project.Project"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new project with the given name and description",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Create a new project",
+                "parameters": [
+                    {
+                        "description": "Project creation request",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.createProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/project.Project"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Project already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new project from an archive made by the export, sent as the request body. Posts, labels and media get new ids and the current user becomes the owner. Platforms have to be authenticated again.",
+                "consumes": [
+                    "application/zip"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Import a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of the new project. Defaults to the name in the archive",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/project.Project"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Project already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a project by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/project.Project"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the project"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a project with the given ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Delete a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a project with the given ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Project update request",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.createProjectRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the project the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/project.Project"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the project"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "412": {
+                        "description": "Project was modified since the given version",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/add-role/{user_id}/{role_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a role to a user in a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Add a role to a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role ID",
+                        "name": "role_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/add-time-slot": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a time slot to a project, or assign an existing one to another queue",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Add a time slot to a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time slot request",
+                        "name": "time_slot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.addTimeSlotRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the schedule the change is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the schedule"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "412": {
+                        "description": "Schedule was modified since the given version",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/add-user": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a user to a project by their email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Add a user to a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User addition request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.addUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "User already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/default-user-platform-info/{platform_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the default user platform info for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get the default user platform info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "platform_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/project.UserPlatformInfo"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/default-user/{user_id}": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set the default user for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Set the default user for a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/disable-social-platform/{platform_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Disable a social platform for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Disable a social platform",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Social Platform ID",
+                        "name": "social_platform_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/enable-social-platform/{platform_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Enable a social platform for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Enable a social platform",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Social Platform ID",
+                        "name": "social_platform_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "User already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download a zip archive with a manifest.json describing the project (posts, queues, schedule, labels, enabled platforms and media metadata) and its media files. Platform secrets and users are not exported.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Export a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/preflight-mode": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get what happens when a post fails validation while being scheduled or queued. strict refuses the post, warn accepts it and notifies the team",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get the project preflight mode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.preflightModeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Set what happens when a post fails validation while being scheduled or queued. strict refuses the post, warn accepts it and notifies the team",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Set the project preflight mode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Preflight mode request",
+                        "name": "mode",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.setPreflightModeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/remove-role/{user_id}/{role_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a role from a user in a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Remove a role from a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role ID",
+                        "name": "role_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/remove-time-slot": {
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a time slot from a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Remove a time slot from a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time slot request",
+                        "name": "time_slot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.addTimeSlotRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the schedule the change is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the schedule"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "412": {
+                        "description": "Schedule was modified since the given version",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/remove-user/{user_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove a user from a project by their email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Remove a user from a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the project schedule for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get the project schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/project.WeeklyPostSchedule"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the schedule"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/social-platforms": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the social platforms enabled for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get enabled social platforms",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/project.SocialPlatform"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{project_id}/user-roles/{user_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the roles of a user in a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get user roles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/publishers": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get available social networks",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publishers"
+                ],
+                "summary": "Get available social networks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/publisher.Platform"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/publishers/{project_id}/{platform_id}/{user_id}/authenticate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Authenticate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publishers"
+                ],
+                "summary": "Authenticate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "platform_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Authentication request",
+                        "name": "authentication",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.authenticationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/publishers/{project_id}/{post_id}/validate": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Validate post for all assigned social networks. Returns one report per platform with every issue found, so they can all be fixed before scheduling",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publishers"
+                ],
+                "summary": "Validate post for all assigned social networks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/publisher.ValidationReport"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/publishers/{project_id}/{post_id}/{platform_id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Publish post to social network",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publishers"
+                ],
+                "summary": "Publish post to social network",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Social Network ID",
+                        "name": "social_network_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/publishers/{project_id}/{post_id}/{platform_id}/info": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get publish post info",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publishers"
+                ],
+                "summary": "Get publish post info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Social Network ID",
+                        "name": "social_network_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/publisher.PublishPostInfo"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/publishers/{project_id}/{post_id}/{platform_id}/validate": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Validate post for social network. Returns every issue found for the platform, errors block publishing while warnings don't",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publishers"
+                ],
+                "summary": "Validate post for social network",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "platform_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/publisher.ValidationReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/publishers/{project_id}/{post_id}/{platform_id}/{user_platform_id}/add-profile-tag": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add profile tag to post",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "publishers"
+                ],
+                "summary": "Add profile tag to post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Social Network ID",
+                        "name": "social_network_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User Platform ID",
+                        "name": "user_platform_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tag",
+                        "name": "tag",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/snippets/{project_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the snippets of a project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "List project snippets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/template.Snippet"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a reusable block of text, inserted in posts and templates with {{snippet:name}}",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Create a snippet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Snippet creation request",
+                        "name": "snippet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.snippetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/template.Snippet"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Snippet already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/snippets/{project_id}/{snippet_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a snippet. Posts where it was inserted are kept",
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Delete a snippet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snippet ID",
+                        "name": "snippet_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Snippet not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update the name and content of a snippet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Update a snippet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snippet ID",
+                        "name": "snippet_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Snippet update request",
+                        "name": "snippet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.snippetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/template.Snippet"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Snippet already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Snippet not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/{project_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the post templates of a project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "List project post templates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/template.Template"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a project post template. The title and content can hold {{variable}} placeholders and {{snippet:name}} references",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Create a post template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template creation request",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.templateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/template.Template"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/{project_id}/{template_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a project post template and the variables it needs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Get a post template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "template_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/template.Template"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a project post template. Posts created from it are kept",
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Delete a post template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "template_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a project post template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Update a post template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "template_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template update request",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.templateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/template.Template"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/{project_id}/{template_id}/posts": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Render the template with the given variables and create a draft post linked to the template platforms. Every variable of the template must be provided",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Create a post from a template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "project_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "template_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template variables",
+                        "name": "post",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.createPostFromTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/post.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "410": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing variables or snippets",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "post": {
+                "description": "Register a new user with username, password and email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "User creation request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.createUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "User already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Login with email and password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "Login request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.loginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Logout the currently authenticated user",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Logout",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get information about the currently authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user information",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/roles": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all application roles",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get all roles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/user.AppRole"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Assign an application role to a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Assign role to user",
+                "parameters": [
+                    {
+                        "description": "Assign role request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.assignRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove an application role from a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Remove role from user",
+                "parameters": [
+                    {
+                        "description": "Remove role request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.assignRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "campaign.Campaign": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "goal": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "posts_count": {
+                    "type": "integer"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "campaign.PlatformSummary": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "processing": {
+                    "type": "integer"
+                },
+                "published": {
+                    "type": "integer"
+                },
+                "ready": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "campaign.Summary": {
+            "type": "object",
+            "properties": {
+                "campaign_id": {
+                    "type": "string"
+                },
+                "platforms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/campaign.PlatformSummary"
+                    }
+                },
+                "posts_by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "total_posts": {
+                    "type": "integer"
+                }
+            }
+        },
+        "errors.APIError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {},
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "feed.Feed": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "last_fetched_at": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "template_id": {
+                    "description": "Template used to auto-draft the items",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.addTimeSlotRequest": {
+            "type": "object",
+            "properties": {
+                "day_of_week": {
+                    "description": "time.Weekday",
+                    "type": "integer"
+                },
+                "hour": {
+                    "type": "integer"
+                },
+                "minute": {
+                    "type": "integer"
+                },
+                "queue": {
+                    "description": "Queue the slot draws posts from, the default queue if empty",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.addUserRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.assignRoleRequest": {
+            "type": "object",
+            "properties": {
+                "role_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.authenticationRequest": {
+            "type": "object",
+            "properties": {
+                "params": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "handlers.campaignRequest": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "goal": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.commentRequest": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.createPostFromTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "scheduled_at": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.createPostRequest": {
+            "type": "object",
+            "properties": {
+                "is_idea": {
+                    "type": "boolean"
+                },
+                "scheduled_at": {
+                    "type": "string"
+                },
+                "text_content": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.createProjectRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.createQueueRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.createUserRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.initiateUploadRequest": {
+            "type": "object",
+            "properties": {
+                "alt_text": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "folder": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.labelRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "#RRGGBB, defaults to grey",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.loginRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.moveInQueueRequest": {
+            "type": "object",
+            "properties": {
+                "current_index": {
+                    "type": "integer"
+                },
+                "new_index": {
+                    "type": "integer"
+                },
+                "queue": {
+                    "description": "Only used for the post queues, the default queue if empty",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.preflightModeResponse": {
+            "type": "object",
+            "properties": {
+                "mode": {
+                    "$ref": "#/definitions/project.PreflightMode"
+                }
+            }
+        },
+        "handlers.refreshFeedResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.schedulePostRequest": {
+            "type": "object",
+            "properties": {
+                "scheduled_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.setBrandingRequest": {
+            "type": "object",
+            "properties": {
+                "logo_media_id": {
+                    "description": "LogoMediaID is an image of the project library",
+                    "type": "string"
+                },
+                "opacity": {
+                    "description": "Opacity goes from 0, invisible, to 1, opaque",
+                    "type": "number"
+                },
+                "position": {
+                    "description": "Position is top_left, top_right, bottom_left, bottom_right or center",
+                    "type": "string"
+                },
+                "scale": {
+                    "description": "Scale is the width of the logo as a fraction of the width of the image",
+                    "type": "number"
+                },
+                "text": {
+                    "description": "Text is written under the logo, optional",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.setFocalPointRequest": {
+            "type": "object",
+            "properties": {
+                "x": {
+                    "description": "X and Y are fractions of the width and height of the image, from its top left corner",
+                    "type": "number"
+                },
+                "y": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.setPostBrandingRequest": {
+            "type": "object",
+            "properties": {
+                "apply": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handlers.setPreflightModeRequest": {
+            "type": "object",
+            "properties": {
+                "mode": {
+                    "description": "strict or warn",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.snippetRequest": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "lowercase letters, digits, - and _",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.subscribeFeedRequest": {
+            "type": "object",
+            "properties": {
+                "template_id": {
+                    "description": "Optional template to auto-draft the items, it can use {{title}}, {{link}} and {{summary}}",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.templateRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "platforms": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text_content": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.updateFeedRequest": {
+            "type": "object",
+            "properties": {
+                "template_id": {
+                    "description": "null turns the items into ideas again",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.updateLibraryMediaRequest": {
+            "type": "object",
+            "properties": {
+                "alt_text": {
+                    "type": "string"
+                },
+                "folder": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "idea.Comment": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "post_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "idea.Idea": {
+            "type": "object",
+            "properties": {
+                "comments_count": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "idea_id": {
+                    "description": "Idea the post was promoted from",
+                    "type": "string"
+                },
+                "is_idea": {
+                    "type": "boolean"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "scheduled_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "text_content": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/post.PostType"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Incremented on every edit, used as ETag",
+                    "type": "integer"
+                },
+                "voted_by_me": {
+                    "type": "boolean"
+                },
+                "votes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "importer.ImportedPost": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "Error tells why a post to schedule or queue was kept as a draft",
+                    "type": "string"
+                },
+                "post_id": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "importer.Report": {
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "type": "boolean"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/importer.RowError"
+                    }
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/importer.ImportedPost"
+                    }
+                },
+                "total_rows": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "importer.RowError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "label.Label": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "media.Branding": {
+            "type": "object",
+            "properties": {
+                "logo_media_id": {
+                    "description": "an image of the project library",
+                    "type": "string"
+                },
+                "opacity": {
+                    "description": "Opacity goes from 0, invisible, to 1, opaque",
+                    "type": "number"
+                },
+                "position": {
+                    "$ref": "#/definitions/media.BrandingPosition"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "scale": {
+                    "description": "Scale is the width of the logo as a fraction of the width of the image",
+                    "type": "number"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "media.BrandingPosition": {
+            "type": "string",
+            "enum": [
+                "top_left",
+                "top_right",
+                "bottom_left",
+                "bottom_right",
+                "center"
+            ],
+            "x-enum-varnames": [
+                "BrandingPositionTopLeft",
+                "BrandingPositionTopRight",
+                "BrandingPositionBottomLeft",
+                "BrandingPositionBottomRight",
+                "BrandingPositionCenter"
+            ]
+        },
+        "media.DownloadMetaData": {
             "type": "object",
             "properties": {
-                "code": {
+                "added_by": {
                     "type": "string"
                 },
-                "details": {},
-                "message": {
+                "alt_text": {
                     "type": "string"
                 },
-                "status": {
+                "content_hash": {
+                    "description": "ContentHash is the SHA-256 of the file, which is stored under it. It's empty for the files stored by name,\nlike thumbnails and media not processed yet.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "focal_x": {
+                    "description": "FocalX and FocalY are the point of an image kept in view when it's cropped, as fractions of its width and\nheight from the top left corner",
+                    "type": "number"
+                },
+                "focal_y": {
+                    "type": "number"
+                },
+                "folder": {
+                    "type": "string"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "frames": {
+                    "description": "of animated GIFs",
                     "type": "integer"
-                }
-            }
-        },
-        "handlers.addTimeSlotRequest": {
-            "type": "object",
-            "properties": {
-                "day_of_week": {
-                    "description": "time.Weekday",
+                },
+                "height": {
                     "type": "integer"
                 },
-                "hour": {
+                "id": {
+                    "type": "string"
+                },
+                "length": {
                     "type": "integer"
                 },
-                "minute": {
+                "media_type": {
+                    "$ref": "#/definitions/media.MediaType"
+                },
+                "metadata_stripped": {
+                    "description": "MetadataStripped tells whether the metadata of the file, like the location of photos, were removed",
+                    "type": "boolean"
+                },
+                "pages": {
+                    "description": "of documents",
                     "type": "integer"
-                }
-            }
-        },
-        "handlers.addUserRequest": {
-            "type": "object",
-            "properties": {
-                "email": {
+                },
+                "post_id": {
+                    "description": "Empty for the media of the project library",
                     "type": "string"
-                }
-            }
-        },
-        "handlers.assignRoleRequest": {
-            "type": "object",
-            "properties": {
-                "role_id": {
+                },
+                "processing_error": {
+                    "description": "ProcessingError is why the processing of the media failed",
                     "type": "string"
                 },
-                "user_id": {
+                "project_id": {
+                    "type": "string"
+                },
+                "size": {
+                    "description": "in bytes",
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/media.MediaStatus"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                },
+                "url_thumbnail": {
                     "type": "string"
+                },
+                "width": {
+                    "type": "integer"
                 }
             }
         },
-        "handlers.authenticationRequest": {
+        "media.GCReport": {
             "type": "object",
             "properties": {
-                "params": {
-                    "type": "object",
-                    "additionalProperties": true
+                "deleted": {
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "missing": {
+                    "description": "Missing is the metadata whose file isn't in the object store",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/media.MetaData"
+                    }
+                },
+                "orphans": {
+                    "description": "Orphans are the files without metadata, older than the grace period",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/media.ObjectInfo"
+                    }
+                },
+                "scanned": {
+                    "type": "integer"
                 }
             }
         },
-        "handlers.createPostRequest": {
+        "media.LibraryMedia": {
             "type": "object",
             "properties": {
-                "is_idea": {
+                "added_by": {
+                    "type": "string"
+                },
+                "alt_text": {
+                    "type": "string"
+                },
+                "content_hash": {
+                    "description": "ContentHash is the SHA-256 of the file, which is stored under it. It's empty for the files stored by name,\nlike thumbnails and media not processed yet.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "focal_x": {
+                    "description": "FocalX and FocalY are the point of an image kept in view when it's cropped, as fractions of its width and\nheight from the top left corner",
+                    "type": "number"
+                },
+                "focal_y": {
+                    "type": "number"
+                },
+                "folder": {
+                    "type": "string"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "frames": {
+                    "description": "of animated GIFs",
+                    "type": "integer"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "length": {
+                    "type": "integer"
+                },
+                "media_type": {
+                    "$ref": "#/definitions/media.MediaType"
+                },
+                "metadata_stripped": {
+                    "description": "MetadataStripped tells whether the metadata of the file, like the location of photos, were removed",
                     "type": "boolean"
                 },
-                "scheduled_at": {
+                "pages": {
+                    "description": "of documents",
+                    "type": "integer"
+                },
+                "post_id": {
+                    "description": "Empty for the media of the project library",
                     "type": "string"
                 },
-                "text_content": {
+                "processing_error": {
+                    "description": "ProcessingError is why the processing of the media failed",
                     "type": "string"
                 },
-                "title": {
+                "project_id": {
                     "type": "string"
                 },
-                "type": {
+                "size": {
+                    "description": "in bytes",
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/media.MediaStatus"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                },
+                "url_thumbnail": {
                     "type": "string"
+                },
+                "usage_count": {
+                    "description": "UsageCount is the number of post platforms the media is attached to. Media in use can't be deleted.",
+                    "type": "integer"
+                },
+                "width": {
+                    "type": "integer"
                 }
             }
         },
-        "handlers.createProjectRequest": {
+        "media.MediaStatus": {
+            "type": "string",
+            "enum": [
+                "processing",
+                "ready",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "MediaStatusProcessing",
+                "MediaStatusReady",
+                "MediaStatusFailed"
+            ]
+        },
+        "media.MediaType": {
+            "type": "string",
+            "enum": [
+                "image",
+                "video",
+                "short_video",
+                "document",
+                "animated_gif"
+            ],
+            "x-enum-varnames": [
+                "MediaTypeImage",
+                "MediaTypeVideo",
+                "MediaTypeShortVideo",
+                "MediaTypeDocument",
+                "MediaTypeAnimatedGIF"
+            ]
+        },
+        "media.MetaData": {
             "type": "object",
             "properties": {
-                "description": {
+                "added_by": {
                     "type": "string"
                 },
-                "name": {
+                "alt_text": {
                     "type": "string"
-                }
-            }
-        },
-        "handlers.createUserRequest": {
-            "type": "object",
-            "properties": {
-                "email": {
+                },
+                "content_hash": {
+                    "description": "ContentHash is the SHA-256 of the file, which is stored under it. It's empty for the files stored by name,\nlike thumbnails and media not processed yet.",
                     "type": "string"
                 },
-                "first_name": {
+                "created_at": {
                     "type": "string"
                 },
-                "last_name": {
+                "filename": {
                     "type": "string"
                 },
-                "password": {
+                "focal_x": {
+                    "description": "FocalX and FocalY are the point of an image kept in view when it's cropped, as fractions of its width and\nheight from the top left corner",
+                    "type": "number"
+                },
+                "focal_y": {
+                    "type": "number"
+                },
+                "folder": {
                     "type": "string"
                 },
-                "username": {
+                "format": {
+                    "type": "string"
+                },
+                "frames": {
+                    "description": "of animated GIFs",
+                    "type": "integer"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "length": {
+                    "type": "integer"
+                },
+                "media_type": {
+                    "$ref": "#/definitions/media.MediaType"
+                },
+                "metadata_stripped": {
+                    "description": "MetadataStripped tells whether the metadata of the file, like the location of photos, were removed",
+                    "type": "boolean"
+                },
+                "pages": {
+                    "description": "of documents",
+                    "type": "integer"
+                },
+                "post_id": {
+                    "description": "Empty for the media of the project library",
+                    "type": "string"
+                },
+                "processing_error": {
+                    "description": "ProcessingError is why the processing of the media failed",
                     "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "size": {
+                    "description": "in bytes",
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/media.MediaStatus"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "width": {
+                    "type": "integer"
                 }
             }
         },
-        "handlers.loginRequest": {
+        "media.ObjectInfo": {
             "type": "object",
             "properties": {
-                "email": {
+                "content_hash": {
+                    "description": "set for blobs, which have no post nor file name",
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "last_modified": {
+                    "type": "string"
+                },
+                "post_id": {
                     "type": "string"
                 },
-                "password": {
+                "project_id": {
                     "type": "string"
-                }
-            }
-        },
-        "handlers.moveInQueueRequest": {
-            "type": "object",
-            "properties": {
-                "current_index": {
-                    "type": "integer"
                 },
-                "new_index": {
+                "size": {
                     "type": "integer"
                 }
             }
         },
-        "handlers.schedulePostRequest": {
+        "media.ProcessingStatus": {
             "type": "object",
             "properties": {
-                "scheduled_at": {
+                "error": {
                     "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/media.MediaStatus"
                 }
             }
         },
-        "media.DownloadMetaData": {
+        "media.Upload": {
             "type": "object",
             "properties": {
-                "added_by": {
-                    "type": "string"
-                },
                 "alt_text": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "filename": {
+                "created_by": {
                     "type": "string"
                 },
-                "format": {
+                "expires_at": {
                     "type": "string"
                 },
-                "height": {
-                    "type": "integer"
-                },
-                "id": {
+                "file_name": {
                     "type": "string"
                 },
-                "length": {
-                    "type": "integer"
+                "folder": {
+                    "type": "string"
                 },
-                "media_type": {
-                    "$ref": "#/definitions/media.MediaType"
+                "id": {
+                    "type": "string"
                 },
                 "post_id": {
+                    "description": "Empty for uploads to the project library",
+                    "type": "string"
+                },
+                "project_id": {
                     "type": "string"
                 },
                 "size": {
-                    "description": "in bytes",
+                    "description": "in bytes, the file can't be bigger",
                     "type": "integer"
                 },
-                "url": {
-                    "type": "string"
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "url_thumbnail": {
+                "url": {
+                    "description": "URL is where the file must be sent with a PUT request. It's only returned when the upload is initiated.",
                     "type": "string"
-                },
-                "width": {
-                    "type": "integer"
                 }
             }
         },
-        "media.MediaType": {
+        "notification.Kind": {
             "type": "string",
             "enum": [
-                "image",
-                "video",
-                "short_video",
-                "document"
+                "post_invalid",
+                "post_validation_warning"
             ],
             "x-enum-varnames": [
-                "MediaTypeImage",
-                "MediaTypeVideo",
-                "MediaTypeShortVideo",
-                "MediaTypeDocument"
+                "KindPostInvalid",
+                "KindPostValidationWarning"
             ]
         },
+        "notification.Notification": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_read": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "$ref": "#/definitions/notification.Kind"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "post_id": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "post.NamedQueue": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
         "post.Platform": {
             "type": "object",
             "properties": {
@@ -3694,12 +9307,19 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "idea_id": {
+                    "description": "Idea the post was promoted from",
+                    "type": "string"
+                },
                 "is_idea": {
                     "type": "boolean"
                 },
                 "project_id": {
                     "type": "string"
                 },
+                "published_at": {
+                    "type": "string"
+                },
                 "scheduled_at": {
                     "type": "string"
                 },
@@ -3717,6 +9337,33 @@ const docTemplate = `{
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "version": {
+                    "description": "Incremented on every edit, used as ETag",
+                    "type": "integer"
+                }
+            }
+        },
+        "post.PostPage": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/post.Post"
+                    }
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
@@ -3732,6 +9379,10 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "idea_id": {
+                    "description": "Idea the post was promoted from",
+                    "type": "string"
+                },
                 "is_idea": {
                     "type": "boolean"
                 },
@@ -3744,6 +9395,9 @@ const docTemplate = `{
                 "project_id": {
                     "type": "string"
                 },
+                "published_at": {
+                    "type": "string"
+                },
                 "scheduled_at": {
                     "type": "string"
                 },
@@ -3761,6 +9415,10 @@ const docTemplate = `{
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "version": {
+                    "description": "Incremented on every edit, used as ETag",
+                    "type": "integer"
                 }
             }
         },
@@ -3799,6 +9457,10 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "idea_id": {
+                    "description": "Idea the post was promoted from",
+                    "type": "string"
+                },
                 "is_idea": {
                     "type": "boolean"
                 },
@@ -3817,6 +9479,9 @@ const docTemplate = `{
                 "publish_status": {
                     "type": "string"
                 },
+                "published_at": {
+                    "type": "string"
+                },
                 "scheduled_at": {
                     "type": "string"
                 },
@@ -3838,9 +9503,47 @@ const docTemplate = `{
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "version": {
+                    "description": "Incremented on every edit, used as ETag",
+                    "type": "integer"
+                }
+            }
+        },
+        "post.TrashedPost": {
+            "type": "object",
+            "properties": {
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/post.PostType"
                 }
             }
         },
+        "project.PreflightMode": {
+            "type": "string",
+            "enum": [
+                "strict",
+                "warn"
+            ],
+            "x-enum-varnames": [
+                "PreflightModeStrict",
+                "PreflightModeWarn"
+            ]
+        },
         "project.Project": {
             "type": "object",
             "properties": {
@@ -3873,6 +9576,10 @@ const docTemplate = `{
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "version": {
+                    "description": "Incremented on every edit, used as ETag",
+                    "type": "integer"
                 }
             }
         },
@@ -3900,6 +9607,10 @@ const docTemplate = `{
                 },
                 "minute": {
                     "type": "integer"
+                },
+                "queue": {
+                    "description": "Queue the slot draws posts from, the default queue when empty",
+                    "type": "string"
                 }
             }
         },
@@ -3927,9 +9638,24 @@ const docTemplate = `{
                     "description": "5 minutes in nanoseconds",
                     "type": "integer",
                     "example": 300000000
+                },
+                "version": {
+                    "description": "Incremented on every change, used as ETag",
+                    "type": "integer"
                 }
             }
         },
+        "publisher.IssueSeverity": {
+            "type": "string",
+            "enum": [
+                "error",
+                "warning"
+            ],
+            "x-enum-varnames": [
+                "SeverityError",
+                "SeverityWarning"
+            ]
+        },
         "publisher.Platform": {
             "type": "object",
             "properties": {
@@ -3970,6 +9696,40 @@ const docTemplate = `{
                 }
             }
         },
+        "publisher.ValidationIssue": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "severity": {
+                    "$ref": "#/definitions/publisher.IssueSeverity"
+                }
+            }
+        },
+        "publisher.ValidationReport": {
+            "type": "object",
+            "properties": {
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/publisher.ValidationIssue"
+                    }
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
+        },
         "session.Session": {
             "type": "object",
             "properties": {
@@ -3993,6 +9753,73 @@ const docTemplate = `{
                 }
             }
         },
+        "template.Snippet": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "template.Template": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "platforms": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "text_content": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "user.AppRole": {
             "type": "object",
             "properties": {
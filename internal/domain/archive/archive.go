@@ -0,0 +1,268 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/project"
+)
+
+const (
+	// ManifestVersion is bumped whenever the manifest changes in a way older importers can't read
+	ManifestVersion = 2
+	manifestFile    = "manifest.json"
+	mediaDir        = "media"
+	// maxMediaFileSize is the largest media file read from an archive
+	maxMediaFileSize = 1 << 30
+)
+
+var (
+	ErrInvalidArchive            = errors.New("invalid project archive")
+	ErrUnsupportedArchiveVersion = errors.New("unsupported project archive version")
+	ErrUnknownPlatform           = errors.New("archive uses a platform that is not available")
+)
+
+// Manifest describes everything a project archive holds except the media files themselves.
+// Users and platform secrets are environment specific and never exported.
+type Manifest struct {
+	Version    int          `json:"version"`
+	ExportedAt time.Time    `json:"exported_at"`
+	Project    *ProjectData `json:"project"`
+	Labels     []*LabelData `json:"labels"`
+	Posts      []*PostData  `json:"posts"`
+	Media      []*MediaData `json:"media"`
+	// MediaLinks are the media each platform of a post publishes, from the post or from the library
+	MediaLinks []*MediaLinkData `json:"media_links"`
+}
+
+type ProjectData struct {
+	Name          string                      `json:"name"`
+	Description   string                      `json:"description"`
+	PostQueue     []string                    `json:"post_queue"`
+	IdeaQueue     []string                    `json:"idea_queue"`
+	Queues        map[string][]string         `json:"queues,omitempty"` // Named post queues
+	Schedule      *project.WeeklyPostSchedule `json:"schedule"`
+	PreflightMode project.PreflightMode       `json:"preflight_mode"`
+	Platforms     []string                    `json:"platforms"` // Enabled platforms, without their secrets
+}
+
+type LabelData struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type PostPlatformData struct {
+	PlatformID  string   `json:"platform_id"`
+	Status      string   `json:"status"`
+	ProfileTags []string `json:"profile_tags"`
+}
+
+type PostData struct {
+	ID          string              `json:"id"`
+	Title       string              `json:"title"`
+	Type        string              `json:"type"`
+	TextContent string              `json:"text_content"`
+	IsIdea      bool                `json:"is_idea"`
+	Status      string              `json:"status"`
+	ScheduledAt *time.Time          `json:"scheduled_at,omitempty"`
+	PublishedAt *time.Time          `json:"published_at,omitempty"`
+	IdeaID      *string             `json:"idea_id,omitempty"` // Idea the post was promoted from
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+	Platforms   []*PostPlatformData `json:"platforms"`
+	LabelIDs    []string            `json:"label_ids"`
+}
+
+type MediaData struct {
+	ID        string          `json:"id"`
+	PostID    string          `json:"post_id,omitempty"` // Empty for the media of the project library
+	Filename  string          `json:"filename"`
+	Type      media.MediaType `json:"media_type"`
+	Format    string          `json:"format"`
+	Width     int             `json:"width"`
+	Height    int             `json:"height"`
+	Length    int             `json:"length"`
+	Size      int             `json:"size"`
+	AltText   string          `json:"alt_text"`
+	CreatedAt time.Time       `json:"created_at"`
+	Folder    string          `json:"folder,omitempty"`
+	Tags      []string        `json:"tags,omitempty"`
+	Platforms []string        `json:"platforms,omitempty"` // Platforms of its post the media is linked to, in version 1 archives
+	Path      string          `json:"path"`                // Location of the file inside the archive
+	// ContentHash is where the file is in the object store, it's computed again on import
+	ContentHash string `json:"-"`
+}
+
+type MediaLinkData struct {
+	MediaID    string `json:"media_id"`
+	PostID     string `json:"post_id"`
+	PlatformID string `json:"platform_id"`
+}
+
+// MediaPath is where the file of a media is stored inside the archive
+func MediaPath(md *MediaData) string {
+	return path.Join(mediaDir, md.ID, md.Filename)
+}
+
+// MetaData returns the media metadata of the file, added by the given user
+func (md *MediaData) MetaData(userID string) *media.MetaData {
+	return &media.MetaData{
+		ID:          md.ID,
+		PostID:      md.PostID,
+		Filename:    md.Filename,
+		Type:        md.Type,
+		Format:      md.Format,
+		Width:       md.Width,
+		Height:      md.Height,
+		Length:      md.Length,
+		Size:        md.Size,
+		AltText:     md.AltText,
+		Folder:      md.Folder,
+		Tags:        md.Tags,
+		AddedBy:     userID,
+		CreatedAt:   md.CreatedAt,
+		ContentHash: md.ContentHash,
+		FocalX:      media.DefaultFocalPoint,
+		FocalY:      media.DefaultFocalPoint,
+	}
+}
+
+// upgrade reads a manifest of an older version as one of the current version. Version 1 archives had no library
+// media, and listed the links of each media to the platforms of its post.
+func (m *Manifest) upgrade() {
+	if m.Version != 1 {
+		return
+	}
+	for _, md := range m.Media {
+		for _, platformID := range md.Platforms {
+			m.MediaLinks = append(m.MediaLinks, &MediaLinkData{MediaID: md.ID, PostID: md.PostID, PlatformID: platformID})
+		}
+		md.Platforms = nil
+	}
+	m.Version = ManifestVersion
+}
+
+// validate checks the manifest references are consistent and every media file is in the archive
+func (m *Manifest) validate(files fs.FS) error {
+	if m.Version != ManifestVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedArchiveVersion, m.Version)
+	}
+	if m.Project == nil || m.Project.Name == "" {
+		return fmt.Errorf("%w: missing project", ErrInvalidArchive)
+	}
+	for name := range m.Project.Queues {
+		if err := post.ValidateQueueName(name); err != nil {
+			return fmt.Errorf("%w: invalid queue name %q", ErrInvalidArchive, name)
+		}
+	}
+
+	labels := make(map[string]bool)
+	for _, l := range m.Labels {
+		labels[l.ID] = true
+	}
+	posts := make(map[string]*PostData)
+	for _, p := range m.Posts {
+		if p.ID == "" || posts[p.ID] != nil {
+			return fmt.Errorf("%w: duplicated or missing post id %q", ErrInvalidArchive, p.ID)
+		}
+		posts[p.ID] = p
+		for _, pp := range p.Platforms {
+			if !slices.Contains(m.Project.Platforms, pp.PlatformID) {
+				return fmt.Errorf("%w: post %s uses platform %q which is not enabled", ErrInvalidArchive, p.ID, pp.PlatformID)
+			}
+		}
+		for _, labelID := range p.LabelIDs {
+			if !labels[labelID] {
+				return fmt.Errorf("%w: post %s has unknown label %q", ErrInvalidArchive, p.ID, labelID)
+			}
+		}
+	}
+	mediaIDs := make(map[string]bool)
+	for _, md := range m.Media {
+		if md.ID == "" || mediaIDs[md.ID] {
+			return fmt.Errorf("%w: duplicated or missing media id %q", ErrInvalidArchive, md.ID)
+		}
+		mediaIDs[md.ID] = true
+		if _, ok := posts[md.PostID]; md.PostID != "" && !ok {
+			return fmt.Errorf("%w: media %s belongs to unknown post %q", ErrInvalidArchive, md.ID, md.PostID)
+		}
+		if _, err := fs.Stat(files, md.Path); err != nil {
+			return fmt.Errorf("%w: missing media file %q", ErrInvalidArchive, md.Path)
+		}
+	}
+	for _, l := range m.MediaLinks {
+		if !mediaIDs[l.MediaID] {
+			return fmt.Errorf("%w: link to unknown media %q", ErrInvalidArchive, l.MediaID)
+		}
+		p, ok := posts[l.PostID]
+		if !ok {
+			return fmt.Errorf("%w: media %s is linked to unknown post %q", ErrInvalidArchive, l.MediaID, l.PostID)
+		}
+		if !slices.ContainsFunc(p.Platforms, func(pp *PostPlatformData) bool { return pp.PlatformID == l.PlatformID }) {
+			return fmt.Errorf("%w: media %s is linked to platform %q which post %s doesn't use", ErrInvalidArchive, l.MediaID, l.PlatformID, l.PostID)
+		}
+	}
+	return nil
+}
+
+// remapIDs gives new ids to the posts, labels and media of the manifest and updates every reference to them.
+// Queued ids of posts that aren't in the archive are dropped.
+func (m *Manifest) remapIDs() {
+	postIDs := make(map[string]string)
+	for _, p := range m.Posts {
+		postIDs[p.ID] = uuid.New().String()
+		p.ID = postIDs[p.ID]
+	}
+	labelIDs := make(map[string]string)
+	for _, l := range m.Labels {
+		labelIDs[l.ID] = uuid.New().String()
+		l.ID = labelIDs[l.ID]
+	}
+	for _, p := range m.Posts {
+		for i, labelID := range p.LabelIDs {
+			p.LabelIDs[i] = labelIDs[labelID]
+		}
+		if p.IdeaID != nil {
+			if newID, ok := postIDs[*p.IdeaID]; ok {
+				p.IdeaID = &newID
+			} else {
+				p.IdeaID = nil
+			}
+		}
+	}
+	mediaIDs := make(map[string]string)
+	for _, md := range m.Media {
+		mediaIDs[md.ID] = uuid.New().String()
+		md.ID = mediaIDs[md.ID]
+		if md.PostID != "" {
+			md.PostID = postIDs[md.PostID]
+		}
+	}
+	for _, l := range m.MediaLinks {
+		l.MediaID = mediaIDs[l.MediaID]
+		l.PostID = postIDs[l.PostID]
+	}
+
+	remapQueue := func(queue []string) []string {
+		remapped := make([]string, 0, len(queue))
+		for _, id := range queue {
+			if newID, ok := postIDs[id]; ok {
+				remapped = append(remapped, newID)
+			}
+		}
+		return remapped
+	}
+	m.Project.PostQueue = remapQueue(m.Project.PostQueue)
+	m.Project.IdeaQueue = remapQueue(m.Project.IdeaQueue)
+	for name, queue := range m.Project.Queues {
+		m.Project.Queues[name] = remapQueue(queue)
+	}
+}
@@ -0,0 +1,496 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package archive
+
+import (
+	context "context"
+
+	project "github.com/redplanettribe/social-media-manager/internal/domain/project"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// DoesProjectNameExist provides a mock function with given fields: ctx, name, userID
+func (_m *MockRepository) DoesProjectNameExist(ctx context.Context, name string, userID string) (bool, error) {
+	ret := _m.Called(ctx, name, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DoesProjectNameExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, name, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, name, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_DoesProjectNameExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoesProjectNameExist'
+type MockRepository_DoesProjectNameExist_Call struct {
+	*mock.Call
+}
+
+// DoesProjectNameExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - userID string
+func (_e *MockRepository_Expecter) DoesProjectNameExist(ctx interface{}, name interface{}, userID interface{}) *MockRepository_DoesProjectNameExist_Call {
+	return &MockRepository_DoesProjectNameExist_Call{Call: _e.mock.On("DoesProjectNameExist", ctx, name, userID)}
+}
+
+func (_c *MockRepository_DoesProjectNameExist_Call) Run(run func(ctx context.Context, name string, userID string)) *MockRepository_DoesProjectNameExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DoesProjectNameExist_Call) Return(_a0 bool, _a1 error) *MockRepository_DoesProjectNameExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_DoesProjectNameExist_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_DoesProjectNameExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLabels provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindLabels(ctx context.Context, projectID string) ([]*LabelData, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLabels")
+	}
+
+	var r0 []*LabelData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*LabelData, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*LabelData); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*LabelData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLabels'
+type MockRepository_FindLabels_Call struct {
+	*mock.Call
+}
+
+// FindLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) FindLabels(ctx interface{}, projectID interface{}) *MockRepository_FindLabels_Call {
+	return &MockRepository_FindLabels_Call{Call: _e.mock.On("FindLabels", ctx, projectID)}
+}
+
+func (_c *MockRepository_FindLabels_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindLabels_Call) Return(_a0 []*LabelData, _a1 error) *MockRepository_FindLabels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindLabels_Call) RunAndReturn(run func(context.Context, string) ([]*LabelData, error)) *MockRepository_FindLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMedia provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindMedia(ctx context.Context, projectID string) ([]*MediaData, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMedia")
+	}
+
+	var r0 []*MediaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*MediaData, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*MediaData); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*MediaData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMedia'
+type MockRepository_FindMedia_Call struct {
+	*mock.Call
+}
+
+// FindMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) FindMedia(ctx interface{}, projectID interface{}) *MockRepository_FindMedia_Call {
+	return &MockRepository_FindMedia_Call{Call: _e.mock.On("FindMedia", ctx, projectID)}
+}
+
+func (_c *MockRepository_FindMedia_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindMedia_Call) Return(_a0 []*MediaData, _a1 error) *MockRepository_FindMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindMedia_Call) RunAndReturn(run func(context.Context, string) ([]*MediaData, error)) *MockRepository_FindMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMediaLinks provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindMediaLinks(ctx context.Context, projectID string) ([]*MediaLinkData, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMediaLinks")
+	}
+
+	var r0 []*MediaLinkData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*MediaLinkData, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*MediaLinkData); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*MediaLinkData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindMediaLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMediaLinks'
+type MockRepository_FindMediaLinks_Call struct {
+	*mock.Call
+}
+
+// FindMediaLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) FindMediaLinks(ctx interface{}, projectID interface{}) *MockRepository_FindMediaLinks_Call {
+	return &MockRepository_FindMediaLinks_Call{Call: _e.mock.On("FindMediaLinks", ctx, projectID)}
+}
+
+func (_c *MockRepository_FindMediaLinks_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindMediaLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindMediaLinks_Call) Return(_a0 []*MediaLinkData, _a1 error) *MockRepository_FindMediaLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindMediaLinks_Call) RunAndReturn(run func(context.Context, string) ([]*MediaLinkData, error)) *MockRepository_FindMediaLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPosts provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindPosts(ctx context.Context, projectID string) ([]*PostData, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPosts")
+	}
+
+	var r0 []*PostData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*PostData, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*PostData); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*PostData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPosts'
+type MockRepository_FindPosts_Call struct {
+	*mock.Call
+}
+
+// FindPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) FindPosts(ctx interface{}, projectID interface{}) *MockRepository_FindPosts_Call {
+	return &MockRepository_FindPosts_Call{Call: _e.mock.On("FindPosts", ctx, projectID)}
+}
+
+func (_c *MockRepository_FindPosts_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindPosts_Call) Return(_a0 []*PostData, _a1 error) *MockRepository_FindPosts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindPosts_Call) RunAndReturn(run func(context.Context, string) ([]*PostData, error)) *MockRepository_FindPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindProject provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindProject(ctx context.Context, projectID string) (*ProjectData, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindProject")
+	}
+
+	var r0 *ProjectData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*ProjectData, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *ProjectData); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ProjectData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindProject'
+type MockRepository_FindProject_Call struct {
+	*mock.Call
+}
+
+// FindProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) FindProject(ctx interface{}, projectID interface{}) *MockRepository_FindProject_Call {
+	return &MockRepository_FindProject_Call{Call: _e.mock.On("FindProject", ctx, projectID)}
+}
+
+func (_c *MockRepository_FindProject_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindProject_Call) Return(_a0 *ProjectData, _a1 error) *MockRepository_FindProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindProject_Call) RunAndReturn(run func(context.Context, string) (*ProjectData, error)) *MockRepository_FindProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlatformIDs provides a mock function with given fields: ctx
+func (_m *MockRepository) GetPlatformIDs(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlatformIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetPlatformIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlatformIDs'
+type MockRepository_GetPlatformIDs_Call struct {
+	*mock.Call
+}
+
+// GetPlatformIDs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) GetPlatformIDs(ctx interface{}) *MockRepository_GetPlatformIDs_Call {
+	return &MockRepository_GetPlatformIDs_Call{Call: _e.mock.On("GetPlatformIDs", ctx)}
+}
+
+func (_c *MockRepository_GetPlatformIDs_Call) Run(run func(ctx context.Context)) *MockRepository_GetPlatformIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetPlatformIDs_Call) Return(_a0 []string, _a1 error) *MockRepository_GetPlatformIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetPlatformIDs_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockRepository_GetPlatformIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveProject provides a mock function with given fields: ctx, p, m
+func (_m *MockRepository) SaveProject(ctx context.Context, p *project.Project, m *Manifest) error {
+	ret := _m.Called(ctx, p, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *project.Project, *Manifest) error); ok {
+		r0 = rf(ctx, p, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SaveProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProject'
+type MockRepository_SaveProject_Call struct {
+	*mock.Call
+}
+
+// SaveProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p *project.Project
+//   - m *Manifest
+func (_e *MockRepository_Expecter) SaveProject(ctx interface{}, p interface{}, m interface{}) *MockRepository_SaveProject_Call {
+	return &MockRepository_SaveProject_Call{Call: _e.mock.On("SaveProject", ctx, p, m)}
+}
+
+func (_c *MockRepository_SaveProject_Call) Run(run func(ctx context.Context, p *project.Project, m *Manifest)) *MockRepository_SaveProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*project.Project), args[2].(*Manifest))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveProject_Call) Return(_a0 error) *MockRepository_SaveProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SaveProject_Call) RunAndReturn(run func(context.Context, *project.Project, *Manifest) error) *MockRepository_SaveProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,148 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package archive
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	project "github.com/redplanettribe/social-media-manager/internal/domain/project"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// ExportProject provides a mock function with given fields: ctx, projectID, w
+func (_m *MockService) ExportProject(ctx context.Context, projectID string, w io.Writer) error {
+	ret := _m.Called(ctx, projectID, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Writer) error); ok {
+		r0 = rf(ctx, projectID, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_ExportProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportProject'
+type MockService_ExportProject_Call struct {
+	*mock.Call
+}
+
+// ExportProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - w io.Writer
+func (_e *MockService_Expecter) ExportProject(ctx interface{}, projectID interface{}, w interface{}) *MockService_ExportProject_Call {
+	return &MockService_ExportProject_Call{Call: _e.mock.On("ExportProject", ctx, projectID, w)}
+}
+
+func (_c *MockService_ExportProject_Call) Run(run func(ctx context.Context, projectID string, w io.Writer)) *MockService_ExportProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockService_ExportProject_Call) Return(_a0 error) *MockService_ExportProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_ExportProject_Call) RunAndReturn(run func(context.Context, string, io.Writer) error) *MockService_ExportProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportProject provides a mock function with given fields: ctx, r, size, name
+func (_m *MockService) ImportProject(ctx context.Context, r io.ReaderAt, size int64, name string) (*project.Project, error) {
+	ret := _m.Called(ctx, r, size, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportProject")
+	}
+
+	var r0 *project.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.ReaderAt, int64, string) (*project.Project, error)); ok {
+		return rf(ctx, r, size, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, io.ReaderAt, int64, string) *project.Project); ok {
+		r0 = rf(ctx, r, size, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*project.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, io.ReaderAt, int64, string) error); ok {
+		r1 = rf(ctx, r, size, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ImportProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportProject'
+type MockService_ImportProject_Call struct {
+	*mock.Call
+}
+
+// ImportProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r io.ReaderAt
+//   - size int64
+//   - name string
+func (_e *MockService_Expecter) ImportProject(ctx interface{}, r interface{}, size interface{}, name interface{}) *MockService_ImportProject_Call {
+	return &MockService_ImportProject_Call{Call: _e.mock.On("ImportProject", ctx, r, size, name)}
+}
+
+func (_c *MockService_ImportProject_Call) Run(run func(ctx context.Context, r io.ReaderAt, size int64, name string)) *MockService_ImportProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.ReaderAt), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ImportProject_Call) Return(_a0 *project.Project, _a1 error) *MockService_ImportProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ImportProject_Call) RunAndReturn(run func(context.Context, io.ReaderAt, int64, string) (*project.Project, error)) *MockService_ImportProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,22 @@
+package archive
+
+import (
+	"context"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/project"
+)
+
+type Repository interface {
+	// FindProject returns nil when the project doesn't exist
+	FindProject(ctx context.Context, projectID string) (*ProjectData, error)
+	FindLabels(ctx context.Context, projectID string) ([]*LabelData, error)
+	FindPosts(ctx context.Context, projectID string) ([]*PostData, error)
+	// FindMedia returns the media of the posts out of the trash, and of the library
+	FindMedia(ctx context.Context, projectID string) ([]*MediaData, error)
+	// FindMediaLinks returns the links between the media and the platforms of the posts FindMedia and FindPosts return
+	FindMediaLinks(ctx context.Context, projectID string) ([]*MediaLinkData, error)
+	DoesProjectNameExist(ctx context.Context, name, userID string) (bool, error)
+	GetPlatformIDs(ctx context.Context) ([]string, error)
+	// SaveProject creates the project, with the user as owner, and everything in the manifest in a single transaction
+	SaveProject(ctx context.Context, p *project.Project, m *Manifest) error
+}
@@ -0,0 +1,237 @@
+package archive
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/project"
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+)
+
+type Service interface {
+	// ExportProject writes a zip archive with the project manifest and its media files
+	ExportProject(ctx context.Context, projectID string, w io.Writer) error
+	// ImportProject recreates an exported project under a new id, owned by the current user.
+	// The archive name is kept unless a new one is given.
+	ImportProject(ctx context.Context, r io.ReaderAt, size int64, name string) (*project.Project, error)
+}
+
+type service struct {
+	repo       Repository
+	objectRepo media.ObjectRepository
+}
+
+func NewService(repo Repository, objectRepo media.ObjectRepository) Service {
+	return &service{
+		repo:       repo,
+		objectRepo: objectRepo,
+	}
+}
+
+func (s *service) ExportProject(ctx context.Context, projectID string, w io.Writer) error {
+	m, err := s.buildManifest(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+	mf, err := zw.Create(manifestFile)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(mf)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(m); err != nil {
+		return err
+	}
+
+	for _, md := range m.Media {
+		body, err := s.getFile(ctx, projectID, md)
+		if err != nil {
+			return fmt.Errorf("failed to get media file %s: %w", md.Filename, err)
+		}
+		f, err := zw.Create(md.Path)
+		if err == nil {
+			_, err = io.Copy(f, body)
+		}
+		body.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// getFile streams the file of a media, from its blob or by name for the media stored before content hashes
+func (s *service) getFile(ctx context.Context, projectID string, md *MediaData) (io.ReadCloser, error) {
+	if md.ContentHash == "" {
+		return s.objectRepo.GetFile(ctx, projectID, md.PostID, md.Filename)
+	}
+	return s.objectRepo.GetBlob(ctx, projectID, md.ContentHash)
+}
+
+func (s *service) buildManifest(ctx context.Context, projectID string) (*Manifest, error) {
+	p, err := s.repo.FindProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, project.ErrProjectNotFound
+	}
+
+	labels, err := s.repo.FindLabels(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := s.repo.FindPosts(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	mediaData, err := s.repo.FindMedia(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, md := range mediaData {
+		md.Path = MediaPath(md)
+	}
+	links, err := s.repo.FindMediaLinks(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Manifest{
+		Version:    ManifestVersion,
+		ExportedAt: time.Now().UTC(),
+		Project:    p,
+		Labels:     labels,
+		Posts:      posts,
+		Media:      mediaData,
+		MediaLinks: links,
+	}, nil
+}
+
+func (s *service) ImportProject(ctx context.Context, r io.ReaderAt, size int64, name string) (*project.Project, error) {
+	userID, ok := ctx.Value(middlewares.UserIDKey).(string)
+	if !ok || userID == "" {
+		return nil, project.ErrNoUserIDInContext
+	}
+
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidArchive, err)
+	}
+	m, err := readManifest(zr)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.validate(zr); err != nil {
+		return nil, err
+	}
+	if name != "" {
+		m.Project.Name = name
+	}
+
+	exists, err := s.repo.DoesProjectNameExist(ctx, m.Project.Name, userID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, project.ErrProjectExists
+	}
+	available, err := s.repo.GetPlatformIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, platformID := range m.Project.Platforms {
+		if !slices.Contains(available, platformID) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownPlatform, platformID)
+		}
+	}
+
+	p, err := project.NewProject(m.Project.Name, m.Project.Description, userID)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidArchive, err)
+	}
+	m.remapIDs()
+	p.PostQueue = m.Project.PostQueue
+	p.IdeaQueue = m.Project.IdeaQueue
+	if m.Project.Schedule == nil {
+		m.Project.Schedule = project.NewWeeklyPostSchedule([]project.TimeSlot{})
+	}
+	if !m.Project.PreflightMode.IsValid() {
+		m.Project.PreflightMode = project.PreflightModeStrict
+	}
+
+	// Files are uploaded first, once per content, and removed if the project can't be saved
+	uploaded := map[string]bool{}
+	cleanup := func() {
+		for hash := range uploaded {
+			_ = s.objectRepo.DeleteBlob(context.WithoutCancel(ctx), p.ID, hash)
+		}
+	}
+	for _, md := range m.Media {
+		file, err := readFile(zr, md.Path)
+		if err == nil {
+			md.ContentHash, err = media.ContentHash(file)
+			if err == nil && !uploaded[md.ContentHash] {
+				err = s.objectRepo.UploadBlob(ctx, p.ID, md.ContentHash, file.Reader())
+				if err == nil {
+					uploaded[md.ContentHash] = true
+				}
+			}
+			_ = file.Close()
+		}
+		if err != nil {
+			cleanup()
+			return nil, err
+		}
+	}
+
+	err = s.repo.SaveProject(ctx, p, m)
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+	return p, nil
+}
+
+func readManifest(zr *zip.Reader) (*Manifest, error) {
+	f, err := zr.Open(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing %s", ErrInvalidArchive, manifestFile)
+	}
+	defer f.Close()
+
+	m := &Manifest{}
+	if err := json.NewDecoder(f).Decode(m); err != nil {
+		return nil, errors.Join(ErrInvalidArchive, err)
+	}
+	m.upgrade()
+	return m, nil
+}
+
+// readFile extracts a media file of the archive to a temporary file, which the caller must close
+func readFile(zr *zip.Reader, name string) (*media.File, error) {
+	f, err := zr.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: missing media file %q", ErrInvalidArchive, name)
+	}
+	defer f.Close()
+
+	file, err := media.NewFile(f, maxMediaFileSize)
+	if errors.Is(err, media.ErrFileTooLarge) {
+		return nil, fmt.Errorf("%w: media file %q is too large", ErrInvalidArchive, name)
+	}
+	if err != nil {
+		return nil, errors.Join(ErrInvalidArchive, err)
+	}
+	return file, nil
+}
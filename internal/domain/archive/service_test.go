@@ -0,0 +1,193 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/project"
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func exportTestProject(t *testing.T, ctx context.Context) []byte {
+	mockRepo := NewMockRepository(t)
+	mockObjectRepo := media.NewMockObjectRepository(t)
+	mockRepo.On("FindProject", ctx, "project-1").Return(&ProjectData{
+		Name:          "Launch",
+		Description:   "Product launch",
+		PostQueue:     []string{"post-1", "deleted-post"},
+		IdeaQueue:     []string{"post-2"},
+		Queues:        map[string][]string{"tips": {"deleted-post"}},
+		Schedule:      project.NewWeeklyPostSchedule([]project.TimeSlot{{DayOfWeek: time.Monday, Hour: 9}}),
+		PreflightMode: project.PreflightModeWarn,
+		Platforms:     []string{"linkedin"},
+	}, nil)
+	mockRepo.On("FindLabels", ctx, "project-1").Return([]*LabelData{{ID: "label-1", Name: "promo", Color: "#ff0000"}}, nil)
+	mockRepo.On("FindPosts", ctx, "project-1").Return([]*PostData{
+		{ID: "post-1", Title: "Queued", Status: "queued", Platforms: []*PostPlatformData{{PlatformID: "linkedin", Status: "ready"}}, LabelIDs: []string{"label-1"}},
+		{ID: "post-2", Title: "Idea", Status: "draft", IsIdea: true},
+	}, nil)
+	mockRepo.On("FindMedia", ctx, "project-1").Return([]*MediaData{
+		{ID: "media-1", PostID: "post-1", Filename: "cover.png", Type: media.MediaTypeImage, ContentHash: pngHash},
+		{ID: "media-2", Filename: "logo.png", Type: media.MediaTypeImage, Folder: "brand", Tags: []string{"logo"}},
+	}, nil)
+	mockRepo.On("FindMediaLinks", ctx, "project-1").Return([]*MediaLinkData{
+		{MediaID: "media-1", PostID: "post-1", PlatformID: "linkedin"},
+		{MediaID: "media-2", PostID: "post-1", PlatformID: "linkedin"},
+	}, nil)
+	mockObjectRepo.On("GetBlob", ctx, "project-1", pngHash).Return(io.NopCloser(strings.NewReader("png")), nil)
+	mockObjectRepo.On("GetFile", ctx, "project-1", "", "logo.png").Return(io.NopCloser(strings.NewReader("logo")), nil)
+
+	var buf bytes.Buffer
+	s := NewService(mockRepo, mockObjectRepo)
+	err := s.ExportProject(ctx, "project-1", &buf)
+	assert.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestExportProject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("writes the manifest and media files", func(t *testing.T) {
+		data := exportTestProject(t, ctx)
+
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		assert.NoError(t, err)
+		m, err := readManifest(zr)
+		assert.NoError(t, err)
+		assert.Equal(t, ManifestVersion, m.Version)
+		assert.Equal(t, "Launch", m.Project.Name)
+		assert.Len(t, m.Posts, 2)
+		assert.Equal(t, "media/media-1/cover.png", m.Media[0].Path)
+		assert.Equal(t, "", m.Media[1].PostID, "library media are exported")
+		assert.Len(t, m.MediaLinks, 2)
+
+		file, err := readFile(zr, m.Media[0].Path)
+		assert.NoError(t, err)
+		defer file.Close()
+		content, err := io.ReadAll(file.Reader())
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("png"), content)
+	})
+
+	t.Run("fails when the project doesn't exist", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockRepo.On("FindProject", ctx, "project-1").Return(nil, nil)
+
+		s := NewService(mockRepo, media.NewMockObjectRepository(t))
+		err := s.ExportProject(ctx, "project-1", &bytes.Buffer{})
+		assert.ErrorIs(t, err, project.ErrProjectNotFound)
+	})
+}
+
+func TestImportProject(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.UserIDKey, "user-1")
+
+	t.Run("recreates the project under new ids", func(t *testing.T) {
+		data := exportTestProject(t, ctx)
+		mockRepo := NewMockRepository(t)
+		mockObjectRepo := media.NewMockObjectRepository(t)
+		mockRepo.On("DoesProjectNameExist", ctx, "Launch copy", "user-1").Return(false, nil)
+		mockRepo.On("GetPlatformIDs", ctx).Return([]string{"linkedin", "x"}, nil)
+		mockObjectRepo.On("UploadBlob", ctx, mock.Anything, pngHash, hasContent("png")).Return(nil)
+		mockObjectRepo.On("UploadBlob", ctx, mock.Anything, mock.Anything, hasContent("logo")).Return(nil)
+
+		var saved *Manifest
+		mockRepo.On("SaveProject", ctx, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(2).(*Manifest)
+		}).Return(nil)
+
+		s := NewService(mockRepo, mockObjectRepo)
+		p, err := s.ImportProject(ctx, bytes.NewReader(data), int64(len(data)), "Launch copy")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Launch copy", p.Name)
+		assert.Equal(t, "user-1", p.CreatedBy)
+		assert.NotEqual(t, "post-1", saved.Posts[0].ID)
+		assert.Equal(t, []string{saved.Posts[0].ID}, p.PostQueue)
+		assert.Equal(t, []string{saved.Posts[1].ID}, p.IdeaQueue)
+		assert.Equal(t, []string{}, saved.Project.Queues["tips"])
+		assert.Equal(t, []string{saved.Labels[0].ID}, saved.Posts[0].LabelIDs)
+		assert.Equal(t, saved.Posts[0].ID, saved.Media[0].PostID)
+		assert.Equal(t, "", saved.Media[1].PostID)
+		assert.Equal(t, []string{"logo"}, saved.Media[1].Tags)
+		assert.Equal(t, &MediaLinkData{MediaID: saved.Media[1].ID, PostID: saved.Posts[0].ID, PlatformID: "linkedin"}, saved.MediaLinks[1])
+		assert.Equal(t, pngHash, saved.Media[0].ContentHash)
+		assert.Equal(t, project.PreflightModeWarn, saved.Project.PreflightMode)
+	})
+
+	t.Run("fails when the name is taken", func(t *testing.T) {
+		data := exportTestProject(t, ctx)
+		mockRepo := NewMockRepository(t)
+		mockRepo.On("DoesProjectNameExist", ctx, "Launch", "user-1").Return(true, nil)
+
+		s := NewService(mockRepo, media.NewMockObjectRepository(t))
+		_, err := s.ImportProject(ctx, bytes.NewReader(data), int64(len(data)), "")
+		assert.ErrorIs(t, err, project.ErrProjectExists)
+	})
+
+	t.Run("removes uploaded files when the project can't be saved", func(t *testing.T) {
+		data := exportTestProject(t, ctx)
+		mockRepo := NewMockRepository(t)
+		mockObjectRepo := media.NewMockObjectRepository(t)
+		mockRepo.On("DoesProjectNameExist", ctx, "Launch", "user-1").Return(false, nil)
+		mockRepo.On("GetPlatformIDs", ctx).Return([]string{"linkedin"}, nil)
+		mockObjectRepo.On("UploadBlob", ctx, mock.Anything, pngHash, hasContent("png")).Return(nil)
+		mockObjectRepo.On("UploadBlob", ctx, mock.Anything, mock.Anything, hasContent("logo")).Return(nil)
+		mockRepo.On("SaveProject", ctx, mock.Anything, mock.Anything).Return(assert.AnError)
+		mockObjectRepo.On("DeleteBlob", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		s := NewService(mockRepo, mockObjectRepo)
+		_, err := s.ImportProject(ctx, bytes.NewReader(data), int64(len(data)), "")
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("rejects archives without a manifest", func(t *testing.T) {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		_, _ = zw.Create("other.json")
+		_ = zw.Close()
+
+		s := NewService(NewMockRepository(t), media.NewMockObjectRepository(t))
+		_, err := s.ImportProject(ctx, bytes.NewReader(buf.Bytes()), int64(buf.Len()), "")
+		assert.ErrorIs(t, err, ErrInvalidArchive)
+	})
+}
+
+func TestManifest_Upgrade(t *testing.T) {
+	m := &Manifest{
+		Version: 1,
+		Media:   []*MediaData{{ID: "media-1", PostID: "post-1", Platforms: []string{"linkedin", "x"}}},
+	}
+
+	m.upgrade()
+
+	assert.Equal(t, ManifestVersion, m.Version)
+	assert.Equal(t, []*MediaLinkData{
+		{MediaID: "media-1", PostID: "post-1", PlatformID: "linkedin"},
+		{MediaID: "media-1", PostID: "post-1", PlatformID: "x"},
+	}, m.MediaLinks)
+	assert.Nil(t, m.Media[0].Platforms)
+}
+
+// pngHash is the SHA-256 of the content of the media of the test project
+const pngHash = "8f8cbb7dcf46e0bc7d53265749a6c17d116093a6ba95e442764060c76fd4a86c"
+
+// hasContent matches a body with the given content, and rewinds it for the code under test
+func hasContent(content string) any {
+	return mock.MatchedBy(func(body io.ReadSeeker) bool {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return false
+		}
+		_, err = body.Seek(0, io.SeekStart)
+		return err == nil && string(data) == content
+	})
+}
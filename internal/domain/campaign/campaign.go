@@ -0,0 +1,83 @@
+package campaign
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCampaignNotFound     = errors.New("campaign not found")
+	ErrCampaignNameRequired = errors.New("campaign name is required")
+	ErrInvalidCampaignDates = errors.New("campaign end date must be after its start date")
+	ErrPostNotInProject     = errors.New("post not in project")
+)
+
+// Campaign groups the posts of a project that work toward the same goal during a period of time
+type Campaign struct {
+	ID         string    `json:"id"`
+	ProjectID  string    `json:"project_id"`
+	Name       string    `json:"name"`
+	Goal       string    `json:"goal"`
+	StartDate  time.Time `json:"start_date"`
+	EndDate    time.Time `json:"end_date"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	PostsCount int       `json:"posts_count"`
+}
+
+func NewCampaign(projectID, userID, name, goal string, startDate, endDate time.Time) (*Campaign, error) {
+	if projectID == "" {
+		return nil, errors.New("projectID cannot be empty")
+	}
+	if userID == "" {
+		return nil, errors.New("userID cannot be empty")
+	}
+	c := &Campaign{
+		ID:        uuid.New().String(),
+		ProjectID: projectID,
+		CreatedBy: userID,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := c.Update(name, goal, startDate, endDate); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Campaign) Update(name, goal string, startDate, endDate time.Time) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrCampaignNameRequired
+	}
+	if !endDate.After(startDate) {
+		return ErrInvalidCampaignDates
+	}
+	c.Name = name
+	c.Goal = goal
+	c.StartDate = startDate.UTC()
+	c.EndDate = endDate.UTC()
+	c.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// PlatformSummary counts the campaign posts linked to a platform by their publish status
+type PlatformSummary struct {
+	PlatformID string `json:"platform_id"`
+	Total      int    `json:"total"`
+	Ready      int    `json:"ready"`
+	Processing int    `json:"processing"`
+	Published  int    `json:"published"`
+	Failed     int    `json:"failed"`
+}
+
+// Summary reports how far the publishing of a campaign has gone
+type Summary struct {
+	CampaignID    string             `json:"campaign_id"`
+	TotalPosts    int                `json:"total_posts"`
+	PostsByStatus map[string]int     `json:"posts_by_status"`
+	Platforms     []*PlatformSummary `json:"platforms"`
+}
@@ -0,0 +1,567 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package campaign
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddPost provides a mock function with given fields: ctx, campaignID, postID
+func (_m *MockRepository) AddPost(ctx context.Context, campaignID string, postID string) error {
+	ret := _m.Called(ctx, campaignID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, campaignID, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_AddPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPost'
+type MockRepository_AddPost_Call struct {
+	*mock.Call
+}
+
+// AddPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID string
+//   - postID string
+func (_e *MockRepository_Expecter) AddPost(ctx interface{}, campaignID interface{}, postID interface{}) *MockRepository_AddPost_Call {
+	return &MockRepository_AddPost_Call{Call: _e.mock.On("AddPost", ctx, campaignID, postID)}
+}
+
+func (_c *MockRepository_AddPost_Call) Run(run func(ctx context.Context, campaignID string, postID string)) *MockRepository_AddPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_AddPost_Call) Return(_a0 error) *MockRepository_AddPost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_AddPost_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_AddPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountPostsByStatus provides a mock function with given fields: ctx, campaignID
+func (_m *MockRepository) CountPostsByStatus(ctx context.Context, campaignID string) (map[string]int, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPostsByStatus")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]int, error)); ok {
+		return rf(ctx, campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]int); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_CountPostsByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPostsByStatus'
+type MockRepository_CountPostsByStatus_Call struct {
+	*mock.Call
+}
+
+// CountPostsByStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID string
+func (_e *MockRepository_Expecter) CountPostsByStatus(ctx interface{}, campaignID interface{}) *MockRepository_CountPostsByStatus_Call {
+	return &MockRepository_CountPostsByStatus_Call{Call: _e.mock.On("CountPostsByStatus", ctx, campaignID)}
+}
+
+func (_c *MockRepository_CountPostsByStatus_Call) Run(run func(ctx context.Context, campaignID string)) *MockRepository_CountPostsByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountPostsByStatus_Call) Return(_a0 map[string]int, _a1 error) *MockRepository_CountPostsByStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_CountPostsByStatus_Call) RunAndReturn(run func(context.Context, string) (map[string]int, error)) *MockRepository_CountPostsByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) Return(_a0 error) *MockRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *MockRepository) FindByID(ctx context.Context, id string) (*Campaign, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*Campaign, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *Campaign); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockRepository_FindByID_Call {
+	return &MockRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockRepository_FindByID_Call) Run(run func(ctx context.Context, id string)) *MockRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) Return(_a0 *Campaign, _a1 error) *MockRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) RunAndReturn(run func(context.Context, string) (*Campaign, error)) *MockRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByProjectID provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindByProjectID(ctx context.Context, projectID string) ([]*Campaign, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProjectID")
+	}
+
+	var r0 []*Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Campaign, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Campaign); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByProjectID'
+type MockRepository_FindByProjectID_Call struct {
+	*mock.Call
+}
+
+// FindByProjectID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) FindByProjectID(ctx interface{}, projectID interface{}) *MockRepository_FindByProjectID_Call {
+	return &MockRepository_FindByProjectID_Call{Call: _e.mock.On("FindByProjectID", ctx, projectID)}
+}
+
+func (_c *MockRepository_FindByProjectID_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByProjectID_Call) Return(_a0 []*Campaign, _a1 error) *MockRepository_FindByProjectID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindByProjectID_Call) RunAndReturn(run func(context.Context, string) ([]*Campaign, error)) *MockRepository_FindByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlatformSummaries provides a mock function with given fields: ctx, campaignID
+func (_m *MockRepository) GetPlatformSummaries(ctx context.Context, campaignID string) ([]*PlatformSummary, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlatformSummaries")
+	}
+
+	var r0 []*PlatformSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*PlatformSummary, error)); ok {
+		return rf(ctx, campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*PlatformSummary); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*PlatformSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetPlatformSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlatformSummaries'
+type MockRepository_GetPlatformSummaries_Call struct {
+	*mock.Call
+}
+
+// GetPlatformSummaries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID string
+func (_e *MockRepository_Expecter) GetPlatformSummaries(ctx interface{}, campaignID interface{}) *MockRepository_GetPlatformSummaries_Call {
+	return &MockRepository_GetPlatformSummaries_Call{Call: _e.mock.On("GetPlatformSummaries", ctx, campaignID)}
+}
+
+func (_c *MockRepository_GetPlatformSummaries_Call) Run(run func(ctx context.Context, campaignID string)) *MockRepository_GetPlatformSummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetPlatformSummaries_Call) Return(_a0 []*PlatformSummary, _a1 error) *MockRepository_GetPlatformSummaries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetPlatformSummaries_Call) RunAndReturn(run func(context.Context, string) ([]*PlatformSummary, error)) *MockRepository_GetPlatformSummaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsPostInProject provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockRepository) IsPostInProject(ctx context.Context, projectID string, postID string) (bool, error) {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsPostInProject")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, projectID, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_IsPostInProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsPostInProject'
+type MockRepository_IsPostInProject_Call struct {
+	*mock.Call
+}
+
+// IsPostInProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockRepository_Expecter) IsPostInProject(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_IsPostInProject_Call {
+	return &MockRepository_IsPostInProject_Call{Call: _e.mock.On("IsPostInProject", ctx, projectID, postID)}
+}
+
+func (_c *MockRepository_IsPostInProject_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_IsPostInProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_IsPostInProject_Call) Return(_a0 bool, _a1 error) *MockRepository_IsPostInProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_IsPostInProject_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_IsPostInProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemovePost provides a mock function with given fields: ctx, campaignID, postID
+func (_m *MockRepository) RemovePost(ctx context.Context, campaignID string, postID string) error {
+	ret := _m.Called(ctx, campaignID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, campaignID, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RemovePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePost'
+type MockRepository_RemovePost_Call struct {
+	*mock.Call
+}
+
+// RemovePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID string
+//   - postID string
+func (_e *MockRepository_Expecter) RemovePost(ctx interface{}, campaignID interface{}, postID interface{}) *MockRepository_RemovePost_Call {
+	return &MockRepository_RemovePost_Call{Call: _e.mock.On("RemovePost", ctx, campaignID, postID)}
+}
+
+func (_c *MockRepository_RemovePost_Call) Run(run func(ctx context.Context, campaignID string, postID string)) *MockRepository_RemovePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RemovePost_Call) Return(_a0 error) *MockRepository_RemovePost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RemovePost_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_RemovePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, c
+func (_m *MockRepository) Save(ctx context.Context, c *Campaign) error {
+	ret := _m.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Campaign) error); ok {
+		r0 = rf(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c *Campaign
+func (_e *MockRepository_Expecter) Save(ctx interface{}, c interface{}) *MockRepository_Save_Call {
+	return &MockRepository_Save_Call{Call: _e.mock.On("Save", ctx, c)}
+}
+
+func (_c *MockRepository_Save_Call) Run(run func(ctx context.Context, c *Campaign)) *MockRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Campaign))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Save_Call) Return(_a0 error) *MockRepository_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Save_Call) RunAndReturn(run func(context.Context, *Campaign) error) *MockRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, c
+func (_m *MockRepository) Update(ctx context.Context, c *Campaign) error {
+	ret := _m.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Campaign) error); ok {
+		r0 = rf(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c *Campaign
+func (_e *MockRepository_Expecter) Update(ctx interface{}, c interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, c)}
+}
+
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, c *Campaign)) *MockRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Campaign))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) Return(_a0 error) *MockRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, *Campaign) error) *MockRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
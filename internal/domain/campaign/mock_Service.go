@@ -0,0 +1,489 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package campaign
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// AddPostToCampaign provides a mock function with given fields: ctx, projectID, campaignID, postID
+func (_m *MockService) AddPostToCampaign(ctx context.Context, projectID string, campaignID string, postID string) error {
+	ret := _m.Called(ctx, projectID, campaignID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPostToCampaign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, projectID, campaignID, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_AddPostToCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPostToCampaign'
+type MockService_AddPostToCampaign_Call struct {
+	*mock.Call
+}
+
+// AddPostToCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - campaignID string
+//   - postID string
+func (_e *MockService_Expecter) AddPostToCampaign(ctx interface{}, projectID interface{}, campaignID interface{}, postID interface{}) *MockService_AddPostToCampaign_Call {
+	return &MockService_AddPostToCampaign_Call{Call: _e.mock.On("AddPostToCampaign", ctx, projectID, campaignID, postID)}
+}
+
+func (_c *MockService_AddPostToCampaign_Call) Run(run func(ctx context.Context, projectID string, campaignID string, postID string)) *MockService_AddPostToCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_AddPostToCampaign_Call) Return(_a0 error) *MockService_AddPostToCampaign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_AddPostToCampaign_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockService_AddPostToCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCampaign provides a mock function with given fields: ctx, projectID, name, goal, startDate, endDate
+func (_m *MockService) CreateCampaign(ctx context.Context, projectID string, name string, goal string, startDate time.Time, endDate time.Time) (*Campaign, error) {
+	ret := _m.Called(ctx, projectID, name, goal, startDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCampaign")
+	}
+
+	var r0 *Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time, time.Time) (*Campaign, error)); ok {
+		return rf(ctx, projectID, name, goal, startDate, endDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time, time.Time) *Campaign); ok {
+		r0 = rf(ctx, projectID, name, goal, startDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, projectID, name, goal, startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCampaign'
+type MockService_CreateCampaign_Call struct {
+	*mock.Call
+}
+
+// CreateCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - name string
+//   - goal string
+//   - startDate time.Time
+//   - endDate time.Time
+func (_e *MockService_Expecter) CreateCampaign(ctx interface{}, projectID interface{}, name interface{}, goal interface{}, startDate interface{}, endDate interface{}) *MockService_CreateCampaign_Call {
+	return &MockService_CreateCampaign_Call{Call: _e.mock.On("CreateCampaign", ctx, projectID, name, goal, startDate, endDate)}
+}
+
+func (_c *MockService_CreateCampaign_Call) Run(run func(ctx context.Context, projectID string, name string, goal string, startDate time.Time, endDate time.Time)) *MockService_CreateCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(time.Time), args[5].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateCampaign_Call) Return(_a0 *Campaign, _a1 error) *MockService_CreateCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateCampaign_Call) RunAndReturn(run func(context.Context, string, string, string, time.Time, time.Time) (*Campaign, error)) *MockService_CreateCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCampaign provides a mock function with given fields: ctx, projectID, id
+func (_m *MockService) DeleteCampaign(ctx context.Context, projectID string, id string) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCampaign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCampaign'
+type MockService_DeleteCampaign_Call struct {
+	*mock.Call
+}
+
+// DeleteCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+func (_e *MockService_Expecter) DeleteCampaign(ctx interface{}, projectID interface{}, id interface{}) *MockService_DeleteCampaign_Call {
+	return &MockService_DeleteCampaign_Call{Call: _e.mock.On("DeleteCampaign", ctx, projectID, id)}
+}
+
+func (_c *MockService_DeleteCampaign_Call) Run(run func(ctx context.Context, projectID string, id string)) *MockService_DeleteCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteCampaign_Call) Return(_a0 error) *MockService_DeleteCampaign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteCampaign_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_DeleteCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampaign provides a mock function with given fields: ctx, projectID, id
+func (_m *MockService) GetCampaign(ctx context.Context, projectID string, id string) (*Campaign, error) {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaign")
+	}
+
+	var r0 *Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*Campaign, error)); ok {
+		return rf(ctx, projectID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *Campaign); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaign'
+type MockService_GetCampaign_Call struct {
+	*mock.Call
+}
+
+// GetCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+func (_e *MockService_Expecter) GetCampaign(ctx interface{}, projectID interface{}, id interface{}) *MockService_GetCampaign_Call {
+	return &MockService_GetCampaign_Call{Call: _e.mock.On("GetCampaign", ctx, projectID, id)}
+}
+
+func (_c *MockService_GetCampaign_Call) Run(run func(ctx context.Context, projectID string, id string)) *MockService_GetCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetCampaign_Call) Return(_a0 *Campaign, _a1 error) *MockService_GetCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetCampaign_Call) RunAndReturn(run func(context.Context, string, string) (*Campaign, error)) *MockService_GetCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampaignSummary provides a mock function with given fields: ctx, projectID, campaignID
+func (_m *MockService) GetCampaignSummary(ctx context.Context, projectID string, campaignID string) (*Summary, error) {
+	ret := _m.Called(ctx, projectID, campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaignSummary")
+	}
+
+	var r0 *Summary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*Summary, error)); ok {
+		return rf(ctx, projectID, campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *Summary); ok {
+		r0 = rf(ctx, projectID, campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Summary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetCampaignSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaignSummary'
+type MockService_GetCampaignSummary_Call struct {
+	*mock.Call
+}
+
+// GetCampaignSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - campaignID string
+func (_e *MockService_Expecter) GetCampaignSummary(ctx interface{}, projectID interface{}, campaignID interface{}) *MockService_GetCampaignSummary_Call {
+	return &MockService_GetCampaignSummary_Call{Call: _e.mock.On("GetCampaignSummary", ctx, projectID, campaignID)}
+}
+
+func (_c *MockService_GetCampaignSummary_Call) Run(run func(ctx context.Context, projectID string, campaignID string)) *MockService_GetCampaignSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetCampaignSummary_Call) Return(_a0 *Summary, _a1 error) *MockService_GetCampaignSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetCampaignSummary_Call) RunAndReturn(run func(context.Context, string, string) (*Summary, error)) *MockService_GetCampaignSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProjectCampaigns provides a mock function with given fields: ctx, projectID
+func (_m *MockService) ListProjectCampaigns(ctx context.Context, projectID string) ([]*Campaign, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectCampaigns")
+	}
+
+	var r0 []*Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Campaign, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Campaign); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListProjectCampaigns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectCampaigns'
+type MockService_ListProjectCampaigns_Call struct {
+	*mock.Call
+}
+
+// ListProjectCampaigns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockService_Expecter) ListProjectCampaigns(ctx interface{}, projectID interface{}) *MockService_ListProjectCampaigns_Call {
+	return &MockService_ListProjectCampaigns_Call{Call: _e.mock.On("ListProjectCampaigns", ctx, projectID)}
+}
+
+func (_c *MockService_ListProjectCampaigns_Call) Run(run func(ctx context.Context, projectID string)) *MockService_ListProjectCampaigns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListProjectCampaigns_Call) Return(_a0 []*Campaign, _a1 error) *MockService_ListProjectCampaigns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListProjectCampaigns_Call) RunAndReturn(run func(context.Context, string) ([]*Campaign, error)) *MockService_ListProjectCampaigns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemovePostFromCampaign provides a mock function with given fields: ctx, projectID, campaignID, postID
+func (_m *MockService) RemovePostFromCampaign(ctx context.Context, projectID string, campaignID string, postID string) error {
+	ret := _m.Called(ctx, projectID, campaignID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePostFromCampaign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, projectID, campaignID, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_RemovePostFromCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePostFromCampaign'
+type MockService_RemovePostFromCampaign_Call struct {
+	*mock.Call
+}
+
+// RemovePostFromCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - campaignID string
+//   - postID string
+func (_e *MockService_Expecter) RemovePostFromCampaign(ctx interface{}, projectID interface{}, campaignID interface{}, postID interface{}) *MockService_RemovePostFromCampaign_Call {
+	return &MockService_RemovePostFromCampaign_Call{Call: _e.mock.On("RemovePostFromCampaign", ctx, projectID, campaignID, postID)}
+}
+
+func (_c *MockService_RemovePostFromCampaign_Call) Run(run func(ctx context.Context, projectID string, campaignID string, postID string)) *MockService_RemovePostFromCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_RemovePostFromCampaign_Call) Return(_a0 error) *MockService_RemovePostFromCampaign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_RemovePostFromCampaign_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockService_RemovePostFromCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCampaign provides a mock function with given fields: ctx, projectID, id, name, goal, startDate, endDate
+func (_m *MockService) UpdateCampaign(ctx context.Context, projectID string, id string, name string, goal string, startDate time.Time, endDate time.Time) (*Campaign, error) {
+	ret := _m.Called(ctx, projectID, id, name, goal, startDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCampaign")
+	}
+
+	var r0 *Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, time.Time, time.Time) (*Campaign, error)); ok {
+		return rf(ctx, projectID, id, name, goal, startDate, endDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, time.Time, time.Time) *Campaign); ok {
+		r0 = rf(ctx, projectID, id, name, goal, startDate, endDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, projectID, id, name, goal, startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UpdateCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCampaign'
+type MockService_UpdateCampaign_Call struct {
+	*mock.Call
+}
+
+// UpdateCampaign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+//   - name string
+//   - goal string
+//   - startDate time.Time
+//   - endDate time.Time
+func (_e *MockService_Expecter) UpdateCampaign(ctx interface{}, projectID interface{}, id interface{}, name interface{}, goal interface{}, startDate interface{}, endDate interface{}) *MockService_UpdateCampaign_Call {
+	return &MockService_UpdateCampaign_Call{Call: _e.mock.On("UpdateCampaign", ctx, projectID, id, name, goal, startDate, endDate)}
+}
+
+func (_c *MockService_UpdateCampaign_Call) Run(run func(ctx context.Context, projectID string, id string, name string, goal string, startDate time.Time, endDate time.Time)) *MockService_UpdateCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(time.Time), args[6].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateCampaign_Call) Return(_a0 *Campaign, _a1 error) *MockService_UpdateCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UpdateCampaign_Call) RunAndReturn(run func(context.Context, string, string, string, string, time.Time, time.Time) (*Campaign, error)) *MockService_UpdateCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
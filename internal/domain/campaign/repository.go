@@ -0,0 +1,18 @@
+package campaign
+
+import "context"
+
+type Repository interface {
+	Save(ctx context.Context, c *Campaign) error
+	Update(ctx context.Context, c *Campaign) error
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Campaign, error)
+	FindByProjectID(ctx context.Context, projectID string) ([]*Campaign, error)
+	IsPostInProject(ctx context.Context, projectID, postID string) (bool, error)
+	AddPost(ctx context.Context, campaignID, postID string) error
+	RemovePost(ctx context.Context, campaignID, postID string) error
+	// CountPostsByStatus returns the number of campaign posts for each post status
+	CountPostsByStatus(ctx context.Context, campaignID string) (map[string]int, error)
+	// GetPlatformSummaries returns the publish status counts of the campaign posts for each platform
+	GetPlatformSummaries(ctx context.Context, campaignID string) ([]*PlatformSummary, error)
+}
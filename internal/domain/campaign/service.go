@@ -0,0 +1,146 @@
+package campaign
+
+import (
+	"context"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+)
+
+type Service interface {
+	CreateCampaign(ctx context.Context, projectID, name, goal string, startDate, endDate time.Time) (*Campaign, error)
+	UpdateCampaign(ctx context.Context, projectID, id, name, goal string, startDate, endDate time.Time) (*Campaign, error)
+	DeleteCampaign(ctx context.Context, projectID, id string) error
+	GetCampaign(ctx context.Context, projectID, id string) (*Campaign, error)
+	ListProjectCampaigns(ctx context.Context, projectID string) ([]*Campaign, error)
+	AddPostToCampaign(ctx context.Context, projectID, campaignID, postID string) error
+	RemovePostFromCampaign(ctx context.Context, projectID, campaignID, postID string) error
+	GetCampaignSummary(ctx context.Context, projectID, campaignID string) (*Summary, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) CreateCampaign(ctx context.Context, projectID, name, goal string, startDate, endDate time.Time) (*Campaign, error) {
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+
+	c, err := NewCampaign(projectID, userID, name, goal, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.Save(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *service) UpdateCampaign(ctx context.Context, projectID, id, name, goal string, startDate, endDate time.Time) (*Campaign, error) {
+	c, err := s.GetCampaign(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.Update(name, goal, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.Update(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *service) DeleteCampaign(ctx context.Context, projectID, id string) error {
+	_, err := s.GetCampaign(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *service) GetCampaign(ctx context.Context, projectID, id string) (*Campaign, error) {
+	c, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil || c.ProjectID != projectID {
+		return nil, ErrCampaignNotFound
+	}
+	return c, nil
+}
+
+func (s *service) ListProjectCampaigns(ctx context.Context, projectID string) ([]*Campaign, error) {
+	return s.repo.FindByProjectID(ctx, projectID)
+}
+
+func (s *service) AddPostToCampaign(ctx context.Context, projectID, campaignID, postID string) error {
+	err := s.checkCampaignAndPost(ctx, projectID, campaignID, postID)
+	if err != nil {
+		return err
+	}
+	return s.repo.AddPost(ctx, campaignID, postID)
+}
+
+func (s *service) RemovePostFromCampaign(ctx context.Context, projectID, campaignID, postID string) error {
+	err := s.checkCampaignAndPost(ctx, projectID, campaignID, postID)
+	if err != nil {
+		return err
+	}
+	return s.repo.RemovePost(ctx, campaignID, postID)
+}
+
+func (s *service) GetCampaignSummary(ctx context.Context, projectID, campaignID string) (*Summary, error) {
+	_, err := s.GetCampaign(ctx, projectID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	postsByStatus, err := s.repo.CountPostsByStatus(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	platforms, err := s.repo.GetPlatformSummaries(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{
+		CampaignID:    campaignID,
+		PostsByStatus: postsByStatus,
+		Platforms:     platforms,
+	}
+	if summary.PostsByStatus == nil {
+		summary.PostsByStatus = map[string]int{}
+	}
+	if summary.Platforms == nil {
+		summary.Platforms = []*PlatformSummary{}
+	}
+	for _, count := range summary.PostsByStatus {
+		summary.TotalPosts += count
+	}
+	return summary, nil
+}
+
+func (s *service) checkCampaignAndPost(ctx context.Context, projectID, campaignID, postID string) error {
+	_, err := s.GetCampaign(ctx, projectID, campaignID)
+	if err != nil {
+		return err
+	}
+	inProject, err := s.repo.IsPostInProject(ctx, projectID, postID)
+	if err != nil {
+		return err
+	}
+	if !inProject {
+		return ErrPostNotInProject
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package campaign
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCampaign_InvalidDates(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewCampaign("project-1", "user-1", "Spring", "", start, start.Add(-time.Hour))
+	assert.ErrorIs(t, err, ErrInvalidCampaignDates)
+
+	_, err = NewCampaign("project-1", "user-1", "", "", start, start.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrCampaignNameRequired)
+}
+
+func TestGetCampaignSummary(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := NewMockRepository(t)
+	mockRepo.On("FindByID", ctx, "campaign-1").Return(&Campaign{ID: "campaign-1", ProjectID: "project-1"}, nil)
+	mockRepo.On("CountPostsByStatus", ctx, "campaign-1").Return(map[string]int{"published": 3, "scheduled": 2}, nil)
+	mockRepo.On("GetPlatformSummaries", ctx, "campaign-1").Return([]*PlatformSummary{
+		{PlatformID: "linkedin", Total: 5, Published: 3, Ready: 2},
+	}, nil)
+
+	s := NewService(mockRepo)
+	summary, err := s.GetCampaignSummary(ctx, "project-1", "campaign-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, summary.TotalPosts)
+	assert.Len(t, summary.Platforms, 1)
+}
+
+func TestGetCampaignSummary_OtherProject(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := NewMockRepository(t)
+	mockRepo.On("FindByID", ctx, "campaign-1").Return(&Campaign{ID: "campaign-1", ProjectID: "project-2"}, nil)
+
+	s := NewService(mockRepo)
+	_, err := s.GetCampaignSummary(ctx, "project-1", "campaign-1")
+
+	assert.ErrorIs(t, err, ErrCampaignNotFound)
+}
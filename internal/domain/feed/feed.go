@@ -0,0 +1,93 @@
+package feed
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InitialItems is how many items of a new subscription become ideas. The older ones are only marked as seen,
+// so subscribing to a feed doesn't flood the idea queue with its whole history.
+const InitialItems = 5
+
+var (
+	ErrFeedNotFound      = errors.New("feed not found")
+	ErrFeedAlreadyExists = errors.New("feed already exists")
+	ErrInvalidFeedURL    = errors.New("invalid feed url, expected an http or https url")
+	ErrInvalidFeed       = errors.New("invalid feed, expected RSS or Atom")
+)
+
+// Feed is an RSS or Atom subscription of a project. New items are turned into ideas, or into drafts
+// when a template is set.
+type Feed struct {
+	ID            string     `json:"id"`
+	ProjectID     string     `json:"project_id"`
+	URL           string     `json:"url"`
+	Title         string     `json:"title"`
+	TemplateID    *string    `json:"template_id,omitempty"` // Template used to auto-draft the items
+	LastFetchedAt *time.Time `json:"last_fetched_at,omitempty"`
+	LastError     string     `json:"last_error,omitempty"`
+	CreatedBy     string     `json:"created_by"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// Item is an entry of a feed
+type Item struct {
+	GUID        string
+	Title       string
+	Link        string
+	Summary     string
+	PublishedAt *time.Time
+}
+
+func NewFeed(projectID, rawURL, userID string, templateID *string) (*Feed, error) {
+	if projectID == "" {
+		return nil, errors.New("projectID cannot be empty")
+	}
+	feedURL, err := normalizeURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return &Feed{
+		ID:         uuid.New().String(),
+		ProjectID:  projectID,
+		URL:        feedURL,
+		TemplateID: templateID,
+		CreatedBy:  userID,
+		CreatedAt:  time.Now().UTC(),
+		UpdatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+func normalizeURL(rawURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", ErrInvalidFeedURL
+	}
+	return u.String(), nil
+}
+
+// IdeaContent is the text of the idea created from an item
+func (i *Item) IdeaContent() string {
+	var parts []string
+	if i.Summary != "" {
+		parts = append(parts, i.Summary)
+	}
+	if i.Link != "" {
+		parts = append(parts, i.Link)
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// TemplateVariables are the variables an auto-draft template can use
+func (i *Item) TemplateVariables() map[string]string {
+	return map[string]string{
+		"title":   i.Title,
+		"link":    i.Link,
+		"summary": i.Summary,
+	}
+}
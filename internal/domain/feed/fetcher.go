@@ -0,0 +1,57 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/fetching"
+)
+
+// Fetcher downloads feed documents
+type Fetcher interface {
+	Fetch(ctx context.Context, url string) (io.Reader, error)
+}
+
+type httpFetcher struct {
+	client  *http.Client
+	maxSize int64
+}
+
+// NewHTTPFetcher returns a fetcher that refuses documents bigger than maxSize bytes, and the urls
+// of addresses that aren't public. Its errors don't tell why an address couldn't be reached, as they
+// are shown on the feed.
+func NewHTTPFetcher(timeout time.Duration, maxSize int64) Fetcher {
+	return &httpFetcher{
+		client:  fetching.NewClient(timeout),
+		maxSize: maxSize,
+	}
+}
+
+func (f *httpFetcher) Fetch(ctx context.Context, url string) (io.Reader, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/xml, text/xml")
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, f.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > f.maxSize {
+		return nil, fmt.Errorf("feed is bigger than %d bytes", f.maxSize)
+	}
+	return bytes.NewReader(data), nil
+}
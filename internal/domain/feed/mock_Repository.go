@@ -0,0 +1,523 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package feed
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) Return(_a0 error) *MockRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistsByURL provides a mock function with given fields: ctx, projectID, url
+func (_m *MockRepository) ExistsByURL(ctx context.Context, projectID string, url string) (bool, error) {
+	ret := _m.Called(ctx, projectID, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsByURL")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, projectID, url)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, projectID, url)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ExistsByURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsByURL'
+type MockRepository_ExistsByURL_Call struct {
+	*mock.Call
+}
+
+// ExistsByURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - url string
+func (_e *MockRepository_Expecter) ExistsByURL(ctx interface{}, projectID interface{}, url interface{}) *MockRepository_ExistsByURL_Call {
+	return &MockRepository_ExistsByURL_Call{Call: _e.mock.On("ExistsByURL", ctx, projectID, url)}
+}
+
+func (_c *MockRepository_ExistsByURL_Call) Run(run func(ctx context.Context, projectID string, url string)) *MockRepository_ExistsByURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ExistsByURL_Call) Return(_a0 bool, _a1 error) *MockRepository_ExistsByURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ExistsByURL_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_ExistsByURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *MockRepository) FindByID(ctx context.Context, id string) (*Feed, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *Feed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*Feed, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *Feed); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Feed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockRepository_FindByID_Call {
+	return &MockRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockRepository_FindByID_Call) Run(run func(ctx context.Context, id string)) *MockRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) Return(_a0 *Feed, _a1 error) *MockRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) RunAndReturn(run func(context.Context, string) (*Feed, error)) *MockRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByProjectID provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindByProjectID(ctx context.Context, projectID string) ([]*Feed, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProjectID")
+	}
+
+	var r0 []*Feed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Feed, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Feed); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Feed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByProjectID'
+type MockRepository_FindByProjectID_Call struct {
+	*mock.Call
+}
+
+// FindByProjectID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) FindByProjectID(ctx interface{}, projectID interface{}) *MockRepository_FindByProjectID_Call {
+	return &MockRepository_FindByProjectID_Call{Call: _e.mock.On("FindByProjectID", ctx, projectID)}
+}
+
+func (_c *MockRepository_FindByProjectID_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByProjectID_Call) Return(_a0 []*Feed, _a1 error) *MockRepository_FindByProjectID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindByProjectID_Call) RunAndReturn(run func(context.Context, string) ([]*Feed, error)) *MockRepository_FindByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDueFeeds provides a mock function with given fields: ctx, fetchedBefore, limit
+func (_m *MockRepository) FindDueFeeds(ctx context.Context, fetchedBefore time.Time, limit int) ([]*Feed, error) {
+	ret := _m.Called(ctx, fetchedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDueFeeds")
+	}
+
+	var r0 []*Feed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*Feed, error)); ok {
+		return rf(ctx, fetchedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*Feed); ok {
+		r0 = rf(ctx, fetchedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Feed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, fetchedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindDueFeeds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDueFeeds'
+type MockRepository_FindDueFeeds_Call struct {
+	*mock.Call
+}
+
+// FindDueFeeds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fetchedBefore time.Time
+//   - limit int
+func (_e *MockRepository_Expecter) FindDueFeeds(ctx interface{}, fetchedBefore interface{}, limit interface{}) *MockRepository_FindDueFeeds_Call {
+	return &MockRepository_FindDueFeeds_Call{Call: _e.mock.On("FindDueFeeds", ctx, fetchedBefore, limit)}
+}
+
+func (_c *MockRepository_FindDueFeeds_Call) Run(run func(ctx context.Context, fetchedBefore time.Time, limit int)) *MockRepository_FindDueFeeds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindDueFeeds_Call) Return(_a0 []*Feed, _a1 error) *MockRepository_FindDueFeeds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindDueFeeds_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*Feed, error)) *MockRepository_FindDueFeeds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSeenGUIDs provides a mock function with given fields: ctx, feedID, guids
+func (_m *MockRepository) FindSeenGUIDs(ctx context.Context, feedID string, guids []string) ([]string, error) {
+	ret := _m.Called(ctx, feedID, guids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSeenGUIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]string, error)); ok {
+		return rf(ctx, feedID, guids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []string); ok {
+		r0 = rf(ctx, feedID, guids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, feedID, guids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindSeenGUIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSeenGUIDs'
+type MockRepository_FindSeenGUIDs_Call struct {
+	*mock.Call
+}
+
+// FindSeenGUIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - feedID string
+//   - guids []string
+func (_e *MockRepository_Expecter) FindSeenGUIDs(ctx interface{}, feedID interface{}, guids interface{}) *MockRepository_FindSeenGUIDs_Call {
+	return &MockRepository_FindSeenGUIDs_Call{Call: _e.mock.On("FindSeenGUIDs", ctx, feedID, guids)}
+}
+
+func (_c *MockRepository_FindSeenGUIDs_Call) Run(run func(ctx context.Context, feedID string, guids []string)) *MockRepository_FindSeenGUIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindSeenGUIDs_Call) Return(_a0 []string, _a1 error) *MockRepository_FindSeenGUIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindSeenGUIDs_Call) RunAndReturn(run func(context.Context, string, []string) ([]string, error)) *MockRepository_FindSeenGUIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, f
+func (_m *MockRepository) Save(ctx context.Context, f *Feed) error {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Feed) error); ok {
+		r0 = rf(ctx, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f *Feed
+func (_e *MockRepository_Expecter) Save(ctx interface{}, f interface{}) *MockRepository_Save_Call {
+	return &MockRepository_Save_Call{Call: _e.mock.On("Save", ctx, f)}
+}
+
+func (_c *MockRepository_Save_Call) Run(run func(ctx context.Context, f *Feed)) *MockRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Feed))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Save_Call) Return(_a0 error) *MockRepository_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Save_Call) RunAndReturn(run func(context.Context, *Feed) error) *MockRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveItem provides a mock function with given fields: ctx, feedID, guid, postID
+func (_m *MockRepository) SaveItem(ctx context.Context, feedID string, guid string, postID string) error {
+	ret := _m.Called(ctx, feedID, guid, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, feedID, guid, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SaveItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveItem'
+type MockRepository_SaveItem_Call struct {
+	*mock.Call
+}
+
+// SaveItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - feedID string
+//   - guid string
+//   - postID string
+func (_e *MockRepository_Expecter) SaveItem(ctx interface{}, feedID interface{}, guid interface{}, postID interface{}) *MockRepository_SaveItem_Call {
+	return &MockRepository_SaveItem_Call{Call: _e.mock.On("SaveItem", ctx, feedID, guid, postID)}
+}
+
+func (_c *MockRepository_SaveItem_Call) Run(run func(ctx context.Context, feedID string, guid string, postID string)) *MockRepository_SaveItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveItem_Call) Return(_a0 error) *MockRepository_SaveItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SaveItem_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockRepository_SaveItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, f
+func (_m *MockRepository) Update(ctx context.Context, f *Feed) error {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Feed) error); ok {
+		r0 = rf(ctx, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f *Feed
+func (_e *MockRepository_Expecter) Update(ctx interface{}, f interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, f)}
+}
+
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, f *Feed)) *MockRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Feed))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) Return(_a0 error) *MockRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, *Feed) error) *MockRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
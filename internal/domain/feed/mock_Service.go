@@ -0,0 +1,372 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package feed
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// ListProjectFeeds provides a mock function with given fields: ctx, projectID
+func (_m *MockService) ListProjectFeeds(ctx context.Context, projectID string) ([]*Feed, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectFeeds")
+	}
+
+	var r0 []*Feed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Feed, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Feed); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Feed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListProjectFeeds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectFeeds'
+type MockService_ListProjectFeeds_Call struct {
+	*mock.Call
+}
+
+// ListProjectFeeds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockService_Expecter) ListProjectFeeds(ctx interface{}, projectID interface{}) *MockService_ListProjectFeeds_Call {
+	return &MockService_ListProjectFeeds_Call{Call: _e.mock.On("ListProjectFeeds", ctx, projectID)}
+}
+
+func (_c *MockService_ListProjectFeeds_Call) Run(run func(ctx context.Context, projectID string)) *MockService_ListProjectFeeds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListProjectFeeds_Call) Return(_a0 []*Feed, _a1 error) *MockService_ListProjectFeeds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListProjectFeeds_Call) RunAndReturn(run func(context.Context, string) ([]*Feed, error)) *MockService_ListProjectFeeds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PollDueFeeds provides a mock function with given fields: ctx, fetchedBefore, limit
+func (_m *MockService) PollDueFeeds(ctx context.Context, fetchedBefore time.Time, limit int) error {
+	ret := _m.Called(ctx, fetchedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PollDueFeeds")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) error); ok {
+		r0 = rf(ctx, fetchedBefore, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_PollDueFeeds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PollDueFeeds'
+type MockService_PollDueFeeds_Call struct {
+	*mock.Call
+}
+
+// PollDueFeeds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fetchedBefore time.Time
+//   - limit int
+func (_e *MockService_Expecter) PollDueFeeds(ctx interface{}, fetchedBefore interface{}, limit interface{}) *MockService_PollDueFeeds_Call {
+	return &MockService_PollDueFeeds_Call{Call: _e.mock.On("PollDueFeeds", ctx, fetchedBefore, limit)}
+}
+
+func (_c *MockService_PollDueFeeds_Call) Run(run func(ctx context.Context, fetchedBefore time.Time, limit int)) *MockService_PollDueFeeds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_PollDueFeeds_Call) Return(_a0 error) *MockService_PollDueFeeds_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_PollDueFeeds_Call) RunAndReturn(run func(context.Context, time.Time, int) error) *MockService_PollDueFeeds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshFeed provides a mock function with given fields: ctx, projectID, feedID
+func (_m *MockService) RefreshFeed(ctx context.Context, projectID string, feedID string) (int, error) {
+	ret := _m.Called(ctx, projectID, feedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshFeed")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int, error)); ok {
+		return rf(ctx, projectID, feedID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = rf(ctx, projectID, feedID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, feedID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_RefreshFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshFeed'
+type MockService_RefreshFeed_Call struct {
+	*mock.Call
+}
+
+// RefreshFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - feedID string
+func (_e *MockService_Expecter) RefreshFeed(ctx interface{}, projectID interface{}, feedID interface{}) *MockService_RefreshFeed_Call {
+	return &MockService_RefreshFeed_Call{Call: _e.mock.On("RefreshFeed", ctx, projectID, feedID)}
+}
+
+func (_c *MockService_RefreshFeed_Call) Run(run func(ctx context.Context, projectID string, feedID string)) *MockService_RefreshFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_RefreshFeed_Call) Return(_a0 int, _a1 error) *MockService_RefreshFeed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_RefreshFeed_Call) RunAndReturn(run func(context.Context, string, string) (int, error)) *MockService_RefreshFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: ctx, projectID, url, templateID
+func (_m *MockService) Subscribe(ctx context.Context, projectID string, url string, templateID *string) (*Feed, error) {
+	ret := _m.Called(ctx, projectID, url, templateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *Feed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string) (*Feed, error)); ok {
+		return rf(ctx, projectID, url, templateID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string) *Feed); ok {
+		r0 = rf(ctx, projectID, url, templateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Feed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *string) error); ok {
+		r1 = rf(ctx, projectID, url, templateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockService_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - url string
+//   - templateID *string
+func (_e *MockService_Expecter) Subscribe(ctx interface{}, projectID interface{}, url interface{}, templateID interface{}) *MockService_Subscribe_Call {
+	return &MockService_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, projectID, url, templateID)}
+}
+
+func (_c *MockService_Subscribe_Call) Run(run func(ctx context.Context, projectID string, url string, templateID *string)) *MockService_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockService_Subscribe_Call) Return(_a0 *Feed, _a1 error) *MockService_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_Subscribe_Call) RunAndReturn(run func(context.Context, string, string, *string) (*Feed, error)) *MockService_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unsubscribe provides a mock function with given fields: ctx, projectID, feedID
+func (_m *MockService) Unsubscribe(ctx context.Context, projectID string, feedID string) error {
+	ret := _m.Called(ctx, projectID, feedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unsubscribe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, feedID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type MockService_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - feedID string
+func (_e *MockService_Expecter) Unsubscribe(ctx interface{}, projectID interface{}, feedID interface{}) *MockService_Unsubscribe_Call {
+	return &MockService_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", ctx, projectID, feedID)}
+}
+
+func (_c *MockService_Unsubscribe_Call) Run(run func(ctx context.Context, projectID string, feedID string)) *MockService_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_Unsubscribe_Call) Return(_a0 error) *MockService_Unsubscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_Unsubscribe_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFeed provides a mock function with given fields: ctx, projectID, feedID, templateID
+func (_m *MockService) UpdateFeed(ctx context.Context, projectID string, feedID string, templateID *string) (*Feed, error) {
+	ret := _m.Called(ctx, projectID, feedID, templateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFeed")
+	}
+
+	var r0 *Feed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string) (*Feed, error)); ok {
+		return rf(ctx, projectID, feedID, templateID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string) *Feed); ok {
+		r0 = rf(ctx, projectID, feedID, templateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Feed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *string) error); ok {
+		r1 = rf(ctx, projectID, feedID, templateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UpdateFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFeed'
+type MockService_UpdateFeed_Call struct {
+	*mock.Call
+}
+
+// UpdateFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - feedID string
+//   - templateID *string
+func (_e *MockService_Expecter) UpdateFeed(ctx interface{}, projectID interface{}, feedID interface{}, templateID interface{}) *MockService_UpdateFeed_Call {
+	return &MockService_UpdateFeed_Call{Call: _e.mock.On("UpdateFeed", ctx, projectID, feedID, templateID)}
+}
+
+func (_c *MockService_UpdateFeed_Call) Run(run func(ctx context.Context, projectID string, feedID string, templateID *string)) *MockService_UpdateFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateFeed_Call) Return(_a0 *Feed, _a1 error) *MockService_UpdateFeed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UpdateFeed_Call) RunAndReturn(run func(context.Context, string, string, *string) (*Feed, error)) *MockService_UpdateFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
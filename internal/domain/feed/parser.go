@@ -0,0 +1,244 @@
+package feed
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxSummaryLength is the length summaries are cut to, in characters
+const maxSummaryLength = 1000
+
+var (
+	tagRegex        = regexp.MustCompile(`<[^>]*>`)
+	whitespaceRegex = regexp.MustCompile(`\s+`)
+	dateLayouts     = []string{
+		time.RFC1123Z,
+		time.RFC1123,
+		time.RFC3339,
+		"Mon, 2 Jan 2006 15:04:05 -0700",
+		"Mon, 2 Jan 2006 15:04:05 MST",
+		"2 Jan 2006 15:04:05 -0700",
+		"2006-01-02T15:04:05",
+	}
+)
+
+type rssItem struct {
+	GUID        string `xml:"guid"`
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	Description string `xml:"description"`
+	PubDate     string `xml:"pubDate"`
+	DCDate      string `xml:"http://purl.org/dc/elements/1.1/ date"`
+	About       string `xml:"http://www.w3.org/1999/02/22-rdf-syntax-ns# about,attr"`
+}
+
+type rssDocument struct {
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+	// RSS 1.0 puts the items next to the channel
+	Items []rssItem `xml:"item"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+}
+
+type atomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Links     []atomLink `xml:"link"`
+	Summary   string     `xml:"summary"`
+	Content   string     `xml:"content"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+}
+
+type atomDocument struct {
+	Title   string      `xml:"title"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+// Parse reads an RSS 2.0, RSS 1.0 or Atom document. Items are returned newest first.
+func Parse(r io.Reader) (title string, items []*Item, err error) {
+	d := xml.NewDecoder(r)
+	d.CharsetReader = charsetReader
+	d.Strict = false
+
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return "", nil, ErrInvalidFeed
+			}
+			return "", nil, errors.Join(ErrInvalidFeed, err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch strings.ToLower(start.Name.Local) {
+		case "rss", "rdf":
+			var doc rssDocument
+			if err := d.DecodeElement(&doc, &start); err != nil {
+				return "", nil, errors.Join(ErrInvalidFeed, err)
+			}
+			title, items = doc.Channel.Title, rssItems(append(doc.Channel.Items, doc.Items...))
+		case "feed":
+			var doc atomDocument
+			if err := d.DecodeElement(&doc, &start); err != nil {
+				return "", nil, errors.Join(ErrInvalidFeed, err)
+			}
+			title, items = doc.Title, atomItems(doc.Entries)
+		default:
+			return "", nil, fmt.Errorf("%w: unexpected root element %q", ErrInvalidFeed, start.Name.Local)
+		}
+
+		sortNewestFirst(items)
+		return cleanText(title), items, nil
+	}
+}
+
+func rssItems(entries []rssItem) []*Item {
+	items := make([]*Item, 0, len(entries))
+	for _, e := range entries {
+		date := e.PubDate
+		if date == "" {
+			date = e.DCDate
+		}
+		item := newItem(firstNonEmpty(e.GUID, e.About, e.Link, e.Title), e.Title, e.Link, e.Description, date)
+		if item != nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func atomItems(entries []atomEntry) []*Item {
+	items := make([]*Item, 0, len(entries))
+	for _, e := range entries {
+		var link string
+		for _, l := range e.Links {
+			if l.Rel == "" || l.Rel == "alternate" {
+				link = l.Href
+				break
+			}
+		}
+		item := newItem(firstNonEmpty(e.ID, link, e.Title), e.Title, link, firstNonEmpty(e.Summary, e.Content), firstNonEmpty(e.Published, e.Updated))
+		if item != nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// newItem returns nil for entries that can't be identified
+func newItem(guid, title, link, summary, date string) *Item {
+	guid = strings.TrimSpace(guid)
+	if guid == "" {
+		return nil
+	}
+	item := &Item{
+		GUID:    guid,
+		Title:   cleanText(title),
+		Link:    strings.TrimSpace(link),
+		Summary: truncate(cleanText(summary), maxSummaryLength),
+	}
+	if item.Title == "" {
+		item.Title = item.Link
+	}
+	if t, ok := parseDate(date); ok {
+		item.PublishedAt = &t
+	}
+	return item
+}
+
+func sortNewestFirst(items []*Item) {
+	slices.SortStableFunc(items, func(a, b *Item) int {
+		switch {
+		case a.PublishedAt == nil || b.PublishedAt == nil:
+			return 0
+		case a.PublishedAt.After(*b.PublishedAt):
+			return -1
+		case a.PublishedAt.Before(*b.PublishedAt):
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+func parseDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// cleanText removes the markup of a feed text and collapses its whitespace
+func cleanText(s string) string {
+	s = tagRegex.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+	return strings.TrimSpace(whitespaceRegex.ReplaceAllString(s, " "))
+}
+
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	return strings.TrimSpace(string(runes[:n-1])) + "…"
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// charsetReader supports the charsets feeds use besides UTF-8
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "utf-8", "utf8", "us-ascii", "ascii":
+		return input, nil
+	case "iso-8859-1", "latin1", "windows-1252":
+		return latin1Reader{bufio.NewReader(input)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported charset %q", charset)
+	}
+}
+
+// latin1Reader converts ISO-8859-1 to UTF-8
+type latin1Reader struct {
+	r io.ByteReader
+}
+
+func (l latin1Reader) Read(p []byte) (int, error) {
+	n := 0
+	for n+utf8.UTFMax <= len(p) {
+		b, err := l.r.ReadByte()
+		if err != nil {
+			return n, err
+		}
+		n += utf8.EncodeRune(p[n:], rune(b))
+	}
+	return n, nil
+}
@@ -0,0 +1,49 @@
+package feed
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/config"
+)
+
+// Poller periodically ingests the feeds that are due
+type Poller struct {
+	service Service
+	cfg     *config.FeedConfig
+	quit    chan struct{}
+}
+
+func NewPoller(service Service, cfg *config.FeedConfig) *Poller {
+	return &Poller{
+		service: service,
+		cfg:     cfg,
+		quit:    make(chan struct{}),
+	}
+}
+
+func (p *Poller) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.Interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				fetchedBefore := time.Now().UTC().Add(-p.cfg.PollEvery)
+				if err := p.service.PollDueFeeds(ctx, fetchedBefore, p.cfg.BatchSize); err != nil {
+					log.Printf("Error polling feeds: %v", err)
+				}
+			case <-p.quit:
+				ticker.Stop()
+				return
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (p *Poller) Stop() {
+	close(p.quit)
+}
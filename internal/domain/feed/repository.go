@@ -0,0 +1,22 @@
+package feed
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Save(ctx context.Context, f *Feed) error
+	Update(ctx context.Context, f *Feed) error
+	Delete(ctx context.Context, id string) error
+	// FindByID returns nil when the feed doesn't exist
+	FindByID(ctx context.Context, id string) (*Feed, error)
+	FindByProjectID(ctx context.Context, projectID string) ([]*Feed, error)
+	ExistsByURL(ctx context.Context, projectID, url string) (bool, error)
+	// FindDueFeeds returns the feeds never fetched or last fetched before the given time, least recently fetched first
+	FindDueFeeds(ctx context.Context, fetchedBefore time.Time, limit int) ([]*Feed, error)
+	// FindSeenGUIDs returns which of the given GUIDs were already ingested for the feed
+	FindSeenGUIDs(ctx context.Context, feedID string, guids []string) ([]string, error)
+	// SaveItem records an ingested item. postID is empty for items skipped on the first fetch.
+	SaveItem(ctx context.Context, feedID, guid, postID string) error
+}
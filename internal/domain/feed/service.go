@@ -0,0 +1,233 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/template"
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+)
+
+type Service interface {
+	Subscribe(ctx context.Context, projectID, url string, templateID *string) (*Feed, error)
+	UpdateFeed(ctx context.Context, projectID, feedID string, templateID *string) (*Feed, error)
+	Unsubscribe(ctx context.Context, projectID, feedID string) error
+	ListProjectFeeds(ctx context.Context, projectID string) ([]*Feed, error)
+	// RefreshFeed polls a feed right away and returns how many posts were created
+	RefreshFeed(ctx context.Context, projectID, feedID string) (int, error)
+	// PollDueFeeds polls up to limit feeds not fetched since fetchedBefore
+	PollDueFeeds(ctx context.Context, fetchedBefore time.Time, limit int) error
+}
+
+type service struct {
+	repo            Repository
+	postService     post.Service
+	templateService template.Service
+	fetcher         Fetcher
+}
+
+func NewService(repo Repository, postService post.Service, templateService template.Service, fetcher Fetcher) Service {
+	return &service{
+		repo:            repo,
+		postService:     postService,
+		templateService: templateService,
+		fetcher:         fetcher,
+	}
+}
+
+func (s *service) Subscribe(ctx context.Context, projectID, url string, templateID *string) (*Feed, error) {
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+	f, err := NewFeed(projectID, url, userID, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTemplate(ctx, projectID, templateID); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repo.ExistsByURL(ctx, projectID, f.URL)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrFeedAlreadyExists
+	}
+
+	// The first fetch checks the url points to a feed and gets its title
+	r, err := s.fetcher.Fetch(ctx, f.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFeed, err.Error())
+	}
+	f.Title, _, err = Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.Save(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *service) UpdateFeed(ctx context.Context, projectID, feedID string, templateID *string) (*Feed, error) {
+	f, err := s.getProjectFeed(ctx, projectID, feedID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTemplate(ctx, projectID, templateID); err != nil {
+		return nil, err
+	}
+
+	f.TemplateID = templateID
+	f.UpdatedAt = time.Now().UTC()
+	err = s.repo.Update(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *service) Unsubscribe(ctx context.Context, projectID, feedID string) error {
+	if _, err := s.getProjectFeed(ctx, projectID, feedID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, feedID)
+}
+
+func (s *service) ListProjectFeeds(ctx context.Context, projectID string) ([]*Feed, error) {
+	return s.repo.FindByProjectID(ctx, projectID)
+}
+
+func (s *service) RefreshFeed(ctx context.Context, projectID, feedID string) (int, error) {
+	f, err := s.getProjectFeed(ctx, projectID, feedID)
+	if err != nil {
+		return 0, err
+	}
+	return s.poll(ctx, f)
+}
+
+func (s *service) PollDueFeeds(ctx context.Context, fetchedBefore time.Time, limit int) error {
+	feeds, err := s.repo.FindDueFeeds(ctx, fetchedBefore, limit)
+	if err != nil {
+		return err
+	}
+	for _, f := range feeds {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if _, err := s.poll(ctx, f); err != nil {
+			log.Printf("Error polling feed %s: %v", f.ID, err)
+		}
+	}
+	return nil
+}
+
+// poll fetches the feed and creates a post for each new item. The outcome of the fetch is saved on the feed.
+func (s *service) poll(ctx context.Context, f *Feed) (int, error) {
+	created, err := s.ingest(ctx, f)
+
+	now := time.Now().UTC()
+	f.LastFetchedAt = &now
+	f.LastError = ""
+	if err != nil {
+		f.LastError = err.Error()
+	}
+	if updateErr := s.repo.Update(ctx, f); updateErr != nil {
+		return created, errors.Join(err, updateErr)
+	}
+	return created, err
+}
+
+func (s *service) ingest(ctx context.Context, f *Feed) (int, error) {
+	r, err := s.fetcher.Fetch(ctx, f.URL)
+	if err != nil {
+		return 0, err
+	}
+	title, items, err := Parse(r)
+	if err != nil {
+		return 0, err
+	}
+	if title != "" {
+		f.Title = title
+	}
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	guids := make([]string, 0, len(items))
+	for _, item := range items {
+		guids = append(guids, item.GUID)
+	}
+	seen, err := s.repo.FindSeenGUIDs(ctx, f.ID, guids)
+	if err != nil {
+		return 0, err
+	}
+
+	// Posts are created on behalf of the user that subscribed the feed
+	ctx = context.WithValue(ctx, middlewares.UserIDKey, f.CreatedBy)
+	firstFetch := f.LastFetchedAt == nil
+	created := 0
+	// Oldest first, so the ideas are queued in publication order
+	for i := len(items) - 1; i >= 0; i-- {
+		item := items[i]
+		if slices.Contains(seen, item.GUID) {
+			continue
+		}
+		seen = append(seen, item.GUID)
+
+		if firstFetch && i >= InitialItems {
+			if err := s.repo.SaveItem(ctx, f.ID, item.GUID, ""); err != nil {
+				return created, err
+			}
+			continue
+		}
+
+		p, err := s.createPost(ctx, f, item)
+		if err != nil {
+			return created, err
+		}
+		if err := s.repo.SaveItem(ctx, f.ID, item.GUID, p.ID); err != nil {
+			return created, err
+		}
+		created++
+	}
+	return created, nil
+}
+
+// createPost drafts the item with the feed template. Without a template, or when the template can't be
+// filled with the item, the item becomes an idea.
+func (s *service) createPost(ctx context.Context, f *Feed, item *Item) (*post.Post, error) {
+	if f.TemplateID != nil {
+		p, err := s.templateService.CreatePostFromTemplate(ctx, f.ProjectID, *f.TemplateID, item.TemplateVariables(), time.Time{})
+		if err == nil {
+			return p, nil
+		}
+		log.Printf("Error drafting item %s of feed %s, creating an idea instead: %v", item.GUID, f.ID, err)
+	}
+	return s.postService.CreatePost(ctx, f.ProjectID, item.Title, string(post.PostTypeText), item.IdeaContent(), true, time.Time{})
+}
+
+func (s *service) checkTemplate(ctx context.Context, projectID string, templateID *string) error {
+	if templateID == nil {
+		return nil
+	}
+	_, err := s.templateService.GetTemplate(ctx, projectID, *templateID)
+	return err
+}
+
+func (s *service) getProjectFeed(ctx context.Context, projectID, feedID string) (*Feed, error) {
+	f, err := s.repo.FindByID(ctx, feedID)
+	if err != nil {
+		return nil, err
+	}
+	if f == nil || f.ProjectID != projectID {
+		return nil, ErrFeedNotFound
+	}
+	return f, nil
+}
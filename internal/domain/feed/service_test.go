@@ -0,0 +1,156 @@
+package feed
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/template"
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/fetching"
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("reads rss items newest first", func(t *testing.T) {
+		f, err := os.Open("testdata/rss.xml")
+		assert.NoError(t, err)
+		defer f.Close()
+
+		title, items, err := Parse(f)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Gopher Blog", title)
+		assert.Len(t, items, 2)
+		assert.Equal(t, "https://blog.example.com/?p=2", items[0].GUID)
+		assert.Equal(t, "Second article", items[0].Summary)
+		assert.Equal(t, "First article of the blog", items[1].Summary)
+	})
+
+	t.Run("reads atom entries", func(t *testing.T) {
+		f, err := os.Open("testdata/atom.xml")
+		assert.NoError(t, err)
+		defer f.Close()
+
+		title, items, err := Parse(f)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Gopher News", title)
+		assert.Equal(t, "tag:news.example.com,2025:1", items[0].GUID)
+		assert.Equal(t, "https://news.example.com/release", items[0].Link)
+		assert.Equal(t, time.Date(2025, 6, 3, 10, 0, 0, 0, time.UTC), *items[0].PublishedAt)
+	})
+
+	t.Run("rejects other documents", func(t *testing.T) {
+		_, _, err := Parse(strings.NewReader("<html><body></body></html>"))
+		assert.ErrorIs(t, err, ErrInvalidFeed)
+	})
+}
+
+func feedServer(t *testing.T, fixture string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		http.ServeFile(w, r, fixture)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRefreshFeed(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.UserIDKey, "user-2")
+	userCtx := mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Value(middlewares.UserIDKey) == "user-1"
+	})
+	// The test servers listen on loopback addresses, which the fetcher of NewHTTPFetcher refuses
+	fetcher := &httpFetcher{client: &http.Client{Timeout: time.Second}, maxSize: 1 << 20}
+
+	t.Run("creates ideas from new items only", func(t *testing.T) {
+		server := feedServer(t, "testdata/rss.xml")
+		lastFetched := time.Now().Add(-time.Hour)
+		f := &Feed{ID: "feed-1", ProjectID: "project-1", URL: server.URL, CreatedBy: "user-1", LastFetchedAt: &lastFetched}
+		mockRepo := NewMockRepository(t)
+		mockPostSvc := post.NewMockService(t)
+		mockRepo.On("FindByID", ctx, "feed-1").Return(f, nil)
+		mockRepo.On("FindSeenGUIDs", ctx, "feed-1", []string{"https://blog.example.com/?p=2", "https://blog.example.com/?p=1"}).
+			Return([]string{"https://blog.example.com/?p=1"}, nil)
+		mockPostSvc.On("CreatePost", userCtx, "project-1", "Newer post", "text", "Second article\n\nhttps://blog.example.com/newer", true, time.Time{}).
+			Return(&post.Post{ID: "post-2"}, nil)
+		mockRepo.On("SaveItem", userCtx, "feed-1", "https://blog.example.com/?p=2", "post-2").Return(nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(f *Feed) bool {
+			return f.Title == "Gopher Blog" && f.LastError == "" && f.LastFetchedAt.After(lastFetched)
+		})).Return(nil)
+
+		s := NewService(mockRepo, mockPostSvc, template.NewMockService(t), fetcher)
+		created, err := s.RefreshFeed(ctx, "project-1", "feed-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, created)
+	})
+
+	t.Run("drafts items with the feed template", func(t *testing.T) {
+		server := feedServer(t, "testdata/atom.xml")
+		templateID := "template-1"
+		f := &Feed{ID: "feed-1", ProjectID: "project-1", URL: server.URL, CreatedBy: "user-1", TemplateID: &templateID}
+		mockRepo := NewMockRepository(t)
+		mockTemplateSvc := template.NewMockService(t)
+		mockRepo.On("FindByID", ctx, "feed-1").Return(f, nil)
+		mockRepo.On("FindSeenGUIDs", ctx, "feed-1", []string{"tag:news.example.com,2025:1"}).Return(nil, nil)
+		mockTemplateSvc.On("CreatePostFromTemplate", userCtx, "project-1", "template-1", map[string]string{
+			"title":   "Release notes",
+			"link":    "https://news.example.com/release",
+			"summary": "What is new",
+		}, time.Time{}).Return(&post.Post{ID: "post-1"}, nil)
+		mockRepo.On("SaveItem", userCtx, "feed-1", "tag:news.example.com,2025:1", "post-1").Return(nil)
+		mockRepo.On("Update", ctx, mock.Anything).Return(nil)
+
+		s := NewService(mockRepo, post.NewMockService(t), mockTemplateSvc, fetcher)
+		created, err := s.RefreshFeed(ctx, "project-1", "feed-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, created)
+	})
+
+	t.Run("records the fetch error on the feed", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		defer server.Close()
+		f := &Feed{ID: "feed-1", ProjectID: "project-1", URL: server.URL, CreatedBy: "user-1"}
+		mockRepo := NewMockRepository(t)
+		mockRepo.On("FindByID", ctx, "feed-1").Return(f, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(f *Feed) bool {
+			return f.LastError == "unexpected status 404" && f.LastFetchedAt != nil
+		})).Return(nil)
+
+		s := NewService(mockRepo, post.NewMockService(t), template.NewMockService(t), fetcher)
+		_, err := s.RefreshFeed(ctx, "project-1", "feed-1")
+		assert.Error(t, err)
+	})
+
+	t.Run("refuses feeds of private addresses", func(t *testing.T) {
+		server := feedServer(t, "testdata/rss.xml")
+		f := &Feed{ID: "feed-1", ProjectID: "project-1", URL: server.URL, CreatedBy: "user-1"}
+		mockRepo := NewMockRepository(t)
+		mockRepo.On("FindByID", ctx, "feed-1").Return(f, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(f *Feed) bool {
+			return strings.HasSuffix(f.LastError, fetching.ErrForbiddenAddress.Error())
+		})).Return(nil)
+
+		s := NewService(mockRepo, post.NewMockService(t), template.NewMockService(t), NewHTTPFetcher(time.Second, 1<<20))
+		_, err := s.RefreshFeed(ctx, "project-1", "feed-1")
+		assert.ErrorIs(t, err, fetching.ErrForbiddenAddress)
+	})
+
+	t.Run("fails for feeds of other projects", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockRepo.On("FindByID", ctx, "feed-1").Return(&Feed{ID: "feed-1", ProjectID: "project-2"}, nil)
+
+		s := NewService(mockRepo, post.NewMockService(t), template.NewMockService(t), fetcher)
+		_, err := s.RefreshFeed(ctx, "project-1", "feed-1")
+		assert.ErrorIs(t, err, ErrFeedNotFound)
+	})
+}
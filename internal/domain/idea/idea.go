@@ -0,0 +1,91 @@
+package idea
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+)
+
+var (
+	ErrIdeaNotFound           = errors.New("idea not found")
+	ErrIdeaAlreadyPromoted    = errors.New("idea already promoted")
+	ErrInvalidIdeaSort        = errors.New("invalid idea sort, use queue or votes")
+	ErrCommentNotFound        = errors.New("comment not found")
+	ErrCommentContentRequired = errors.New("comment content is required")
+	ErrNotCommentAuthor       = errors.New("only the author can delete a comment")
+)
+
+type Sort string
+
+const (
+	// SortByQueue keeps the order of the project idea queue
+	SortByQueue Sort = "queue"
+	// SortByVotes puts the most voted ideas first. Ties keep their queue order.
+	SortByVotes Sort = "votes"
+)
+
+func (s Sort) IsValid() bool {
+	return s == SortByQueue || s == SortByVotes
+}
+
+// Idea is an idea post with its board activity
+type Idea struct {
+	*post.Post
+	Votes         int  `json:"votes"`
+	VotedByMe     bool `json:"voted_by_me"`
+	CommentsCount int  `json:"comments_count"`
+}
+
+type Comment struct {
+	ID        string    `json:"id"`
+	PostID    string    `json:"post_id"`
+	UserID    string    `json:"user_id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewComment(postID, userID, content string) (*Comment, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, ErrCommentContentRequired
+	}
+	return &Comment{
+		ID:        uuid.New().String(),
+		PostID:    postID,
+		UserID:    userID,
+		Content:   content,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// NewDraftFromIdea returns the draft post an idea is promoted to. The draft keeps the idea author
+// and content and links back to the idea.
+func NewDraftFromIdea(i *post.Post) *post.Post {
+	ideaID := i.ID
+	return &post.Post{
+		ID:          uuid.New().String(),
+		ProjectID:   i.ProjectID,
+		Title:       i.Title,
+		Type:        i.Type,
+		TextContent: i.TextContent,
+		IsIdea:      false,
+		Status:      string(post.PostStatusDraft),
+		CreatedBy:   i.CreatedBy,
+		IdeaID:      &ideaID,
+		CreatedAt:   time.Now().UTC(),
+		UpdatedAt:   time.Now().UTC(),
+	}
+}
+
+// sortByVotes orders the ideas by votes, most voted first, keeping the current order of ties
+func sortByVotes(ideas []*Idea) {
+	slices.SortStableFunc(ideas, func(a, b *Idea) int {
+		return b.Votes - a.Votes
+	})
+}
@@ -0,0 +1,619 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package idea
+
+import (
+	context "context"
+
+	post "github.com/redplanettribe/social-media-manager/internal/domain/post"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddVote provides a mock function with given fields: ctx, ideaID, userID
+func (_m *MockRepository) AddVote(ctx context.Context, ideaID string, userID string) error {
+	ret := _m.Called(ctx, ideaID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddVote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, ideaID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_AddVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVote'
+type MockRepository_AddVote_Call struct {
+	*mock.Call
+}
+
+// AddVote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ideaID string
+//   - userID string
+func (_e *MockRepository_Expecter) AddVote(ctx interface{}, ideaID interface{}, userID interface{}) *MockRepository_AddVote_Call {
+	return &MockRepository_AddVote_Call{Call: _e.mock.On("AddVote", ctx, ideaID, userID)}
+}
+
+func (_c *MockRepository_AddVote_Call) Run(run func(ctx context.Context, ideaID string, userID string)) *MockRepository_AddVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_AddVote_Call) Return(_a0 error) *MockRepository_AddVote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_AddVote_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_AddVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: ctx, commentID
+func (_m *MockRepository) DeleteComment(ctx context.Context, commentID string) error {
+	ret := _m.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, commentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockRepository_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID string
+func (_e *MockRepository_Expecter) DeleteComment(ctx interface{}, commentID interface{}) *MockRepository_DeleteComment_Call {
+	return &MockRepository_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, commentID)}
+}
+
+func (_c *MockRepository_DeleteComment_Call) Run(run func(ctx context.Context, commentID string)) *MockRepository_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteComment_Call) Return(_a0 error) *MockRepository_DeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_DeleteComment_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindComment provides a mock function with given fields: ctx, ideaID, commentID
+func (_m *MockRepository) FindComment(ctx context.Context, ideaID string, commentID string) (*Comment, error) {
+	ret := _m.Called(ctx, ideaID, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindComment")
+	}
+
+	var r0 *Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*Comment, error)); ok {
+		return rf(ctx, ideaID, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *Comment); ok {
+		r0 = rf(ctx, ideaID, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, ideaID, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindComment'
+type MockRepository_FindComment_Call struct {
+	*mock.Call
+}
+
+// FindComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ideaID string
+//   - commentID string
+func (_e *MockRepository_Expecter) FindComment(ctx interface{}, ideaID interface{}, commentID interface{}) *MockRepository_FindComment_Call {
+	return &MockRepository_FindComment_Call{Call: _e.mock.On("FindComment", ctx, ideaID, commentID)}
+}
+
+func (_c *MockRepository_FindComment_Call) Run(run func(ctx context.Context, ideaID string, commentID string)) *MockRepository_FindComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindComment_Call) Return(_a0 *Comment, _a1 error) *MockRepository_FindComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindComment_Call) RunAndReturn(run func(context.Context, string, string) (*Comment, error)) *MockRepository_FindComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindIdea provides a mock function with given fields: ctx, projectID, ideaID
+func (_m *MockRepository) FindIdea(ctx context.Context, projectID string, ideaID string) (*post.Post, error) {
+	ret := _m.Called(ctx, projectID, ideaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindIdea")
+	}
+
+	var r0 *post.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*post.Post, error)); ok {
+		return rf(ctx, projectID, ideaID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *post.Post); ok {
+		r0 = rf(ctx, projectID, ideaID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*post.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, ideaID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindIdea_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindIdea'
+type MockRepository_FindIdea_Call struct {
+	*mock.Call
+}
+
+// FindIdea is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - ideaID string
+func (_e *MockRepository_Expecter) FindIdea(ctx interface{}, projectID interface{}, ideaID interface{}) *MockRepository_FindIdea_Call {
+	return &MockRepository_FindIdea_Call{Call: _e.mock.On("FindIdea", ctx, projectID, ideaID)}
+}
+
+func (_c *MockRepository_FindIdea_Call) Run(run func(ctx context.Context, projectID string, ideaID string)) *MockRepository_FindIdea_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindIdea_Call) Return(_a0 *post.Post, _a1 error) *MockRepository_FindIdea_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindIdea_Call) RunAndReturn(run func(context.Context, string, string) (*post.Post, error)) *MockRepository_FindIdea_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPromotedPostID provides a mock function with given fields: ctx, ideaID
+func (_m *MockRepository) FindPromotedPostID(ctx context.Context, ideaID string) (string, error) {
+	ret := _m.Called(ctx, ideaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPromotedPostID")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, ideaID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, ideaID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ideaID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindPromotedPostID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPromotedPostID'
+type MockRepository_FindPromotedPostID_Call struct {
+	*mock.Call
+}
+
+// FindPromotedPostID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ideaID string
+func (_e *MockRepository_Expecter) FindPromotedPostID(ctx interface{}, ideaID interface{}) *MockRepository_FindPromotedPostID_Call {
+	return &MockRepository_FindPromotedPostID_Call{Call: _e.mock.On("FindPromotedPostID", ctx, ideaID)}
+}
+
+func (_c *MockRepository_FindPromotedPostID_Call) Run(run func(ctx context.Context, ideaID string)) *MockRepository_FindPromotedPostID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindPromotedPostID_Call) Return(_a0 string, _a1 error) *MockRepository_FindPromotedPostID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindPromotedPostID_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockRepository_FindPromotedPostID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function with given fields: ctx, ideaID
+func (_m *MockRepository) ListComments(ctx context.Context, ideaID string) ([]*Comment, error) {
+	ret := _m.Called(ctx, ideaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 []*Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Comment, error)); ok {
+		return rf(ctx, ideaID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Comment); ok {
+		r0 = rf(ctx, ideaID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ideaID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type MockRepository_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ideaID string
+func (_e *MockRepository_Expecter) ListComments(ctx interface{}, ideaID interface{}) *MockRepository_ListComments_Call {
+	return &MockRepository_ListComments_Call{Call: _e.mock.On("ListComments", ctx, ideaID)}
+}
+
+func (_c *MockRepository_ListComments_Call) Run(run func(ctx context.Context, ideaID string)) *MockRepository_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListComments_Call) Return(_a0 []*Comment, _a1 error) *MockRepository_ListComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ListComments_Call) RunAndReturn(run func(context.Context, string) ([]*Comment, error)) *MockRepository_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIdeas provides a mock function with given fields: ctx, projectID, userID
+func (_m *MockRepository) ListIdeas(ctx context.Context, projectID string, userID string) ([]*Idea, error) {
+	ret := _m.Called(ctx, projectID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIdeas")
+	}
+
+	var r0 []*Idea
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*Idea, error)); ok {
+		return rf(ctx, projectID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*Idea); ok {
+		r0 = rf(ctx, projectID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Idea)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ListIdeas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdeas'
+type MockRepository_ListIdeas_Call struct {
+	*mock.Call
+}
+
+// ListIdeas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - userID string
+func (_e *MockRepository_Expecter) ListIdeas(ctx interface{}, projectID interface{}, userID interface{}) *MockRepository_ListIdeas_Call {
+	return &MockRepository_ListIdeas_Call{Call: _e.mock.On("ListIdeas", ctx, projectID, userID)}
+}
+
+func (_c *MockRepository_ListIdeas_Call) Run(run func(ctx context.Context, projectID string, userID string)) *MockRepository_ListIdeas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListIdeas_Call) Return(_a0 []*Idea, _a1 error) *MockRepository_ListIdeas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ListIdeas_Call) RunAndReturn(run func(context.Context, string, string) ([]*Idea, error)) *MockRepository_ListIdeas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteIdea provides a mock function with given fields: ctx, _a1, draft
+func (_m *MockRepository) PromoteIdea(ctx context.Context, _a1 *post.Post, draft *post.Post) error {
+	ret := _m.Called(ctx, _a1, draft)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteIdea")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *post.Post, *post.Post) error); ok {
+		r0 = rf(ctx, _a1, draft)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_PromoteIdea_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteIdea'
+type MockRepository_PromoteIdea_Call struct {
+	*mock.Call
+}
+
+// PromoteIdea is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *post.Post
+//   - draft *post.Post
+func (_e *MockRepository_Expecter) PromoteIdea(ctx interface{}, _a1 interface{}, draft interface{}) *MockRepository_PromoteIdea_Call {
+	return &MockRepository_PromoteIdea_Call{Call: _e.mock.On("PromoteIdea", ctx, _a1, draft)}
+}
+
+func (_c *MockRepository_PromoteIdea_Call) Run(run func(ctx context.Context, _a1 *post.Post, draft *post.Post)) *MockRepository_PromoteIdea_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*post.Post), args[2].(*post.Post))
+	})
+	return _c
+}
+
+func (_c *MockRepository_PromoteIdea_Call) Return(_a0 error) *MockRepository_PromoteIdea_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_PromoteIdea_Call) RunAndReturn(run func(context.Context, *post.Post, *post.Post) error) *MockRepository_PromoteIdea_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveVote provides a mock function with given fields: ctx, ideaID, userID
+func (_m *MockRepository) RemoveVote(ctx context.Context, ideaID string, userID string) error {
+	ret := _m.Called(ctx, ideaID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveVote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, ideaID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RemoveVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveVote'
+type MockRepository_RemoveVote_Call struct {
+	*mock.Call
+}
+
+// RemoveVote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ideaID string
+//   - userID string
+func (_e *MockRepository_Expecter) RemoveVote(ctx interface{}, ideaID interface{}, userID interface{}) *MockRepository_RemoveVote_Call {
+	return &MockRepository_RemoveVote_Call{Call: _e.mock.On("RemoveVote", ctx, ideaID, userID)}
+}
+
+func (_c *MockRepository_RemoveVote_Call) Run(run func(ctx context.Context, ideaID string, userID string)) *MockRepository_RemoveVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RemoveVote_Call) Return(_a0 error) *MockRepository_RemoveVote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RemoveVote_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_RemoveVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveComment provides a mock function with given fields: ctx, c
+func (_m *MockRepository) SaveComment(ctx context.Context, c *Comment) error {
+	ret := _m.Called(ctx, c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Comment) error); ok {
+		r0 = rf(ctx, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SaveComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveComment'
+type MockRepository_SaveComment_Call struct {
+	*mock.Call
+}
+
+// SaveComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - c *Comment
+func (_e *MockRepository_Expecter) SaveComment(ctx interface{}, c interface{}) *MockRepository_SaveComment_Call {
+	return &MockRepository_SaveComment_Call{Call: _e.mock.On("SaveComment", ctx, c)}
+}
+
+func (_c *MockRepository_SaveComment_Call) Run(run func(ctx context.Context, c *Comment)) *MockRepository_SaveComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Comment))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveComment_Call) Return(_a0 error) *MockRepository_SaveComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SaveComment_Call) RunAndReturn(run func(context.Context, *Comment) error) *MockRepository_SaveComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateIdeaQueue provides a mock function with given fields: ctx, projectID, queue
+func (_m *MockRepository) UpdateIdeaQueue(ctx context.Context, projectID string, queue []string) error {
+	ret := _m.Called(ctx, projectID, queue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateIdeaQueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, projectID, queue)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateIdeaQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateIdeaQueue'
+type MockRepository_UpdateIdeaQueue_Call struct {
+	*mock.Call
+}
+
+// UpdateIdeaQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - queue []string
+func (_e *MockRepository_Expecter) UpdateIdeaQueue(ctx interface{}, projectID interface{}, queue interface{}) *MockRepository_UpdateIdeaQueue_Call {
+	return &MockRepository_UpdateIdeaQueue_Call{Call: _e.mock.On("UpdateIdeaQueue", ctx, projectID, queue)}
+}
+
+func (_c *MockRepository_UpdateIdeaQueue_Call) Run(run func(ctx context.Context, projectID string, queue []string)) *MockRepository_UpdateIdeaQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateIdeaQueue_Call) Return(_a0 error) *MockRepository_UpdateIdeaQueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateIdeaQueue_Call) RunAndReturn(run func(context.Context, string, []string) error) *MockRepository_UpdateIdeaQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
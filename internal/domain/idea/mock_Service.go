@@ -0,0 +1,482 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package idea
+
+import (
+	context "context"
+
+	post "github.com/redplanettribe/social-media-manager/internal/domain/post"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// AddComment provides a mock function with given fields: ctx, projectID, ideaID, content
+func (_m *MockService) AddComment(ctx context.Context, projectID string, ideaID string, content string) (*Comment, error) {
+	ret := _m.Called(ctx, projectID, ideaID, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddComment")
+	}
+
+	var r0 *Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*Comment, error)); ok {
+		return rf(ctx, projectID, ideaID, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *Comment); ok {
+		r0 = rf(ctx, projectID, ideaID, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, ideaID, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_AddComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddComment'
+type MockService_AddComment_Call struct {
+	*mock.Call
+}
+
+// AddComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - ideaID string
+//   - content string
+func (_e *MockService_Expecter) AddComment(ctx interface{}, projectID interface{}, ideaID interface{}, content interface{}) *MockService_AddComment_Call {
+	return &MockService_AddComment_Call{Call: _e.mock.On("AddComment", ctx, projectID, ideaID, content)}
+}
+
+func (_c *MockService_AddComment_Call) Run(run func(ctx context.Context, projectID string, ideaID string, content string)) *MockService_AddComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_AddComment_Call) Return(_a0 *Comment, _a1 error) *MockService_AddComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_AddComment_Call) RunAndReturn(run func(context.Context, string, string, string) (*Comment, error)) *MockService_AddComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: ctx, projectID, ideaID, commentID
+func (_m *MockService) DeleteComment(ctx context.Context, projectID string, ideaID string, commentID string) error {
+	ret := _m.Called(ctx, projectID, ideaID, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, projectID, ideaID, commentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockService_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - ideaID string
+//   - commentID string
+func (_e *MockService_Expecter) DeleteComment(ctx interface{}, projectID interface{}, ideaID interface{}, commentID interface{}) *MockService_DeleteComment_Call {
+	return &MockService_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, projectID, ideaID, commentID)}
+}
+
+func (_c *MockService_DeleteComment_Call) Run(run func(ctx context.Context, projectID string, ideaID string, commentID string)) *MockService_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteComment_Call) Return(_a0 error) *MockService_DeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteComment_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockService_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function with given fields: ctx, projectID, ideaID
+func (_m *MockService) ListComments(ctx context.Context, projectID string, ideaID string) ([]*Comment, error) {
+	ret := _m.Called(ctx, projectID, ideaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 []*Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*Comment, error)); ok {
+		return rf(ctx, projectID, ideaID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*Comment); ok {
+		r0 = rf(ctx, projectID, ideaID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, ideaID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type MockService_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - ideaID string
+func (_e *MockService_Expecter) ListComments(ctx interface{}, projectID interface{}, ideaID interface{}) *MockService_ListComments_Call {
+	return &MockService_ListComments_Call{Call: _e.mock.On("ListComments", ctx, projectID, ideaID)}
+}
+
+func (_c *MockService_ListComments_Call) Run(run func(ctx context.Context, projectID string, ideaID string)) *MockService_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListComments_Call) Return(_a0 []*Comment, _a1 error) *MockService_ListComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListComments_Call) RunAndReturn(run func(context.Context, string, string) ([]*Comment, error)) *MockService_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIdeas provides a mock function with given fields: ctx, projectID, sort
+func (_m *MockService) ListIdeas(ctx context.Context, projectID string, sort Sort) ([]*Idea, error) {
+	ret := _m.Called(ctx, projectID, sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIdeas")
+	}
+
+	var r0 []*Idea
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, Sort) ([]*Idea, error)); ok {
+		return rf(ctx, projectID, sort)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, Sort) []*Idea); ok {
+		r0 = rf(ctx, projectID, sort)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Idea)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, Sort) error); ok {
+		r1 = rf(ctx, projectID, sort)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListIdeas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdeas'
+type MockService_ListIdeas_Call struct {
+	*mock.Call
+}
+
+// ListIdeas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - sort Sort
+func (_e *MockService_Expecter) ListIdeas(ctx interface{}, projectID interface{}, sort interface{}) *MockService_ListIdeas_Call {
+	return &MockService_ListIdeas_Call{Call: _e.mock.On("ListIdeas", ctx, projectID, sort)}
+}
+
+func (_c *MockService_ListIdeas_Call) Run(run func(ctx context.Context, projectID string, sort Sort)) *MockService_ListIdeas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(Sort))
+	})
+	return _c
+}
+
+func (_c *MockService_ListIdeas_Call) Return(_a0 []*Idea, _a1 error) *MockService_ListIdeas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListIdeas_Call) RunAndReturn(run func(context.Context, string, Sort) ([]*Idea, error)) *MockService_ListIdeas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteIdea provides a mock function with given fields: ctx, projectID, ideaID
+func (_m *MockService) PromoteIdea(ctx context.Context, projectID string, ideaID string) (*post.Post, error) {
+	ret := _m.Called(ctx, projectID, ideaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteIdea")
+	}
+
+	var r0 *post.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*post.Post, error)); ok {
+		return rf(ctx, projectID, ideaID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *post.Post); ok {
+		r0 = rf(ctx, projectID, ideaID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*post.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, ideaID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_PromoteIdea_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteIdea'
+type MockService_PromoteIdea_Call struct {
+	*mock.Call
+}
+
+// PromoteIdea is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - ideaID string
+func (_e *MockService_Expecter) PromoteIdea(ctx interface{}, projectID interface{}, ideaID interface{}) *MockService_PromoteIdea_Call {
+	return &MockService_PromoteIdea_Call{Call: _e.mock.On("PromoteIdea", ctx, projectID, ideaID)}
+}
+
+func (_c *MockService_PromoteIdea_Call) Run(run func(ctx context.Context, projectID string, ideaID string)) *MockService_PromoteIdea_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_PromoteIdea_Call) Return(_a0 *post.Post, _a1 error) *MockService_PromoteIdea_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_PromoteIdea_Call) RunAndReturn(run func(context.Context, string, string) (*post.Post, error)) *MockService_PromoteIdea_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveVote provides a mock function with given fields: ctx, projectID, ideaID
+func (_m *MockService) RemoveVote(ctx context.Context, projectID string, ideaID string) error {
+	ret := _m.Called(ctx, projectID, ideaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveVote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, ideaID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_RemoveVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveVote'
+type MockService_RemoveVote_Call struct {
+	*mock.Call
+}
+
+// RemoveVote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - ideaID string
+func (_e *MockService_Expecter) RemoveVote(ctx interface{}, projectID interface{}, ideaID interface{}) *MockService_RemoveVote_Call {
+	return &MockService_RemoveVote_Call{Call: _e.mock.On("RemoveVote", ctx, projectID, ideaID)}
+}
+
+func (_c *MockService_RemoveVote_Call) Run(run func(ctx context.Context, projectID string, ideaID string)) *MockService_RemoveVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_RemoveVote_Call) Return(_a0 error) *MockService_RemoveVote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_RemoveVote_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_RemoveVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SortQueueByVotes provides a mock function with given fields: ctx, projectID
+func (_m *MockService) SortQueueByVotes(ctx context.Context, projectID string) ([]*Idea, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SortQueueByVotes")
+	}
+
+	var r0 []*Idea
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Idea, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Idea); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Idea)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_SortQueueByVotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SortQueueByVotes'
+type MockService_SortQueueByVotes_Call struct {
+	*mock.Call
+}
+
+// SortQueueByVotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockService_Expecter) SortQueueByVotes(ctx interface{}, projectID interface{}) *MockService_SortQueueByVotes_Call {
+	return &MockService_SortQueueByVotes_Call{Call: _e.mock.On("SortQueueByVotes", ctx, projectID)}
+}
+
+func (_c *MockService_SortQueueByVotes_Call) Run(run func(ctx context.Context, projectID string)) *MockService_SortQueueByVotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_SortQueueByVotes_Call) Return(_a0 []*Idea, _a1 error) *MockService_SortQueueByVotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_SortQueueByVotes_Call) RunAndReturn(run func(context.Context, string) ([]*Idea, error)) *MockService_SortQueueByVotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Vote provides a mock function with given fields: ctx, projectID, ideaID
+func (_m *MockService) Vote(ctx context.Context, projectID string, ideaID string) error {
+	ret := _m.Called(ctx, projectID, ideaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, ideaID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_Vote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Vote'
+type MockService_Vote_Call struct {
+	*mock.Call
+}
+
+// Vote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - ideaID string
+func (_e *MockService_Expecter) Vote(ctx interface{}, projectID interface{}, ideaID interface{}) *MockService_Vote_Call {
+	return &MockService_Vote_Call{Call: _e.mock.On("Vote", ctx, projectID, ideaID)}
+}
+
+func (_c *MockService_Vote_Call) Run(run func(ctx context.Context, projectID string, ideaID string)) *MockService_Vote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_Vote_Call) Return(_a0 error) *MockService_Vote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_Vote_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_Vote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
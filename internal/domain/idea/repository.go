@@ -0,0 +1,27 @@
+package idea
+
+import (
+	"context"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+)
+
+type Repository interface {
+	// FindIdea returns the post if it belongs to the project, nil otherwise
+	FindIdea(ctx context.Context, projectID, ideaID string) (*post.Post, error)
+	// ListIdeas returns the ideas of the project that weren't promoted, in idea queue order
+	ListIdeas(ctx context.Context, projectID, userID string) ([]*Idea, error)
+	UpdateIdeaQueue(ctx context.Context, projectID string, queue []string) error
+	AddVote(ctx context.Context, ideaID, userID string) error
+	RemoveVote(ctx context.Context, ideaID, userID string) error
+	SaveComment(ctx context.Context, c *Comment) error
+	FindComment(ctx context.Context, ideaID, commentID string) (*Comment, error)
+	ListComments(ctx context.Context, ideaID string) ([]*Comment, error)
+	DeleteComment(ctx context.Context, commentID string) error
+	// FindPromotedPostID returns the id of the draft the idea was promoted to, or an empty string
+	FindPromotedPostID(ctx context.Context, ideaID string) (string, error)
+	// PromoteIdea saves the draft, copies the idea platforms and labels to it, archives the idea and
+	// removes it from the idea queue in a single transaction. It returns ErrIdeaAlreadyPromoted when the idea
+	// was archived, by a concurrent promotion or before.
+	PromoteIdea(ctx context.Context, idea, draft *post.Post) error
+}
@@ -0,0 +1,169 @@
+package idea
+
+import (
+	"context"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+)
+
+type Service interface {
+	ListIdeas(ctx context.Context, projectID string, sort Sort) ([]*Idea, error)
+	// SortQueueByVotes reorders the project idea queue by votes and returns the ideas in the new order
+	SortQueueByVotes(ctx context.Context, projectID string) ([]*Idea, error)
+	Vote(ctx context.Context, projectID, ideaID string) error
+	RemoveVote(ctx context.Context, projectID, ideaID string) error
+	AddComment(ctx context.Context, projectID, ideaID, content string) (*Comment, error)
+	ListComments(ctx context.Context, projectID, ideaID string) ([]*Comment, error)
+	DeleteComment(ctx context.Context, projectID, ideaID, commentID string) error
+	// PromoteIdea turns an idea into a draft post attributed to the idea author. The idea is archived
+	// and keeps its votes and comments, the draft links back to it.
+	PromoteIdea(ctx context.Context, projectID, ideaID string) (*post.Post, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) ListIdeas(ctx context.Context, projectID string, sort Sort) ([]*Idea, error) {
+	if sort == "" {
+		sort = SortByQueue
+	}
+	if !sort.IsValid() {
+		return nil, ErrInvalidIdeaSort
+	}
+
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+	ideas, err := s.repo.ListIdeas(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if sort == SortByVotes {
+		sortByVotes(ideas)
+	}
+	return ideas, nil
+}
+
+func (s *service) SortQueueByVotes(ctx context.Context, projectID string) ([]*Idea, error) {
+	ideas, err := s.ListIdeas(ctx, projectID, SortByVotes)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := make([]string, 0, len(ideas))
+	for _, i := range ideas {
+		queue = append(queue, i.ID)
+	}
+	err = s.repo.UpdateIdeaQueue(ctx, projectID, queue)
+	if err != nil {
+		return nil, err
+	}
+	return ideas, nil
+}
+
+func (s *service) Vote(ctx context.Context, projectID, ideaID string) error {
+	if _, err := s.getOpenIdea(ctx, projectID, ideaID); err != nil {
+		return err
+	}
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+	return s.repo.AddVote(ctx, ideaID, userID)
+}
+
+func (s *service) RemoveVote(ctx context.Context, projectID, ideaID string) error {
+	if _, err := s.getIdea(ctx, projectID, ideaID); err != nil {
+		return err
+	}
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+	return s.repo.RemoveVote(ctx, ideaID, userID)
+}
+
+func (s *service) AddComment(ctx context.Context, projectID, ideaID, content string) (*Comment, error) {
+	if _, err := s.getIdea(ctx, projectID, ideaID); err != nil {
+		return nil, err
+	}
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+	c, err := NewComment(ideaID, userID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SaveComment(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *service) ListComments(ctx context.Context, projectID, ideaID string) ([]*Comment, error) {
+	if _, err := s.getIdea(ctx, projectID, ideaID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListComments(ctx, ideaID)
+}
+
+func (s *service) DeleteComment(ctx context.Context, projectID, ideaID, commentID string) error {
+	if _, err := s.getIdea(ctx, projectID, ideaID); err != nil {
+		return err
+	}
+	c, err := s.repo.FindComment(ctx, ideaID, commentID)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return ErrCommentNotFound
+	}
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+	if c.UserID != userID {
+		return ErrNotCommentAuthor
+	}
+	return s.repo.DeleteComment(ctx, commentID)
+}
+
+func (s *service) PromoteIdea(ctx context.Context, projectID, ideaID string) (*post.Post, error) {
+	i, err := s.getOpenIdea(ctx, projectID, ideaID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := NewDraftFromIdea(i)
+	err = s.repo.PromoteIdea(ctx, i, draft)
+	if err != nil {
+		return nil, err
+	}
+	return draft, nil
+}
+
+// getIdea returns the idea if it belongs to the project
+func (s *service) getIdea(ctx context.Context, projectID, ideaID string) (*post.Post, error) {
+	i, err := s.repo.FindIdea(ctx, projectID, ideaID)
+	if err != nil {
+		return nil, err
+	}
+	if i == nil {
+		return nil, ErrIdeaNotFound
+	}
+	if !i.IsIdea {
+		return nil, post.ErrPostIsNotIdea
+	}
+	return i, nil
+}
+
+// getOpenIdea returns the idea if it wasn't promoted yet
+func (s *service) getOpenIdea(ctx context.Context, projectID, ideaID string) (*post.Post, error) {
+	i, err := s.getIdea(ctx, projectID, ideaID)
+	if err != nil {
+		return nil, err
+	}
+	promotedID, err := s.repo.FindPromotedPostID(ctx, ideaID)
+	if err != nil {
+		return nil, err
+	}
+	if promotedID != "" {
+		return nil, ErrIdeaAlreadyPromoted
+	}
+	return i, nil
+}
@@ -0,0 +1,94 @@
+package idea
+
+import (
+	"context"
+	"testing"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newIdea(id string, votes int) *Idea {
+	return &Idea{Post: &post.Post{ID: id, IsIdea: true}, Votes: votes}
+}
+
+func TestSortQueueByVotes(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.UserIDKey, "user-1")
+	mockRepo := NewMockRepository(t)
+	mockRepo.On("ListIdeas", ctx, "project-1", "user-1").Return([]*Idea{
+		newIdea("idea-1", 1),
+		newIdea("idea-2", 3),
+		newIdea("idea-3", 1),
+		newIdea("idea-4", 0),
+	}, nil)
+	mockRepo.On("UpdateIdeaQueue", ctx, "project-1", []string{"idea-2", "idea-1", "idea-3", "idea-4"}).Return(nil)
+
+	s := NewService(mockRepo)
+	ideas, err := s.SortQueueByVotes(ctx, "project-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "idea-2", ideas[0].ID)
+}
+
+func TestListIdeas(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.UserIDKey, "user-1")
+
+	s := NewService(NewMockRepository(t))
+	_, err := s.ListIdeas(ctx, "project-1", Sort("likes"))
+	assert.ErrorIs(t, err, ErrInvalidIdeaSort)
+}
+
+func TestPromoteIdea(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.UserIDKey, "user-2")
+	idea := &post.Post{ID: "idea-1", ProjectID: "project-1", Title: "Idea", Type: post.PostTypeText, TextContent: "Content", IsIdea: true, CreatedBy: "user-1"}
+
+	t.Run("creates a draft attributed to the idea author", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockRepo.On("FindIdea", ctx, "project-1", "idea-1").Return(idea, nil)
+		mockRepo.On("FindPromotedPostID", ctx, "idea-1").Return("", nil)
+		mockRepo.On("PromoteIdea", ctx, idea, mock.AnythingOfType("*post.Post")).Return(nil)
+
+		s := NewService(mockRepo)
+		draft, err := s.PromoteIdea(ctx, "project-1", "idea-1")
+
+		assert.NoError(t, err)
+		assert.False(t, draft.IsIdea)
+		assert.Equal(t, string(post.PostStatusDraft), draft.Status)
+		assert.Equal(t, "user-1", draft.CreatedBy)
+		assert.Equal(t, "idea-1", *draft.IdeaID)
+		assert.Equal(t, "Content", draft.TextContent)
+	})
+
+	t.Run("fails when the idea was already promoted", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockRepo.On("FindIdea", ctx, "project-1", "idea-1").Return(idea, nil)
+		mockRepo.On("FindPromotedPostID", ctx, "idea-1").Return("post-1", nil)
+
+		s := NewService(mockRepo)
+		_, err := s.PromoteIdea(ctx, "project-1", "idea-1")
+		assert.ErrorIs(t, err, ErrIdeaAlreadyPromoted)
+	})
+
+	t.Run("fails when the post is not an idea", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockRepo.On("FindIdea", ctx, "project-1", "post-1").Return(&post.Post{ID: "post-1"}, nil)
+
+		s := NewService(mockRepo)
+		_, err := s.PromoteIdea(ctx, "project-1", "post-1")
+		assert.ErrorIs(t, err, post.ErrPostIsNotIdea)
+	})
+}
+
+func TestDeleteComment(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.UserIDKey, "user-2")
+	mockRepo := NewMockRepository(t)
+	mockRepo.On("FindIdea", ctx, "project-1", "idea-1").Return(&post.Post{ID: "idea-1", IsIdea: true}, nil)
+	mockRepo.On("FindComment", ctx, "idea-1", "comment-1").Return(&Comment{ID: "comment-1", UserID: "user-1"}, nil)
+
+	s := NewService(mockRepo)
+	err := s.DeleteComment(ctx, "project-1", "idea-1", "comment-1")
+	assert.ErrorIs(t, err, ErrNotCommentAuthor)
+	mockRepo.AssertNotCalled(t, "DeleteComment", mock.Anything, mock.Anything)
+}
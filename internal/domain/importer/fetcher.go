@@ -0,0 +1,63 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/fetching"
+)
+
+// MediaFetcher downloads the media referenced by URL in the import files
+type MediaFetcher interface {
+	// Fetch returns the downloaded file, the caller must close it
+	Fetch(ctx context.Context, url string) (fileName string, file *media.File, err error)
+}
+
+type httpFetcher struct {
+	client  *http.Client
+	maxSize int64
+}
+
+// NewHTTPFetcher returns a fetcher that refuses files bigger than maxSize bytes, and the urls
+// of addresses that aren't public
+func NewHTTPFetcher(timeout time.Duration, maxSize int64) MediaFetcher {
+	return &httpFetcher{
+		client:  fetching.NewClient(timeout),
+		maxSize: maxSize,
+	}
+}
+
+func (f *httpFetcher) Fetch(ctx context.Context, rawURL string) (string, *media.File, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", nil, err
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	file, err := media.NewFile(resp.Body, f.maxSize)
+	if errors.Is(err, media.ErrFileTooLarge) {
+		return "", nil, fmt.Errorf("file is bigger than %d bytes", f.maxSize)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return path.Base(u.Path), file, nil
+}
@@ -0,0 +1,86 @@
+package importer
+
+import (
+	"errors"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+)
+
+const (
+	MaxRows = 500
+	// MaxMediaSize is the largest media file downloaded from a media URL
+	MaxMediaSize = 50 << 20
+	// ScheduleQueue is the scheduled_at value that adds the post to the project queue instead of scheduling it
+	ScheduleQueue = "queue"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported import format, use csv or json")
+	ErrInvalidImportFile = errors.New("invalid import file")
+	ErrEmptyImport       = errors.New("import file has no rows")
+	ErrTooManyRows       = errors.New("import file has too many rows")
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+func (f Format) IsValid() bool {
+	return f == FormatCSV || f == FormatJSON
+}
+
+// Row is a post to import, as written in the import file
+type Row struct {
+	Title       string   `json:"title"`
+	Content     string   `json:"content"`
+	Type        string   `json:"type"`
+	Platforms   []string `json:"platforms"`
+	ScheduledAt string   `json:"scheduled_at"` // RFC3339 date, "queue" or empty for a draft
+	MediaURLs   []string `json:"media_urls"`
+	MediaIDs    []string `json:"media_ids"` // Media already uploaded to another post of the project
+}
+
+// RowError is a validation error of a row. Rows are numbered from 1, not counting the CSV header.
+type RowError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ImportedPost is a post created, or to be created on dry runs, by the import
+type ImportedPost struct {
+	Row    int    `json:"row"`
+	PostID string `json:"post_id,omitempty"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	// Error tells why a post to schedule or queue was kept as a draft
+	Error string `json:"error,omitempty"`
+}
+
+// Report describes the outcome of an import. Nothing is created unless every row is valid.
+type Report struct {
+	DryRun    bool            `json:"dry_run"`
+	Valid     bool            `json:"valid"`
+	Committed bool            `json:"committed"`
+	TotalRows int             `json:"total_rows"`
+	Errors    []*RowError     `json:"errors"`
+	Posts     []*ImportedPost `json:"posts"`
+}
+
+func (r *Report) addError(row int, field, message string) {
+	r.Errors = append(r.Errors, &RowError{Row: row, Field: field, Message: message})
+}
+
+// PostToSave holds everything the repository must write for an imported post
+type PostToSave struct {
+	Post      *post.Post
+	Platforms []string
+	// Media holds the metadata of the files stored for the post, thumbnails included
+	Media []*media.MetaData
+	// LinkedMediaIDs are linked to every platform of the post
+	LinkedMediaIDs []string
+}
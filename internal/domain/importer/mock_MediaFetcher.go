@@ -0,0 +1,103 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package importer
+
+import (
+	context "context"
+
+	media "github.com/redplanettribe/social-media-manager/internal/domain/media"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMediaFetcher is an autogenerated mock type for the MediaFetcher type
+type MockMediaFetcher struct {
+	mock.Mock
+}
+
+type MockMediaFetcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMediaFetcher) EXPECT() *MockMediaFetcher_Expecter {
+	return &MockMediaFetcher_Expecter{mock: &_m.Mock}
+}
+
+// Fetch provides a mock function with given fields: ctx, url
+func (_m *MockMediaFetcher) Fetch(ctx context.Context, url string) (string, *media.File, error) {
+	ret := _m.Called(ctx, url)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fetch")
+	}
+
+	var r0 string
+	var r1 *media.File
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, *media.File, error)); ok {
+		return rf(ctx, url)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, url)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *media.File); ok {
+		r1 = rf(ctx, url)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*media.File)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, url)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockMediaFetcher_Fetch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fetch'
+type MockMediaFetcher_Fetch_Call struct {
+	*mock.Call
+}
+
+// Fetch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+func (_e *MockMediaFetcher_Expecter) Fetch(ctx interface{}, url interface{}) *MockMediaFetcher_Fetch_Call {
+	return &MockMediaFetcher_Fetch_Call{Call: _e.mock.On("Fetch", ctx, url)}
+}
+
+func (_c *MockMediaFetcher_Fetch_Call) Run(run func(ctx context.Context, url string)) *MockMediaFetcher_Fetch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMediaFetcher_Fetch_Call) Return(fileName string, file *media.File, err error) *MockMediaFetcher_Fetch_Call {
+	_c.Call.Return(fileName, file, err)
+	return _c
+}
+
+func (_c *MockMediaFetcher_Fetch_Call) RunAndReturn(run func(context.Context, string) (string, *media.File, error)) *MockMediaFetcher_Fetch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMediaFetcher creates a new instance of MockMediaFetcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMediaFetcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMediaFetcher {
+	mock := &MockMediaFetcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,204 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package importer
+
+import (
+	context "context"
+
+	media "github.com/redplanettribe/social-media-manager/internal/domain/media"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindProjectMedia provides a mock function with given fields: ctx, projectID, mediaIDs
+func (_m *MockRepository) FindProjectMedia(ctx context.Context, projectID string, mediaIDs []string) ([]*media.MetaData, error) {
+	ret := _m.Called(ctx, projectID, mediaIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindProjectMedia")
+	}
+
+	var r0 []*media.MetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]*media.MetaData, error)); ok {
+		return rf(ctx, projectID, mediaIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*media.MetaData); ok {
+		r0 = rf(ctx, projectID, mediaIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*media.MetaData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, projectID, mediaIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindProjectMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindProjectMedia'
+type MockRepository_FindProjectMedia_Call struct {
+	*mock.Call
+}
+
+// FindProjectMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - mediaIDs []string
+func (_e *MockRepository_Expecter) FindProjectMedia(ctx interface{}, projectID interface{}, mediaIDs interface{}) *MockRepository_FindProjectMedia_Call {
+	return &MockRepository_FindProjectMedia_Call{Call: _e.mock.On("FindProjectMedia", ctx, projectID, mediaIDs)}
+}
+
+func (_c *MockRepository_FindProjectMedia_Call) Run(run func(ctx context.Context, projectID string, mediaIDs []string)) *MockRepository_FindProjectMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindProjectMedia_Call) Return(_a0 []*media.MetaData, _a1 error) *MockRepository_FindProjectMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindProjectMedia_Call) RunAndReturn(run func(context.Context, string, []string) ([]*media.MetaData, error)) *MockRepository_FindProjectMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnabledPlatforms provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) GetEnabledPlatforms(ctx context.Context, projectID string) ([]string, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnabledPlatforms")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetEnabledPlatforms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnabledPlatforms'
+type MockRepository_GetEnabledPlatforms_Call struct {
+	*mock.Call
+}
+
+// GetEnabledPlatforms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) GetEnabledPlatforms(ctx interface{}, projectID interface{}) *MockRepository_GetEnabledPlatforms_Call {
+	return &MockRepository_GetEnabledPlatforms_Call{Call: _e.mock.On("GetEnabledPlatforms", ctx, projectID)}
+}
+
+func (_c *MockRepository_GetEnabledPlatforms_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_GetEnabledPlatforms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetEnabledPlatforms_Call) Return(_a0 []string, _a1 error) *MockRepository_GetEnabledPlatforms_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetEnabledPlatforms_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *MockRepository_GetEnabledPlatforms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SavePosts provides a mock function with given fields: ctx, projectID, posts
+func (_m *MockRepository) SavePosts(ctx context.Context, projectID string, posts []*PostToSave) error {
+	ret := _m.Called(ctx, projectID, posts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePosts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*PostToSave) error); ok {
+		r0 = rf(ctx, projectID, posts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SavePosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePosts'
+type MockRepository_SavePosts_Call struct {
+	*mock.Call
+}
+
+// SavePosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - posts []*PostToSave
+func (_e *MockRepository_Expecter) SavePosts(ctx interface{}, projectID interface{}, posts interface{}) *MockRepository_SavePosts_Call {
+	return &MockRepository_SavePosts_Call{Call: _e.mock.On("SavePosts", ctx, projectID, posts)}
+}
+
+func (_c *MockRepository_SavePosts_Call) Run(run func(ctx context.Context, projectID string, posts []*PostToSave)) *MockRepository_SavePosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*PostToSave))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SavePosts_Call) Return(_a0 error) *MockRepository_SavePosts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SavePosts_Call) RunAndReturn(run func(context.Context, string, []*PostToSave) error) *MockRepository_SavePosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package importer
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// ImportPosts provides a mock function with given fields: ctx, projectID, format, r, dryRun
+func (_m *MockService) ImportPosts(ctx context.Context, projectID string, format Format, r io.Reader, dryRun bool) (*Report, error) {
+	ret := _m.Called(ctx, projectID, format, r, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportPosts")
+	}
+
+	var r0 *Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, Format, io.Reader, bool) (*Report, error)); ok {
+		return rf(ctx, projectID, format, r, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, Format, io.Reader, bool) *Report); ok {
+		r0 = rf(ctx, projectID, format, r, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, Format, io.Reader, bool) error); ok {
+		r1 = rf(ctx, projectID, format, r, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ImportPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportPosts'
+type MockService_ImportPosts_Call struct {
+	*mock.Call
+}
+
+// ImportPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - format Format
+//   - r io.Reader
+//   - dryRun bool
+func (_e *MockService_Expecter) ImportPosts(ctx interface{}, projectID interface{}, format interface{}, r interface{}, dryRun interface{}) *MockService_ImportPosts_Call {
+	return &MockService_ImportPosts_Call{Call: _e.mock.On("ImportPosts", ctx, projectID, format, r, dryRun)}
+}
+
+func (_c *MockService_ImportPosts_Call) Run(run func(ctx context.Context, projectID string, format Format, r io.Reader, dryRun bool)) *MockService_ImportPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(Format), args[3].(io.Reader), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockService_ImportPosts_Call) Return(_a0 *Report, _a1 error) *MockService_ImportPosts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ImportPosts_Call) RunAndReturn(run func(context.Context, string, Format, io.Reader, bool) (*Report, error)) *MockService_ImportPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,111 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// List columns of CSV files hold several values separated by this character
+const csvListSeparator = "|"
+
+var csvColumns = []string{"title", "content", "type", "platforms", "scheduled_at", "media_urls", "media_ids"}
+
+// Parse reads the rows of an import file
+func Parse(format Format, r io.Reader) ([]*Row, error) {
+	var (
+		rows []*Row
+		err  error
+	)
+	switch format {
+	case FormatCSV:
+		rows, err = parseCSV(r)
+	case FormatJSON:
+		rows, err = parseJSON(r)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrEmptyImport
+	}
+	if len(rows) > MaxRows {
+		return nil, fmt.Errorf("%w: the maximum is %d", ErrTooManyRows, MaxRows)
+	}
+	return rows, nil
+}
+
+func parseJSON(r io.Reader) ([]*Row, error) {
+	var rows []*Row
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, errors.Join(ErrInvalidImportFile, err)
+	}
+	return rows, nil
+}
+
+// parseCSV reads a CSV file whose first line is a header naming the columns. Only title is mandatory.
+func parseCSV(r io.Reader) ([]*Row, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Join(ErrInvalidImportFile, err)
+	}
+
+	index := make(map[string]int)
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := index["title"]; !ok {
+		return nil, fmt.Errorf("%w: missing title column, expected %s", ErrInvalidImportFile, strings.Join(csvColumns, ", "))
+	}
+
+	var rows []*Row
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Join(ErrInvalidImportFile, err)
+		}
+
+		get := func(column string) string {
+			i, ok := index[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		rows = append(rows, &Row{
+			Title:       get("title"),
+			Content:     get("content"),
+			Type:        get("type"),
+			Platforms:   splitList(get("platforms")),
+			ScheduledAt: get("scheduled_at"),
+			MediaURLs:   splitList(get("media_urls")),
+			MediaIDs:    splitList(get("media_ids")),
+		})
+	}
+	return rows, nil
+}
+
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, csvListSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,15 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+)
+
+type Repository interface {
+	GetEnabledPlatforms(ctx context.Context, projectID string) ([]string, error)
+	// FindProjectMedia returns the metadata of the media with the given IDs that belong to a post of the project
+	FindProjectMedia(ctx context.Context, projectID string, mediaIDs []string) ([]*media.MetaData, error)
+	// SavePosts writes the posts, their platforms and media in a single transaction
+	SavePosts(ctx context.Context, projectID string, posts []*PostToSave) error
+}
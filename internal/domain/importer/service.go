@@ -0,0 +1,289 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+)
+
+type Service interface {
+	// ImportPosts creates the posts of an import file, all of them or none. On dry runs nothing is created,
+	// the report only tells what would happen. Row validation errors are returned in the report, not as an error.
+	// Posts are then scheduled or queued through the post service, the ones it refuses are kept as drafts
+	// and the reason is reported with the post.
+	ImportPosts(ctx context.Context, projectID string, format Format, r io.Reader, dryRun bool) (*Report, error)
+}
+
+type service struct {
+	repo         Repository
+	mediaService media.Service
+	postService  post.Service
+	fetcher      MediaFetcher
+}
+
+func NewService(repo Repository, mediaService media.Service, postService post.Service, fetcher MediaFetcher) Service {
+	return &service{
+		repo:         repo,
+		mediaService: mediaService,
+		postService:  postService,
+		fetcher:      fetcher,
+	}
+}
+
+// plannedPost is a valid row, ready to be created
+type plannedPost struct {
+	row         int
+	r           *Row
+	status      post.PostStatus
+	scheduledAt time.Time
+	media       []*media.MetaData
+}
+
+func (s *service) ImportPosts(ctx context.Context, projectID string, format Format, r io.Reader, dryRun bool) (*Report, error) {
+	rows, err := Parse(format, r)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		DryRun:    dryRun,
+		TotalRows: len(rows),
+		Errors:    []*RowError{},
+		Posts:     []*ImportedPost{},
+	}
+
+	planned, err := s.validate(ctx, projectID, rows, report)
+	if err != nil {
+		return nil, err
+	}
+	report.Valid = len(report.Errors) == 0
+	if !report.Valid {
+		return report, nil
+	}
+
+	if dryRun {
+		for _, p := range planned {
+			report.Posts = append(report.Posts, &ImportedPost{Row: p.row, Title: p.r.Title, Status: string(p.status)})
+		}
+		return report, nil
+	}
+
+	return s.commit(ctx, projectID, planned, report)
+}
+
+func (s *service) validate(ctx context.Context, projectID string, rows []*Row, report *Report) ([]*plannedPost, error) {
+	enabledPlatforms, err := s.repo.GetEnabledPlatforms(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var mediaIDs []string
+	for _, row := range rows {
+		mediaIDs = append(mediaIDs, row.MediaIDs...)
+	}
+	projectMedia := make(map[string]*media.MetaData)
+	if len(mediaIDs) > 0 {
+		found, err := s.repo.FindProjectMedia(ctx, projectID, mediaIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, md := range found {
+			projectMedia[md.ID] = md
+		}
+	}
+
+	now := time.Now().UTC()
+	planned := make([]*plannedPost, 0, len(rows))
+	for i, row := range rows {
+		n := i + 1
+		p := &plannedPost{row: n, r: row, status: post.PostStatusDraft}
+
+		if row.Title == "" {
+			report.addError(n, "title", "required")
+		}
+		if row.Content == "" {
+			report.addError(n, "content", "required")
+		}
+		if row.Type == "" {
+			row.Type = string(post.PostTypeText)
+		}
+		if !post.PostType(row.Type).IsValid() {
+			report.addError(n, "type", post.ErrInvalidPostType.Error())
+		}
+		for _, platform := range row.Platforms {
+			if !slices.Contains(enabledPlatforms, platform) {
+				report.addError(n, "platforms", fmt.Sprintf("platform %q is not enabled for the project", platform))
+			}
+		}
+
+		switch row.ScheduledAt {
+		case "":
+		case ScheduleQueue:
+			p.status = post.PostStatusQueued
+		default:
+			scheduledAt, err := time.Parse(time.RFC3339, row.ScheduledAt)
+			if err != nil {
+				report.addError(n, "scheduled_at", `must be an RFC3339 date or "queue"`)
+				break
+			}
+			if scheduledAt.Before(now) {
+				report.addError(n, "scheduled_at", post.ErrPostScheduledTime.Error())
+				break
+			}
+			p.status = post.PostStatusScheduled
+			p.scheduledAt = scheduledAt.UTC()
+		}
+		if p.status != post.PostStatusDraft && len(row.Platforms) == 0 {
+			report.addError(n, "platforms", "scheduled and queued posts need at least one platform")
+		}
+
+		for _, rawURL := range row.MediaURLs {
+			u, err := url.Parse(rawURL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				report.addError(n, "media_urls", fmt.Sprintf("%q is not a valid http url", rawURL))
+				continue
+			}
+			if _, err := media.GetProcessor(path.Base(u.Path)); err != nil {
+				report.addError(n, "media_urls", fmt.Sprintf("%q: %s", rawURL, err.Error()))
+			}
+		}
+		for _, id := range row.MediaIDs {
+			md, ok := projectMedia[id]
+			if !ok {
+				report.addError(n, "media_ids", fmt.Sprintf("media %q not found in the project", id))
+				continue
+			}
+			p.media = append(p.media, md)
+		}
+
+		planned = append(planned, p)
+	}
+	return planned, nil
+}
+
+// storedFiles are the files uploaded for a post, removed if the import fails
+type storedFiles struct {
+	fileNames []string
+	media     []*media.MetaData
+}
+
+func (s *service) commit(ctx context.Context, projectID string, planned []*plannedPost, report *Report) (*Report, error) {
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+
+	var (
+		toSave = make([]*PostToSave, 0, len(planned))
+		stored []*storedFiles
+	)
+	cleanup := func() {
+		for _, sf := range stored {
+			_ = s.mediaService.DeleteMediaFiles(context.WithoutCancel(ctx), sf.media)
+		}
+	}
+
+	for _, p := range planned {
+		// Saved as a draft, schedule runs the checks of the post service before moving it on
+		np, err := post.NewPost(projectID, userID, p.r.Title, p.r.Type, p.r.Content, false, time.Time{})
+		if err != nil {
+			cleanup()
+			return nil, err
+		}
+
+		ps := &PostToSave{
+			Post:      np,
+			Platforms: p.r.Platforms,
+		}
+		sf := &storedFiles{}
+		stored = append(stored, sf)
+
+		store := func(fileName, altText string, r io.Reader) error {
+			if slices.Contains(sf.fileNames, fileName) {
+				fileName = fmt.Sprintf("%d_%s", len(ps.LinkedMediaIDs), fileName)
+			}
+			mds, err := s.mediaService.StoreMediaFiles(ctx, projectID, np.ID, fileName, altText, r)
+			if err != nil {
+				return err
+			}
+			for _, md := range mds {
+				sf.fileNames = append(sf.fileNames, md.Filename)
+			}
+			sf.media = append(sf.media, mds...)
+			ps.Media = append(ps.Media, mds...)
+			ps.LinkedMediaIDs = append(ps.LinkedMediaIDs, mds[0].ID)
+			return nil
+		}
+
+		for _, rawURL := range p.r.MediaURLs {
+			fileName, file, err := s.fetcher.Fetch(ctx, rawURL)
+			if err == nil {
+				err = store(fileName, "", file.Reader())
+				_ = file.Close()
+			}
+			if err != nil {
+				cleanup()
+				report.addError(p.row, "media_urls", fmt.Sprintf("%q: %s", rawURL, err.Error()))
+				report.Valid = false
+				return report, nil
+			}
+		}
+		for _, md := range p.media {
+			m, err := s.mediaService.GetMediaFile(ctx, projectID, md.PostID, md.Filename)
+			if err == nil {
+				err = store(md.Filename, md.AltText, m.File.Reader())
+				_ = m.Close()
+			}
+			if err != nil {
+				cleanup()
+				report.addError(p.row, "media_ids", fmt.Sprintf("%q: %s", md.ID, err.Error()))
+				report.Valid = false
+				return report, nil
+			}
+		}
+
+		toSave = append(toSave, ps)
+	}
+
+	err := s.repo.SavePosts(ctx, projectID, toSave)
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	report.Committed = true
+	for i, ps := range toSave {
+		imported := &ImportedPost{
+			Row:    planned[i].row,
+			PostID: ps.Post.ID,
+			Title:  ps.Post.Title,
+			Status: ps.Post.Status,
+		}
+		err = s.schedule(ctx, projectID, ps.Post.ID, planned[i])
+		if err != nil {
+			imported.Error = err.Error()
+		} else {
+			imported.Status = string(planned[i].status)
+		}
+		report.Posts = append(report.Posts, imported)
+	}
+	return report, nil
+}
+
+// schedule moves a saved draft to the status of its row, through the same checks as the posts
+// scheduled or queued by hand
+func (s *service) schedule(ctx context.Context, projectID, postID string, p *plannedPost) error {
+	switch p.status {
+	case post.PostStatusScheduled:
+		return s.postService.SchedulePost(ctx, postID, p.scheduledAt)
+	case post.PostStatusQueued:
+		return s.postService.AddToProjectQueue(ctx, projectID, postID, post.DefaultQueue)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("reads csv rows by header name", func(t *testing.T) {
+		file := "content,title,platforms\nHello world,First,linkedin|x\n"
+		rows, err := Parse(FormatCSV, strings.NewReader(file))
+
+		assert.NoError(t, err)
+		assert.Len(t, rows, 1)
+		assert.Equal(t, "First", rows[0].Title)
+		assert.Equal(t, "Hello world", rows[0].Content)
+		assert.Equal(t, []string{"linkedin", "x"}, rows[0].Platforms)
+	})
+
+	t.Run("fails without a title column", func(t *testing.T) {
+		_, err := Parse(FormatCSV, strings.NewReader("content\nHello\n"))
+		assert.ErrorIs(t, err, ErrInvalidImportFile)
+	})
+
+	t.Run("reads json rows", func(t *testing.T) {
+		rows, err := Parse(FormatJSON, strings.NewReader(`[{"title":"First","content":"Hello","scheduled_at":"queue"}]`))
+
+		assert.NoError(t, err)
+		assert.Equal(t, ScheduleQueue, rows[0].ScheduledAt)
+	})
+
+	t.Run("fails on empty files", func(t *testing.T) {
+		_, err := Parse(FormatJSON, strings.NewReader(`[]`))
+		assert.ErrorIs(t, err, ErrEmptyImport)
+	})
+
+	t.Run("fails on unknown formats", func(t *testing.T) {
+		_, err := Parse(Format("xml"), strings.NewReader(""))
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+}
+
+func TestImportPosts(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.UserIDKey, "user-1")
+	future := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+
+	t.Run("dry run reports row errors and creates nothing", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockMedia := media.NewMockService(t)
+		mockRepo.On("GetEnabledPlatforms", ctx, "project-1").Return([]string{"linkedin"}, nil)
+
+		file := `[
+			{"title":"Ok","content":"Hello","platforms":["linkedin"],"scheduled_at":"` + future + `"},
+			{"title":"","content":"Hello","platforms":["x"]},
+			{"title":"Past","content":"Hello","platforms":["linkedin"],"scheduled_at":"2001-01-01T00:00:00Z"}
+		]`
+		s := NewService(mockRepo, mockMedia, post.NewMockService(t), NewMockMediaFetcher(t))
+		report, err := s.ImportPosts(ctx, "project-1", FormatJSON, strings.NewReader(file), true)
+
+		assert.NoError(t, err)
+		assert.False(t, report.Valid)
+		assert.False(t, report.Committed)
+		assert.Equal(t, 3, report.TotalRows)
+		assert.ElementsMatch(t, []*RowError{
+			{Row: 2, Field: "title", Message: "required"},
+			{Row: 2, Field: "platforms", Message: `platform "x" is not enabled for the project`},
+			{Row: 3, Field: "scheduled_at", Message: post.ErrPostScheduledTime.Error()},
+		}, report.Errors)
+		mockRepo.AssertNotCalled(t, "SavePosts")
+	})
+
+	t.Run("dry run of a valid file lists the posts to create", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockRepo.On("GetEnabledPlatforms", ctx, "project-1").Return([]string{"linkedin"}, nil)
+
+		file := "title,content,platforms,scheduled_at\nFirst,Hello,linkedin,queue\nSecond,Draft,,\n"
+		s := NewService(mockRepo, media.NewMockService(t), post.NewMockService(t), NewMockMediaFetcher(t))
+		report, err := s.ImportPosts(ctx, "project-1", FormatCSV, strings.NewReader(file), true)
+
+		assert.NoError(t, err)
+		assert.True(t, report.Valid)
+		assert.Len(t, report.Posts, 2)
+		assert.Equal(t, string(post.PostStatusQueued), report.Posts[0].Status)
+		assert.Equal(t, string(post.PostStatusDraft), report.Posts[1].Status)
+	})
+
+	t.Run("commits every post in a single save", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockPosts := post.NewMockService(t)
+		mockRepo.On("GetEnabledPlatforms", ctx, "project-1").Return([]string{"linkedin"}, nil)
+		mockRepo.On("SavePosts", ctx, "project-1", mock.MatchedBy(func(posts []*PostToSave) bool {
+			return len(posts) == 2 &&
+				posts[0].Post.Status == string(post.PostStatusDraft) &&
+				posts[1].Post.Status == string(post.PostStatusDraft)
+		})).Return(nil)
+		mockPosts.On("AddToProjectQueue", ctx, "project-1", mock.Anything, post.DefaultQueue).Return(nil)
+
+		file := "title,content,platforms,scheduled_at\nFirst,Hello,linkedin,queue\nSecond,Draft,,\n"
+		s := NewService(mockRepo, media.NewMockService(t), mockPosts, NewMockMediaFetcher(t))
+		report, err := s.ImportPosts(ctx, "project-1", FormatCSV, strings.NewReader(file), false)
+
+		assert.NoError(t, err)
+		assert.True(t, report.Committed)
+		assert.NotEmpty(t, report.Posts[0].PostID)
+		assert.Equal(t, string(post.PostStatusQueued), report.Posts[0].Status)
+		assert.Equal(t, string(post.PostStatusDraft), report.Posts[1].Status)
+		mockPosts.AssertNumberOfCalls(t, "AddToProjectQueue", 1)
+	})
+
+	t.Run("keeps the posts refused by the post service as drafts", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockPosts := post.NewMockService(t)
+		mockRepo.On("GetEnabledPlatforms", ctx, "project-1").Return([]string{"linkedin"}, nil)
+		mockRepo.On("SavePosts", ctx, "project-1", mock.Anything).Return(nil)
+		mockPosts.On("SchedulePost", ctx, mock.Anything, mock.Anything).Return(post.ErrPostMediaProcessing).Once()
+		mockPosts.On("SchedulePost", ctx, mock.Anything, mock.Anything).Return(nil).Once()
+
+		file := `[
+			{"title":"Refused","content":"Hello","platforms":["linkedin"],"scheduled_at":"` + future + `"},
+			{"title":"Scheduled","content":"Hello","platforms":["linkedin"],"scheduled_at":"` + future + `"}
+		]`
+		s := NewService(mockRepo, media.NewMockService(t), mockPosts, NewMockMediaFetcher(t))
+		report, err := s.ImportPosts(ctx, "project-1", FormatJSON, strings.NewReader(file), false)
+
+		assert.NoError(t, err)
+		assert.True(t, report.Committed)
+		assert.Equal(t, string(post.PostStatusDraft), report.Posts[0].Status)
+		assert.Equal(t, post.ErrPostMediaProcessing.Error(), report.Posts[0].Error)
+		assert.Equal(t, string(post.PostStatusScheduled), report.Posts[1].Status)
+		assert.Empty(t, report.Posts[1].Error)
+	})
+
+	t.Run("removes uploaded media when a download fails", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockMedia := media.NewMockService(t)
+		mockFetcher := NewMockMediaFetcher(t)
+		mockRepo.On("GetEnabledPlatforms", ctx, "project-1").Return([]string{"linkedin"}, nil)
+		file, err := media.NewFile(strings.NewReader("a"), 0)
+		assert.NoError(t, err)
+		mockFetcher.On("Fetch", ctx, "https://example.com/a.png").Return("a.png", file, nil)
+		mockFetcher.On("Fetch", ctx, "https://example.com/b.png").Return("", nil, errors.New("not found"))
+		mockMedia.On("StoreMediaFiles", ctx, "project-1", mock.Anything, "a.png", "", mock.Anything).
+			Return([]*media.MetaData{{ID: "media-1", Filename: "a.png", ContentHash: "hash-a"}}, nil)
+		mockMedia.On("DeleteMediaFiles", mock.Anything, []*media.MetaData{{ID: "media-1", Filename: "a.png", ContentHash: "hash-a"}}).Return(nil)
+
+		rows := `[{"title":"First","content":"Hello","media_urls":["https://example.com/a.png","https://example.com/b.png"]}]`
+		s := NewService(mockRepo, mockMedia, post.NewMockService(t), mockFetcher)
+		report, err := s.ImportPosts(ctx, "project-1", FormatJSON, strings.NewReader(rows), false)
+
+		assert.NoError(t, err)
+		assert.False(t, report.Valid)
+		assert.False(t, report.Committed)
+		assert.Len(t, report.Errors, 1)
+		mockRepo.AssertNotCalled(t, "SavePosts")
+	})
+}
@@ -0,0 +1,62 @@
+package label
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrLabelNotFound      = errors.New("label not found")
+	ErrLabelAlreadyExists = errors.New("label already exists")
+	ErrLabelNameRequired  = errors.New("label name is required")
+	ErrInvalidLabelColor  = errors.New("invalid label color, expected #RRGGBB")
+	ErrPostNotInProject   = errors.New("post not in project")
+)
+
+const DefaultColor = "#9e9e9e"
+
+var colorRegex = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Label is a project scoped tag that can be attached to posts
+type Label struct {
+	ID        string    `json:"id"`
+	ProjectID string    `json:"project_id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewLabel(projectID, name, color string) (*Label, error) {
+	if projectID == "" {
+		return nil, errors.New("projectID cannot be empty")
+	}
+	l := &Label{
+		ID:        uuid.New().String(),
+		ProjectID: projectID,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := l.Update(name, color); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *Label) Update(name, color string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrLabelNameRequired
+	}
+	if color == "" {
+		color = DefaultColor
+	}
+	if !colorRegex.MatchString(color) {
+		return ErrInvalidLabelColor
+	}
+	l.Name = name
+	l.Color = strings.ToLower(color)
+	return nil
+}
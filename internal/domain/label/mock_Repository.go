@@ -0,0 +1,566 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package label
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddLabelToPost provides a mock function with given fields: ctx, postID, labelID
+func (_m *MockRepository) AddLabelToPost(ctx context.Context, postID string, labelID string) error {
+	ret := _m.Called(ctx, postID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddLabelToPost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, postID, labelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_AddLabelToPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddLabelToPost'
+type MockRepository_AddLabelToPost_Call struct {
+	*mock.Call
+}
+
+// AddLabelToPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - labelID string
+func (_e *MockRepository_Expecter) AddLabelToPost(ctx interface{}, postID interface{}, labelID interface{}) *MockRepository_AddLabelToPost_Call {
+	return &MockRepository_AddLabelToPost_Call{Call: _e.mock.On("AddLabelToPost", ctx, postID, labelID)}
+}
+
+func (_c *MockRepository_AddLabelToPost_Call) Run(run func(ctx context.Context, postID string, labelID string)) *MockRepository_AddLabelToPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_AddLabelToPost_Call) Return(_a0 error) *MockRepository_AddLabelToPost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_AddLabelToPost_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_AddLabelToPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) Return(_a0 error) *MockRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistsByName provides a mock function with given fields: ctx, projectID, name
+func (_m *MockRepository) ExistsByName(ctx context.Context, projectID string, name string) (bool, error) {
+	ret := _m.Called(ctx, projectID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsByName")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, projectID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, projectID, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_ExistsByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsByName'
+type MockRepository_ExistsByName_Call struct {
+	*mock.Call
+}
+
+// ExistsByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - name string
+func (_e *MockRepository_Expecter) ExistsByName(ctx interface{}, projectID interface{}, name interface{}) *MockRepository_ExistsByName_Call {
+	return &MockRepository_ExistsByName_Call{Call: _e.mock.On("ExistsByName", ctx, projectID, name)}
+}
+
+func (_c *MockRepository_ExistsByName_Call) Run(run func(ctx context.Context, projectID string, name string)) *MockRepository_ExistsByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_ExistsByName_Call) Return(_a0 bool, _a1 error) *MockRepository_ExistsByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_ExistsByName_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_ExistsByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *MockRepository) FindByID(ctx context.Context, id string) (*Label, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*Label, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *Label); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockRepository_FindByID_Call {
+	return &MockRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockRepository_FindByID_Call) Run(run func(ctx context.Context, id string)) *MockRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) Return(_a0 *Label, _a1 error) *MockRepository_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindByID_Call) RunAndReturn(run func(context.Context, string) (*Label, error)) *MockRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByPostID provides a mock function with given fields: ctx, postID
+func (_m *MockRepository) FindByPostID(ctx context.Context, postID string) ([]*Label, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByPostID")
+	}
+
+	var r0 []*Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Label, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Label); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindByPostID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByPostID'
+type MockRepository_FindByPostID_Call struct {
+	*mock.Call
+}
+
+// FindByPostID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockRepository_Expecter) FindByPostID(ctx interface{}, postID interface{}) *MockRepository_FindByPostID_Call {
+	return &MockRepository_FindByPostID_Call{Call: _e.mock.On("FindByPostID", ctx, postID)}
+}
+
+func (_c *MockRepository_FindByPostID_Call) Run(run func(ctx context.Context, postID string)) *MockRepository_FindByPostID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByPostID_Call) Return(_a0 []*Label, _a1 error) *MockRepository_FindByPostID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindByPostID_Call) RunAndReturn(run func(context.Context, string) ([]*Label, error)) *MockRepository_FindByPostID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByProjectID provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindByProjectID(ctx context.Context, projectID string) ([]*Label, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProjectID")
+	}
+
+	var r0 []*Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Label, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Label); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByProjectID'
+type MockRepository_FindByProjectID_Call struct {
+	*mock.Call
+}
+
+// FindByProjectID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) FindByProjectID(ctx interface{}, projectID interface{}) *MockRepository_FindByProjectID_Call {
+	return &MockRepository_FindByProjectID_Call{Call: _e.mock.On("FindByProjectID", ctx, projectID)}
+}
+
+func (_c *MockRepository_FindByProjectID_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByProjectID_Call) Return(_a0 []*Label, _a1 error) *MockRepository_FindByProjectID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindByProjectID_Call) RunAndReturn(run func(context.Context, string) ([]*Label, error)) *MockRepository_FindByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsPostInProject provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockRepository) IsPostInProject(ctx context.Context, projectID string, postID string) (bool, error) {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsPostInProject")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, projectID, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_IsPostInProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsPostInProject'
+type MockRepository_IsPostInProject_Call struct {
+	*mock.Call
+}
+
+// IsPostInProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockRepository_Expecter) IsPostInProject(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_IsPostInProject_Call {
+	return &MockRepository_IsPostInProject_Call{Call: _e.mock.On("IsPostInProject", ctx, projectID, postID)}
+}
+
+func (_c *MockRepository_IsPostInProject_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_IsPostInProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_IsPostInProject_Call) Return(_a0 bool, _a1 error) *MockRepository_IsPostInProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_IsPostInProject_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_IsPostInProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveLabelFromPost provides a mock function with given fields: ctx, postID, labelID
+func (_m *MockRepository) RemoveLabelFromPost(ctx context.Context, postID string, labelID string) error {
+	ret := _m.Called(ctx, postID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveLabelFromPost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, postID, labelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RemoveLabelFromPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveLabelFromPost'
+type MockRepository_RemoveLabelFromPost_Call struct {
+	*mock.Call
+}
+
+// RemoveLabelFromPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - labelID string
+func (_e *MockRepository_Expecter) RemoveLabelFromPost(ctx interface{}, postID interface{}, labelID interface{}) *MockRepository_RemoveLabelFromPost_Call {
+	return &MockRepository_RemoveLabelFromPost_Call{Call: _e.mock.On("RemoveLabelFromPost", ctx, postID, labelID)}
+}
+
+func (_c *MockRepository_RemoveLabelFromPost_Call) Run(run func(ctx context.Context, postID string, labelID string)) *MockRepository_RemoveLabelFromPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RemoveLabelFromPost_Call) Return(_a0 error) *MockRepository_RemoveLabelFromPost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RemoveLabelFromPost_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_RemoveLabelFromPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, l
+func (_m *MockRepository) Save(ctx context.Context, l *Label) error {
+	ret := _m.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Label) error); ok {
+		r0 = rf(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - l *Label
+func (_e *MockRepository_Expecter) Save(ctx interface{}, l interface{}) *MockRepository_Save_Call {
+	return &MockRepository_Save_Call{Call: _e.mock.On("Save", ctx, l)}
+}
+
+func (_c *MockRepository_Save_Call) Run(run func(ctx context.Context, l *Label)) *MockRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Label))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Save_Call) Return(_a0 error) *MockRepository_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Save_Call) RunAndReturn(run func(context.Context, *Label) error) *MockRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, l
+func (_m *MockRepository) Update(ctx context.Context, l *Label) error {
+	ret := _m.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Label) error); ok {
+		r0 = rf(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - l *Label
+func (_e *MockRepository_Expecter) Update(ctx interface{}, l interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, l)}
+}
+
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, l *Label)) *MockRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Label))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) Return(_a0 error) *MockRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, *Label) error) *MockRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,424 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package label
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// AddLabelToPost provides a mock function with given fields: ctx, projectID, postID, labelID
+func (_m *MockService) AddLabelToPost(ctx context.Context, projectID string, postID string, labelID string) error {
+	ret := _m.Called(ctx, projectID, postID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddLabelToPost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID, labelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_AddLabelToPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddLabelToPost'
+type MockService_AddLabelToPost_Call struct {
+	*mock.Call
+}
+
+// AddLabelToPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - labelID string
+func (_e *MockService_Expecter) AddLabelToPost(ctx interface{}, projectID interface{}, postID interface{}, labelID interface{}) *MockService_AddLabelToPost_Call {
+	return &MockService_AddLabelToPost_Call{Call: _e.mock.On("AddLabelToPost", ctx, projectID, postID, labelID)}
+}
+
+func (_c *MockService_AddLabelToPost_Call) Run(run func(ctx context.Context, projectID string, postID string, labelID string)) *MockService_AddLabelToPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_AddLabelToPost_Call) Return(_a0 error) *MockService_AddLabelToPost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_AddLabelToPost_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockService_AddLabelToPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLabel provides a mock function with given fields: ctx, projectID, name, color
+func (_m *MockService) CreateLabel(ctx context.Context, projectID string, name string, color string) (*Label, error) {
+	ret := _m.Called(ctx, projectID, name, color)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLabel")
+	}
+
+	var r0 *Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*Label, error)); ok {
+		return rf(ctx, projectID, name, color)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *Label); ok {
+		r0 = rf(ctx, projectID, name, color)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, name, color)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLabel'
+type MockService_CreateLabel_Call struct {
+	*mock.Call
+}
+
+// CreateLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - name string
+//   - color string
+func (_e *MockService_Expecter) CreateLabel(ctx interface{}, projectID interface{}, name interface{}, color interface{}) *MockService_CreateLabel_Call {
+	return &MockService_CreateLabel_Call{Call: _e.mock.On("CreateLabel", ctx, projectID, name, color)}
+}
+
+func (_c *MockService_CreateLabel_Call) Run(run func(ctx context.Context, projectID string, name string, color string)) *MockService_CreateLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateLabel_Call) Return(_a0 *Label, _a1 error) *MockService_CreateLabel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateLabel_Call) RunAndReturn(run func(context.Context, string, string, string) (*Label, error)) *MockService_CreateLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLabel provides a mock function with given fields: ctx, projectID, id
+func (_m *MockService) DeleteLabel(ctx context.Context, projectID string, id string) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLabel'
+type MockService_DeleteLabel_Call struct {
+	*mock.Call
+}
+
+// DeleteLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+func (_e *MockService_Expecter) DeleteLabel(ctx interface{}, projectID interface{}, id interface{}) *MockService_DeleteLabel_Call {
+	return &MockService_DeleteLabel_Call{Call: _e.mock.On("DeleteLabel", ctx, projectID, id)}
+}
+
+func (_c *MockService_DeleteLabel_Call) Run(run func(ctx context.Context, projectID string, id string)) *MockService_DeleteLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteLabel_Call) Return(_a0 error) *MockService_DeleteLabel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteLabel_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_DeleteLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostLabels provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockService) GetPostLabels(ctx context.Context, projectID string, postID string) ([]*Label, error) {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostLabels")
+	}
+
+	var r0 []*Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*Label, error)); ok {
+		return rf(ctx, projectID, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*Label); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetPostLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostLabels'
+type MockService_GetPostLabels_Call struct {
+	*mock.Call
+}
+
+// GetPostLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockService_Expecter) GetPostLabels(ctx interface{}, projectID interface{}, postID interface{}) *MockService_GetPostLabels_Call {
+	return &MockService_GetPostLabels_Call{Call: _e.mock.On("GetPostLabels", ctx, projectID, postID)}
+}
+
+func (_c *MockService_GetPostLabels_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockService_GetPostLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetPostLabels_Call) Return(_a0 []*Label, _a1 error) *MockService_GetPostLabels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetPostLabels_Call) RunAndReturn(run func(context.Context, string, string) ([]*Label, error)) *MockService_GetPostLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProjectLabels provides a mock function with given fields: ctx, projectID
+func (_m *MockService) ListProjectLabels(ctx context.Context, projectID string) ([]*Label, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectLabels")
+	}
+
+	var r0 []*Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Label, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Label); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListProjectLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectLabels'
+type MockService_ListProjectLabels_Call struct {
+	*mock.Call
+}
+
+// ListProjectLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockService_Expecter) ListProjectLabels(ctx interface{}, projectID interface{}) *MockService_ListProjectLabels_Call {
+	return &MockService_ListProjectLabels_Call{Call: _e.mock.On("ListProjectLabels", ctx, projectID)}
+}
+
+func (_c *MockService_ListProjectLabels_Call) Run(run func(ctx context.Context, projectID string)) *MockService_ListProjectLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListProjectLabels_Call) Return(_a0 []*Label, _a1 error) *MockService_ListProjectLabels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListProjectLabels_Call) RunAndReturn(run func(context.Context, string) ([]*Label, error)) *MockService_ListProjectLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveLabelFromPost provides a mock function with given fields: ctx, projectID, postID, labelID
+func (_m *MockService) RemoveLabelFromPost(ctx context.Context, projectID string, postID string, labelID string) error {
+	ret := _m.Called(ctx, projectID, postID, labelID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveLabelFromPost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID, labelID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_RemoveLabelFromPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveLabelFromPost'
+type MockService_RemoveLabelFromPost_Call struct {
+	*mock.Call
+}
+
+// RemoveLabelFromPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - labelID string
+func (_e *MockService_Expecter) RemoveLabelFromPost(ctx interface{}, projectID interface{}, postID interface{}, labelID interface{}) *MockService_RemoveLabelFromPost_Call {
+	return &MockService_RemoveLabelFromPost_Call{Call: _e.mock.On("RemoveLabelFromPost", ctx, projectID, postID, labelID)}
+}
+
+func (_c *MockService_RemoveLabelFromPost_Call) Run(run func(ctx context.Context, projectID string, postID string, labelID string)) *MockService_RemoveLabelFromPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_RemoveLabelFromPost_Call) Return(_a0 error) *MockService_RemoveLabelFromPost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_RemoveLabelFromPost_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockService_RemoveLabelFromPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLabel provides a mock function with given fields: ctx, projectID, id, name, color
+func (_m *MockService) UpdateLabel(ctx context.Context, projectID string, id string, name string, color string) (*Label, error) {
+	ret := _m.Called(ctx, projectID, id, name, color)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLabel")
+	}
+
+	var r0 *Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*Label, error)); ok {
+		return rf(ctx, projectID, id, name, color)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *Label); ok {
+		r0 = rf(ctx, projectID, id, name, color)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, id, name, color)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UpdateLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLabel'
+type MockService_UpdateLabel_Call struct {
+	*mock.Call
+}
+
+// UpdateLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+//   - name string
+//   - color string
+func (_e *MockService_Expecter) UpdateLabel(ctx interface{}, projectID interface{}, id interface{}, name interface{}, color interface{}) *MockService_UpdateLabel_Call {
+	return &MockService_UpdateLabel_Call{Call: _e.mock.On("UpdateLabel", ctx, projectID, id, name, color)}
+}
+
+func (_c *MockService_UpdateLabel_Call) Run(run func(ctx context.Context, projectID string, id string, name string, color string)) *MockService_UpdateLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateLabel_Call) Return(_a0 *Label, _a1 error) *MockService_UpdateLabel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UpdateLabel_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*Label, error)) *MockService_UpdateLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
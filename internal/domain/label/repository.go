@@ -0,0 +1,16 @@
+package label
+
+import "context"
+
+type Repository interface {
+	Save(ctx context.Context, l *Label) error
+	Update(ctx context.Context, l *Label) error
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Label, error)
+	FindByProjectID(ctx context.Context, projectID string) ([]*Label, error)
+	ExistsByName(ctx context.Context, projectID, name string) (bool, error)
+	IsPostInProject(ctx context.Context, projectID, postID string) (bool, error)
+	AddLabelToPost(ctx context.Context, postID, labelID string) error
+	RemoveLabelFromPost(ctx context.Context, postID, labelID string) error
+	FindByPostID(ctx context.Context, postID string) ([]*Label, error)
+}
@@ -0,0 +1,136 @@
+package label
+
+import (
+	"context"
+	"strings"
+)
+
+type Service interface {
+	CreateLabel(ctx context.Context, projectID, name, color string) (*Label, error)
+	UpdateLabel(ctx context.Context, projectID, id, name, color string) (*Label, error)
+	DeleteLabel(ctx context.Context, projectID, id string) error
+	ListProjectLabels(ctx context.Context, projectID string) ([]*Label, error)
+	AddLabelToPost(ctx context.Context, projectID, postID, labelID string) error
+	RemoveLabelFromPost(ctx context.Context, projectID, postID, labelID string) error
+	GetPostLabels(ctx context.Context, projectID, postID string) ([]*Label, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) CreateLabel(ctx context.Context, projectID, name, color string) (*Label, error) {
+	l, err := NewLabel(projectID, name, color)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.repo.ExistsByName(ctx, projectID, l.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrLabelAlreadyExists
+	}
+
+	err = s.repo.Save(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *service) UpdateLabel(ctx context.Context, projectID, id, name, color string) (*Label, error) {
+	l, err := s.getProjectLabel(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previousName := l.Name
+	err = l.Update(name, color)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(previousName, l.Name) {
+		exists, err := s.repo.ExistsByName(ctx, projectID, l.Name)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, ErrLabelAlreadyExists
+		}
+	}
+
+	err = s.repo.Update(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *service) DeleteLabel(ctx context.Context, projectID, id string) error {
+	_, err := s.getProjectLabel(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *service) ListProjectLabels(ctx context.Context, projectID string) ([]*Label, error) {
+	return s.repo.FindByProjectID(ctx, projectID)
+}
+
+func (s *service) AddLabelToPost(ctx context.Context, projectID, postID, labelID string) error {
+	err := s.checkPostAndLabel(ctx, projectID, postID, labelID)
+	if err != nil {
+		return err
+	}
+	return s.repo.AddLabelToPost(ctx, postID, labelID)
+}
+
+func (s *service) RemoveLabelFromPost(ctx context.Context, projectID, postID, labelID string) error {
+	err := s.checkPostAndLabel(ctx, projectID, postID, labelID)
+	if err != nil {
+		return err
+	}
+	return s.repo.RemoveLabelFromPost(ctx, postID, labelID)
+}
+
+func (s *service) GetPostLabels(ctx context.Context, projectID, postID string) ([]*Label, error) {
+	inProject, err := s.repo.IsPostInProject(ctx, projectID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if !inProject {
+		return nil, ErrPostNotInProject
+	}
+	return s.repo.FindByPostID(ctx, postID)
+}
+
+func (s *service) getProjectLabel(ctx context.Context, projectID, id string) (*Label, error) {
+	l, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if l == nil || l.ProjectID != projectID {
+		return nil, ErrLabelNotFound
+	}
+	return l, nil
+}
+
+func (s *service) checkPostAndLabel(ctx context.Context, projectID, postID, labelID string) error {
+	inProject, err := s.repo.IsPostInProject(ctx, projectID, postID)
+	if err != nil {
+		return err
+	}
+	if !inProject {
+		return ErrPostNotInProject
+	}
+	_, err = s.getProjectLabel(ctx, projectID, labelID)
+	return err
+}
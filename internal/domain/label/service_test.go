@@ -0,0 +1,46 @@
+package label
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewLabel(t *testing.T) {
+	l, err := NewLabel("project-1", "  Launch ", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "Launch", l.Name)
+	assert.Equal(t, DefaultColor, l.Color)
+
+	_, err = NewLabel("project-1", "Launch", "red")
+	assert.ErrorIs(t, err, ErrInvalidLabelColor)
+
+	_, err = NewLabel("project-1", " ", "#FF0000")
+	assert.ErrorIs(t, err, ErrLabelNameRequired)
+}
+
+func TestCreateLabel_AlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := NewMockRepository(t)
+	mockRepo.On("ExistsByName", ctx, "project-1", "Launch").Return(true, nil)
+
+	s := NewService(mockRepo)
+	_, err := s.CreateLabel(ctx, "project-1", "Launch", "#ff0000")
+
+	assert.ErrorIs(t, err, ErrLabelAlreadyExists)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestAddLabelToPost_LabelFromAnotherProject(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := NewMockRepository(t)
+	mockRepo.On("IsPostInProject", ctx, "project-1", "post-1").Return(true, nil)
+	mockRepo.On("FindByID", ctx, "label-1").Return(&Label{ID: "label-1", ProjectID: "project-2"}, nil)
+
+	s := NewService(mockRepo)
+	err := s.AddLabelToPost(ctx, "project-1", "post-1", "label-1")
+
+	assert.ErrorIs(t, err, ErrLabelNotFound)
+}
@@ -0,0 +1,299 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// MaxBrandingTextLength is the longest text of a branding, in characters
+const MaxBrandingTextLength = 100
+
+var (
+	ErrBrandingNotFound         = errors.New("branding not found")
+	ErrInvalidBrandingPosition  = errors.New("invalid branding position")
+	ErrInvalidBrandingOpacity   = errors.New("branding opacity must be between 0 and 1")
+	ErrInvalidBrandingScale     = errors.New("branding scale must be greater than 0 and at most 1")
+	ErrBrandingTextTooLong      = errors.New("branding text too long")
+	ErrBrandingLogoNotAnImage   = errors.New("branding logo must be an image")
+	ErrBrandingLogoNotInLibrary = errors.New("branding logo must be a media of the project library")
+)
+
+// BrandingPosition is the corner, or the center, of the images where the branding is stamped
+type BrandingPosition string
+
+const (
+	BrandingPositionTopLeft     BrandingPosition = "top_left"
+	BrandingPositionTopRight    BrandingPosition = "top_right"
+	BrandingPositionBottomLeft  BrandingPosition = "bottom_left"
+	BrandingPositionBottomRight BrandingPosition = "bottom_right"
+	BrandingPositionCenter      BrandingPosition = "center"
+)
+
+func (p BrandingPosition) IsValid() bool {
+	switch p {
+	case BrandingPositionTopLeft, BrandingPositionTopRight, BrandingPositionBottomLeft,
+		BrandingPositionBottomRight, BrandingPositionCenter:
+		return true
+	}
+	return false
+}
+
+// Branding is the logo of a project, with an optional text under it, stamped on the images of the posts that
+// opt in for a platform. It's stamped on the file sent to the platform only, the media and their renditions are
+// left as they are.
+type Branding struct {
+	ProjectID   string           `json:"project_id"`
+	LogoMediaID string           `json:"logo_media_id"` // an image of the project library
+	Position    BrandingPosition `json:"position"`
+	// Opacity goes from 0, invisible, to 1, opaque
+	Opacity float64 `json:"opacity"`
+	// Scale is the width of the logo as a fraction of the width of the image
+	Scale     float64   `json:"scale"`
+	Text      string    `json:"text"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (b *Branding) Validate() error {
+	switch {
+	case !b.Position.IsValid():
+		return ErrInvalidBrandingPosition
+	case b.Opacity < 0 || b.Opacity > 1:
+		return ErrInvalidBrandingOpacity
+	case b.Scale <= 0 || b.Scale > 1:
+		return ErrInvalidBrandingScale
+	case utf8.RuneCountInString(b.Text) > MaxBrandingTextLength:
+		return ErrBrandingTextTooLong
+	}
+	return nil
+}
+
+// Stamp returns a copy of an image with the branding drawn over it
+func (b *Branding) Stamp(img, logo image.Image) (image.Image, error) {
+	bounds := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+
+	mark, err := b.mark(logo, dst.Bounds().Dx())
+	if err != nil {
+		return nil, err
+	}
+	at := b.place(dst.Bounds(), mark.Bounds().Size())
+	opacity := image.NewUniform(color.Alpha16{A: uint16(b.Opacity * 0xffff)})
+	draw.DrawMask(dst, mark.Bounds().Add(at), mark, image.Point{}, opacity, image.Point{}, draw.Over)
+	return dst, nil
+}
+
+// mark returns the logo scaled for an image of the given width, with the text centered under it
+func (b *Branding) mark(logo image.Image, width int) (*image.RGBA, error) {
+	logoBounds := logo.Bounds()
+	logoWidth := max(1, int(b.Scale*float64(width)))
+	logoHeight := max(1, logoWidth*logoBounds.Dy()/max(1, logoBounds.Dx()))
+	scaled := image.NewRGBA(image.Rect(0, 0, logoWidth, logoHeight))
+	draw.CatmullRom.Scale(scaled, scaled.Bounds(), logo, logoBounds, draw.Src, nil)
+	if b.Text == "" {
+		return scaled, nil
+	}
+
+	// The text is a third of the height of the logo, readable whatever its shape
+	text, err := renderText(b.Text, max(8, float64(logoHeight)/3))
+	if err != nil {
+		return nil, err
+	}
+	gap := text.Bounds().Dy() / 4
+	mark := image.NewRGBA(image.Rect(0, 0, max(logoWidth, text.Bounds().Dx()), logoHeight+gap+text.Bounds().Dy()))
+	draw.Draw(mark, scaled.Bounds().Add(image.Pt((mark.Bounds().Dx()-logoWidth)/2, 0)), scaled, image.Point{}, draw.Src)
+	textAt := image.Pt((mark.Bounds().Dx()-text.Bounds().Dx())/2, logoHeight+gap)
+	draw.Draw(mark, text.Bounds().Add(textAt), text, image.Point{}, draw.Over)
+	return mark, nil
+}
+
+// place returns where a mark of the given size goes in an image, away from the edges by 2% of its shorter side
+func (b *Branding) place(bounds image.Rectangle, size image.Point) image.Point {
+	margin := min(bounds.Dx(), bounds.Dy()) / 50
+	left, top := margin, margin
+	right, bottom := bounds.Dx()-size.X-margin, bounds.Dy()-size.Y-margin
+	switch b.Position {
+	case BrandingPositionTopLeft:
+		return image.Pt(left, top)
+	case BrandingPositionTopRight:
+		return image.Pt(right, top)
+	case BrandingPositionBottomLeft:
+		return image.Pt(left, bottom)
+	case BrandingPositionCenter:
+		return image.Pt((bounds.Dx()-size.X)/2, (bounds.Dy()-size.Y)/2)
+	}
+	return image.Pt(right, bottom)
+}
+
+var brandingFont = sync.OnceValues(func() (*opentype.Font, error) {
+	return opentype.Parse(goregular.TTF)
+})
+
+// renderText draws a line of white text with a dark shadow, so it can be read on light and dark images. size is
+// the height of the font in pixels.
+func renderText(text string, size float64) (*image.RGBA, error) {
+	f, err := brandingFont()
+	if err != nil {
+		return nil, err
+	}
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, err
+	}
+	defer face.Close()
+
+	metrics := face.Metrics()
+	shadow := max(1, int(size/16))
+	width := font.MeasureString(face, text).Ceil() + shadow
+	height := (metrics.Ascent + metrics.Descent).Ceil() + shadow
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(color.RGBA{A: 0xa0}), Face: face}
+	d.Dot = fixed.P(shadow, metrics.Ascent.Ceil()+shadow)
+	d.DrawString(text)
+	d.Src, d.Dot = image.White, fixed.P(0, metrics.Ascent.Ceil())
+	d.DrawString(text)
+	return img, nil
+}
+
+// GetBranding returns the branding of a project
+func (s *service) GetBranding(ctx context.Context, projectID string) (*Branding, error) {
+	b, err := s.repo.FindBranding(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, ErrBrandingNotFound
+	}
+	return b, nil
+}
+
+// SetBranding saves the branding of a project, replacing the previous one. The logo must be an image of the
+// project library.
+func (s *service) SetBranding(ctx context.Context, b *Branding) (*Branding, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	md, err := s.repo.GetMetadataByID(ctx, b.LogoMediaID)
+	if err != nil {
+		return nil, err
+	}
+	if md == nil || md.ProjectID != b.ProjectID || md.PostID != "" {
+		return nil, ErrBrandingLogoNotInLibrary
+	}
+	if !md.IsImage() {
+		return nil, ErrBrandingLogoNotAnImage
+	}
+
+	b.UpdatedAt = time.Now().UTC()
+	err = s.repo.SaveBranding(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// DeleteBranding removes the branding of a project. The posts that opted in are published without it.
+func (s *service) DeleteBranding(ctx context.Context, projectID string) error {
+	deleted, err := s.repo.DeleteBranding(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrBrandingNotFound
+	}
+	return nil
+}
+
+// SetPostBranding opts a post in or out of the branding of its project for a platform
+func (s *service) SetPostBranding(ctx context.Context, projectID, postID, platformID string, apply bool) error {
+	belongs, err := s.repo.DoesPostBelongToProject(ctx, projectID, postID)
+	if err != nil {
+		return err
+	}
+	if !belongs {
+		return ErrPostDoesNotBelongToProject
+	}
+
+	enabled, err := s.repo.IsThePostEnabledToPlatform(ctx, postID, platformID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return ErrPostNotLinkedToPlatform
+	}
+	return s.repo.SetApplyBranding(ctx, postID, platformID, apply)
+}
+
+// getPublishBranding returns the branding to stamp on the images of a post for a platform, with its logo decoded.
+// It returns nil when the post didn't opt in or the project has no branding.
+func (s *service) getPublishBranding(ctx context.Context, projectID, postID, platformID string) (*Branding, image.Image, error) {
+	b, err := s.repo.FindPublishBranding(ctx, postID, platformID)
+	if err != nil || b == nil {
+		return nil, nil, err
+	}
+
+	md, err := s.repo.GetMetadataByID(ctx, b.LogoMediaID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if md == nil {
+		return nil, nil, ErrBrandingLogoNotInLibrary
+	}
+	m, err := s.GetMediaFile(ctx, projectID, md.PostID, md.Filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer m.Close()
+
+	src, err := decodableImage(md.Filename, m.File)
+	if err != nil {
+		return nil, nil, err
+	}
+	logo, _, err := image.Decode(src.Reader())
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, logo, nil
+}
+
+// stampMedia replaces the file of an image by a copy with the branding, encoded to fit the profile of the platform
+func (s *service) stampMedia(m *Media, b *Branding, logo image.Image, platformID string) error {
+	img, format, err := image.Decode(m.File.Reader())
+	if err != nil {
+		return err
+	}
+	stamped, err := b.Stamp(img, logo)
+	if err != nil {
+		return err
+	}
+	data, format, err := encodeImage(stamped, format, s.imageProfiles[platformID].MaxSize)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return ErrFileTooLarge
+	}
+	file, err := NewFile(bytes.NewReader(data), 0)
+	if err != nil {
+		return err
+	}
+
+	closeErr := m.File.Close()
+	m.File = file
+	m.Format, m.Size = format, len(data)
+	return closeErr
+}
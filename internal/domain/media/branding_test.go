@@ -0,0 +1,187 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func whiteImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	return img
+}
+
+func TestBranding_Validate(t *testing.T) {
+	valid := Branding{Position: BrandingPositionBottomRight, Opacity: 0.8, Scale: 0.2}
+	assert.NoError(t, valid.Validate())
+
+	b := valid
+	b.Position = "middle"
+	assert.ErrorIs(t, b.Validate(), ErrInvalidBrandingPosition)
+	b = valid
+	b.Opacity = 1.5
+	assert.ErrorIs(t, b.Validate(), ErrInvalidBrandingOpacity)
+	b = valid
+	b.Scale = 0
+	assert.ErrorIs(t, b.Validate(), ErrInvalidBrandingScale)
+	b = valid
+	b.Text = string(bytes.Repeat([]byte("a"), MaxBrandingTextLength+1))
+	assert.ErrorIs(t, b.Validate(), ErrBrandingTextTooLong)
+}
+
+func TestBranding_Stamp(t *testing.T) {
+	img := whiteImage(500, 200)
+	logo := solidImage(40, 20)
+
+	t.Run("places the logo in its corner, scaled to the image", func(t *testing.T) {
+		b := &Branding{Position: BrandingPositionBottomRight, Opacity: 1, Scale: 0.2}
+
+		stamped, err := b.Stamp(img, logo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, img.Bounds(), stamped.Bounds())
+		// The logo is 100x50, 4 pixels away from the edges
+		assert.Equal(t, color.RGBA{R: 200, A: 255}, color.RGBAModel.Convert(stamped.At(450, 170)))
+		assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, color.RGBAModel.Convert(stamped.At(380, 170)))
+		assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, color.RGBAModel.Convert(stamped.At(450, 120)))
+		assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, color.RGBAModel.Convert(stamped.At(498, 198)))
+	})
+
+	t.Run("blends the logo with the opacity", func(t *testing.T) {
+		b := &Branding{Position: BrandingPositionTopLeft, Opacity: 0.5, Scale: 0.2}
+
+		stamped, err := b.Stamp(img, logo)
+
+		assert.NoError(t, err)
+		c := color.RGBAModel.Convert(stamped.At(50, 25)).(color.RGBA)
+		assert.InDelta(t, 227, int(c.R), 2)
+		assert.InDelta(t, 127, int(c.G), 2)
+	})
+
+	t.Run("writes the text under the logo", func(t *testing.T) {
+		b := &Branding{Position: BrandingPositionTopLeft, Opacity: 1, Scale: 0.2}
+		withText := *b
+		withText.Text = "@redplanet"
+
+		plain, err := b.Stamp(img, logo)
+		assert.NoError(t, err)
+		stamped, err := withText.Stamp(img, logo)
+		assert.NoError(t, err)
+
+		var changed bool
+		for y := 60; y < 100 && !changed; y++ {
+			for x := 0; x < 200 && !changed; x++ {
+				changed = stamped.At(x, y) != plain.At(x, y)
+			}
+		}
+		assert.True(t, changed)
+	})
+
+	t.Run("leaves the original image as it is", func(t *testing.T) {
+		b := &Branding{Position: BrandingPositionCenter, Opacity: 1, Scale: 0.5}
+
+		_, err := b.Stamp(img, logo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, img.At(250, 100))
+	})
+}
+
+func TestSetBranding(t *testing.T) {
+	ctx := context.Background()
+	branding := func() *Branding {
+		return &Branding{ProjectID: "project-1", LogoMediaID: "logo-1", Position: BrandingPositionBottomRight, Opacity: 1, Scale: 0.2}
+	}
+
+	t.Run("saves the branding", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().GetMetadataByID(ctx, "logo-1").Return(&MetaData{ID: "logo-1", ProjectID: "project-1", Type: MediaTypeImage}, nil)
+		repo.EXPECT().SaveBranding(ctx, mock.MatchedBy(func(b *Branding) bool {
+			return b.LogoMediaID == "logo-1" && !b.UpdatedAt.IsZero()
+		})).Return(nil)
+
+		got, err := NewService(repo, NewMockObjectRepository(t)).SetBranding(ctx, branding())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "logo-1", got.LogoMediaID)
+	})
+
+	t.Run("rejects logos out of the project library", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().GetMetadataByID(ctx, "logo-1").Return(&MetaData{ID: "logo-1", ProjectID: "project-1", PostID: "post-1", Type: MediaTypeImage}, nil)
+
+		_, err := NewService(repo, NewMockObjectRepository(t)).SetBranding(ctx, branding())
+
+		assert.ErrorIs(t, err, ErrBrandingLogoNotInLibrary)
+	})
+
+	t.Run("rejects logos that aren't images", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().GetMetadataByID(ctx, "logo-1").Return(&MetaData{ID: "logo-1", ProjectID: "project-1", Type: MediaTypeVideo}, nil)
+
+		_, err := NewService(repo, NewMockObjectRepository(t)).SetBranding(ctx, branding())
+
+		assert.ErrorIs(t, err, ErrBrandingLogoNotAnImage)
+	})
+}
+
+func TestGetMediaForPublishPost_Branding(t *testing.T) {
+	ctx := context.Background()
+	photo := &MetaData{
+		ID: "media-1", ProjectID: "project-1", PostID: "post-1", Filename: "photo.png", Type: MediaTypeImage,
+		Status: MediaStatusReady,
+	}
+	logoMd := &MetaData{ID: "logo-1", ProjectID: "project-1", Filename: "logo.png", Type: MediaTypeImage, Status: MediaStatusReady}
+	photoData := encodePNG(t, whiteImage(500, 200))
+	logoData := encodePNG(t, solidImage(40, 20))
+
+	repo := NewMockRepository(t)
+	objectRepo := NewMockObjectRepository(t)
+	repo.EXPECT().GetMediaForPublishPost(ctx, "post-1", "custom").Return([]*MetaData{photo}, nil)
+	repo.EXPECT().FindPublishBranding(ctx, "post-1", "custom").Return(&Branding{
+		ProjectID: "project-1", LogoMediaID: "logo-1", Position: BrandingPositionBottomRight, Opacity: 1, Scale: 0.2,
+	}, nil)
+	repo.EXPECT().GetMetadataByID(ctx, "logo-1").Return(logoMd, nil)
+	repo.EXPECT().GetMetadata(ctx, "project-1", "", "logo.png").Return(logoMd, nil)
+	objectRepo.EXPECT().GetFile(ctx, "project-1", "", "logo.png").Return(io.NopCloser(bytes.NewReader(logoData)), nil)
+	// The media are downloaded concurrently, with a context of their own
+	repo.EXPECT().GetMetadata(mock.Anything, "project-1", "post-1", "photo.png").Return(photo, nil)
+	objectRepo.EXPECT().GetFile(mock.Anything, "project-1", "post-1", "photo.png").Return(io.NopCloser(bytes.NewReader(photoData)), nil)
+
+	medias, err := NewService(repo, objectRepo).GetMediaForPublishPost(ctx, "project-1", "post-1", "custom")
+
+	assert.NoError(t, err)
+	defer CloseAll(medias)
+	assert.Len(t, medias, 1)
+	img, err := png.Decode(medias[0].File.Reader())
+	assert.NoError(t, err)
+	assert.Equal(t, color.RGBA{R: 200, A: 255}, color.RGBAModel.Convert(img.At(450, 170)))
+	assert.Equal(t, "png", medias[0].Format)
+	assert.Equal(t, int(medias[0].File.Size()), medias[0].Size)
+}
+
+func TestGetMediaDetailsForPublishPost_Branding(t *testing.T) {
+	ctx := context.Background()
+	photo := &MetaData{
+		ID: "media-1", ProjectID: "project-1", PostID: "post-1", Filename: "photo.png", Type: MediaTypeImage,
+		Format: "png", Width: 500, Height: 500, Size: 100, Status: MediaStatusReady,
+	}
+	repo := NewMockRepository(t)
+	repo.EXPECT().GetMediaForPublishPost(ctx, "post-1", "custom").Return([]*MetaData{photo}, nil)
+
+	// The branding isn't read, the images are only stamped when they are published
+	medias, err := NewService(repo, NewMockObjectRepository(t)).GetMediaDetailsForPublishPost(ctx, "project-1", "post-1", "custom")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*Media{{MetaData: photo}}, medias)
+}
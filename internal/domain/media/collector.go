@@ -0,0 +1,70 @@
+package media
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/config"
+)
+
+// Collector periodically removes the files of the object store that have no metadata, and the direct uploads
+// that were never completed
+type Collector struct {
+	service Service
+	cfg     *config.MediaGCConfig
+	quit    chan struct{}
+}
+
+func NewCollector(service Service, cfg *config.MediaGCConfig) *Collector {
+	return &Collector{
+		service: service,
+		cfg:     cfg,
+		quit:    make(chan struct{}),
+	}
+}
+
+func (c *Collector) Start(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.Interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				c.collect(ctx)
+			case <-c.quit:
+				ticker.Stop()
+				return
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (c *Collector) Stop() {
+	close(c.quit)
+}
+
+func (c *Collector) collect(ctx context.Context) {
+	purged, err := c.service.PurgeExpiredUploads(ctx)
+	if err != nil {
+		log.Printf("Error purging expired uploads: %v", err)
+	}
+	if purged > 0 {
+		log.Printf("Media GC: purged %d expired uploads", purged)
+	}
+
+	olderThan := time.Now().UTC().Add(-c.cfg.GracePeriod)
+	report, err := c.service.CollectGarbage(ctx, olderThan, false)
+	if err != nil {
+		log.Printf("Error collecting media garbage: %v", err)
+	}
+	if report == nil {
+		return
+	}
+	log.Printf("Media GC: scanned %d files, deleted %d of %d orphans", report.Scanned, report.Deleted, len(report.Orphans))
+	for _, md := range report.Missing {
+		log.Printf("Media GC: file %s of post %s is missing from the object store (media %s)", md.Filename, md.PostID, md.ID)
+	}
+}
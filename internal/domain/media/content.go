@@ -0,0 +1,73 @@
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+)
+
+// ContentHash returns the SHA-256 of a file, hex encoded
+func ContentHash(f *File) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, f.Reader()); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// storeContent stores the file of a media under its content hash, sets the hash in its metadata, and saves the
+// metadata with save, on the repository given to it. A file the project already has isn't uploaded again. The
+// content stays locked until the metadata is saved, so deleteContent can't remove the blob in between. save is nil
+// when the caller saves the metadata itself.
+func (s *service) storeContent(ctx context.Context, md *MetaData, file *File, save func(repo Repository) error) error {
+	hash, err := ContentHash(file)
+	if err != nil {
+		return err
+	}
+	md.ContentHash = hash
+
+	return s.repo.LockContent(ctx, md.ProjectID, hash, func(repo Repository) error {
+		_, err := s.objectRepo.StatBlob(ctx, md.ProjectID, hash)
+		if errors.Is(err, ErrFileNotFound) {
+			err = s.objectRepo.UploadBlob(ctx, md.ProjectID, hash, file.Reader())
+		}
+		if err != nil || save == nil {
+			return err
+		}
+		return save(repo)
+	})
+}
+
+// getContent streams the file of a media, from its blob or by name for the files stored by name. The caller must
+// close it.
+func (s *service) getContent(ctx context.Context, md *MetaData) (io.ReadCloser, error) {
+	if md.ContentHash == "" {
+		return s.objectRepo.GetFile(ctx, md.ProjectID, md.PostID, md.Filename)
+	}
+	return s.objectRepo.GetBlob(ctx, md.ProjectID, md.ContentHash)
+}
+
+// signContent returns a URL to download the file of a media
+func (s *service) signContent(ctx context.Context, md *MetaData) (string, error) {
+	if md.ContentHash == "" {
+		return s.objectRepo.GetSignedURL(ctx, md.ProjectID, md.PostID, md.Filename)
+	}
+	return s.objectRepo.GetSignedBlobURL(ctx, md.ProjectID, md.ContentHash, md.Filename)
+}
+
+// deleteContent removes the file of a media whose metadata was deleted, or never saved. Blobs are kept while other
+// media of the project have the same content.
+func (s *service) deleteContent(ctx context.Context, md *MetaData) error {
+	if md.ContentHash == "" {
+		return s.objectRepo.DeleteFile(ctx, md.ProjectID, md.PostID, md.Filename)
+	}
+	return s.repo.LockContent(ctx, md.ProjectID, md.ContentHash, func(repo Repository) error {
+		referenced, err := repo.IsContentReferenced(ctx, md.ProjectID, md.ContentHash)
+		if err != nil || referenced {
+			return err
+		}
+		return s.objectRepo.DeleteBlob(ctx, md.ProjectID, md.ContentHash)
+	})
+}
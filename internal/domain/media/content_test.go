@@ -0,0 +1,137 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// runLockedContent makes the mock run the functions given to LockContent
+func runLockedContent(repo *MockRepository) {
+	repo.EXPECT().LockContent(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _, _ string, fn func(Repository) error) error { return fn(repo) })
+}
+
+func TestStoreContent(t *testing.T) {
+	ctx := context.Background()
+	sum := sha256.Sum256([]byte("photo"))
+	hash := hex.EncodeToString(sum[:])
+	newFile := func(t *testing.T) *File {
+		f, err := NewFile(bytes.NewReader([]byte("photo")), 0)
+		assert.NoError(t, err)
+		t.Cleanup(func() { f.Close() })
+		return f
+	}
+
+	t.Run("uploads content the project doesn't have", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		runLockedContent(repo)
+		objectRepo.EXPECT().StatBlob(ctx, "project-1", hash).Return(nil, ErrFileNotFound)
+		objectRepo.EXPECT().UploadBlob(ctx, "project-1", hash, mock.Anything).Return(nil)
+		md := &MetaData{ProjectID: "project-1", PostID: "post-1", Filename: "photo.png"}
+
+		err := NewService(repo, objectRepo).(*service).storeContent(ctx, md, newFile(t), nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, hash, md.ContentHash)
+	})
+
+	t.Run("stores identical content once", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		runLockedContent(repo)
+		objectRepo.EXPECT().StatBlob(ctx, "project-1", hash).Return(&ObjectInfo{ProjectID: "project-1", ContentHash: hash}, nil)
+		md := &MetaData{ProjectID: "project-1", PostID: "post-2", Filename: "copy.png"}
+
+		err := NewService(repo, objectRepo).(*service).storeContent(ctx, md, newFile(t), nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, hash, md.ContentHash)
+		objectRepo.AssertNotCalled(t, "UploadBlob", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("saves the metadata while the content is locked", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		locked := false
+		repo.EXPECT().LockContent(ctx, "project-1", hash, mock.Anything).
+			RunAndReturn(func(_ context.Context, _, _ string, fn func(Repository) error) error {
+				locked = true
+				defer func() { locked = false }()
+				return fn(repo)
+			})
+		objectRepo.EXPECT().StatBlob(ctx, "project-1", hash).Return(&ObjectInfo{ProjectID: "project-1", ContentHash: hash}, nil)
+		md := &MetaData{ProjectID: "project-1", PostID: "post-2", Filename: "copy.png"}
+
+		savedLocked := false
+		err := NewService(repo, objectRepo).(*service).storeContent(ctx, md, newFile(t), func(Repository) error {
+			savedLocked = locked
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, savedLocked)
+	})
+}
+
+func TestDeleteContent(t *testing.T) {
+	ctx := context.Background()
+	md := &MetaData{ProjectID: "project-1", PostID: "post-1", Filename: "photo.png", ContentHash: "hash-1"}
+
+	t.Run("keeps content other media have", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		runLockedContent(repo)
+		repo.EXPECT().IsContentReferenced(ctx, "project-1", "hash-1").Return(true, nil)
+
+		err := NewService(repo, NewMockObjectRepository(t)).(*service).deleteContent(ctx, md)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("deletes content no media has", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		runLockedContent(repo)
+		repo.EXPECT().IsContentReferenced(ctx, "project-1", "hash-1").Return(false, nil)
+		objectRepo.EXPECT().DeleteBlob(ctx, "project-1", "hash-1").Return(nil)
+
+		err := NewService(repo, objectRepo).(*service).deleteContent(ctx, md)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("deletes files stored by name", func(t *testing.T) {
+		objectRepo := NewMockObjectRepository(t)
+		objectRepo.EXPECT().DeleteFile(ctx, "project-1", "post-1", "photo.png").Return(nil)
+		legacy := &MetaData{ProjectID: "project-1", PostID: "post-1", Filename: "photo.png"}
+
+		err := NewService(NewMockRepository(t), objectRepo).(*service).deleteContent(ctx, legacy)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestPostFilesRemover_SharedContent(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository(t)
+	objectRepo := NewMockObjectRepository(t)
+	runLockedContent(repo)
+	repo.EXPECT().ListMediaFilesForPost(ctx, "post-1").Return([]string{"photo.png"}, nil)
+	// The blob of another media of the post is shared with the library, only this one goes
+	repo.EXPECT().FindUnsharedContent(ctx, "project-1", "post-1").Return([]string{"hash-1"}, nil)
+	objectRepo.EXPECT().DeleteFile(ctx, "project-1", "post-1", mock.Anything).Return(nil)
+
+	removeFiles, err := NewService(repo, objectRepo).PostFilesRemover(ctx, "project-1", "post-1")
+	assert.NoError(t, err)
+
+	// Once the post is deleted, the blob is checked again before it's removed
+	repo.EXPECT().IsContentReferenced(ctx, "project-1", "hash-1").Return(false, nil)
+	objectRepo.EXPECT().DeleteBlob(ctx, "project-1", "hash-1").Return(nil)
+	assert.NoError(t, removeFiles(ctx))
+}
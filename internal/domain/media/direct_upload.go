@@ -0,0 +1,209 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+)
+
+// UploadExpiry is how long a direct upload can be completed after it's initiated. The files sent to uploads that
+// weren't completed in time are removed by PurgeExpiredUploads.
+const UploadExpiry = time.Hour
+
+var (
+	ErrUploadNotFound   = errors.New("upload not found")
+	ErrUploadExpired    = errors.New("upload expired")
+	ErrUploadIncomplete = errors.New("file was not uploaded")
+	ErrInvalidFileSize  = errors.New("invalid file size")
+)
+
+// Upload is a media sent straight to the object store by the client instead of through the API.
+// It's saved as a media once completed, and dropped if it isn't completed before it expires.
+type Upload struct {
+	ID        string    `json:"id"`
+	ProjectID string    `json:"project_id"`
+	PostID    string    `json:"post_id"` // Empty for uploads to the project library
+	FileName  string    `json:"file_name"`
+	AltText   string    `json:"alt_text"`
+	Folder    string    `json:"folder"`
+	Tags      []string  `json:"tags"`
+	Size      int64     `json:"size"` // in bytes, the file can't be bigger
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// URL is where the file must be sent with a PUT request. It's only returned when the upload is initiated.
+	URL string `json:"url,omitempty"`
+}
+
+func NewUpload(projectID, postID, userID, fileName, altText, folder string, tags []string, size int64) *Upload {
+	if tags == nil {
+		tags = []string{}
+	}
+	now := time.Now().UTC()
+	return &Upload{
+		ID:        uuid.New().String(),
+		ProjectID: projectID,
+		PostID:    postID,
+		FileName:  fileName,
+		AltText:   altText,
+		Folder:    folder,
+		Tags:      tags,
+		Size:      size,
+		CreatedBy: userID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(UploadExpiry),
+	}
+}
+
+func (u *Upload) IsExpired(now time.Time) bool {
+	return !now.Before(u.ExpiresAt)
+}
+
+// InitiateUpload returns a presigned URL where the client sends the file of a post, or of the project library
+// when postID is empty. Folder and tags only apply to the library.
+func (s *service) InitiateUpload(ctx context.Context, projectID, postID, fileName, altText, folder string, tags []string, size int64) (*Upload, error) {
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+
+	var err error
+	if postID == "" {
+		folder, err = NormalizeFolder(folder)
+		if err != nil {
+			return nil, err
+		}
+		tags, err = NormalizeTags(tags)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		belongs, err := s.repo.DoesPostBelongToProject(ctx, projectID, postID)
+		if err != nil {
+			return nil, err
+		}
+		if !belongs {
+			return nil, ErrPostDoesNotBelongToProject
+		}
+		folder, tags = "", nil
+	}
+
+	processor, err := GetProcessor(fileName)
+	if err != nil {
+		return nil, err
+	}
+	if size <= 0 {
+		return nil, ErrInvalidFileSize
+	}
+	if limit := s.limits[processor.GetMediaType()]; limit > 0 && size > limit {
+		return nil, ErrFileTooLarge
+	}
+
+	existingMetadata, err := s.repo.GetMetadata(ctx, projectID, postID, fileName)
+	if err == nil && existingMetadata != nil {
+		return nil, ErrFileAlreadyExists
+	}
+
+	u := NewUpload(projectID, postID, userID, fileName, altText, folder, tags, size)
+	err = s.repo.SaveUpload(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	u.URL, err = s.objectRepo.GetSignedUploadURL(ctx, u.ID, UploadExpiry)
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// CompleteUpload saves a file sent to the URL of an upload as a media. The file is stripped of its metadata before
+// it's stored with the media, and analyzed right away with its thumbnail unless there is a processing queue. Files
+// that can't be used are removed, so the upload has to be initiated again.
+func (s *service) CompleteUpload(ctx context.Context, projectID, uploadID string) (DownloadMetaData, error) {
+	u, err := s.repo.FindUpload(ctx, projectID, uploadID)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	if u == nil {
+		return DownloadMetaData{}, ErrUploadNotFound
+	}
+	if u.IsExpired(time.Now().UTC()) {
+		return DownloadMetaData{}, ErrUploadExpired
+	}
+
+	existingMetadata, err := s.repo.GetMetadata(ctx, u.ProjectID, u.PostID, u.FileName)
+	if err == nil && existingMetadata != nil {
+		return DownloadMetaData{}, ErrFileAlreadyExists
+	}
+
+	processor, err := GetProcessor(u.FileName)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+
+	body, err := s.objectRepo.GetStagedFile(ctx, u.ID)
+	if errors.Is(err, ErrFileNotFound) {
+		return DownloadMetaData{}, ErrUploadIncomplete
+	}
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	file, err := NewFile(body, u.Size)
+	body.Close()
+	if errors.Is(err, ErrFileTooLarge) {
+		return DownloadMetaData{}, errors.Join(err, s.discardUpload(ctx, u))
+	}
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	defer file.Close()
+
+	if s.queue != nil {
+		return s.completePendingUpload(ctx, u, processor, file)
+	}
+
+	d, err := s.save(ctx, u, processor, file, true)
+	if errors.Is(err, ErrFailedToAnalyzeMedia) {
+		return DownloadMetaData{}, errors.Join(err, s.discardUpload(ctx, u))
+	}
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	return d, s.repo.DeleteUpload(ctx, u.ID)
+}
+
+// completePendingUpload saves the media of an upload to be processed by the queue, and removes the file from
+// where it was staged
+func (s *service) completePendingUpload(ctx context.Context, u *Upload, processor MediaProcessor, file *File) (DownloadMetaData, error) {
+	d, err := s.savePendingFile(ctx, u, processor, file)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	err = s.objectRepo.DeleteStagedFile(ctx, u.ID)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	return d, s.repo.DeleteUpload(ctx, u.ID)
+}
+
+// discardUpload removes an upload and its file
+func (s *service) discardUpload(ctx context.Context, u *Upload) error {
+	return errors.Join(
+		s.objectRepo.DeleteStagedFile(ctx, u.ID),
+		s.repo.DeleteUpload(ctx, u.ID),
+	)
+}
+
+// PurgeExpiredUploads removes the uploads that weren't completed in time, with the file if it was sent
+func (s *service) PurgeExpiredUploads(ctx context.Context) (int, error) {
+	uploads, err := s.repo.DeleteExpiredUploads(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	for _, u := range uploads {
+		errs = append(errs, s.objectRepo.DeleteStagedFile(ctx, u.ID))
+	}
+	return len(uploads), errors.Join(errs...)
+}
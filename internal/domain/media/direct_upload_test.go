@@ -0,0 +1,75 @@
+package media
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCompleteUpload(t *testing.T) {
+	ctx := context.Background()
+	newTestUpload := func() *Upload {
+		return NewUpload("project-1", "post-1", "user-1", "clip.mp4", "", "", nil, 4)
+	}
+
+	t.Run("refuses expired uploads", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		u := newTestUpload()
+		u.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+		repo.EXPECT().FindUpload(ctx, "project-1", u.ID).Return(u, nil)
+
+		_, err := NewService(repo, NewMockObjectRepository(t)).CompleteUpload(ctx, "project-1", u.ID)
+
+		assert.ErrorIs(t, err, ErrUploadExpired)
+	})
+
+	t.Run("fails until the file is sent", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		u := newTestUpload()
+		repo.EXPECT().FindUpload(ctx, "project-1", u.ID).Return(u, nil)
+		repo.EXPECT().GetMetadata(ctx, "project-1", "post-1", "clip.mp4").Return(nil, nil)
+		objectRepo.EXPECT().GetStagedFile(ctx, u.ID).Return(nil, ErrFileNotFound)
+
+		_, err := NewService(repo, objectRepo).CompleteUpload(ctx, "project-1", u.ID)
+
+		assert.ErrorIs(t, err, ErrUploadIncomplete)
+	})
+
+	t.Run("discards files bigger than announced", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		u := newTestUpload()
+		repo.EXPECT().FindUpload(ctx, "project-1", u.ID).Return(u, nil)
+		repo.EXPECT().GetMetadata(ctx, "project-1", "post-1", "clip.mp4").Return(nil, nil)
+		objectRepo.EXPECT().GetStagedFile(ctx, u.ID).Return(io.NopCloser(strings.NewReader("video")), nil)
+		objectRepo.EXPECT().DeleteStagedFile(ctx, u.ID).Return(nil)
+		repo.EXPECT().DeleteUpload(ctx, u.ID).Return(nil)
+
+		_, err := NewService(repo, objectRepo).CompleteUpload(ctx, "project-1", u.ID)
+
+		assert.ErrorIs(t, err, ErrFileTooLarge)
+	})
+}
+
+func TestPurgeExpiredUploads(t *testing.T) {
+	ctx := context.Background()
+	abandoned := NewUpload("project-1", "post-1", "user-1", "abandoned.png", "", "", nil, 10)
+	unsent := NewUpload("project-1", "", "user-1", "logo.png", "", "", nil, 10)
+	repo := NewMockRepository(t)
+	objectRepo := NewMockObjectRepository(t)
+	repo.EXPECT().DeleteExpiredUploads(ctx, mock.Anything).Return([]*Upload{abandoned, unsent}, nil)
+	// Staged files are apart from the media, a media saved meanwhile under the same name isn't touched
+	objectRepo.EXPECT().DeleteStagedFile(ctx, abandoned.ID).Return(nil)
+	objectRepo.EXPECT().DeleteStagedFile(ctx, unsent.ID).Return(nil)
+
+	purged, err := NewService(repo, objectRepo).PurgeExpiredUploads(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, purged)
+}
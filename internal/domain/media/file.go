@@ -0,0 +1,106 @@
+package media
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sync"
+)
+
+var ErrFileTooLarge = errors.New("file too large")
+
+// SizeLimits is the largest file accepted for each media type, in bytes
+type SizeLimits map[MediaType]int64
+
+var DefaultSizeLimits = SizeLimits{
+	MediaTypeImage:    10 << 20,
+	MediaTypeVideo:    500 << 20,
+	MediaTypeDocument: 100 << 20,
+}
+
+// Max returns the largest of the limits
+func (l SizeLimits) Max() int64 {
+	var max int64
+	for _, limit := range l {
+		if limit > max {
+			max = limit
+		}
+	}
+	return max
+}
+
+// File is the content of a media spooled to a temporary file, so large media are never held in memory.
+// It can be read concurrently with ReadAt or Reader. Close removes it.
+type File struct {
+	f    *os.File
+	size int64
+
+	// converted is the content of an image the image package can't decode, in a format it can
+	convertOnce sync.Once
+	converted   *File
+	convertErr  error
+}
+
+// NewFile copies r to a temporary file. It returns ErrFileTooLarge when r has more than maxSize bytes,
+// a maxSize of 0 means no limit.
+func NewFile(r io.Reader, maxSize int64) (*File, error) {
+	f, err := os.CreateTemp("", "media-*")
+	if err != nil {
+		return nil, err
+	}
+	file := &File{f: f}
+
+	src := r
+	if maxSize > 0 {
+		src = io.LimitReader(r, maxSize+1)
+	}
+	file.size, err = io.Copy(f, src)
+	if err != nil {
+		return nil, errors.Join(err, file.Close())
+	}
+	if maxSize > 0 && file.size > maxSize {
+		return nil, errors.Join(ErrFileTooLarge, file.Close())
+	}
+	return file, nil
+}
+
+// openFile takes over a temporary file written by another tool, Close removes it
+func openFile(path string) (*File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Join(err, os.Remove(path))
+	}
+	info, err := f.Stat()
+	if err != nil {
+		file := &File{f: f}
+		return nil, errors.Join(err, file.Close())
+	}
+	return &File{f: f, size: info.Size()}, nil
+}
+
+// Size returns the size of the file in bytes
+func (f *File) Size() int64 {
+	return f.size
+}
+
+// Path returns where the file is on disk, for the tools that only read files
+func (f *File) Path() string {
+	return f.f.Name()
+}
+
+func (f *File) ReadAt(p []byte, off int64) (int, error) {
+	return f.f.ReadAt(p, off)
+}
+
+// Reader returns a new reader of the whole file, independent of the other readers
+func (f *File) Reader() *io.SectionReader {
+	return io.NewSectionReader(f.f, 0, f.size)
+}
+
+// Close closes and removes the file
+func (f *File) Close() error {
+	if f == nil {
+		return nil
+	}
+	return errors.Join(f.f.Close(), os.Remove(f.f.Name()), f.converted.Close())
+}
@@ -0,0 +1,36 @@
+package media
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFile(t *testing.T) {
+	t.Run("spools the content", func(t *testing.T) {
+		file, err := NewFile(strings.NewReader("video"), 5)
+		assert.NoError(t, err)
+		defer file.Close()
+
+		assert.Equal(t, int64(5), file.Size())
+		content, err := io.ReadAll(file.Reader())
+		assert.NoError(t, err)
+		assert.Equal(t, "video", string(content))
+		chunk, err := io.ReadAll(io.NewSectionReader(file, 1, 3))
+		assert.NoError(t, err)
+		assert.Equal(t, "ide", string(chunk))
+	})
+
+	t.Run("refuses files over the limit", func(t *testing.T) {
+		file, err := NewFile(strings.NewReader("video"), 4)
+
+		assert.ErrorIs(t, err, ErrFileTooLarge)
+		assert.Nil(t, file)
+	})
+}
+
+func TestSizeLimitsMax(t *testing.T) {
+	assert.Equal(t, int64(500<<20), DefaultSizeLimits.Max())
+}
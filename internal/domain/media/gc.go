@@ -0,0 +1,119 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultGCGracePeriod is how old a file without metadata must be before it's collected.
+// Younger files may belong to an upload that hasn't saved its metadata yet.
+const DefaultGCGracePeriod = 24 * time.Hour
+
+const gcPageSize = 500
+
+// GCReport is the result of reconciling the object store with the media metadata
+type GCReport struct {
+	DryRun  bool `json:"dry_run"`
+	Scanned int  `json:"scanned"`
+	// Orphans are the files without metadata, older than the grace period
+	Orphans []*ObjectInfo `json:"orphans"`
+	Deleted int           `json:"deleted"`
+	// Missing is the metadata whose file isn't in the object store
+	Missing []*MetaData `json:"missing"`
+}
+
+// CollectGarbage deletes the files of the object store that have no metadata and were last modified before olderThan.
+// It also reports the metadata created before olderThan whose file is missing. In a dry run nothing is deleted.
+func (s *service) CollectGarbage(ctx context.Context, olderThan time.Time, dryRun bool) (*GCReport, error) {
+	report := &GCReport{
+		DryRun:  dryRun,
+		Orphans: []*ObjectInfo{},
+		Missing: []*MetaData{},
+	}
+	stored := make(map[string]struct{})
+	var errs []error
+
+	err := s.objectRepo.ListFiles(ctx, func(files []*ObjectInfo) error {
+		report.Scanned += len(files)
+		known, err := s.repo.HasMetadata(ctx, files)
+		if err != nil {
+			return err
+		}
+		for i, f := range files {
+			stored[storedFileKey(f.ProjectID, f.PostID, f.FileName, f.ContentHash)] = struct{}{}
+			if known[i] || !f.LastModified.Before(olderThan) {
+				continue
+			}
+			report.Orphans = append(report.Orphans, f)
+			if dryRun {
+				continue
+			}
+			deleted, err := s.deleteObject(ctx, f)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if deleted {
+				report.Deleted++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	afterID := ""
+	for {
+		page, err := s.repo.FindMetadataCreatedBefore(ctx, olderThan, afterID, gcPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, md := range page {
+			if _, ok := stored[storedFileKey(md.ProjectID, md.PostID, md.Filename, md.ContentHash)]; !ok {
+				report.Missing = append(report.Missing, md)
+			}
+		}
+		if len(page) < gcPageSize {
+			break
+		}
+		afterID = page[len(page)-1].ID
+	}
+
+	return report, errors.Join(errs...)
+}
+
+// deleteObject removes an orphan file, and tells whether it was removed. A media may have been given the content of a
+// blob since the listing, blobs are checked again under the content lock, as deleteContent does.
+func (s *service) deleteObject(ctx context.Context, f *ObjectInfo) (bool, error) {
+	if f.ContentHash != "" {
+		deleted := false
+		err := s.repo.LockContent(ctx, f.ProjectID, f.ContentHash, func(repo Repository) error {
+			referenced, err := repo.IsContentReferenced(ctx, f.ProjectID, f.ContentHash)
+			if err != nil || referenced {
+				return err
+			}
+			deleted = true
+			return s.objectRepo.DeleteBlob(ctx, f.ProjectID, f.ContentHash)
+		})
+		if err != nil {
+			return false, fmt.Errorf("blob %s of project %s: %w", f.ContentHash, f.ProjectID, err)
+		}
+		return deleted, nil
+	}
+	err := s.objectRepo.DeleteFile(ctx, f.ProjectID, f.PostID, f.FileName)
+	if err != nil {
+		return false, fmt.Errorf("file %s of post %s: %w", f.FileName, f.PostID, err)
+	}
+	return true, nil
+}
+
+// storedFileKey identifies a file in the object store, blobs by their content hash
+func storedFileKey(projectID, postID, fileName, hash string) string {
+	if hash != "" {
+		return projectID + "/blobs/" + hash
+	}
+	return projectID + "/" + postID + "/" + fileName
+}
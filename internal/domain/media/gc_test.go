@@ -0,0 +1,76 @@
+package media
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	olderThan := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	files := []*ObjectInfo{
+		{ProjectID: "project-1", PostID: "post-1", FileName: "kept.png", LastModified: olderThan.Add(-time.Hour)},
+		{ProjectID: "project-1", PostID: "post-1", FileName: "orphan.png", LastModified: olderThan.Add(-time.Hour)},
+		{ProjectID: "project-1", PostID: "post-2", FileName: "uploading.png", LastModified: olderThan.Add(time.Minute)},
+		{ProjectID: "project-1", ContentHash: "hash-1", LastModified: olderThan.Add(-time.Hour)},
+		{ProjectID: "project-1", ContentHash: "hash-2", LastModified: olderThan.Add(-time.Hour)},
+	}
+	metadata := []*MetaData{
+		{ID: "media-1", ProjectID: "project-1", PostID: "post-1", Filename: "kept.png"},
+		{ID: "media-2", ProjectID: "project-1", PostID: "post-3", Filename: "lost.png"},
+		{ID: "media-3", ProjectID: "project-1", PostID: "post-3", Filename: "photo.png", ContentHash: "hash-1"},
+	}
+
+	setup := func(t *testing.T) (*MockRepository, *MockObjectRepository) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		objectRepo.EXPECT().ListFiles(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, fn func([]*ObjectInfo) error) error {
+			return fn(files)
+		})
+		repo.EXPECT().HasMetadata(ctx, files).Return([]bool{true, false, false, true, false}, nil)
+		repo.EXPECT().FindMetadataCreatedBefore(ctx, olderThan, "", gcPageSize).Return(metadata, nil)
+		return repo, objectRepo
+	}
+
+	t.Run("only reports in a dry run", func(t *testing.T) {
+		repo, objectRepo := setup(t)
+
+		report, err := NewService(repo, objectRepo).CollectGarbage(ctx, olderThan, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, report.Scanned)
+		assert.Equal(t, []*ObjectInfo{files[1], files[4]}, report.Orphans)
+		assert.Equal(t, 0, report.Deleted)
+		assert.Equal(t, []*MetaData{metadata[1]}, report.Missing)
+	})
+
+	t.Run("deletes the orphans older than the grace period", func(t *testing.T) {
+		repo, objectRepo := setup(t)
+		runLockedContent(repo)
+		objectRepo.EXPECT().DeleteFile(ctx, "project-1", "post-1", "orphan.png").Return(nil)
+		repo.EXPECT().IsContentReferenced(ctx, "project-1", "hash-2").Return(false, nil)
+		objectRepo.EXPECT().DeleteBlob(ctx, "project-1", "hash-2").Return(nil)
+
+		report, err := NewService(repo, objectRepo).CollectGarbage(ctx, olderThan, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, report.Deleted)
+	})
+
+	t.Run("keeps the blobs given to a media since the listing", func(t *testing.T) {
+		repo, objectRepo := setup(t)
+		runLockedContent(repo)
+		objectRepo.EXPECT().DeleteFile(ctx, "project-1", "post-1", "orphan.png").Return(nil)
+		repo.EXPECT().IsContentReferenced(ctx, "project-1", "hash-2").Return(true, nil)
+
+		report, err := NewService(repo, objectRepo).CollectGarbage(ctx, olderThan, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Deleted)
+		objectRepo.AssertNotCalled(t, "DeleteBlob", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
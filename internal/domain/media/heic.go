@@ -0,0 +1,137 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"time"
+)
+
+// heicBrands are the brands of the HEIF files holding HEVC images. Other HEIF files, such as AVIF images, share
+// the generic brands and aren't HEIC.
+var heicBrands = []string{"heic", "heix", "hevc"}
+
+// convertHEICTimeout bounds the conversion of an HEIC image
+const convertHEICTimeout = time.Minute
+
+var ErrNotHEIC = errors.New("not an HEIC image")
+
+// HEICProcessor handles HEIC and HEIF images. The image package can't decode them, they are converted to PNG with
+// ffmpeg, already needed for videos, and the conversion is analyzed, thumbnailed and rendered like other images.
+// The conversion is made once per file and removed with it.
+type HEICProcessor struct {
+	ext string
+}
+
+func (p *HEICProcessor) GetMediaType() MediaType {
+	return MediaTypeImage
+}
+
+// Analyze describes the image, the format and size are the ones of the HEIC file
+func (p *HEICProcessor) Analyze(f *File) (*MediaInfo, error) {
+	converted, err := p.decodable(f)
+	if err != nil {
+		return nil, err
+	}
+	info, err := (&ImageProcessor{ext: "png"}).Analyze(converted)
+	if err != nil {
+		return nil, err
+	}
+	info.Format = "heic"
+	info.Size = int(f.Size())
+	return info, nil
+}
+
+func (p *HEICProcessor) GetThumbnail(f *File) (*[]byte, error) {
+	converted, err := p.decodable(f)
+	if err != nil {
+		return nil, err
+	}
+	return (&ImageProcessor{ext: "png"}).GetThumbnail(converted)
+}
+
+// decodable returns the conversion of f to PNG, made on the first call
+func (p *HEICProcessor) decodable(f *File) (*File, error) {
+	f.convertOnce.Do(func() {
+		f.converted, f.convertErr = convertHEIC(f)
+	})
+	return f.converted, f.convertErr
+}
+
+// decodableImage returns an image file the image package can decode, the conversion of HEIC images
+func decodableImage(fileName string, f *File) (*File, error) {
+	processor, err := GetProcessor(fileName)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := processor.(*HEICProcessor); ok {
+		return p.decodable(f)
+	}
+	return f, nil
+}
+
+// isHEIC tells whether the ftyp box of a file names an HEIC brand, as its major or a compatible brand
+func isHEIC(f *File) bool {
+	header := make([]byte, 8)
+	if _, err := f.ReadAt(header, 0); err != nil || string(header[4:8]) != "ftyp" {
+		return false
+	}
+	size := int64(binary.BigEndian.Uint32(header))
+	if size < 16 || size > 4096 || size > f.Size() {
+		return false
+	}
+	box := make([]byte, size)
+	if _, err := f.ReadAt(box, 0); err != nil {
+		return false
+	}
+	// The minor version follows the major brand, it's skipped
+	brands := [][]byte{box[8:12]}
+	for i := int64(16); i+4 <= size; i += 4 {
+		brands = append(brands, box[i:i+4])
+	}
+	for _, brand := range brands {
+		if slices.Contains(heicBrands, string(brand)) {
+			return true
+		}
+	}
+	return false
+}
+
+// convertHEIC writes the primary image of an HEIC file as PNG to a temporary file
+func convertHEIC(f *File) (*File, error) {
+	if !isHEIC(f) {
+		return nil, ErrNotHEIC
+	}
+
+	out, err := os.CreateTemp("", "heic-*.png")
+	if err != nil {
+		return nil, err
+	}
+	path := out.Name()
+	if err := out.Close(); err != nil {
+		return nil, errors.Join(err, os.Remove(path))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), convertHEICTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "ffmpeg",
+		"-v", "error",
+		"-y",
+		"-i", f.Path(),
+		"-frames:v", "1",
+		"-c:v", "png",
+		path)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Join(fmt.Errorf("ffmpeg failed to convert HEIC: %w: %s", err, stderr.String()), os.Remove(path))
+	}
+	return openFile(path)
+}
@@ -0,0 +1,207 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"slices"
+
+	"golang.org/x/image/draw"
+)
+
+var (
+	ErrInvalidFocalPoint = errors.New("focal point must be between 0 and 1")
+	ErrNotAnImage        = errors.New("media is not an image")
+)
+
+// jpegQualities are tried in order until an image fits the size limit of a profile
+var jpegQualities = []int{90, 80, 70, 60}
+
+// ImageProfile is what a platform accepts for images. Images outside of it are published from a rendition
+// cropped and resized to fit.
+type ImageProfile struct {
+	// MinAspectRatio and MaxAspectRatio bound the width divided by the height, 0 is no bound
+	MinAspectRatio float64
+	MaxAspectRatio float64
+	MaxLongSide    int
+	MaxSize        int64
+	// Formats are the encodings accepted, as named by the image package. Others are converted to JPEG or PNG.
+	Formats []string
+}
+
+// ImageProfiles are the image profiles of the platforms, by platform id. Images are published as they are to
+// the platforms without a profile.
+var ImageProfiles = map[string]ImageProfile{
+	"linkedin": {
+		MinAspectRatio: 1 / 1.91,
+		MaxAspectRatio: 1.91,
+		MaxLongSide:    4096,
+		MaxSize:        10 << 20,
+		Formats:        []string{"jpeg", "png", "gif"},
+	},
+	"x": {
+		MaxLongSide: 4096,
+		MaxSize:     5 << 20,
+		Formats:     []string{"jpeg", "png", "gif", "webp"},
+	},
+}
+
+// Accepts tells whether an image can be published as it is
+func (p ImageProfile) Accepts(info *MediaInfo) bool {
+	if info.Width == 0 || info.Height == 0 {
+		return false
+	}
+	if p.Formats != nil && !slices.Contains(p.Formats, info.Format) {
+		return false
+	}
+	ratio := float64(info.Width) / float64(info.Height)
+	switch {
+	case p.MinAspectRatio > 0 && ratio < p.MinAspectRatio:
+		return false
+	case p.MaxAspectRatio > 0 && ratio > p.MaxAspectRatio:
+		return false
+	case max(info.Width, info.Height) > p.MaxLongSide:
+		return false
+	case p.MaxSize > 0 && int64(info.Size) > p.MaxSize:
+		return false
+	}
+	return true
+}
+
+// Crop returns the largest part of an image within the aspect ratios of the profile, as close as possible to be
+// centered on the focal point. focalX and focalY are fractions of the width and height.
+func (p ImageProfile) Crop(bounds image.Rectangle, focalX, focalY float64) image.Rectangle {
+	width, height := bounds.Dx(), bounds.Dy()
+	ratio := float64(width) / float64(height)
+	switch {
+	case p.MaxAspectRatio > 0 && ratio > p.MaxAspectRatio:
+		cropped := int(float64(height) * p.MaxAspectRatio)
+		x := cropOffset(width, cropped, focalX)
+		return image.Rect(bounds.Min.X+x, bounds.Min.Y, bounds.Min.X+x+cropped, bounds.Max.Y)
+	case p.MinAspectRatio > 0 && ratio < p.MinAspectRatio:
+		cropped := int(float64(width) / p.MinAspectRatio)
+		y := cropOffset(height, cropped, focalY)
+		return image.Rect(bounds.Min.X, bounds.Min.Y+y, bounds.Max.X, bounds.Min.Y+y+cropped)
+	}
+	return bounds
+}
+
+// cropOffset places a window of size cropped centered on focal in a side of size total, without going out of it
+func cropOffset(total, cropped int, focal float64) int {
+	offset := int(focal*float64(total)) - cropped/2
+	return max(0, min(offset, total-cropped))
+}
+
+// RenderImage crops and resizes an image to fit the profile. PNG and GIF images are encoded as PNG, unless they
+// are too large, others are encoded as JPEG with the best quality that fits.
+func RenderImage(f *File, profile ImageProfile, focalX, focalY float64) (*File, *MediaInfo, error) {
+	src, format, err := image.Decode(f.Reader())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	crop := profile.Crop(src.Bounds(), focalX, focalY)
+	scale := 1.0
+	if long := max(crop.Dx(), crop.Dy()); profile.MaxLongSide > 0 && long > profile.MaxLongSide {
+		scale = float64(profile.MaxLongSide) / float64(long)
+	}
+
+	// Images that don't fit at the lowest quality are scaled down until they do
+	for {
+		width, height := max(1, int(float64(crop.Dx())*scale)), max(1, int(float64(crop.Dy())*scale))
+		dst := image.NewRGBA(image.Rect(0, 0, width, height))
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, crop, draw.Src, nil)
+
+		data, outFormat, err := encodeImage(dst, format, profile.MaxSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		if data != nil {
+			file, err := NewFile(bytes.NewReader(data), 0)
+			if err != nil {
+				return nil, nil, err
+			}
+			return file, &MediaInfo{
+				Type:   MediaTypeImage,
+				Format: outFormat,
+				Width:  width,
+				Height: height,
+				Size:   len(data),
+			}, nil
+		}
+		if width == 1 || height == 1 {
+			return nil, nil, ErrFileTooLarge
+		}
+		scale *= 0.75
+	}
+}
+
+// encodeImage returns the image encoded within maxSize, or nil when it doesn't fit. 0 is no size limit.
+func encodeImage(img image.Image, format string, maxSize int64) ([]byte, string, error) {
+	fits := func(b *bytes.Buffer) bool {
+		return maxSize == 0 || int64(b.Len()) <= maxSize
+	}
+
+	var out bytes.Buffer
+	if format == "png" || format == "gif" {
+		if err := png.Encode(&out, img); err != nil {
+			return nil, "", err
+		}
+		if fits(&out) {
+			return out.Bytes(), "png", nil
+		}
+	}
+	img = flatten(img)
+	for _, quality := range jpegQualities {
+		out.Reset()
+		if err := jpeg.Encode(&out, img, &jpeg.Options{Quality: quality}); err != nil {
+			return nil, "", err
+		}
+		if fits(&out) {
+			return out.Bytes(), "jpeg", nil
+		}
+	}
+	return nil, "", nil
+}
+
+// flatten draws an image over a white background, since JPEG has no transparency
+func flatten(img image.Image) image.Image {
+	dst := image.NewRGBA(img.Bounds())
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, img.Bounds().Min, draw.Over)
+	return dst
+}
+
+// SetFocalPoint changes the point of an image kept in view when it's cropped for a platform. The renditions made
+// with the previous one are removed, they are made again when the image is published.
+func (s *service) SetFocalPoint(ctx context.Context, projectID, mediaID string, x, y float64) (*MetaData, error) {
+	if x < 0 || x > 1 || y < 0 || y > 1 {
+		return nil, ErrInvalidFocalPoint
+	}
+
+	md, err := s.repo.GetMetadataByID(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+	if md == nil || md.ProjectID != projectID {
+		return nil, ErrMediaNotFound
+	}
+	if !md.IsImage() {
+		return nil, ErrNotAnImage
+	}
+
+	err = s.repo.UpdateFocalPoint(ctx, mediaID, x, y)
+	if err != nil {
+		return nil, err
+	}
+	md.FocalX, md.FocalY = x, y
+
+	err = s.repo.DeleteRenditions(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+	return md, s.deleteRenditionFiles(ctx, projectID, md.PostID, md.Filename)
+}
@@ -0,0 +1,170 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var b bytes.Buffer
+	assert.NoError(t, png.Encode(&b, img))
+	return b.Bytes()
+}
+
+// noiseImage doesn't compress, so its encoded size follows its dimensions
+func noiseImage(width, height int) image.Image {
+	rng := rand.New(rand.NewSource(1))
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = byte(rng.Intn(256))
+	}
+	return img
+}
+
+func solidImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{R: 200, A: 255}), image.Point{}, draw.Src)
+	return img
+}
+
+func TestImageProfile_Accepts(t *testing.T) {
+	profile := ImageProfiles["linkedin"]
+
+	assert.True(t, profile.Accepts(&MediaInfo{Format: "jpeg", Width: 1200, Height: 630, Size: 1 << 20}))
+	assert.True(t, profile.Accepts(&MediaInfo{Format: "png", Width: 1080, Height: 1350, Size: 1 << 20}))
+	assert.False(t, profile.Accepts(&MediaInfo{Format: "jpeg", Width: 3000, Height: 1000, Size: 1 << 20}), "too wide")
+	assert.False(t, profile.Accepts(&MediaInfo{Format: "jpeg", Width: 1000, Height: 3000, Size: 1 << 20}), "too tall")
+	assert.False(t, profile.Accepts(&MediaInfo{Format: "jpeg", Width: 6000, Height: 4000, Size: 1 << 20}), "too large")
+	assert.False(t, profile.Accepts(&MediaInfo{Format: "jpeg", Width: 1200, Height: 630, Size: 11 << 20}), "file too big")
+	assert.False(t, profile.Accepts(&MediaInfo{Format: "webp", Width: 1200, Height: 630, Size: 1 << 20}), "format not supported")
+	assert.False(t, profile.Accepts(&MediaInfo{Format: "heic", Width: 1200, Height: 630, Size: 1 << 20}), "format not supported")
+	assert.False(t, profile.Accepts(&MediaInfo{}), "unknown dimensions")
+}
+
+func TestImageProfile_Crop(t *testing.T) {
+	profile := ImageProfile{MinAspectRatio: 1, MaxAspectRatio: 1}
+	bounds := image.Rect(0, 0, 300, 100)
+
+	assert.Equal(t, image.Rect(100, 0, 200, 100), profile.Crop(bounds, 0.5, 0.5), "centered")
+	assert.Equal(t, image.Rect(0, 0, 100, 100), profile.Crop(bounds, 0.1, 0.5), "kept inside the image")
+	assert.Equal(t, image.Rect(200, 0, 300, 100), profile.Crop(bounds, 0.9, 0.5))
+	assert.Equal(t, image.Rect(0, 150, 100, 250), profile.Crop(image.Rect(0, 0, 100, 300), 0.5, 2.0/3))
+	assert.Equal(t, bounds, ImageProfile{}.Crop(bounds, 0.5, 0.5), "no aspect ratio bounds")
+}
+
+func TestRenderImage(t *testing.T) {
+	t.Run("crops and resizes", func(t *testing.T) {
+		file, err := NewFile(bytes.NewReader(encodePNG(t, solidImage(400, 100))), 0)
+		assert.NoError(t, err)
+		defer file.Close()
+
+		rFile, info, err := RenderImage(file, ImageProfile{MaxAspectRatio: 2, MaxLongSide: 100}, 0.5, 0.5)
+
+		assert.NoError(t, err)
+		defer rFile.Close()
+		assert.Equal(t, "png", info.Format)
+		assert.Equal(t, 100, info.Width)
+		assert.Equal(t, 50, info.Height)
+		assert.Equal(t, int64(info.Size), rFile.Size())
+	})
+
+	t.Run("compresses images too big for the profile", func(t *testing.T) {
+		data := encodePNG(t, noiseImage(200, 200))
+		file, err := NewFile(bytes.NewReader(data), 0)
+		assert.NoError(t, err)
+		defer file.Close()
+
+		maxSize := int64(len(data) / 4)
+		rFile, info, err := RenderImage(file, ImageProfile{MaxLongSide: 4096, MaxSize: maxSize}, 0.5, 0.5)
+
+		assert.NoError(t, err)
+		defer rFile.Close()
+		assert.Equal(t, "jpeg", info.Format)
+		assert.LessOrEqual(t, rFile.Size(), maxSize)
+	})
+}
+
+func TestGetMediaForPlatform_Image(t *testing.T) {
+	ctx := context.Background()
+	md := &MetaData{
+		ID: "media-1", ProjectID: "project-1", PostID: "post-1", Filename: "banner.png", Type: MediaTypeImage,
+		Status: MediaStatusReady, FocalX: 0.25, FocalY: 0.5,
+	}
+	data := encodePNG(t, solidImage(400, 100))
+
+	repo := NewMockRepository(t)
+	objectRepo := NewMockObjectRepository(t)
+	repo.EXPECT().GetMetadata(ctx, "project-1", "post-1", "banner.png").Return(md, nil)
+	objectRepo.EXPECT().GetFile(ctx, "project-1", "post-1", "banner.png").Return(io.NopCloser(bytes.NewReader(data)), nil)
+	repo.EXPECT().FindRendition(ctx, "media-1", "linkedin").Return(nil, nil)
+	name := getRenditionName("banner.png", "linkedin", "png")
+	objectRepo.EXPECT().UploadFile(ctx, "project-1", "post-1", name, mock.Anything, mock.Anything).Return(nil)
+	repo.EXPECT().SaveRendition(ctx, mock.MatchedBy(func(r *Rendition) bool {
+		return r.FileName == name && r.Width == 191 && r.Height == 100
+	})).Return(nil)
+
+	s := NewService(repo, objectRepo).(*service)
+	m, err := s.getMediaForPlatform(ctx, "project-1", md, "linkedin")
+
+	assert.NoError(t, err)
+	defer m.Close()
+	assert.Equal(t, 191, m.Width)
+	assert.Equal(t, 100, m.Height)
+	assert.Equal(t, "banner.png", m.Filename)
+}
+
+func TestSetFocalPoint(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("removes the renditions made with the previous focal point", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		md := &MetaData{ID: "media-1", ProjectID: "project-1", PostID: "post-1", Filename: "banner.png", Type: MediaTypeImage}
+		repo.EXPECT().GetMetadataByID(ctx, "media-1").Return(md, nil)
+		repo.EXPECT().UpdateFocalPoint(ctx, "media-1", 0.2, 0.8).Return(nil)
+		repo.EXPECT().DeleteRenditions(ctx, "media-1").Return(nil)
+		for _, name := range getRenditionNames("banner.png") {
+			objectRepo.EXPECT().DeleteFile(ctx, "project-1", "post-1", name).Return(nil)
+		}
+
+		got, err := NewService(repo, objectRepo).SetFocalPoint(ctx, "project-1", "media-1", 0.2, 0.8)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0.2, got.FocalX)
+		assert.Equal(t, 0.8, got.FocalY)
+	})
+
+	t.Run("rejects points outside of the image", func(t *testing.T) {
+		_, err := NewService(NewMockRepository(t), NewMockObjectRepository(t)).SetFocalPoint(ctx, "project-1", "media-1", 1.5, 0.5)
+
+		assert.ErrorIs(t, err, ErrInvalidFocalPoint)
+	})
+
+	t.Run("rejects media of other projects", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().GetMetadataByID(ctx, "media-1").Return(&MetaData{ID: "media-1", ProjectID: "project-2", Type: MediaTypeImage}, nil)
+
+		_, err := NewService(repo, NewMockObjectRepository(t)).SetFocalPoint(ctx, "project-1", "media-1", 0.5, 0.5)
+
+		assert.ErrorIs(t, err, ErrMediaNotFound)
+	})
+
+	t.Run("rejects videos", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().GetMetadataByID(ctx, "media-1").Return(&MetaData{ID: "media-1", ProjectID: "project-1", Type: MediaTypeVideo}, nil)
+
+		_, err := NewService(repo, NewMockObjectRepository(t)).SetFocalPoint(ctx, "project-1", "media-1", 0.5, 0.5)
+
+		assert.ErrorIs(t, err, ErrNotAnImage)
+	})
+}
@@ -0,0 +1,164 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+const (
+	maxFolderLength = 255
+	maxTags         = 20
+	maxTagLength    = 50
+)
+
+// LibraryMedia is a media of the project library, which can be attached to any post of the project
+type LibraryMedia struct {
+	*MetaData
+	Url          *string `json:"url"`
+	UrlThumbnail *string `json:"url_thumbnail"`
+	// UsageCount is the number of post platforms the media is attached to. Media in use can't be deleted.
+	UsageCount int `json:"usage_count"`
+}
+
+// LibraryFilter narrows the media listed from the library. Empty fields don't filter.
+type LibraryFilter struct {
+	Folder string
+	Tag    string
+	// Query is searched in the file names and alt texts
+	Query string
+}
+
+// NormalizeFolder trims the spaces and slashes around a folder path. The empty folder is the library root.
+func NormalizeFolder(folder string) (string, error) {
+	folder = strings.Trim(strings.TrimSpace(folder), "/")
+	if len(folder) > maxFolderLength || strings.Contains(folder, "//") {
+		return "", ErrInvalidFolder
+	}
+	return folder, nil
+}
+
+// NormalizeTags lower cases the tags and drops the empty and repeated ones
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if len(tag) > maxTagLength {
+			return nil, ErrInvalidTags
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > maxTags {
+		return nil, ErrInvalidTags
+	}
+	return normalized, nil
+}
+
+// UploadLibraryMedia uploads a media to the project library instead of a post
+func (s *service) UploadLibraryMedia(ctx context.Context, projectID, fileName, altText, folder string, tags []string, r io.Reader) (DownloadMetaData, error) {
+	folder, err := NormalizeFolder(folder)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	tags, err = NormalizeTags(tags)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	return s.upload(ctx, projectID, "", fileName, altText, folder, tags, r)
+}
+
+func (s *service) ListLibraryMedia(ctx context.Context, projectID string, filter *LibraryFilter) ([]*LibraryMedia, error) {
+	folder, err := NormalizeFolder(filter.Folder)
+	if err != nil {
+		return nil, err
+	}
+	filter.Folder = folder
+	filter.Tag = strings.ToLower(strings.TrimSpace(filter.Tag))
+
+	items, err := s.repo.FindLibraryMedia(ctx, projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		err = s.signLibraryMedia(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// UpdateLibraryMedia changes the alt text, folder and tags of a library media
+func (s *service) UpdateLibraryMedia(ctx context.Context, projectID, mediaID, altText, folder string, tags []string) (*LibraryMedia, error) {
+	folder, err := NormalizeFolder(folder)
+	if err != nil {
+		return nil, err
+	}
+	tags, err = NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.FindLibraryMediaByID(ctx, projectID, mediaID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, ErrLibraryMediaNotFound
+	}
+
+	item.AltText = altText
+	item.Folder = folder
+	item.Tags = tags
+	err = s.repo.UpdateLibraryMedia(ctx, item.MetaData)
+	if err != nil {
+		return nil, err
+	}
+	return item, s.signLibraryMedia(ctx, item)
+}
+
+// DeleteLibraryMedia deletes a library media with its thumbnail and renditions, unless it's still attached to a post
+func (s *service) DeleteLibraryMedia(ctx context.Context, projectID, mediaID string) error {
+	item, err := s.repo.FindLibraryMediaByID(ctx, projectID, mediaID)
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		return ErrLibraryMediaNotFound
+	}
+	if item.UsageCount > 0 {
+		return ErrMediaInUse
+	}
+
+	thumbnailName := getThumbnailName(item.Filename)
+	err = s.repo.DeleteLibraryMedia(ctx, projectID, mediaID, thumbnailName)
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(
+		s.deleteContent(ctx, item.MetaData),
+		s.objectRepo.DeleteFile(ctx, projectID, "", thumbnailName),
+		s.deleteRenditionFiles(ctx, projectID, "", item.Filename),
+	)
+}
+
+func (s *service) signLibraryMedia(ctx context.Context, item *LibraryMedia) error {
+	url, err := s.signContent(ctx, item.MetaData)
+	if err != nil {
+		return err
+	}
+	item.Url = &url
+	thumbnailUrl, err := s.objectRepo.GetSignedURL(ctx, item.ProjectID, "", getThumbnailName(item.Filename))
+	if err != nil {
+		return err
+	}
+	item.UrlThumbnail = &thumbnailUrl
+	return nil
+}
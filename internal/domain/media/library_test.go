@@ -0,0 +1,59 @@
+package media
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteLibraryMedia(t *testing.T) {
+	ctx := context.Background()
+	item := &LibraryMedia{
+		MetaData: &MetaData{ID: "media-1", ProjectID: "project-1", Filename: "logo.png"},
+	}
+
+	t.Run("deletes the metadata and the files", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		repo.EXPECT().FindLibraryMediaByID(ctx, "project-1", "media-1").Return(item, nil)
+		repo.EXPECT().DeleteLibraryMedia(ctx, "project-1", "media-1", getThumbnailName("logo.png")).Return(nil)
+		objectRepo.EXPECT().DeleteFile(ctx, "project-1", "", "logo.png").Return(nil)
+		objectRepo.EXPECT().DeleteFile(ctx, "project-1", "", getThumbnailName("logo.png")).Return(nil)
+		for _, name := range getRenditionNames("logo.png") {
+			objectRepo.EXPECT().DeleteFile(ctx, "project-1", "", name).Return(nil)
+		}
+
+		err := NewService(repo, objectRepo).DeleteLibraryMedia(ctx, "project-1", "media-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("refuses media used by posts", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		used := &LibraryMedia{MetaData: item.MetaData, UsageCount: 2}
+		repo.EXPECT().FindLibraryMediaByID(ctx, "project-1", "media-1").Return(used, nil)
+
+		err := NewService(repo, objectRepo).DeleteLibraryMedia(ctx, "project-1", "media-1")
+
+		assert.ErrorIs(t, err, ErrMediaInUse)
+	})
+
+	t.Run("returns not found for unknown media", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		repo.EXPECT().FindLibraryMediaByID(ctx, "project-1", "media-1").Return(nil, nil)
+
+		err := NewService(repo, objectRepo).DeleteLibraryMedia(ctx, "project-1", "media-1")
+
+		assert.ErrorIs(t, err, ErrLibraryMediaNotFound)
+	})
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := NormalizeTags([]string{" Brand ", "", "brand", "logo"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"brand", "logo"}, tags)
+}
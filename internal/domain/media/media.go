@@ -9,6 +9,8 @@ import (
 
 const (
 	thumbnailPrefix = "thumbnail_"
+	// DefaultFocalPoint centers the crops of images
+	DefaultFocalPoint = 0.5
 )
 
 var (
@@ -21,14 +23,37 @@ var (
 	ErrMediaAlreadyLinkedToPost     = errors.New("media already linked to post")
 	ErrFileAlreadyExists            = errors.New("file already exists")
 	ErrFailedToAnalyzeMedia         = errors.New("failed to analyze media")
+	ErrMediaInUse                   = errors.New("media is still used by posts or by the project branding")
+	ErrLibraryMediaNotFound         = errors.New("media not found in library")
+	ErrInvalidFolder                = errors.New("invalid folder")
+	ErrInvalidTags                  = errors.New("invalid tags")
+	ErrFileNotFound                 = errors.New("media file not found")
+	ErrMediaNotFound                = errors.New("media not found")
 )
 
 type Media struct {
-	Data      []byte
+	File      *File
 	Thumbnail *Media
 	*MetaData
 }
 
+// Close removes the files of the media and of its thumbnail
+func (m *Media) Close() error {
+	if m == nil {
+		return nil
+	}
+	return errors.Join(m.File.Close(), m.Thumbnail.Close())
+}
+
+// CloseAll closes all the media of the list
+func CloseAll(medias []*Media) error {
+	var errs []error
+	for _, m := range medias {
+		errs = append(errs, m.Close())
+	}
+	return errors.Join(errs...)
+}
+
 type MediaType string
 
 const (
@@ -36,21 +61,50 @@ const (
 	MediaTypeVideo      MediaType = "video"
 	MediaTypeShortVideo MediaType = "short_video"
 	MediaTypeDocument   MediaType = "document"
+	// MediaTypeAnimatedGIF is a GIF with more than one frame. Platforms take them apart from images, with their
+	// own limits. GIFs with a single frame are images.
+	MediaTypeAnimatedGIF MediaType = "animated_gif"
+)
+
+// MediaStatus tells whether a media has been analyzed and can be published
+type MediaStatus string
+
+const (
+	MediaStatusProcessing MediaStatus = "processing"
+	MediaStatusReady      MediaStatus = "ready"
+	MediaStatusFailed     MediaStatus = "failed"
 )
 
 type MetaData struct {
-	ID        string    `json:"id"`
-	PostID    string    `json:"post_id"`
-	Filename  string    `json:"filename"`
-	Type      MediaType `json:"media_type"`
-	Format    string    `json:"format"`
-	Width     int       `json:"width"`
-	Height    int       `json:"height"`
-	Length    int       `json:"length"`
-	Size      int       `json:"size"` // in bytes
-	AltText   string    `json:"alt_text"`
-	AddedBy   string    `json:"added_by"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        string      `json:"id"`
+	ProjectID string      `json:"project_id"`
+	PostID    string      `json:"post_id"` // Empty for the media of the project library
+	Filename  string      `json:"filename"`
+	Type      MediaType   `json:"media_type"`
+	Format    string      `json:"format"`
+	Width     int         `json:"width"`
+	Height    int         `json:"height"`
+	Length    int         `json:"length"`
+	Frames    int         `json:"frames"` // of animated GIFs
+	Pages     int         `json:"pages"`  // of documents
+	Size      int         `json:"size"`   // in bytes
+	AltText   string      `json:"alt_text"`
+	AddedBy   string      `json:"added_by"`
+	Folder    string      `json:"folder"`
+	Tags      []string    `json:"tags"`
+	CreatedAt time.Time   `json:"created_at"`
+	Status    MediaStatus `json:"status"`
+	// ProcessingError is why the processing of the media failed
+	ProcessingError string `json:"processing_error,omitempty"`
+	// FocalX and FocalY are the point of an image kept in view when it's cropped, as fractions of its width and
+	// height from the top left corner
+	FocalX float64 `json:"focal_x"`
+	FocalY float64 `json:"focal_y"`
+	// MetadataStripped tells whether the metadata of the file, like the location of photos, were removed
+	MetadataStripped bool `json:"metadata_stripped"`
+	// ContentHash is the SHA-256 of the file, which is stored under it. It's empty for the files stored by name,
+	// like thumbnails and media not processed yet.
+	ContentHash string `json:"content_hash"`
 }
 
 func (m *MetaData) IsImage() bool {
@@ -61,27 +115,90 @@ func (m *MetaData) IsVideo() bool {
 	return m.Type == MediaTypeVideo
 }
 
+func (m *MetaData) IsAnimatedGIF() bool {
+	return m.Type == MediaTypeAnimatedGIF
+}
+
+func (m *MetaData) IsReady() bool {
+	return m.Status == MediaStatusReady
+}
+
+// setMediaInfo sets what the analysis of the file found, and marks the media as ready
+func (m *MetaData) setMediaInfo(mediaInfo *MediaInfo) {
+	m.Type = mediaInfo.Type
+	m.Width = mediaInfo.Width
+	m.Height = mediaInfo.Height
+	m.Length = mediaInfo.Length
+	m.Frames = mediaInfo.Frames
+	m.Pages = mediaInfo.Pages
+	m.Format = mediaInfo.Format
+	m.Size = mediaInfo.Size
+	m.Status = MediaStatusReady
+	m.ProcessingError = ""
+}
+
+// mediaInfo returns the details of the media known from its metadata. The codecs of videos aren't stored.
+func (m *MetaData) mediaInfo() *MediaInfo {
+	return &MediaInfo{
+		Type:   m.Type,
+		Format: m.Format,
+		Width:  m.Width,
+		Height: m.Height,
+		Length: m.Length,
+		Frames: m.Frames,
+		Pages:  m.Pages,
+		Size:   m.Size,
+	}
+}
+
 func getThumbnailName(name string) string {
 	return thumbnailPrefix + name + "." + ThumbnailFormat
 }
 
-func NewMetadata(postID, userID, fileName, altText string, data []byte, mediaInfo *MediaInfo) (*MetaData, error) {
+func NewMetadata(projectID, postID, userID, fileName, altText string, mediaInfo *MediaInfo) (*MetaData, error) {
 	return &MetaData{
 		ID:        uuid.New().String(),
+		ProjectID: projectID,
 		PostID:    postID,
 		Filename:  fileName,
 		Type:      mediaInfo.Type,
 		Width:     mediaInfo.Width,
 		Height:    mediaInfo.Height,
 		Length:    mediaInfo.Length,
+		Frames:    mediaInfo.Frames,
+		Pages:     mediaInfo.Pages,
 		Format:    mediaInfo.Format,
 		Size:      mediaInfo.Size,
 		AltText:   altText,
 		AddedBy:   userID,
+		Tags:      []string{},
 		CreatedAt: time.Now().UTC(),
+		Status:    MediaStatusReady,
+		FocalX:    DefaultFocalPoint,
+		FocalY:    DefaultFocalPoint,
 	}, nil
 }
 
+// NewPendingMetadata returns the metadata of a media stored before being analyzed. Only the type and size are
+// known until it's processed.
+func NewPendingMetadata(projectID, postID, userID, fileName, altText string, mediaType MediaType, size int64) *MetaData {
+	return &MetaData{
+		ID:        uuid.New().String(),
+		ProjectID: projectID,
+		PostID:    postID,
+		Filename:  fileName,
+		Type:      mediaType,
+		Size:      int(size),
+		AltText:   altText,
+		AddedBy:   userID,
+		Tags:      []string{},
+		CreatedAt: time.Now().UTC(),
+		Status:    MediaStatusProcessing,
+		FocalX:    DefaultFocalPoint,
+		FocalY:    DefaultFocalPoint,
+	}
+}
+
 type DownloadMetaData struct {
 	Url          *string `json:"url"`
 	UrlThumbnail *string `json:"url_thumbnail"`
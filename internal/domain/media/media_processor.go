@@ -6,27 +6,29 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"image/gif"
 	"image/jpeg"
 	_ "image/png"
-	"os"
+	"math"
 	"os/exec"
 	"path/filepath"
 	"strings"
 
 	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
 )
 
 var (
 	ErrUnsupportedMediaType = errors.New("unsupported media type")
-	allowedImageFormats     = map[string]bool{"jpg": true, "jpeg": true, "png": true}
+	allowedImageFormats     = map[string]bool{"jpg": true, "jpeg": true, "png": true, "gif": true, "webp": true, "heic": true, "heif": true}
 	allowedVideoFormats     = map[string]bool{"mp4": true, "mov": true}
 	allowedDocumentFormats  = map[string]bool{"pdf": true}
 	ThumbnailFormat         = "jpeg"
 )
 
 type MediaProcessor interface {
-	Analyze(data []byte) (*MediaInfo, error)
-	GetThumbnail(data []byte) (*[]byte, error)
+	Analyze(f *File) (*MediaInfo, error)
+	GetThumbnail(f *File) (*[]byte, error)
 	GetMediaType() MediaType
 }
 
@@ -35,8 +37,13 @@ type MediaInfo struct {
 	Format string
 	Width  int
 	Height int
-	Length int // for videos
+	Length int // for videos and animated GIFs, in seconds
+	Frames int // for animated GIFs
+	Pages  int // for documents
 	Size   int // in bytes
+	// VideoCodec and AudioCodec are the codecs of the first streams of videos, AudioCodec is empty without audio
+	VideoCodec string
+	AudioCodec string
 }
 
 func GetProcessor(filename string) (MediaProcessor, error) {
@@ -47,6 +54,10 @@ func GetProcessor(filename string) (MediaProcessor, error) {
 	ext = ext[1:] // remove dot
 
 	switch {
+	case ext == "heic" || ext == "heif":
+		return &HEICProcessor{
+			ext: ext,
+		}, nil
 	case allowedImageFormats[ext]:
 		return &ImageProcessor{
 			ext: ext,
@@ -74,24 +85,27 @@ func (a *ImageProcessor) GetMediaType() MediaType {
 	return MediaTypeImage
 }
 
-func (a *ImageProcessor) Analyze(data []byte) (*MediaInfo, error) {
-	img, format, err := image.DecodeConfig(bytes.NewReader(data))
+func (a *ImageProcessor) Analyze(f *File) (*MediaInfo, error) {
+	img, format, err := image.DecodeConfig(f.Reader())
 	if err != nil {
 		return nil, err
 	}
+	if format == "gif" {
+		return analyzeGIF(f)
+	}
 
 	return &MediaInfo{
 		Type:   MediaTypeImage,
 		Format: format,
 		Width:  img.Width,
 		Height: img.Height,
-		Size:   len(data),
+		Size:   int(f.Size()),
 	}, nil
 }
 
-func (a *ImageProcessor) GetThumbnail(imgData []byte) (*[]byte, error) {
+func (a *ImageProcessor) GetThumbnail(f *File) (*[]byte, error) {
 	// 1. Decode source image
-	src, format, err := image.Decode(bytes.NewReader(imgData))
+	src, format, err := image.Decode(f.Reader())
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode image: %w", err)
 	}
@@ -136,6 +150,35 @@ func (a *ImageProcessor) GetThumbnail(imgData []byte) (*[]byte, error) {
 	return &result, nil
 }
 
+// analyzeGIF tells animated GIFs apart from the GIFs with a single frame, which are images
+func analyzeGIF(f *File) (*MediaInfo, error) {
+	g, err := gif.DecodeAll(f.Reader())
+	if err != nil {
+		return nil, err
+	}
+
+	info := &MediaInfo{
+		Type:   MediaTypeImage,
+		Format: "gif",
+		Width:  g.Config.Width,
+		Height: g.Config.Height,
+		Size:   int(f.Size()),
+	}
+	if len(g.Image) < 2 {
+		return info, nil
+	}
+
+	// Delays are in hundredths of a second
+	var delay int
+	for _, d := range g.Delay {
+		delay += d
+	}
+	info.Type = MediaTypeAnimatedGIF
+	info.Frames = len(g.Image)
+	info.Length = int(math.Ceil(float64(delay) / 100))
+	return info, nil
+}
+
 type VideoProcessor struct {
 	ext string
 }
@@ -143,6 +186,7 @@ type VideoProcessor struct {
 type ffprobeOutput struct {
 	Streams []struct {
 		CodecType string `json:"codec_type"`
+		CodecName string `json:"codec_name"`
 		Width     int    `json:"width,omitempty"`
 		Height    int    `json:"height,omitempty"`
 	} `json:"streams"`
@@ -155,29 +199,14 @@ func (v *VideoProcessor) GetMediaType() MediaType {
 	return MediaTypeVideo
 }
 
-func (a *VideoProcessor) Analyze(video []byte) (*MediaInfo, error) {
-	// Create a temporary file to store the video data
-	tmpFile, err := os.CreateTemp("", "video-*"+"."+a.ext)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create temp file: %w", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	// Write video data to temp file
-	if _, err := tmpFile.Write(video); err != nil {
-		return nil, fmt.Errorf("failed to write temp file: %w", err)
-	}
-	if err := tmpFile.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close temp file: %w", err)
-	}
-
+func (a *VideoProcessor) Analyze(f *File) (*MediaInfo, error) {
 	// Prepare ffprobe command
 	cmd := exec.Command("ffprobe",
 		"-v", "quiet",
 		"-print_format", "json",
 		"-show_format",
 		"-show_streams",
-		tmpFile.Name())
+		f.Path())
 
 	var output bytes.Buffer
 	cmd.Stdout = &output
@@ -194,12 +223,18 @@ func (a *VideoProcessor) Analyze(video []byte) (*MediaInfo, error) {
 	}
 
 	// Find video stream and get dimensions
-	var width, height int
+	var (
+		width, height          int
+		videoCodec, audioCodec string
+	)
 	for _, stream := range ffdata.Streams {
-		if stream.CodecType == "video" {
+		switch {
+		case stream.CodecType == "video" && videoCodec == "":
 			width = stream.Width
 			height = stream.Height
-			break
+			videoCodec = stream.CodecName
+		case stream.CodecType == "audio" && audioCodec == "":
+			audioCodec = stream.CodecName
 		}
 	}
 
@@ -212,32 +247,21 @@ func (a *VideoProcessor) Analyze(video []byte) (*MediaInfo, error) {
 	fmt.Sscanf(ffdata.Format.Duration, "%f", &length)
 
 	return &MediaInfo{
-		Type:   MediaTypeVideo,
-		Format: a.ext,
-		Width:  width,
-		Height: height,
-		Length: int(length),
-		Size:   len(video),
+		Type:       MediaTypeVideo,
+		Format:     a.ext,
+		Width:      width,
+		Height:     height,
+		Length:     int(length),
+		Size:       int(f.Size()),
+		VideoCodec: videoCodec,
+		AudioCodec: audioCodec,
 	}, nil
 }
 
-func (a *VideoProcessor) GetThumbnail(video []byte) (*[]byte, error) {
-	tmpFile, err := os.CreateTemp("", "video-*"+"."+a.ext)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create temp file: %w", err)
-	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err := tmpFile.Write(video); err != nil {
-		return nil, fmt.Errorf("failed to write temp file: %w", err)
-	}
-	if err := tmpFile.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close temp file: %w", err)
-	}
-
+func (a *VideoProcessor) GetThumbnail(f *File) (*[]byte, error) {
 	// Prepare ffmpeg command
 	cmd := exec.Command("ffmpeg",
-		"-i", tmpFile.Name(),
+		"-i", f.Path(),
 		"-ss", "00:00:01.000",
 		"-vframes", "1",
 		"-f", "image2",
@@ -263,16 +287,89 @@ func (d *DocumentProcessor) GetMediaType() MediaType {
 	return MediaTypeDocument
 }
 
-func (d *DocumentProcessor) Analyze(data []byte) (*MediaInfo, error) {
+// Analyze gets the page count of a PDF, and the size of its first page in points, with pdfinfo from poppler-utils
+func (d *DocumentProcessor) Analyze(f *File) (*MediaInfo, error) {
+	cmd := exec.Command("pdfinfo", f.Path())
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = nil
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("pdfinfo failed: %w", err)
+	}
+
+	info, err := parsePDFInfo(output.String())
+	if err != nil {
+		return nil, err
+	}
+	info.Type = MediaTypeDocument
+	info.Format = d.ext
+	info.Size = int(f.Size())
+	return info, nil
+}
+
+// parsePDFInfo reads the page count and the size of the first page from the output of pdfinfo
+func parsePDFInfo(output string) (*MediaInfo, error) {
+	info := &MediaInfo{}
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch key {
+		case "Pages":
+			fmt.Sscanf(value, "%d", &info.Pages)
+		case "Page size":
+			// e.g. 595.276 x 841.89 pts (A4)
+			var width, height float64
+			fmt.Sscanf(value, "%f x %f", &width, &height)
+			info.Width = int(math.Round(width))
+			info.Height = int(math.Round(height))
+		}
+	}
+	if info.Pages == 0 {
+		return nil, errors.New("no pages found in document")
+	}
+	return info, nil
+}
+
+// GetThumbnail renders the first page of a PDF as a JPEG with pdftoppm from poppler-utils
+func (d *DocumentProcessor) GetThumbnail(f *File) (*[]byte, error) {
+	cmd := exec.Command("pdftoppm",
+		"-f", "1",
+		"-l", "1",
+		"-singlefile",
+		"-jpeg",
+		"-jpegopt", "quality=85",
+		"-scale-to", "100",
+		f.Path(),
+		"-")
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = nil
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("pdftoppm failed: %w", err)
+	}
+
+	result := output.Bytes()
+	return &result, nil
+}
+
+// analyzeThumbnail gets the info of a thumbnail, which is always an image
+func analyzeThumbnail(data []byte) (*MediaInfo, error) {
+	img, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
 	return &MediaInfo{
-		Type:   MediaTypeDocument,
-		Format: d.ext,     
+		Type:   MediaTypeImage,
+		Format: format,
+		Width:  img.Width,
+		Height: img.Height,
 		Size:   len(data),
-		// Width, Height, and Length don’t apply to PDFs here
 	}, nil
 }
-
-func (d *DocumentProcessor) GetThumbnail(data []byte) (*[]byte, error) {
-	// Currently no thumbnail creation for PDFs
-	return nil, errors.New("no thumbnail for documents")
-}
\ No newline at end of file
@@ -0,0 +1,112 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodeGIF(t *testing.T, frames int, delay int) *File {
+	g := &gif.GIF{}
+	for i := 0; i < frames; i++ {
+		g.Image = append(g.Image, image.NewPaletted(image.Rect(0, 0, 320, 240), color.Palette{color.Black, color.White}))
+		g.Delay = append(g.Delay, delay)
+	}
+	var b bytes.Buffer
+	assert.NoError(t, gif.EncodeAll(&b, g))
+	f, err := NewFile(&b, 0)
+	assert.NoError(t, err)
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func TestImageProcessor_AnalyzeGIF(t *testing.T) {
+	processor, err := GetProcessor("loop.gif")
+	assert.NoError(t, err)
+
+	t.Run("animated", func(t *testing.T) {
+		info, err := processor.Analyze(encodeGIF(t, 25, 10))
+
+		assert.NoError(t, err)
+		assert.Equal(t, MediaTypeAnimatedGIF, info.Type)
+		assert.Equal(t, "gif", info.Format)
+		assert.Equal(t, 320, info.Width)
+		assert.Equal(t, 240, info.Height)
+		assert.Equal(t, 25, info.Frames)
+		assert.Equal(t, 3, info.Length, "2.5 seconds are rounded up")
+	})
+
+	t.Run("single frame", func(t *testing.T) {
+		info, err := processor.Analyze(encodeGIF(t, 1, 0))
+
+		assert.NoError(t, err)
+		assert.Equal(t, MediaTypeImage, info.Type)
+		assert.Equal(t, 0, info.Frames)
+	})
+
+	t.Run("thumbnail from the first frame", func(t *testing.T) {
+		thumbnail, err := processor.GetThumbnail(encodeGIF(t, 3, 10))
+
+		assert.NoError(t, err)
+		info, err := analyzeThumbnail(*thumbnail)
+		assert.NoError(t, err)
+		assert.Equal(t, "jpeg", info.Format)
+	})
+}
+
+func TestIsHEIC(t *testing.T) {
+	ftyp := func(brands string) *File {
+		data := binary.BigEndian.AppendUint32(nil, uint32(8+len(brands)))
+		data = append(append(data, "ftyp"...), brands...)
+		f, err := NewFile(bytes.NewReader(append(data, "mdat"...)), 0)
+		assert.NoError(t, err)
+		t.Cleanup(func() { f.Close() })
+		return f
+	}
+
+	assert.True(t, isHEIC(ftyp("heic\x00\x00\x00\x00mif1heic")), "iPhone photo")
+	assert.True(t, isHEIC(ftyp("mif1\x00\x00\x00\x00mif1heic")), "generic major brand, HEIC compatible")
+	assert.False(t, isHEIC(ftyp("avif\x00\x00\x00\x00avifmif1miaf")), "AVIF image")
+	assert.False(t, isHEIC(ftyp("mif1\x00\x00\x00\x00mif1")), "no HEVC brand")
+}
+
+func TestGetProcessor_ImageFormats(t *testing.T) {
+	for _, name := range []string{"photo.jpg", "photo.JPEG", "logo.png", "loop.gif", "export.webp", "IMG_0001.HEIC", "photo.heif"} {
+		processor, err := GetProcessor(name)
+
+		assert.NoError(t, err, name)
+		assert.Equal(t, MediaTypeImage, processor.GetMediaType(), name)
+	}
+
+	processor, err := GetProcessor("IMG_0001.HEIC")
+	assert.NoError(t, err)
+	assert.IsType(t, &HEICProcessor{}, processor)
+}
+
+func TestParsePDFInfo(t *testing.T) {
+	output := `Title:           Quarterly report
+Producer:        LibreOffice 7.6
+Tagged:          no
+Pages:           12
+Encrypted:       no
+Page size:       595.276 x 841.89 pts (A4)
+Page rot:        0
+File size:       482113 bytes
+PDF version:     1.7
+`
+
+	info, err := parsePDFInfo(output)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 12, info.Pages)
+	assert.Equal(t, 595, info.Width)
+	assert.Equal(t, 842, info.Height)
+
+	_, err = parsePDFInfo("Syntax Error: Couldn't find trailer dictionary\n")
+	assert.Error(t, err)
+}
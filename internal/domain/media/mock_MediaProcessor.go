@@ -17,9 +17,9 @@ func (_m *MockMediaProcessor) EXPECT() *MockMediaProcessor_Expecter {
 	return &MockMediaProcessor_Expecter{mock: &_m.Mock}
 }
 
-// Analyze provides a mock function with given fields: data
-func (_m *MockMediaProcessor) Analyze(data []byte) (*MediaInfo, error) {
-	ret := _m.Called(data)
+// Analyze provides a mock function with given fields: f
+func (_m *MockMediaProcessor) Analyze(f *File) (*MediaInfo, error) {
+	ret := _m.Called(f)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Analyze")
@@ -27,19 +27,19 @@ func (_m *MockMediaProcessor) Analyze(data []byte) (*MediaInfo, error) {
 
 	var r0 *MediaInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]byte) (*MediaInfo, error)); ok {
-		return rf(data)
+	if rf, ok := ret.Get(0).(func(*File) (*MediaInfo, error)); ok {
+		return rf(f)
 	}
-	if rf, ok := ret.Get(0).(func([]byte) *MediaInfo); ok {
-		r0 = rf(data)
+	if rf, ok := ret.Get(0).(func(*File) *MediaInfo); ok {
+		r0 = rf(f)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*MediaInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]byte) error); ok {
-		r1 = rf(data)
+	if rf, ok := ret.Get(1).(func(*File) error); ok {
+		r1 = rf(f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -53,14 +53,14 @@ type MockMediaProcessor_Analyze_Call struct {
 }
 
 // Analyze is a helper method to define mock.On call
-//   - data []byte
-func (_e *MockMediaProcessor_Expecter) Analyze(data interface{}) *MockMediaProcessor_Analyze_Call {
-	return &MockMediaProcessor_Analyze_Call{Call: _e.mock.On("Analyze", data)}
+//   - f *File
+func (_e *MockMediaProcessor_Expecter) Analyze(f interface{}) *MockMediaProcessor_Analyze_Call {
+	return &MockMediaProcessor_Analyze_Call{Call: _e.mock.On("Analyze", f)}
 }
 
-func (_c *MockMediaProcessor_Analyze_Call) Run(run func(data []byte)) *MockMediaProcessor_Analyze_Call {
+func (_c *MockMediaProcessor_Analyze_Call) Run(run func(f *File)) *MockMediaProcessor_Analyze_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]byte))
+		run(args[0].(*File))
 	})
 	return _c
 }
@@ -70,7 +70,7 @@ func (_c *MockMediaProcessor_Analyze_Call) Return(_a0 *MediaInfo, _a1 error) *Mo
 	return _c
 }
 
-func (_c *MockMediaProcessor_Analyze_Call) RunAndReturn(run func([]byte) (*MediaInfo, error)) *MockMediaProcessor_Analyze_Call {
+func (_c *MockMediaProcessor_Analyze_Call) RunAndReturn(run func(*File) (*MediaInfo, error)) *MockMediaProcessor_Analyze_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -120,9 +120,9 @@ func (_c *MockMediaProcessor_GetMediaType_Call) RunAndReturn(run func() MediaTyp
 	return _c
 }
 
-// GetThumbnail provides a mock function with given fields: data
-func (_m *MockMediaProcessor) GetThumbnail(data []byte) (*[]byte, error) {
-	ret := _m.Called(data)
+// GetThumbnail provides a mock function with given fields: f
+func (_m *MockMediaProcessor) GetThumbnail(f *File) (*[]byte, error) {
+	ret := _m.Called(f)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetThumbnail")
@@ -130,19 +130,19 @@ func (_m *MockMediaProcessor) GetThumbnail(data []byte) (*[]byte, error) {
 
 	var r0 *[]byte
 	var r1 error
-	if rf, ok := ret.Get(0).(func([]byte) (*[]byte, error)); ok {
-		return rf(data)
+	if rf, ok := ret.Get(0).(func(*File) (*[]byte, error)); ok {
+		return rf(f)
 	}
-	if rf, ok := ret.Get(0).(func([]byte) *[]byte); ok {
-		r0 = rf(data)
+	if rf, ok := ret.Get(0).(func(*File) *[]byte); ok {
+		r0 = rf(f)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*[]byte)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([]byte) error); ok {
-		r1 = rf(data)
+	if rf, ok := ret.Get(1).(func(*File) error); ok {
+		r1 = rf(f)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -156,14 +156,14 @@ type MockMediaProcessor_GetThumbnail_Call struct {
 }
 
 // GetThumbnail is a helper method to define mock.On call
-//   - data []byte
-func (_e *MockMediaProcessor_Expecter) GetThumbnail(data interface{}) *MockMediaProcessor_GetThumbnail_Call {
-	return &MockMediaProcessor_GetThumbnail_Call{Call: _e.mock.On("GetThumbnail", data)}
+//   - f *File
+func (_e *MockMediaProcessor_Expecter) GetThumbnail(f interface{}) *MockMediaProcessor_GetThumbnail_Call {
+	return &MockMediaProcessor_GetThumbnail_Call{Call: _e.mock.On("GetThumbnail", f)}
 }
 
-func (_c *MockMediaProcessor_GetThumbnail_Call) Run(run func(data []byte)) *MockMediaProcessor_GetThumbnail_Call {
+func (_c *MockMediaProcessor_GetThumbnail_Call) Run(run func(f *File)) *MockMediaProcessor_GetThumbnail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]byte))
+		run(args[0].(*File))
 	})
 	return _c
 }
@@ -173,7 +173,7 @@ func (_c *MockMediaProcessor_GetThumbnail_Call) Return(_a0 *[]byte, _a1 error) *
 	return _c
 }
 
-func (_c *MockMediaProcessor_GetThumbnail_Call) RunAndReturn(run func([]byte) (*[]byte, error)) *MockMediaProcessor_GetThumbnail_Call {
+func (_c *MockMediaProcessor_GetThumbnail_Call) RunAndReturn(run func(*File) (*[]byte, error)) *MockMediaProcessor_GetThumbnail_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package media
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMetadataStripper is an autogenerated mock type for the MetadataStripper type
+type MockMetadataStripper struct {
+	mock.Mock
+}
+
+type MockMetadataStripper_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMetadataStripper) EXPECT() *MockMetadataStripper_Expecter {
+	return &MockMetadataStripper_Expecter{mock: &_m.Mock}
+}
+
+// Strip provides a mock function with given fields: ctx, f, format
+func (_m *MockMetadataStripper) Strip(ctx context.Context, f *File, format string) (*File, error) {
+	ret := _m.Called(ctx, f, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Strip")
+	}
+
+	var r0 *File
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *File, string) (*File, error)); ok {
+		return rf(ctx, f, format)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *File, string) *File); ok {
+		r0 = rf(ctx, f, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*File)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *File, string) error); ok {
+		r1 = rf(ctx, f, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMetadataStripper_Strip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Strip'
+type MockMetadataStripper_Strip_Call struct {
+	*mock.Call
+}
+
+// Strip is a helper method to define mock.On call
+//   - ctx context.Context
+//   - f *File
+//   - format string
+func (_e *MockMetadataStripper_Expecter) Strip(ctx interface{}, f interface{}, format interface{}) *MockMetadataStripper_Strip_Call {
+	return &MockMetadataStripper_Strip_Call{Call: _e.mock.On("Strip", ctx, f, format)}
+}
+
+func (_c *MockMetadataStripper_Strip_Call) Run(run func(ctx context.Context, f *File, format string)) *MockMetadataStripper_Strip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*File), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockMetadataStripper_Strip_Call) Return(_a0 *File, _a1 error) *MockMetadataStripper_Strip_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMetadataStripper_Strip_Call) RunAndReturn(run func(context.Context, *File, string) (*File, error)) *MockMetadataStripper_Strip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMetadataStripper creates a new instance of MockMetadataStripper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMetadataStripper(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMetadataStripper {
+	mock := &MockMetadataStripper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -4,8 +4,11 @@ package media
 
 import (
 	context "context"
+	io "io"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockObjectRepository is an autogenerated mock type for the ObjectRepository type
@@ -21,6 +24,54 @@ func (_m *MockObjectRepository) EXPECT() *MockObjectRepository_Expecter {
 	return &MockObjectRepository_Expecter{mock: &_m.Mock}
 }
 
+// DeleteBlob provides a mock function with given fields: ctx, projectID, hash
+func (_m *MockObjectRepository) DeleteBlob(ctx context.Context, projectID string, hash string) error {
+	ret := _m.Called(ctx, projectID, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, hash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockObjectRepository_DeleteBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBlob'
+type MockObjectRepository_DeleteBlob_Call struct {
+	*mock.Call
+}
+
+// DeleteBlob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - hash string
+func (_e *MockObjectRepository_Expecter) DeleteBlob(ctx interface{}, projectID interface{}, hash interface{}) *MockObjectRepository_DeleteBlob_Call {
+	return &MockObjectRepository_DeleteBlob_Call{Call: _e.mock.On("DeleteBlob", ctx, projectID, hash)}
+}
+
+func (_c *MockObjectRepository_DeleteBlob_Call) Run(run func(ctx context.Context, projectID string, hash string)) *MockObjectRepository_DeleteBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_DeleteBlob_Call) Return(_a0 error) *MockObjectRepository_DeleteBlob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockObjectRepository_DeleteBlob_Call) RunAndReturn(run func(context.Context, string, string) error) *MockObjectRepository_DeleteBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteFile provides a mock function with given fields: ctx, projectID, postID, filename
 func (_m *MockObjectRepository) DeleteFile(ctx context.Context, projectID string, postID string, filename string) error {
 	ret := _m.Called(ctx, projectID, postID, filename)
@@ -70,24 +121,131 @@ func (_c *MockObjectRepository_DeleteFile_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DeleteStagedFile provides a mock function with given fields: ctx, uploadID
+func (_m *MockObjectRepository) DeleteStagedFile(ctx context.Context, uploadID string) error {
+	ret := _m.Called(ctx, uploadID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStagedFile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uploadID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockObjectRepository_DeleteStagedFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStagedFile'
+type MockObjectRepository_DeleteStagedFile_Call struct {
+	*mock.Call
+}
+
+// DeleteStagedFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadID string
+func (_e *MockObjectRepository_Expecter) DeleteStagedFile(ctx interface{}, uploadID interface{}) *MockObjectRepository_DeleteStagedFile_Call {
+	return &MockObjectRepository_DeleteStagedFile_Call{Call: _e.mock.On("DeleteStagedFile", ctx, uploadID)}
+}
+
+func (_c *MockObjectRepository_DeleteStagedFile_Call) Run(run func(ctx context.Context, uploadID string)) *MockObjectRepository_DeleteStagedFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_DeleteStagedFile_Call) Return(_a0 error) *MockObjectRepository_DeleteStagedFile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockObjectRepository_DeleteStagedFile_Call) RunAndReturn(run func(context.Context, string) error) *MockObjectRepository_DeleteStagedFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlob provides a mock function with given fields: ctx, projectID, hash
+func (_m *MockObjectRepository) GetBlob(ctx context.Context, projectID string, hash string) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, projectID, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlob")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (io.ReadCloser, error)); ok {
+		return rf(ctx, projectID, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) io.ReadCloser); ok {
+		r0 = rf(ctx, projectID, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockObjectRepository_GetBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlob'
+type MockObjectRepository_GetBlob_Call struct {
+	*mock.Call
+}
+
+// GetBlob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - hash string
+func (_e *MockObjectRepository_Expecter) GetBlob(ctx interface{}, projectID interface{}, hash interface{}) *MockObjectRepository_GetBlob_Call {
+	return &MockObjectRepository_GetBlob_Call{Call: _e.mock.On("GetBlob", ctx, projectID, hash)}
+}
+
+func (_c *MockObjectRepository_GetBlob_Call) Run(run func(ctx context.Context, projectID string, hash string)) *MockObjectRepository_GetBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_GetBlob_Call) Return(_a0 io.ReadCloser, _a1 error) *MockObjectRepository_GetBlob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockObjectRepository_GetBlob_Call) RunAndReturn(run func(context.Context, string, string) (io.ReadCloser, error)) *MockObjectRepository_GetBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFile provides a mock function with given fields: ctx, projectID, postID, filename
-func (_m *MockObjectRepository) GetFile(ctx context.Context, projectID string, postID string, filename string) ([]byte, error) {
+func (_m *MockObjectRepository) GetFile(ctx context.Context, projectID string, postID string, filename string) (io.ReadCloser, error) {
 	ret := _m.Called(ctx, projectID, postID, filename)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetFile")
 	}
 
-	var r0 []byte
+	var r0 io.ReadCloser
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]byte, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (io.ReadCloser, error)); ok {
 		return rf(ctx, projectID, postID, filename)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []byte); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) io.ReadCloser); ok {
 		r0 = rf(ctx, projectID, postID, filename)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]byte)
+			r0 = ret.Get(0).(io.ReadCloser)
 		}
 	}
 
@@ -121,12 +279,71 @@ func (_c *MockObjectRepository_GetFile_Call) Run(run func(ctx context.Context, p
 	return _c
 }
 
-func (_c *MockObjectRepository_GetFile_Call) Return(_a0 []byte, _a1 error) *MockObjectRepository_GetFile_Call {
+func (_c *MockObjectRepository_GetFile_Call) Return(_a0 io.ReadCloser, _a1 error) *MockObjectRepository_GetFile_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockObjectRepository_GetFile_Call) RunAndReturn(run func(context.Context, string, string, string) ([]byte, error)) *MockObjectRepository_GetFile_Call {
+func (_c *MockObjectRepository_GetFile_Call) RunAndReturn(run func(context.Context, string, string, string) (io.ReadCloser, error)) *MockObjectRepository_GetFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSignedBlobURL provides a mock function with given fields: ctx, projectID, hash, fileName
+func (_m *MockObjectRepository) GetSignedBlobURL(ctx context.Context, projectID string, hash string, fileName string) (string, error) {
+	ret := _m.Called(ctx, projectID, hash, fileName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSignedBlobURL")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return rf(ctx, projectID, hash, fileName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, projectID, hash, fileName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, hash, fileName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockObjectRepository_GetSignedBlobURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSignedBlobURL'
+type MockObjectRepository_GetSignedBlobURL_Call struct {
+	*mock.Call
+}
+
+// GetSignedBlobURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - hash string
+//   - fileName string
+func (_e *MockObjectRepository_Expecter) GetSignedBlobURL(ctx interface{}, projectID interface{}, hash interface{}, fileName interface{}) *MockObjectRepository_GetSignedBlobURL_Call {
+	return &MockObjectRepository_GetSignedBlobURL_Call{Call: _e.mock.On("GetSignedBlobURL", ctx, projectID, hash, fileName)}
+}
+
+func (_c *MockObjectRepository_GetSignedBlobURL_Call) Run(run func(ctx context.Context, projectID string, hash string, fileName string)) *MockObjectRepository_GetSignedBlobURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_GetSignedBlobURL_Call) Return(_a0 string, _a1 error) *MockObjectRepository_GetSignedBlobURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockObjectRepository_GetSignedBlobURL_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *MockObjectRepository_GetSignedBlobURL_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -190,17 +407,351 @@ func (_c *MockObjectRepository_GetSignedURL_Call) RunAndReturn(run func(context.
 	return _c
 }
 
-// UploadFile provides a mock function with given fields: ctx, projectID, postID, filename, data, metadata
-func (_m *MockObjectRepository) UploadFile(ctx context.Context, projectID string, postID string, filename string, data []byte, metadata *MetaData) error {
-	ret := _m.Called(ctx, projectID, postID, filename, data, metadata)
+// GetSignedUploadURL provides a mock function with given fields: ctx, uploadID, expiry
+func (_m *MockObjectRepository) GetSignedUploadURL(ctx context.Context, uploadID string, expiry time.Duration) (string, error) {
+	ret := _m.Called(ctx, uploadID, expiry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSignedUploadURL")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (string, error)); ok {
+		return rf(ctx, uploadID, expiry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) string); ok {
+		r0 = rf(ctx, uploadID, expiry)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, uploadID, expiry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockObjectRepository_GetSignedUploadURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSignedUploadURL'
+type MockObjectRepository_GetSignedUploadURL_Call struct {
+	*mock.Call
+}
+
+// GetSignedUploadURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadID string
+//   - expiry time.Duration
+func (_e *MockObjectRepository_Expecter) GetSignedUploadURL(ctx interface{}, uploadID interface{}, expiry interface{}) *MockObjectRepository_GetSignedUploadURL_Call {
+	return &MockObjectRepository_GetSignedUploadURL_Call{Call: _e.mock.On("GetSignedUploadURL", ctx, uploadID, expiry)}
+}
+
+func (_c *MockObjectRepository_GetSignedUploadURL_Call) Run(run func(ctx context.Context, uploadID string, expiry time.Duration)) *MockObjectRepository_GetSignedUploadURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_GetSignedUploadURL_Call) Return(_a0 string, _a1 error) *MockObjectRepository_GetSignedUploadURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockObjectRepository_GetSignedUploadURL_Call) RunAndReturn(run func(context.Context, string, time.Duration) (string, error)) *MockObjectRepository_GetSignedUploadURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStagedFile provides a mock function with given fields: ctx, uploadID
+func (_m *MockObjectRepository) GetStagedFile(ctx context.Context, uploadID string) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, uploadID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStagedFile")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, error)); ok {
+		return rf(ctx, uploadID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = rf(ctx, uploadID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uploadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockObjectRepository_GetStagedFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStagedFile'
+type MockObjectRepository_GetStagedFile_Call struct {
+	*mock.Call
+}
+
+// GetStagedFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadID string
+func (_e *MockObjectRepository_Expecter) GetStagedFile(ctx interface{}, uploadID interface{}) *MockObjectRepository_GetStagedFile_Call {
+	return &MockObjectRepository_GetStagedFile_Call{Call: _e.mock.On("GetStagedFile", ctx, uploadID)}
+}
+
+func (_c *MockObjectRepository_GetStagedFile_Call) Run(run func(ctx context.Context, uploadID string)) *MockObjectRepository_GetStagedFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_GetStagedFile_Call) Return(_a0 io.ReadCloser, _a1 error) *MockObjectRepository_GetStagedFile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockObjectRepository_GetStagedFile_Call) RunAndReturn(run func(context.Context, string) (io.ReadCloser, error)) *MockObjectRepository_GetStagedFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFiles provides a mock function with given fields: ctx, fn
+func (_m *MockObjectRepository) ListFiles(ctx context.Context, fn func([]*ObjectInfo) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFiles")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func([]*ObjectInfo) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockObjectRepository_ListFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFiles'
+type MockObjectRepository_ListFiles_Call struct {
+	*mock.Call
+}
+
+// ListFiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func([]*ObjectInfo) error
+func (_e *MockObjectRepository_Expecter) ListFiles(ctx interface{}, fn interface{}) *MockObjectRepository_ListFiles_Call {
+	return &MockObjectRepository_ListFiles_Call{Call: _e.mock.On("ListFiles", ctx, fn)}
+}
+
+func (_c *MockObjectRepository_ListFiles_Call) Run(run func(ctx context.Context, fn func([]*ObjectInfo) error)) *MockObjectRepository_ListFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func([]*ObjectInfo) error))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_ListFiles_Call) Return(_a0 error) *MockObjectRepository_ListFiles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockObjectRepository_ListFiles_Call) RunAndReturn(run func(context.Context, func([]*ObjectInfo) error) error) *MockObjectRepository_ListFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StatBlob provides a mock function with given fields: ctx, projectID, hash
+func (_m *MockObjectRepository) StatBlob(ctx context.Context, projectID string, hash string) (*ObjectInfo, error) {
+	ret := _m.Called(ctx, projectID, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StatBlob")
+	}
+
+	var r0 *ObjectInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*ObjectInfo, error)); ok {
+		return rf(ctx, projectID, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *ObjectInfo); ok {
+		r0 = rf(ctx, projectID, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ObjectInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockObjectRepository_StatBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StatBlob'
+type MockObjectRepository_StatBlob_Call struct {
+	*mock.Call
+}
+
+// StatBlob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - hash string
+func (_e *MockObjectRepository_Expecter) StatBlob(ctx interface{}, projectID interface{}, hash interface{}) *MockObjectRepository_StatBlob_Call {
+	return &MockObjectRepository_StatBlob_Call{Call: _e.mock.On("StatBlob", ctx, projectID, hash)}
+}
+
+func (_c *MockObjectRepository_StatBlob_Call) Run(run func(ctx context.Context, projectID string, hash string)) *MockObjectRepository_StatBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_StatBlob_Call) Return(_a0 *ObjectInfo, _a1 error) *MockObjectRepository_StatBlob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockObjectRepository_StatBlob_Call) RunAndReturn(run func(context.Context, string, string) (*ObjectInfo, error)) *MockObjectRepository_StatBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StatFile provides a mock function with given fields: ctx, projectID, postID, filename
+func (_m *MockObjectRepository) StatFile(ctx context.Context, projectID string, postID string, filename string) (*ObjectInfo, error) {
+	ret := _m.Called(ctx, projectID, postID, filename)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StatFile")
+	}
+
+	var r0 *ObjectInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*ObjectInfo, error)); ok {
+		return rf(ctx, projectID, postID, filename)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *ObjectInfo); ok {
+		r0 = rf(ctx, projectID, postID, filename)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ObjectInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID, filename)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockObjectRepository_StatFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StatFile'
+type MockObjectRepository_StatFile_Call struct {
+	*mock.Call
+}
+
+// StatFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - filename string
+func (_e *MockObjectRepository_Expecter) StatFile(ctx interface{}, projectID interface{}, postID interface{}, filename interface{}) *MockObjectRepository_StatFile_Call {
+	return &MockObjectRepository_StatFile_Call{Call: _e.mock.On("StatFile", ctx, projectID, postID, filename)}
+}
+
+func (_c *MockObjectRepository_StatFile_Call) Run(run func(ctx context.Context, projectID string, postID string, filename string)) *MockObjectRepository_StatFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_StatFile_Call) Return(_a0 *ObjectInfo, _a1 error) *MockObjectRepository_StatFile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockObjectRepository_StatFile_Call) RunAndReturn(run func(context.Context, string, string, string) (*ObjectInfo, error)) *MockObjectRepository_StatFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadBlob provides a mock function with given fields: ctx, projectID, hash, body
+func (_m *MockObjectRepository) UploadBlob(ctx context.Context, projectID string, hash string, body io.ReadSeeker) error {
+	ret := _m.Called(ctx, projectID, hash, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadBlob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.ReadSeeker) error); ok {
+		r0 = rf(ctx, projectID, hash, body)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockObjectRepository_UploadBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadBlob'
+type MockObjectRepository_UploadBlob_Call struct {
+	*mock.Call
+}
+
+// UploadBlob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - hash string
+//   - body io.ReadSeeker
+func (_e *MockObjectRepository_Expecter) UploadBlob(ctx interface{}, projectID interface{}, hash interface{}, body interface{}) *MockObjectRepository_UploadBlob_Call {
+	return &MockObjectRepository_UploadBlob_Call{Call: _e.mock.On("UploadBlob", ctx, projectID, hash, body)}
+}
+
+func (_c *MockObjectRepository_UploadBlob_Call) Run(run func(ctx context.Context, projectID string, hash string, body io.ReadSeeker)) *MockObjectRepository_UploadBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(io.ReadSeeker))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_UploadBlob_Call) Return(_a0 error) *MockObjectRepository_UploadBlob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockObjectRepository_UploadBlob_Call) RunAndReturn(run func(context.Context, string, string, io.ReadSeeker) error) *MockObjectRepository_UploadBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadFile provides a mock function with given fields: ctx, projectID, postID, filename, body, metadata
+func (_m *MockObjectRepository) UploadFile(ctx context.Context, projectID string, postID string, filename string, body io.ReadSeeker, metadata *MetaData) error {
+	ret := _m.Called(ctx, projectID, postID, filename, body, metadata)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UploadFile")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []byte, *MetaData) error); ok {
-		r0 = rf(ctx, projectID, postID, filename, data, metadata)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, io.ReadSeeker, *MetaData) error); ok {
+		r0 = rf(ctx, projectID, postID, filename, body, metadata)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -218,15 +769,15 @@ type MockObjectRepository_UploadFile_Call struct {
 //   - projectID string
 //   - postID string
 //   - filename string
-//   - data []byte
+//   - body io.ReadSeeker
 //   - metadata *MetaData
-func (_e *MockObjectRepository_Expecter) UploadFile(ctx interface{}, projectID interface{}, postID interface{}, filename interface{}, data interface{}, metadata interface{}) *MockObjectRepository_UploadFile_Call {
-	return &MockObjectRepository_UploadFile_Call{Call: _e.mock.On("UploadFile", ctx, projectID, postID, filename, data, metadata)}
+func (_e *MockObjectRepository_Expecter) UploadFile(ctx interface{}, projectID interface{}, postID interface{}, filename interface{}, body interface{}, metadata interface{}) *MockObjectRepository_UploadFile_Call {
+	return &MockObjectRepository_UploadFile_Call{Call: _e.mock.On("UploadFile", ctx, projectID, postID, filename, body, metadata)}
 }
 
-func (_c *MockObjectRepository_UploadFile_Call) Run(run func(ctx context.Context, projectID string, postID string, filename string, data []byte, metadata *MetaData)) *MockObjectRepository_UploadFile_Call {
+func (_c *MockObjectRepository_UploadFile_Call) Run(run func(ctx context.Context, projectID string, postID string, filename string, body io.ReadSeeker, metadata *MetaData)) *MockObjectRepository_UploadFile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].([]byte), args[5].(*MetaData))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(io.ReadSeeker), args[5].(*MetaData))
 	})
 	return _c
 }
@@ -236,7 +787,7 @@ func (_c *MockObjectRepository_UploadFile_Call) Return(_a0 error) *MockObjectRep
 	return _c
 }
 
-func (_c *MockObjectRepository_UploadFile_Call) RunAndReturn(run func(context.Context, string, string, string, []byte, *MetaData) error) *MockObjectRepository_UploadFile_Call {
+func (_c *MockObjectRepository_UploadFile_Call) RunAndReturn(run func(context.Context, string, string, string, io.ReadSeeker, *MetaData) error) *MockObjectRepository_UploadFile_Call {
 	_c.Call.Return(run)
 	return _c
 }
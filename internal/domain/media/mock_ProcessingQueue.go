@@ -0,0 +1,167 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package media
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProcessingQueue is an autogenerated mock type for the ProcessingQueue type
+type MockProcessingQueue struct {
+	mock.Mock
+}
+
+type MockProcessingQueue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProcessingQueue) EXPECT() *MockProcessingQueue_Expecter {
+	return &MockProcessingQueue_Expecter{mock: &_m.Mock}
+}
+
+// Enqueue provides a mock function with given fields: mediaID
+func (_m *MockProcessingQueue) Enqueue(mediaID string) {
+	_m.Called(mediaID)
+}
+
+// MockProcessingQueue_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockProcessingQueue_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - mediaID string
+func (_e *MockProcessingQueue_Expecter) Enqueue(mediaID interface{}) *MockProcessingQueue_Enqueue_Call {
+	return &MockProcessingQueue_Enqueue_Call{Call: _e.mock.On("Enqueue", mediaID)}
+}
+
+func (_c *MockProcessingQueue_Enqueue_Call) Run(run func(mediaID string)) *MockProcessingQueue_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockProcessingQueue_Enqueue_Call) Return() *MockProcessingQueue_Enqueue_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProcessingQueue_Enqueue_Call) RunAndReturn(run func(string)) *MockProcessingQueue_Enqueue_Call {
+	_c.Run(run)
+	return _c
+}
+
+// EnqueueRenditions provides a mock function with given fields: mediaID
+func (_m *MockProcessingQueue) EnqueueRenditions(mediaID string) {
+	_m.Called(mediaID)
+}
+
+// MockProcessingQueue_EnqueueRenditions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueRenditions'
+type MockProcessingQueue_EnqueueRenditions_Call struct {
+	*mock.Call
+}
+
+// EnqueueRenditions is a helper method to define mock.On call
+//   - mediaID string
+func (_e *MockProcessingQueue_Expecter) EnqueueRenditions(mediaID interface{}) *MockProcessingQueue_EnqueueRenditions_Call {
+	return &MockProcessingQueue_EnqueueRenditions_Call{Call: _e.mock.On("EnqueueRenditions", mediaID)}
+}
+
+func (_c *MockProcessingQueue_EnqueueRenditions_Call) Run(run func(mediaID string)) *MockProcessingQueue_EnqueueRenditions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockProcessingQueue_EnqueueRenditions_Call) Return() *MockProcessingQueue_EnqueueRenditions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProcessingQueue_EnqueueRenditions_Call) RunAndReturn(run func(string)) *MockProcessingQueue_EnqueueRenditions_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *MockProcessingQueue) Start(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// MockProcessingQueue_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockProcessingQueue_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProcessingQueue_Expecter) Start(ctx interface{}) *MockProcessingQueue_Start_Call {
+	return &MockProcessingQueue_Start_Call{Call: _e.mock.On("Start", ctx)}
+}
+
+func (_c *MockProcessingQueue_Start_Call) Run(run func(ctx context.Context)) *MockProcessingQueue_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProcessingQueue_Start_Call) Return() *MockProcessingQueue_Start_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProcessingQueue_Start_Call) RunAndReturn(run func(context.Context)) *MockProcessingQueue_Start_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *MockProcessingQueue) Stop() {
+	_m.Called()
+}
+
+// MockProcessingQueue_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockProcessingQueue_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *MockProcessingQueue_Expecter) Stop() *MockProcessingQueue_Stop_Call {
+	return &MockProcessingQueue_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *MockProcessingQueue_Stop_Call) Run(run func()) *MockProcessingQueue_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockProcessingQueue_Stop_Call) Return() *MockProcessingQueue_Stop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProcessingQueue_Stop_Call) RunAndReturn(run func()) *MockProcessingQueue_Stop_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockProcessingQueue creates a new instance of MockProcessingQueue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProcessingQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProcessingQueue {
+	mock := &MockProcessingQueue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
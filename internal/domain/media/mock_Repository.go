@@ -4,6 +4,7 @@ package media
 
 import (
 	context "context"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -21,12 +22,227 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
-// DeleteMetadata provides a mock function with given fields: ctx, mediaID
-func (_m *MockRepository) DeleteMetadata(ctx context.Context, mediaID string) error {
+// DeleteBranding provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) DeleteBranding(ctx context.Context, projectID string) (bool, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBranding")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_DeleteBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranding'
+type MockRepository_DeleteBranding_Call struct {
+	*mock.Call
+}
+
+// DeleteBranding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) DeleteBranding(ctx interface{}, projectID interface{}) *MockRepository_DeleteBranding_Call {
+	return &MockRepository_DeleteBranding_Call{Call: _e.mock.On("DeleteBranding", ctx, projectID)}
+}
+
+func (_c *MockRepository_DeleteBranding_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_DeleteBranding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteBranding_Call) Return(_a0 bool, _a1 error) *MockRepository_DeleteBranding_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_DeleteBranding_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockRepository_DeleteBranding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredUploads provides a mock function with given fields: ctx, now
+func (_m *MockRepository) DeleteExpiredUploads(ctx context.Context, now time.Time) ([]*Upload, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredUploads")
+	}
+
+	var r0 []*Upload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*Upload, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*Upload); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Upload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_DeleteExpiredUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredUploads'
+type MockRepository_DeleteExpiredUploads_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredUploads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockRepository_Expecter) DeleteExpiredUploads(ctx interface{}, now interface{}) *MockRepository_DeleteExpiredUploads_Call {
+	return &MockRepository_DeleteExpiredUploads_Call{Call: _e.mock.On("DeleteExpiredUploads", ctx, now)}
+}
+
+func (_c *MockRepository_DeleteExpiredUploads_Call) Run(run func(ctx context.Context, now time.Time)) *MockRepository_DeleteExpiredUploads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteExpiredUploads_Call) Return(_a0 []*Upload, _a1 error) *MockRepository_DeleteExpiredUploads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_DeleteExpiredUploads_Call) RunAndReturn(run func(context.Context, time.Time) ([]*Upload, error)) *MockRepository_DeleteExpiredUploads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLibraryMedia provides a mock function with given fields: ctx, projectID, mediaID, thumbnailName
+func (_m *MockRepository) DeleteLibraryMedia(ctx context.Context, projectID string, mediaID string, thumbnailName string) error {
+	ret := _m.Called(ctx, projectID, mediaID, thumbnailName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLibraryMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, projectID, mediaID, thumbnailName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_DeleteLibraryMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLibraryMedia'
+type MockRepository_DeleteLibraryMedia_Call struct {
+	*mock.Call
+}
+
+// DeleteLibraryMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - mediaID string
+//   - thumbnailName string
+func (_e *MockRepository_Expecter) DeleteLibraryMedia(ctx interface{}, projectID interface{}, mediaID interface{}, thumbnailName interface{}) *MockRepository_DeleteLibraryMedia_Call {
+	return &MockRepository_DeleteLibraryMedia_Call{Call: _e.mock.On("DeleteLibraryMedia", ctx, projectID, mediaID, thumbnailName)}
+}
+
+func (_c *MockRepository_DeleteLibraryMedia_Call) Run(run func(ctx context.Context, projectID string, mediaID string, thumbnailName string)) *MockRepository_DeleteLibraryMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteLibraryMedia_Call) Return(_a0 error) *MockRepository_DeleteLibraryMedia_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_DeleteLibraryMedia_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockRepository_DeleteLibraryMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePostMedia provides a mock function with given fields: ctx, projectID, postID, mediaID, thumbnailName
+func (_m *MockRepository) DeletePostMedia(ctx context.Context, projectID string, postID string, mediaID string, thumbnailName string) error {
+	ret := _m.Called(ctx, projectID, postID, mediaID, thumbnailName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePostMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID, mediaID, thumbnailName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_DeletePostMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePostMedia'
+type MockRepository_DeletePostMedia_Call struct {
+	*mock.Call
+}
+
+// DeletePostMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - mediaID string
+//   - thumbnailName string
+func (_e *MockRepository_Expecter) DeletePostMedia(ctx interface{}, projectID interface{}, postID interface{}, mediaID interface{}, thumbnailName interface{}) *MockRepository_DeletePostMedia_Call {
+	return &MockRepository_DeletePostMedia_Call{Call: _e.mock.On("DeletePostMedia", ctx, projectID, postID, mediaID, thumbnailName)}
+}
+
+func (_c *MockRepository_DeletePostMedia_Call) Run(run func(ctx context.Context, projectID string, postID string, mediaID string, thumbnailName string)) *MockRepository_DeletePostMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeletePostMedia_Call) Return(_a0 error) *MockRepository_DeletePostMedia_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_DeletePostMedia_Call) RunAndReturn(run func(context.Context, string, string, string, string) error) *MockRepository_DeletePostMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRenditions provides a mock function with given fields: ctx, mediaID
+func (_m *MockRepository) DeleteRenditions(ctx context.Context, mediaID string) error {
 	ret := _m.Called(ctx, mediaID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteMetadata")
+		panic("no return value specified for DeleteRenditions")
 	}
 
 	var r0 error
@@ -39,31 +255,78 @@ func (_m *MockRepository) DeleteMetadata(ctx context.Context, mediaID string) er
 	return r0
 }
 
-// MockRepository_DeleteMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMetadata'
-type MockRepository_DeleteMetadata_Call struct {
+// MockRepository_DeleteRenditions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRenditions'
+type MockRepository_DeleteRenditions_Call struct {
 	*mock.Call
 }
 
-// DeleteMetadata is a helper method to define mock.On call
+// DeleteRenditions is a helper method to define mock.On call
 //   - ctx context.Context
 //   - mediaID string
-func (_e *MockRepository_Expecter) DeleteMetadata(ctx interface{}, mediaID interface{}) *MockRepository_DeleteMetadata_Call {
-	return &MockRepository_DeleteMetadata_Call{Call: _e.mock.On("DeleteMetadata", ctx, mediaID)}
+func (_e *MockRepository_Expecter) DeleteRenditions(ctx interface{}, mediaID interface{}) *MockRepository_DeleteRenditions_Call {
+	return &MockRepository_DeleteRenditions_Call{Call: _e.mock.On("DeleteRenditions", ctx, mediaID)}
+}
+
+func (_c *MockRepository_DeleteRenditions_Call) Run(run func(ctx context.Context, mediaID string)) *MockRepository_DeleteRenditions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteRenditions_Call) Return(_a0 error) *MockRepository_DeleteRenditions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_DeleteRenditions_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_DeleteRenditions_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-func (_c *MockRepository_DeleteMetadata_Call) Run(run func(ctx context.Context, mediaID string)) *MockRepository_DeleteMetadata_Call {
+// DeleteUpload provides a mock function with given fields: ctx, uploadID
+func (_m *MockRepository) DeleteUpload(ctx context.Context, uploadID string) error {
+	ret := _m.Called(ctx, uploadID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUpload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uploadID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_DeleteUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUpload'
+type MockRepository_DeleteUpload_Call struct {
+	*mock.Call
+}
+
+// DeleteUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadID string
+func (_e *MockRepository_Expecter) DeleteUpload(ctx interface{}, uploadID interface{}) *MockRepository_DeleteUpload_Call {
+	return &MockRepository_DeleteUpload_Call{Call: _e.mock.On("DeleteUpload", ctx, uploadID)}
+}
+
+func (_c *MockRepository_DeleteUpload_Call) Run(run func(ctx context.Context, uploadID string)) *MockRepository_DeleteUpload_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_DeleteMetadata_Call) Return(_a0 error) *MockRepository_DeleteMetadata_Call {
+func (_c *MockRepository_DeleteUpload_Call) Return(_a0 error) *MockRepository_DeleteUpload_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockRepository_DeleteMetadata_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_DeleteMetadata_Call {
+func (_c *MockRepository_DeleteUpload_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_DeleteUpload_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -184,27 +447,29 @@ func (_c *MockRepository_DoesPostBelongToProject_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// GetMediaFileName provides a mock function with given fields: ctx, mediaID
-func (_m *MockRepository) GetMediaFileName(ctx context.Context, mediaID string) (string, error) {
-	ret := _m.Called(ctx, mediaID)
+// FindBranding provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindBranding(ctx context.Context, projectID string) (*Branding, error) {
+	ret := _m.Called(ctx, projectID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMediaFileName")
+		panic("no return value specified for FindBranding")
 	}
 
-	var r0 string
+	var r0 *Branding
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, mediaID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*Branding, error)); ok {
+		return rf(ctx, projectID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, mediaID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *Branding); ok {
+		r0 = rf(ctx, projectID)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Branding)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, mediaID)
+		r1 = rf(ctx, projectID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -212,58 +477,58 @@ func (_m *MockRepository) GetMediaFileName(ctx context.Context, mediaID string)
 	return r0, r1
 }
 
-// MockRepository_GetMediaFileName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaFileName'
-type MockRepository_GetMediaFileName_Call struct {
+// MockRepository_FindBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBranding'
+type MockRepository_FindBranding_Call struct {
 	*mock.Call
 }
 
-// GetMediaFileName is a helper method to define mock.On call
+// FindBranding is a helper method to define mock.On call
 //   - ctx context.Context
-//   - mediaID string
-func (_e *MockRepository_Expecter) GetMediaFileName(ctx interface{}, mediaID interface{}) *MockRepository_GetMediaFileName_Call {
-	return &MockRepository_GetMediaFileName_Call{Call: _e.mock.On("GetMediaFileName", ctx, mediaID)}
+//   - projectID string
+func (_e *MockRepository_Expecter) FindBranding(ctx interface{}, projectID interface{}) *MockRepository_FindBranding_Call {
+	return &MockRepository_FindBranding_Call{Call: _e.mock.On("FindBranding", ctx, projectID)}
 }
 
-func (_c *MockRepository_GetMediaFileName_Call) Run(run func(ctx context.Context, mediaID string)) *MockRepository_GetMediaFileName_Call {
+func (_c *MockRepository_FindBranding_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindBranding_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetMediaFileName_Call) Return(_a0 string, _a1 error) *MockRepository_GetMediaFileName_Call {
+func (_c *MockRepository_FindBranding_Call) Return(_a0 *Branding, _a1 error) *MockRepository_FindBranding_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_GetMediaFileName_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockRepository_GetMediaFileName_Call {
+func (_c *MockRepository_FindBranding_Call) RunAndReturn(run func(context.Context, string) (*Branding, error)) *MockRepository_FindBranding_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMediaFileNamesForPublishPost provides a mock function with given fields: ctx, postID, platformID
-func (_m *MockRepository) GetMediaFileNamesForPublishPost(ctx context.Context, postID string, platformID string) ([]string, error) {
-	ret := _m.Called(ctx, postID, platformID)
+// FindLibraryMedia provides a mock function with given fields: ctx, projectID, filter
+func (_m *MockRepository) FindLibraryMedia(ctx context.Context, projectID string, filter *LibraryFilter) ([]*LibraryMedia, error) {
+	ret := _m.Called(ctx, projectID, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMediaFileNamesForPublishPost")
+		panic("no return value specified for FindLibraryMedia")
 	}
 
-	var r0 []string
+	var r0 []*LibraryMedia
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
-		return rf(ctx, postID, platformID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *LibraryFilter) ([]*LibraryMedia, error)); ok {
+		return rf(ctx, projectID, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
-		r0 = rf(ctx, postID, platformID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *LibraryFilter) []*LibraryMedia); ok {
+		r0 = rf(ctx, projectID, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).([]*LibraryMedia)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, postID, platformID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *LibraryFilter) error); ok {
+		r1 = rf(ctx, projectID, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -271,59 +536,59 @@ func (_m *MockRepository) GetMediaFileNamesForPublishPost(ctx context.Context, p
 	return r0, r1
 }
 
-// MockRepository_GetMediaFileNamesForPublishPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaFileNamesForPublishPost'
-type MockRepository_GetMediaFileNamesForPublishPost_Call struct {
+// MockRepository_FindLibraryMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLibraryMedia'
+type MockRepository_FindLibraryMedia_Call struct {
 	*mock.Call
 }
 
-// GetMediaFileNamesForPublishPost is a helper method to define mock.On call
+// FindLibraryMedia is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
-//   - platformID string
-func (_e *MockRepository_Expecter) GetMediaFileNamesForPublishPost(ctx interface{}, postID interface{}, platformID interface{}) *MockRepository_GetMediaFileNamesForPublishPost_Call {
-	return &MockRepository_GetMediaFileNamesForPublishPost_Call{Call: _e.mock.On("GetMediaFileNamesForPublishPost", ctx, postID, platformID)}
+//   - projectID string
+//   - filter *LibraryFilter
+func (_e *MockRepository_Expecter) FindLibraryMedia(ctx interface{}, projectID interface{}, filter interface{}) *MockRepository_FindLibraryMedia_Call {
+	return &MockRepository_FindLibraryMedia_Call{Call: _e.mock.On("FindLibraryMedia", ctx, projectID, filter)}
 }
 
-func (_c *MockRepository_GetMediaFileNamesForPublishPost_Call) Run(run func(ctx context.Context, postID string, platformID string)) *MockRepository_GetMediaFileNamesForPublishPost_Call {
+func (_c *MockRepository_FindLibraryMedia_Call) Run(run func(ctx context.Context, projectID string, filter *LibraryFilter)) *MockRepository_FindLibraryMedia_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(*LibraryFilter))
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetMediaFileNamesForPublishPost_Call) Return(_a0 []string, _a1 error) *MockRepository_GetMediaFileNamesForPublishPost_Call {
+func (_c *MockRepository_FindLibraryMedia_Call) Return(_a0 []*LibraryMedia, _a1 error) *MockRepository_FindLibraryMedia_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_GetMediaFileNamesForPublishPost_Call) RunAndReturn(run func(context.Context, string, string) ([]string, error)) *MockRepository_GetMediaFileNamesForPublishPost_Call {
+func (_c *MockRepository_FindLibraryMedia_Call) RunAndReturn(run func(context.Context, string, *LibraryFilter) ([]*LibraryMedia, error)) *MockRepository_FindLibraryMedia_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMetadata provides a mock function with given fields: ctx, postID, fileName
-func (_m *MockRepository) GetMetadata(ctx context.Context, postID string, fileName string) (*MetaData, error) {
-	ret := _m.Called(ctx, postID, fileName)
+// FindLibraryMediaByID provides a mock function with given fields: ctx, projectID, mediaID
+func (_m *MockRepository) FindLibraryMediaByID(ctx context.Context, projectID string, mediaID string) (*LibraryMedia, error) {
+	ret := _m.Called(ctx, projectID, mediaID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMetadata")
+		panic("no return value specified for FindLibraryMediaByID")
 	}
 
-	var r0 *MetaData
+	var r0 *LibraryMedia
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*MetaData, error)); ok {
-		return rf(ctx, postID, fileName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*LibraryMedia, error)); ok {
+		return rf(ctx, projectID, mediaID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *MetaData); ok {
-		r0 = rf(ctx, postID, fileName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *LibraryMedia); ok {
+		r0 = rf(ctx, projectID, mediaID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*MetaData)
+			r0 = ret.Get(0).(*LibraryMedia)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, postID, fileName)
+		r1 = rf(ctx, projectID, mediaID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -331,32 +596,926 @@ func (_m *MockRepository) GetMetadata(ctx context.Context, postID string, fileNa
 	return r0, r1
 }
 
-// MockRepository_GetMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetadata'
-type MockRepository_GetMetadata_Call struct {
+// MockRepository_FindLibraryMediaByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLibraryMediaByID'
+type MockRepository_FindLibraryMediaByID_Call struct {
 	*mock.Call
 }
 
-// GetMetadata is a helper method to define mock.On call
+// FindLibraryMediaByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
-//   - fileName string
-func (_e *MockRepository_Expecter) GetMetadata(ctx interface{}, postID interface{}, fileName interface{}) *MockRepository_GetMetadata_Call {
-	return &MockRepository_GetMetadata_Call{Call: _e.mock.On("GetMetadata", ctx, postID, fileName)}
+//   - projectID string
+//   - mediaID string
+func (_e *MockRepository_Expecter) FindLibraryMediaByID(ctx interface{}, projectID interface{}, mediaID interface{}) *MockRepository_FindLibraryMediaByID_Call {
+	return &MockRepository_FindLibraryMediaByID_Call{Call: _e.mock.On("FindLibraryMediaByID", ctx, projectID, mediaID)}
+}
+
+func (_c *MockRepository_FindLibraryMediaByID_Call) Run(run func(ctx context.Context, projectID string, mediaID string)) *MockRepository_FindLibraryMediaByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindLibraryMediaByID_Call) Return(_a0 *LibraryMedia, _a1 error) *MockRepository_FindLibraryMediaByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindLibraryMediaByID_Call) RunAndReturn(run func(context.Context, string, string) (*LibraryMedia, error)) *MockRepository_FindLibraryMediaByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMetadataCreatedBefore provides a mock function with given fields: ctx, createdBefore, afterID, limit
+func (_m *MockRepository) FindMetadataCreatedBefore(ctx context.Context, createdBefore time.Time, afterID string, limit int) ([]*MetaData, error) {
+	ret := _m.Called(ctx, createdBefore, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMetadataCreatedBefore")
+	}
+
+	var r0 []*MetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string, int) ([]*MetaData, error)); ok {
+		return rf(ctx, createdBefore, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string, int) []*MetaData); ok {
+		r0 = rf(ctx, createdBefore, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*MetaData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, string, int) error); ok {
+		r1 = rf(ctx, createdBefore, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindMetadataCreatedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMetadataCreatedBefore'
+type MockRepository_FindMetadataCreatedBefore_Call struct {
+	*mock.Call
+}
+
+// FindMetadataCreatedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - afterID string
+//   - limit int
+func (_e *MockRepository_Expecter) FindMetadataCreatedBefore(ctx interface{}, createdBefore interface{}, afterID interface{}, limit interface{}) *MockRepository_FindMetadataCreatedBefore_Call {
+	return &MockRepository_FindMetadataCreatedBefore_Call{Call: _e.mock.On("FindMetadataCreatedBefore", ctx, createdBefore, afterID, limit)}
+}
+
+func (_c *MockRepository_FindMetadataCreatedBefore_Call) Run(run func(ctx context.Context, createdBefore time.Time, afterID string, limit int)) *MockRepository_FindMetadataCreatedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindMetadataCreatedBefore_Call) Return(_a0 []*MetaData, _a1 error) *MockRepository_FindMetadataCreatedBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindMetadataCreatedBefore_Call) RunAndReturn(run func(context.Context, time.Time, string, int) ([]*MetaData, error)) *MockRepository_FindMetadataCreatedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPostMediaByID provides a mock function with given fields: ctx, projectID, postID, mediaID
+func (_m *MockRepository) FindPostMediaByID(ctx context.Context, projectID string, postID string, mediaID string) (*MetaData, error) {
+	ret := _m.Called(ctx, projectID, postID, mediaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPostMediaByID")
+	}
+
+	var r0 *MetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*MetaData, error)); ok {
+		return rf(ctx, projectID, postID, mediaID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *MetaData); ok {
+		r0 = rf(ctx, projectID, postID, mediaID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MetaData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID, mediaID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindPostMediaByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostMediaByID'
+type MockRepository_FindPostMediaByID_Call struct {
+	*mock.Call
+}
+
+// FindPostMediaByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - mediaID string
+func (_e *MockRepository_Expecter) FindPostMediaByID(ctx interface{}, projectID interface{}, postID interface{}, mediaID interface{}) *MockRepository_FindPostMediaByID_Call {
+	return &MockRepository_FindPostMediaByID_Call{Call: _e.mock.On("FindPostMediaByID", ctx, projectID, postID, mediaID)}
+}
+
+func (_c *MockRepository_FindPostMediaByID_Call) Run(run func(ctx context.Context, projectID string, postID string, mediaID string)) *MockRepository_FindPostMediaByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindPostMediaByID_Call) Return(_a0 *MetaData, _a1 error) *MockRepository_FindPostMediaByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindPostMediaByID_Call) RunAndReturn(run func(context.Context, string, string, string) (*MetaData, error)) *MockRepository_FindPostMediaByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindProcessingMedia provides a mock function with given fields: ctx, createdBefore, limit
+func (_m *MockRepository) FindProcessingMedia(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	ret := _m.Called(ctx, createdBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindProcessingMedia")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]string, error)); ok {
+		return rf(ctx, createdBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []string); ok {
+		r0 = rf(ctx, createdBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, createdBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindProcessingMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindProcessingMedia'
+type MockRepository_FindProcessingMedia_Call struct {
+	*mock.Call
+}
+
+// FindProcessingMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+func (_e *MockRepository_Expecter) FindProcessingMedia(ctx interface{}, createdBefore interface{}, limit interface{}) *MockRepository_FindProcessingMedia_Call {
+	return &MockRepository_FindProcessingMedia_Call{Call: _e.mock.On("FindProcessingMedia", ctx, createdBefore, limit)}
+}
+
+func (_c *MockRepository_FindProcessingMedia_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int)) *MockRepository_FindProcessingMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindProcessingMedia_Call) Return(_a0 []string, _a1 error) *MockRepository_FindProcessingMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindProcessingMedia_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]string, error)) *MockRepository_FindProcessingMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindProcessingStatus provides a mock function with given fields: ctx, projectID, mediaIDs
+func (_m *MockRepository) FindProcessingStatus(ctx context.Context, projectID string, mediaIDs []string) ([]*ProcessingStatus, error) {
+	ret := _m.Called(ctx, projectID, mediaIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindProcessingStatus")
+	}
+
+	var r0 []*ProcessingStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]*ProcessingStatus, error)); ok {
+		return rf(ctx, projectID, mediaIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*ProcessingStatus); ok {
+		r0 = rf(ctx, projectID, mediaIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ProcessingStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, projectID, mediaIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindProcessingStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindProcessingStatus'
+type MockRepository_FindProcessingStatus_Call struct {
+	*mock.Call
+}
+
+// FindProcessingStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - mediaIDs []string
+func (_e *MockRepository_Expecter) FindProcessingStatus(ctx interface{}, projectID interface{}, mediaIDs interface{}) *MockRepository_FindProcessingStatus_Call {
+	return &MockRepository_FindProcessingStatus_Call{Call: _e.mock.On("FindProcessingStatus", ctx, projectID, mediaIDs)}
+}
+
+func (_c *MockRepository_FindProcessingStatus_Call) Run(run func(ctx context.Context, projectID string, mediaIDs []string)) *MockRepository_FindProcessingStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindProcessingStatus_Call) Return(_a0 []*ProcessingStatus, _a1 error) *MockRepository_FindProcessingStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindProcessingStatus_Call) RunAndReturn(run func(context.Context, string, []string) ([]*ProcessingStatus, error)) *MockRepository_FindProcessingStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPublishBranding provides a mock function with given fields: ctx, postID, platformID
+func (_m *MockRepository) FindPublishBranding(ctx context.Context, postID string, platformID string) (*Branding, error) {
+	ret := _m.Called(ctx, postID, platformID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPublishBranding")
+	}
+
+	var r0 *Branding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*Branding, error)); ok {
+		return rf(ctx, postID, platformID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *Branding); ok {
+		r0 = rf(ctx, postID, platformID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Branding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, postID, platformID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindPublishBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPublishBranding'
+type MockRepository_FindPublishBranding_Call struct {
+	*mock.Call
+}
+
+// FindPublishBranding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - platformID string
+func (_e *MockRepository_Expecter) FindPublishBranding(ctx interface{}, postID interface{}, platformID interface{}) *MockRepository_FindPublishBranding_Call {
+	return &MockRepository_FindPublishBranding_Call{Call: _e.mock.On("FindPublishBranding", ctx, postID, platformID)}
+}
+
+func (_c *MockRepository_FindPublishBranding_Call) Run(run func(ctx context.Context, postID string, platformID string)) *MockRepository_FindPublishBranding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindPublishBranding_Call) Return(_a0 *Branding, _a1 error) *MockRepository_FindPublishBranding_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindPublishBranding_Call) RunAndReturn(run func(context.Context, string, string) (*Branding, error)) *MockRepository_FindPublishBranding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRendition provides a mock function with given fields: ctx, mediaID, platformID
+func (_m *MockRepository) FindRendition(ctx context.Context, mediaID string, platformID string) (*Rendition, error) {
+	ret := _m.Called(ctx, mediaID, platformID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRendition")
+	}
+
+	var r0 *Rendition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*Rendition, error)); ok {
+		return rf(ctx, mediaID, platformID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *Rendition); ok {
+		r0 = rf(ctx, mediaID, platformID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Rendition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, mediaID, platformID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindRendition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRendition'
+type MockRepository_FindRendition_Call struct {
+	*mock.Call
+}
+
+// FindRendition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mediaID string
+//   - platformID string
+func (_e *MockRepository_Expecter) FindRendition(ctx interface{}, mediaID interface{}, platformID interface{}) *MockRepository_FindRendition_Call {
+	return &MockRepository_FindRendition_Call{Call: _e.mock.On("FindRendition", ctx, mediaID, platformID)}
+}
+
+func (_c *MockRepository_FindRendition_Call) Run(run func(ctx context.Context, mediaID string, platformID string)) *MockRepository_FindRendition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindRendition_Call) Return(_a0 *Rendition, _a1 error) *MockRepository_FindRendition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindRendition_Call) RunAndReturn(run func(context.Context, string, string) (*Rendition, error)) *MockRepository_FindRendition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUnsharedContent provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockRepository) FindUnsharedContent(ctx context.Context, projectID string, postID string) ([]string, error) {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUnsharedContent")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return rf(ctx, projectID, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindUnsharedContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUnsharedContent'
+type MockRepository_FindUnsharedContent_Call struct {
+	*mock.Call
+}
+
+// FindUnsharedContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockRepository_Expecter) FindUnsharedContent(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_FindUnsharedContent_Call {
+	return &MockRepository_FindUnsharedContent_Call{Call: _e.mock.On("FindUnsharedContent", ctx, projectID, postID)}
+}
+
+func (_c *MockRepository_FindUnsharedContent_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_FindUnsharedContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindUnsharedContent_Call) Return(_a0 []string, _a1 error) *MockRepository_FindUnsharedContent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindUnsharedContent_Call) RunAndReturn(run func(context.Context, string, string) ([]string, error)) *MockRepository_FindUnsharedContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUpload provides a mock function with given fields: ctx, projectID, uploadID
+func (_m *MockRepository) FindUpload(ctx context.Context, projectID string, uploadID string) (*Upload, error) {
+	ret := _m.Called(ctx, projectID, uploadID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUpload")
+	}
+
+	var r0 *Upload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*Upload, error)); ok {
+		return rf(ctx, projectID, uploadID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *Upload); ok {
+		r0 = rf(ctx, projectID, uploadID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Upload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, uploadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUpload'
+type MockRepository_FindUpload_Call struct {
+	*mock.Call
+}
+
+// FindUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - uploadID string
+func (_e *MockRepository_Expecter) FindUpload(ctx interface{}, projectID interface{}, uploadID interface{}) *MockRepository_FindUpload_Call {
+	return &MockRepository_FindUpload_Call{Call: _e.mock.On("FindUpload", ctx, projectID, uploadID)}
+}
+
+func (_c *MockRepository_FindUpload_Call) Run(run func(ctx context.Context, projectID string, uploadID string)) *MockRepository_FindUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindUpload_Call) Return(_a0 *Upload, _a1 error) *MockRepository_FindUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindUpload_Call) RunAndReturn(run func(context.Context, string, string) (*Upload, error)) *MockRepository_FindUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMediaFileName provides a mock function with given fields: ctx, mediaID
+func (_m *MockRepository) GetMediaFileName(ctx context.Context, mediaID string) (string, error) {
+	ret := _m.Called(ctx, mediaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMediaFileName")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, mediaID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, mediaID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mediaID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetMediaFileName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaFileName'
+type MockRepository_GetMediaFileName_Call struct {
+	*mock.Call
+}
+
+// GetMediaFileName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mediaID string
+func (_e *MockRepository_Expecter) GetMediaFileName(ctx interface{}, mediaID interface{}) *MockRepository_GetMediaFileName_Call {
+	return &MockRepository_GetMediaFileName_Call{Call: _e.mock.On("GetMediaFileName", ctx, mediaID)}
+}
+
+func (_c *MockRepository_GetMediaFileName_Call) Run(run func(ctx context.Context, mediaID string)) *MockRepository_GetMediaFileName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetMediaFileName_Call) Return(_a0 string, _a1 error) *MockRepository_GetMediaFileName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetMediaFileName_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockRepository_GetMediaFileName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMediaForPublishPost provides a mock function with given fields: ctx, postID, platformID
+func (_m *MockRepository) GetMediaForPublishPost(ctx context.Context, postID string, platformID string) ([]*MetaData, error) {
+	ret := _m.Called(ctx, postID, platformID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMediaForPublishPost")
+	}
+
+	var r0 []*MetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*MetaData, error)); ok {
+		return rf(ctx, postID, platformID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*MetaData); ok {
+		r0 = rf(ctx, postID, platformID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*MetaData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, postID, platformID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetMediaForPublishPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaForPublishPost'
+type MockRepository_GetMediaForPublishPost_Call struct {
+	*mock.Call
+}
+
+// GetMediaForPublishPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - platformID string
+func (_e *MockRepository_Expecter) GetMediaForPublishPost(ctx interface{}, postID interface{}, platformID interface{}) *MockRepository_GetMediaForPublishPost_Call {
+	return &MockRepository_GetMediaForPublishPost_Call{Call: _e.mock.On("GetMediaForPublishPost", ctx, postID, platformID)}
+}
+
+func (_c *MockRepository_GetMediaForPublishPost_Call) Run(run func(ctx context.Context, postID string, platformID string)) *MockRepository_GetMediaForPublishPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetMediaForPublishPost_Call) Return(_a0 []*MetaData, _a1 error) *MockRepository_GetMediaForPublishPost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetMediaForPublishPost_Call) RunAndReturn(run func(context.Context, string, string) ([]*MetaData, error)) *MockRepository_GetMediaForPublishPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetadata provides a mock function with given fields: ctx, projectID, postID, fileName
+func (_m *MockRepository) GetMetadata(ctx context.Context, projectID string, postID string, fileName string) (*MetaData, error) {
+	ret := _m.Called(ctx, projectID, postID, fileName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetadata")
+	}
+
+	var r0 *MetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*MetaData, error)); ok {
+		return rf(ctx, projectID, postID, fileName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *MetaData); ok {
+		r0 = rf(ctx, projectID, postID, fileName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MetaData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID, fileName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetadata'
+type MockRepository_GetMetadata_Call struct {
+	*mock.Call
+}
+
+// GetMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - fileName string
+func (_e *MockRepository_Expecter) GetMetadata(ctx interface{}, projectID interface{}, postID interface{}, fileName interface{}) *MockRepository_GetMetadata_Call {
+	return &MockRepository_GetMetadata_Call{Call: _e.mock.On("GetMetadata", ctx, projectID, postID, fileName)}
+}
+
+func (_c *MockRepository_GetMetadata_Call) Run(run func(ctx context.Context, projectID string, postID string, fileName string)) *MockRepository_GetMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetMetadata_Call) Return(_a0 *MetaData, _a1 error) *MockRepository_GetMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetMetadata_Call) RunAndReturn(run func(context.Context, string, string, string) (*MetaData, error)) *MockRepository_GetMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetadataByID provides a mock function with given fields: ctx, mediaID
+func (_m *MockRepository) GetMetadataByID(ctx context.Context, mediaID string) (*MetaData, error) {
+	ret := _m.Called(ctx, mediaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetadataByID")
+	}
+
+	var r0 *MetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*MetaData, error)); ok {
+		return rf(ctx, mediaID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *MetaData); ok {
+		r0 = rf(ctx, mediaID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MetaData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mediaID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetMetadataByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetadataByID'
+type MockRepository_GetMetadataByID_Call struct {
+	*mock.Call
+}
+
+// GetMetadataByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mediaID string
+func (_e *MockRepository_Expecter) GetMetadataByID(ctx interface{}, mediaID interface{}) *MockRepository_GetMetadataByID_Call {
+	return &MockRepository_GetMetadataByID_Call{Call: _e.mock.On("GetMetadataByID", ctx, mediaID)}
+}
+
+func (_c *MockRepository_GetMetadataByID_Call) Run(run func(ctx context.Context, mediaID string)) *MockRepository_GetMetadataByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetMetadataByID_Call) Return(_a0 *MetaData, _a1 error) *MockRepository_GetMetadataByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetMetadataByID_Call) RunAndReturn(run func(context.Context, string) (*MetaData, error)) *MockRepository_GetMetadataByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasMetadata provides a mock function with given fields: ctx, files
+func (_m *MockRepository) HasMetadata(ctx context.Context, files []*ObjectInfo) ([]bool, error) {
+	ret := _m.Called(ctx, files)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasMetadata")
+	}
+
+	var r0 []bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*ObjectInfo) ([]bool, error)); ok {
+		return rf(ctx, files)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*ObjectInfo) []bool); ok {
+		r0 = rf(ctx, files)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*ObjectInfo) error); ok {
+		r1 = rf(ctx, files)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_HasMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasMetadata'
+type MockRepository_HasMetadata_Call struct {
+	*mock.Call
+}
+
+// HasMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - files []*ObjectInfo
+func (_e *MockRepository_Expecter) HasMetadata(ctx interface{}, files interface{}) *MockRepository_HasMetadata_Call {
+	return &MockRepository_HasMetadata_Call{Call: _e.mock.On("HasMetadata", ctx, files)}
+}
+
+func (_c *MockRepository_HasMetadata_Call) Run(run func(ctx context.Context, files []*ObjectInfo)) *MockRepository_HasMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*ObjectInfo))
+	})
+	return _c
+}
+
+func (_c *MockRepository_HasMetadata_Call) Return(_a0 []bool, _a1 error) *MockRepository_HasMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_HasMetadata_Call) RunAndReturn(run func(context.Context, []*ObjectInfo) ([]bool, error)) *MockRepository_HasMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsContentReferenced provides a mock function with given fields: ctx, projectID, hash
+func (_m *MockRepository) IsContentReferenced(ctx context.Context, projectID string, hash string) (bool, error) {
+	ret := _m.Called(ctx, projectID, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsContentReferenced")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, projectID, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, projectID, hash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_IsContentReferenced_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsContentReferenced'
+type MockRepository_IsContentReferenced_Call struct {
+	*mock.Call
+}
+
+// IsContentReferenced is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - hash string
+func (_e *MockRepository_Expecter) IsContentReferenced(ctx interface{}, projectID interface{}, hash interface{}) *MockRepository_IsContentReferenced_Call {
+	return &MockRepository_IsContentReferenced_Call{Call: _e.mock.On("IsContentReferenced", ctx, projectID, hash)}
+}
+
+func (_c *MockRepository_IsContentReferenced_Call) Run(run func(ctx context.Context, projectID string, hash string)) *MockRepository_IsContentReferenced_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_IsContentReferenced_Call) Return(_a0 bool, _a1 error) *MockRepository_IsContentReferenced_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_IsContentReferenced_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_IsContentReferenced_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMediaInLibrary provides a mock function with given fields: ctx, projectID, mediaID
+func (_m *MockRepository) IsMediaInLibrary(ctx context.Context, projectID string, mediaID string) (bool, error) {
+	ret := _m.Called(ctx, projectID, mediaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMediaInLibrary")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, projectID, mediaID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, projectID, mediaID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, mediaID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_IsMediaInLibrary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMediaInLibrary'
+type MockRepository_IsMediaInLibrary_Call struct {
+	*mock.Call
+}
+
+// IsMediaInLibrary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - mediaID string
+func (_e *MockRepository_Expecter) IsMediaInLibrary(ctx interface{}, projectID interface{}, mediaID interface{}) *MockRepository_IsMediaInLibrary_Call {
+	return &MockRepository_IsMediaInLibrary_Call{Call: _e.mock.On("IsMediaInLibrary", ctx, projectID, mediaID)}
 }
 
-func (_c *MockRepository_GetMetadata_Call) Run(run func(ctx context.Context, postID string, fileName string)) *MockRepository_GetMetadata_Call {
+func (_c *MockRepository_IsMediaInLibrary_Call) Run(run func(ctx context.Context, projectID string, mediaID string)) *MockRepository_IsMediaInLibrary_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetMetadata_Call) Return(_a0 *MetaData, _a1 error) *MockRepository_GetMetadata_Call {
+func (_c *MockRepository_IsMediaInLibrary_Call) Return(_a0 bool, _a1 error) *MockRepository_IsMediaInLibrary_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_GetMetadata_Call) RunAndReturn(run func(context.Context, string, string) (*MetaData, error)) *MockRepository_GetMetadata_Call {
+func (_c *MockRepository_IsMediaInLibrary_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_IsMediaInLibrary_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -644,6 +1803,102 @@ func (_c *MockRepository_ListMediaFilesForPost_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// LockContent provides a mock function with given fields: ctx, projectID, hash, fn
+func (_m *MockRepository) LockContent(ctx context.Context, projectID string, hash string, fn func(Repository) error) error {
+	ret := _m.Called(ctx, projectID, hash, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockContent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, func(Repository) error) error); ok {
+		r0 = rf(ctx, projectID, hash, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_LockContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockContent'
+type MockRepository_LockContent_Call struct {
+	*mock.Call
+}
+
+// LockContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - hash string
+//   - fn func(Repository) error
+func (_e *MockRepository_Expecter) LockContent(ctx interface{}, projectID interface{}, hash interface{}, fn interface{}) *MockRepository_LockContent_Call {
+	return &MockRepository_LockContent_Call{Call: _e.mock.On("LockContent", ctx, projectID, hash, fn)}
+}
+
+func (_c *MockRepository_LockContent_Call) Run(run func(ctx context.Context, projectID string, hash string, fn func(Repository) error)) *MockRepository_LockContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(func(Repository) error))
+	})
+	return _c
+}
+
+func (_c *MockRepository_LockContent_Call) Return(_a0 error) *MockRepository_LockContent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_LockContent_Call) RunAndReturn(run func(context.Context, string, string, func(Repository) error) error) *MockRepository_LockContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveBranding provides a mock function with given fields: ctx, b
+func (_m *MockRepository) SaveBranding(ctx context.Context, b *Branding) error {
+	ret := _m.Called(ctx, b)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveBranding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Branding) error); ok {
+		r0 = rf(ctx, b)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SaveBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBranding'
+type MockRepository_SaveBranding_Call struct {
+	*mock.Call
+}
+
+// SaveBranding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - b *Branding
+func (_e *MockRepository_Expecter) SaveBranding(ctx interface{}, b interface{}) *MockRepository_SaveBranding_Call {
+	return &MockRepository_SaveBranding_Call{Call: _e.mock.On("SaveBranding", ctx, b)}
+}
+
+func (_c *MockRepository_SaveBranding_Call) Run(run func(ctx context.Context, b *Branding)) *MockRepository_SaveBranding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Branding))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveBranding_Call) Return(_a0 error) *MockRepository_SaveBranding_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SaveBranding_Call) RunAndReturn(run func(context.Context, *Branding) error) *MockRepository_SaveBranding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveMetadata provides a mock function with given fields: ctx, _a1
 func (_m *MockRepository) SaveMetadata(ctx context.Context, _a1 *MetaData) (*MetaData, error) {
 	ret := _m.Called(ctx, _a1)
@@ -703,6 +1958,149 @@ func (_c *MockRepository_SaveMetadata_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// SaveRendition provides a mock function with given fields: ctx, r
+func (_m *MockRepository) SaveRendition(ctx context.Context, r *Rendition) error {
+	ret := _m.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRendition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Rendition) error); ok {
+		r0 = rf(ctx, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SaveRendition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRendition'
+type MockRepository_SaveRendition_Call struct {
+	*mock.Call
+}
+
+// SaveRendition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r *Rendition
+func (_e *MockRepository_Expecter) SaveRendition(ctx interface{}, r interface{}) *MockRepository_SaveRendition_Call {
+	return &MockRepository_SaveRendition_Call{Call: _e.mock.On("SaveRendition", ctx, r)}
+}
+
+func (_c *MockRepository_SaveRendition_Call) Run(run func(ctx context.Context, r *Rendition)) *MockRepository_SaveRendition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Rendition))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveRendition_Call) Return(_a0 error) *MockRepository_SaveRendition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SaveRendition_Call) RunAndReturn(run func(context.Context, *Rendition) error) *MockRepository_SaveRendition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveUpload provides a mock function with given fields: ctx, u
+func (_m *MockRepository) SaveUpload(ctx context.Context, u *Upload) error {
+	ret := _m.Called(ctx, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveUpload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Upload) error); ok {
+		r0 = rf(ctx, u)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SaveUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveUpload'
+type MockRepository_SaveUpload_Call struct {
+	*mock.Call
+}
+
+// SaveUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - u *Upload
+func (_e *MockRepository_Expecter) SaveUpload(ctx interface{}, u interface{}) *MockRepository_SaveUpload_Call {
+	return &MockRepository_SaveUpload_Call{Call: _e.mock.On("SaveUpload", ctx, u)}
+}
+
+func (_c *MockRepository_SaveUpload_Call) Run(run func(ctx context.Context, u *Upload)) *MockRepository_SaveUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Upload))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveUpload_Call) Return(_a0 error) *MockRepository_SaveUpload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SaveUpload_Call) RunAndReturn(run func(context.Context, *Upload) error) *MockRepository_SaveUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetApplyBranding provides a mock function with given fields: ctx, postID, platformID, apply
+func (_m *MockRepository) SetApplyBranding(ctx context.Context, postID string, platformID string, apply bool) error {
+	ret := _m.Called(ctx, postID, platformID, apply)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetApplyBranding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) error); ok {
+		r0 = rf(ctx, postID, platformID, apply)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SetApplyBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetApplyBranding'
+type MockRepository_SetApplyBranding_Call struct {
+	*mock.Call
+}
+
+// SetApplyBranding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - platformID string
+//   - apply bool
+func (_e *MockRepository_Expecter) SetApplyBranding(ctx interface{}, postID interface{}, platformID interface{}, apply interface{}) *MockRepository_SetApplyBranding_Call {
+	return &MockRepository_SetApplyBranding_Call{Call: _e.mock.On("SetApplyBranding", ctx, postID, platformID, apply)}
+}
+
+func (_c *MockRepository_SetApplyBranding_Call) Run(run func(ctx context.Context, postID string, platformID string, apply bool)) *MockRepository_SetApplyBranding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SetApplyBranding_Call) Return(_a0 error) *MockRepository_SetApplyBranding_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SetApplyBranding_Call) RunAndReturn(run func(context.Context, string, string, bool) error) *MockRepository_SetApplyBranding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnlinkMediaFromPublishPost provides a mock function with given fields: ctx, postID, fileName, platformID
 func (_m *MockRepository) UnlinkMediaFromPublishPost(ctx context.Context, postID string, fileName string, platformID string) error {
 	ret := _m.Called(ctx, postID, fileName, platformID)
@@ -752,6 +2150,149 @@ func (_c *MockRepository_UnlinkMediaFromPublishPost_Call) RunAndReturn(run func(
 	return _c
 }
 
+// UpdateFocalPoint provides a mock function with given fields: ctx, mediaID, x, y
+func (_m *MockRepository) UpdateFocalPoint(ctx context.Context, mediaID string, x float64, y float64) error {
+	ret := _m.Called(ctx, mediaID, x, y)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFocalPoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, float64) error); ok {
+		r0 = rf(ctx, mediaID, x, y)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateFocalPoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFocalPoint'
+type MockRepository_UpdateFocalPoint_Call struct {
+	*mock.Call
+}
+
+// UpdateFocalPoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mediaID string
+//   - x float64
+//   - y float64
+func (_e *MockRepository_Expecter) UpdateFocalPoint(ctx interface{}, mediaID interface{}, x interface{}, y interface{}) *MockRepository_UpdateFocalPoint_Call {
+	return &MockRepository_UpdateFocalPoint_Call{Call: _e.mock.On("UpdateFocalPoint", ctx, mediaID, x, y)}
+}
+
+func (_c *MockRepository_UpdateFocalPoint_Call) Run(run func(ctx context.Context, mediaID string, x float64, y float64)) *MockRepository_UpdateFocalPoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateFocalPoint_Call) Return(_a0 error) *MockRepository_UpdateFocalPoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateFocalPoint_Call) RunAndReturn(run func(context.Context, string, float64, float64) error) *MockRepository_UpdateFocalPoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLibraryMedia provides a mock function with given fields: ctx, md
+func (_m *MockRepository) UpdateLibraryMedia(ctx context.Context, md *MetaData) error {
+	ret := _m.Called(ctx, md)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLibraryMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *MetaData) error); ok {
+		r0 = rf(ctx, md)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateLibraryMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLibraryMedia'
+type MockRepository_UpdateLibraryMedia_Call struct {
+	*mock.Call
+}
+
+// UpdateLibraryMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - md *MetaData
+func (_e *MockRepository_Expecter) UpdateLibraryMedia(ctx interface{}, md interface{}) *MockRepository_UpdateLibraryMedia_Call {
+	return &MockRepository_UpdateLibraryMedia_Call{Call: _e.mock.On("UpdateLibraryMedia", ctx, md)}
+}
+
+func (_c *MockRepository_UpdateLibraryMedia_Call) Run(run func(ctx context.Context, md *MetaData)) *MockRepository_UpdateLibraryMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*MetaData))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateLibraryMedia_Call) Return(_a0 error) *MockRepository_UpdateLibraryMedia_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateLibraryMedia_Call) RunAndReturn(run func(context.Context, *MetaData) error) *MockRepository_UpdateLibraryMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMetadata provides a mock function with given fields: ctx, md
+func (_m *MockRepository) UpdateMetadata(ctx context.Context, md *MetaData) error {
+	ret := _m.Called(ctx, md)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMetadata")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *MetaData) error); ok {
+		r0 = rf(ctx, md)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMetadata'
+type MockRepository_UpdateMetadata_Call struct {
+	*mock.Call
+}
+
+// UpdateMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - md *MetaData
+func (_e *MockRepository_Expecter) UpdateMetadata(ctx interface{}, md interface{}) *MockRepository_UpdateMetadata_Call {
+	return &MockRepository_UpdateMetadata_Call{Call: _e.mock.On("UpdateMetadata", ctx, md)}
+}
+
+func (_c *MockRepository_UpdateMetadata_Call) Run(run func(ctx context.Context, md *MetaData)) *MockRepository_UpdateMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*MetaData))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateMetadata_Call) Return(_a0 error) *MockRepository_UpdateMetadata_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateMetadata_Call) RunAndReturn(run func(context.Context, *MetaData) error) *MockRepository_UpdateMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRepository(t interface {
@@ -4,8 +4,11 @@ package media
 
 import (
 	context "context"
+	io "io"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockService is an autogenerated mock type for the Service type
@@ -21,6 +24,219 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// CollectGarbage provides a mock function with given fields: ctx, olderThan, dryRun
+func (_m *MockService) CollectGarbage(ctx context.Context, olderThan time.Time, dryRun bool) (*GCReport, error) {
+	ret := _m.Called(ctx, olderThan, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectGarbage")
+	}
+
+	var r0 *GCReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, bool) (*GCReport, error)); ok {
+		return rf(ctx, olderThan, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, bool) *GCReport); ok {
+		r0 = rf(ctx, olderThan, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GCReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, bool) error); ok {
+		r1 = rf(ctx, olderThan, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CollectGarbage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CollectGarbage'
+type MockService_CollectGarbage_Call struct {
+	*mock.Call
+}
+
+// CollectGarbage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - olderThan time.Time
+//   - dryRun bool
+func (_e *MockService_Expecter) CollectGarbage(ctx interface{}, olderThan interface{}, dryRun interface{}) *MockService_CollectGarbage_Call {
+	return &MockService_CollectGarbage_Call{Call: _e.mock.On("CollectGarbage", ctx, olderThan, dryRun)}
+}
+
+func (_c *MockService_CollectGarbage_Call) Run(run func(ctx context.Context, olderThan time.Time, dryRun bool)) *MockService_CollectGarbage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockService_CollectGarbage_Call) Return(_a0 *GCReport, _a1 error) *MockService_CollectGarbage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CollectGarbage_Call) RunAndReturn(run func(context.Context, time.Time, bool) (*GCReport, error)) *MockService_CollectGarbage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteUpload provides a mock function with given fields: ctx, projectID, uploadID
+func (_m *MockService) CompleteUpload(ctx context.Context, projectID string, uploadID string) (DownloadMetaData, error) {
+	ret := _m.Called(ctx, projectID, uploadID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteUpload")
+	}
+
+	var r0 DownloadMetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (DownloadMetaData, error)); ok {
+		return rf(ctx, projectID, uploadID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) DownloadMetaData); ok {
+		r0 = rf(ctx, projectID, uploadID)
+	} else {
+		r0 = ret.Get(0).(DownloadMetaData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, uploadID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CompleteUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteUpload'
+type MockService_CompleteUpload_Call struct {
+	*mock.Call
+}
+
+// CompleteUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - uploadID string
+func (_e *MockService_Expecter) CompleteUpload(ctx interface{}, projectID interface{}, uploadID interface{}) *MockService_CompleteUpload_Call {
+	return &MockService_CompleteUpload_Call{Call: _e.mock.On("CompleteUpload", ctx, projectID, uploadID)}
+}
+
+func (_c *MockService_CompleteUpload_Call) Run(run func(ctx context.Context, projectID string, uploadID string)) *MockService_CompleteUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_CompleteUpload_Call) Return(_a0 DownloadMetaData, _a1 error) *MockService_CompleteUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CompleteUpload_Call) RunAndReturn(run func(context.Context, string, string) (DownloadMetaData, error)) *MockService_CompleteUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBranding provides a mock function with given fields: ctx, projectID
+func (_m *MockService) DeleteBranding(ctx context.Context, projectID string) error {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBranding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranding'
+type MockService_DeleteBranding_Call struct {
+	*mock.Call
+}
+
+// DeleteBranding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockService_Expecter) DeleteBranding(ctx interface{}, projectID interface{}) *MockService_DeleteBranding_Call {
+	return &MockService_DeleteBranding_Call{Call: _e.mock.On("DeleteBranding", ctx, projectID)}
+}
+
+func (_c *MockService_DeleteBranding_Call) Run(run func(ctx context.Context, projectID string)) *MockService_DeleteBranding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteBranding_Call) Return(_a0 error) *MockService_DeleteBranding_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteBranding_Call) RunAndReturn(run func(context.Context, string) error) *MockService_DeleteBranding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLibraryMedia provides a mock function with given fields: ctx, projectID, mediaID
+func (_m *MockService) DeleteLibraryMedia(ctx context.Context, projectID string, mediaID string) error {
+	ret := _m.Called(ctx, projectID, mediaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLibraryMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, mediaID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteLibraryMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLibraryMedia'
+type MockService_DeleteLibraryMedia_Call struct {
+	*mock.Call
+}
+
+// DeleteLibraryMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - mediaID string
+func (_e *MockService_Expecter) DeleteLibraryMedia(ctx interface{}, projectID interface{}, mediaID interface{}) *MockService_DeleteLibraryMedia_Call {
+	return &MockService_DeleteLibraryMedia_Call{Call: _e.mock.On("DeleteLibraryMedia", ctx, projectID, mediaID)}
+}
+
+func (_c *MockService_DeleteLibraryMedia_Call) Run(run func(ctx context.Context, projectID string, mediaID string)) *MockService_DeleteLibraryMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteLibraryMedia_Call) Return(_a0 error) *MockService_DeleteLibraryMedia_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteLibraryMedia_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_DeleteLibraryMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteMedia provides a mock function with given fields: ctx, projectID, postID, fileID
 func (_m *MockService) DeleteMedia(ctx context.Context, projectID string, postID string, fileID string) error {
 	ret := _m.Called(ctx, projectID, postID, fileID)
@@ -70,6 +286,172 @@ func (_c *MockService_DeleteMedia_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// DeleteMediaFiles provides a mock function with given fields: ctx, mds
+func (_m *MockService) DeleteMediaFiles(ctx context.Context, mds []*MetaData) error {
+	ret := _m.Called(ctx, mds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMediaFiles")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*MetaData) error); ok {
+		r0 = rf(ctx, mds)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteMediaFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMediaFiles'
+type MockService_DeleteMediaFiles_Call struct {
+	*mock.Call
+}
+
+// DeleteMediaFiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mds []*MetaData
+func (_e *MockService_Expecter) DeleteMediaFiles(ctx interface{}, mds interface{}) *MockService_DeleteMediaFiles_Call {
+	return &MockService_DeleteMediaFiles_Call{Call: _e.mock.On("DeleteMediaFiles", ctx, mds)}
+}
+
+func (_c *MockService_DeleteMediaFiles_Call) Run(run func(ctx context.Context, mds []*MetaData)) *MockService_DeleteMediaFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*MetaData))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteMediaFiles_Call) Return(_a0 error) *MockService_DeleteMediaFiles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteMediaFiles_Call) RunAndReturn(run func(context.Context, []*MetaData) error) *MockService_DeleteMediaFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMediaToProcess provides a mock function with given fields: ctx, createdBefore, limit
+func (_m *MockService) FindMediaToProcess(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	ret := _m.Called(ctx, createdBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMediaToProcess")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]string, error)); ok {
+		return rf(ctx, createdBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []string); ok {
+		r0 = rf(ctx, createdBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, createdBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_FindMediaToProcess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMediaToProcess'
+type MockService_FindMediaToProcess_Call struct {
+	*mock.Call
+}
+
+// FindMediaToProcess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createdBefore time.Time
+//   - limit int
+func (_e *MockService_Expecter) FindMediaToProcess(ctx interface{}, createdBefore interface{}, limit interface{}) *MockService_FindMediaToProcess_Call {
+	return &MockService_FindMediaToProcess_Call{Call: _e.mock.On("FindMediaToProcess", ctx, createdBefore, limit)}
+}
+
+func (_c *MockService_FindMediaToProcess_Call) Run(run func(ctx context.Context, createdBefore time.Time, limit int)) *MockService_FindMediaToProcess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_FindMediaToProcess_Call) Return(_a0 []string, _a1 error) *MockService_FindMediaToProcess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_FindMediaToProcess_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]string, error)) *MockService_FindMediaToProcess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBranding provides a mock function with given fields: ctx, projectID
+func (_m *MockService) GetBranding(ctx context.Context, projectID string) (*Branding, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBranding")
+	}
+
+	var r0 *Branding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*Branding, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *Branding); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Branding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBranding'
+type MockService_GetBranding_Call struct {
+	*mock.Call
+}
+
+// GetBranding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockService_Expecter) GetBranding(ctx interface{}, projectID interface{}) *MockService_GetBranding_Call {
+	return &MockService_GetBranding_Call{Call: _e.mock.On("GetBranding", ctx, projectID)}
+}
+
+func (_c *MockService_GetBranding_Call) Run(run func(ctx context.Context, projectID string)) *MockService_GetBranding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetBranding_Call) Return(_a0 *Branding, _a1 error) *MockService_GetBranding_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetBranding_Call) RunAndReturn(run func(context.Context, string) (*Branding, error)) *MockService_GetBranding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDownloadMetaData provides a mock function with given fields: ctx, projectID, postID, fileName
 func (_m *MockService) GetDownloadMetaData(ctx context.Context, projectID string, postID string, fileName string) (DownloadMetaData, error) {
 	ret := _m.Called(ctx, projectID, postID, fileName)
@@ -250,15 +632,76 @@ func (_c *MockService_GetDownloadMetadataForPublishPost_Call) RunAndReturn(run f
 	return _c
 }
 
-// GetMediaFile provides a mock function with given fields: ctx, projectID, postID, fileName
-func (_m *MockService) GetMediaFile(ctx context.Context, projectID string, postID string, fileName string) (*Media, error) {
-	ret := _m.Called(ctx, projectID, postID, fileName)
+// GetMediaDetailsForPublishPost provides a mock function with given fields: ctx, projectID, postID, platformID
+func (_m *MockService) GetMediaDetailsForPublishPost(ctx context.Context, projectID string, postID string, platformID string) ([]*Media, error) {
+	ret := _m.Called(ctx, projectID, postID, platformID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMediaFile")
+		panic("no return value specified for GetMediaDetailsForPublishPost")
 	}
 
-	var r0 *Media
+	var r0 []*Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]*Media, error)); ok {
+		return rf(ctx, projectID, postID, platformID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []*Media); ok {
+		r0 = rf(ctx, projectID, postID, platformID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID, platformID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetMediaDetailsForPublishPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMediaDetailsForPublishPost'
+type MockService_GetMediaDetailsForPublishPost_Call struct {
+	*mock.Call
+}
+
+// GetMediaDetailsForPublishPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - platformID string
+func (_e *MockService_Expecter) GetMediaDetailsForPublishPost(ctx interface{}, projectID interface{}, postID interface{}, platformID interface{}) *MockService_GetMediaDetailsForPublishPost_Call {
+	return &MockService_GetMediaDetailsForPublishPost_Call{Call: _e.mock.On("GetMediaDetailsForPublishPost", ctx, projectID, postID, platformID)}
+}
+
+func (_c *MockService_GetMediaDetailsForPublishPost_Call) Run(run func(ctx context.Context, projectID string, postID string, platformID string)) *MockService_GetMediaDetailsForPublishPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetMediaDetailsForPublishPost_Call) Return(_a0 []*Media, _a1 error) *MockService_GetMediaDetailsForPublishPost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetMediaDetailsForPublishPost_Call) RunAndReturn(run func(context.Context, string, string, string) ([]*Media, error)) *MockService_GetMediaDetailsForPublishPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMediaFile provides a mock function with given fields: ctx, projectID, postID, fileName
+func (_m *MockService) GetMediaFile(ctx context.Context, projectID string, postID string, fileName string) (*Media, error) {
+	ret := _m.Called(ctx, projectID, postID, fileName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMediaFile")
+	}
+
+	var r0 *Media
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*Media, error)); ok {
 		return rf(ctx, projectID, postID, fileName)
@@ -372,6 +815,131 @@ func (_c *MockService_GetMediaForPublishPost_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetProcessingStatus provides a mock function with given fields: ctx, projectID, mediaIDs
+func (_m *MockService) GetProcessingStatus(ctx context.Context, projectID string, mediaIDs []string) ([]*ProcessingStatus, error) {
+	ret := _m.Called(ctx, projectID, mediaIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProcessingStatus")
+	}
+
+	var r0 []*ProcessingStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]*ProcessingStatus, error)); ok {
+		return rf(ctx, projectID, mediaIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*ProcessingStatus); ok {
+		r0 = rf(ctx, projectID, mediaIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ProcessingStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, projectID, mediaIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetProcessingStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProcessingStatus'
+type MockService_GetProcessingStatus_Call struct {
+	*mock.Call
+}
+
+// GetProcessingStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - mediaIDs []string
+func (_e *MockService_Expecter) GetProcessingStatus(ctx interface{}, projectID interface{}, mediaIDs interface{}) *MockService_GetProcessingStatus_Call {
+	return &MockService_GetProcessingStatus_Call{Call: _e.mock.On("GetProcessingStatus", ctx, projectID, mediaIDs)}
+}
+
+func (_c *MockService_GetProcessingStatus_Call) Run(run func(ctx context.Context, projectID string, mediaIDs []string)) *MockService_GetProcessingStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetProcessingStatus_Call) Return(_a0 []*ProcessingStatus, _a1 error) *MockService_GetProcessingStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetProcessingStatus_Call) RunAndReturn(run func(context.Context, string, []string) ([]*ProcessingStatus, error)) *MockService_GetProcessingStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitiateUpload provides a mock function with given fields: ctx, projectID, postID, fileName, altText, folder, tags, size
+func (_m *MockService) InitiateUpload(ctx context.Context, projectID string, postID string, fileName string, altText string, folder string, tags []string, size int64) (*Upload, error) {
+	ret := _m.Called(ctx, projectID, postID, fileName, altText, folder, tags, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitiateUpload")
+	}
+
+	var r0 *Upload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, []string, int64) (*Upload, error)); ok {
+		return rf(ctx, projectID, postID, fileName, altText, folder, tags, size)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, []string, int64) *Upload); ok {
+		r0 = rf(ctx, projectID, postID, fileName, altText, folder, tags, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Upload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string, []string, int64) error); ok {
+		r1 = rf(ctx, projectID, postID, fileName, altText, folder, tags, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_InitiateUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitiateUpload'
+type MockService_InitiateUpload_Call struct {
+	*mock.Call
+}
+
+// InitiateUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - fileName string
+//   - altText string
+//   - folder string
+//   - tags []string
+//   - size int64
+func (_e *MockService_Expecter) InitiateUpload(ctx interface{}, projectID interface{}, postID interface{}, fileName interface{}, altText interface{}, folder interface{}, tags interface{}, size interface{}) *MockService_InitiateUpload_Call {
+	return &MockService_InitiateUpload_Call{Call: _e.mock.On("InitiateUpload", ctx, projectID, postID, fileName, altText, folder, tags, size)}
+}
+
+func (_c *MockService_InitiateUpload_Call) Run(run func(ctx context.Context, projectID string, postID string, fileName string, altText string, folder string, tags []string, size int64)) *MockService_InitiateUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].([]string), args[7].(int64))
+	})
+	return _c
+}
+
+func (_c *MockService_InitiateUpload_Call) Return(_a0 *Upload, _a1 error) *MockService_InitiateUpload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_InitiateUpload_Call) RunAndReturn(run func(context.Context, string, string, string, string, string, []string, int64) (*Upload, error)) *MockService_InitiateUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LinkMediaToPublishPost provides a mock function with given fields: ctx, projectID, postID, mediaID, platformID
 func (_m *MockService) LinkMediaToPublishPost(ctx context.Context, projectID string, postID string, mediaID string, platformID string) error {
 	ret := _m.Called(ctx, projectID, postID, mediaID, platformID)
@@ -422,17 +990,137 @@ func (_c *MockService_LinkMediaToPublishPost_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// UnLinkMediaFromPublishPost provides a mock function with given fields: ctx, projectID, postID, mediaID, platformID
-func (_m *MockService) UnLinkMediaFromPublishPost(ctx context.Context, projectID string, postID string, mediaID string, platformID string) error {
-	ret := _m.Called(ctx, projectID, postID, mediaID, platformID)
+// ListLibraryMedia provides a mock function with given fields: ctx, projectID, filter
+func (_m *MockService) ListLibraryMedia(ctx context.Context, projectID string, filter *LibraryFilter) ([]*LibraryMedia, error) {
+	ret := _m.Called(ctx, projectID, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UnLinkMediaFromPublishPost")
+		panic("no return value specified for ListLibraryMedia")
+	}
+
+	var r0 []*LibraryMedia
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *LibraryFilter) ([]*LibraryMedia, error)); ok {
+		return rf(ctx, projectID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *LibraryFilter) []*LibraryMedia); ok {
+		r0 = rf(ctx, projectID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*LibraryMedia)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *LibraryFilter) error); ok {
+		r1 = rf(ctx, projectID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListLibraryMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLibraryMedia'
+type MockService_ListLibraryMedia_Call struct {
+	*mock.Call
+}
+
+// ListLibraryMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - filter *LibraryFilter
+func (_e *MockService_Expecter) ListLibraryMedia(ctx interface{}, projectID interface{}, filter interface{}) *MockService_ListLibraryMedia_Call {
+	return &MockService_ListLibraryMedia_Call{Call: _e.mock.On("ListLibraryMedia", ctx, projectID, filter)}
+}
+
+func (_c *MockService_ListLibraryMedia_Call) Run(run func(ctx context.Context, projectID string, filter *LibraryFilter)) *MockService_ListLibraryMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*LibraryFilter))
+	})
+	return _c
+}
+
+func (_c *MockService_ListLibraryMedia_Call) Return(_a0 []*LibraryMedia, _a1 error) *MockService_ListLibraryMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListLibraryMedia_Call) RunAndReturn(run func(context.Context, string, *LibraryFilter) ([]*LibraryMedia, error)) *MockService_ListLibraryMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostFilesRemover provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockService) PostFilesRemover(ctx context.Context, projectID string, postID string) (func(context.Context) error, error) {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostFilesRemover")
+	}
+
+	var r0 func(context.Context) error
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (func(context.Context) error, error)); ok {
+		return rf(ctx, projectID, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) func(context.Context) error); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func(context.Context) error)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_PostFilesRemover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostFilesRemover'
+type MockService_PostFilesRemover_Call struct {
+	*mock.Call
+}
+
+// PostFilesRemover is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockService_Expecter) PostFilesRemover(ctx interface{}, projectID interface{}, postID interface{}) *MockService_PostFilesRemover_Call {
+	return &MockService_PostFilesRemover_Call{Call: _e.mock.On("PostFilesRemover", ctx, projectID, postID)}
+}
+
+func (_c *MockService_PostFilesRemover_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockService_PostFilesRemover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_PostFilesRemover_Call) Return(_a0 func(context.Context) error, _a1 error) *MockService_PostFilesRemover_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_PostFilesRemover_Call) RunAndReturn(run func(context.Context, string, string) (func(context.Context) error, error)) *MockService_PostFilesRemover_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessMedia provides a mock function with given fields: ctx, mediaID
+func (_m *MockService) ProcessMedia(ctx context.Context, mediaID string) error {
+	ret := _m.Called(ctx, mediaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessMedia")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
-		r0 = rf(ctx, projectID, postID, mediaID, platformID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, mediaID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -440,41 +1128,649 @@ func (_m *MockService) UnLinkMediaFromPublishPost(ctx context.Context, projectID
 	return r0
 }
 
-// MockService_UnLinkMediaFromPublishPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnLinkMediaFromPublishPost'
-type MockService_UnLinkMediaFromPublishPost_Call struct {
+// MockService_ProcessMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessMedia'
+type MockService_ProcessMedia_Call struct {
 	*mock.Call
 }
 
-// UnLinkMediaFromPublishPost is a helper method to define mock.On call
+// ProcessMedia is a helper method to define mock.On call
 //   - ctx context.Context
-//   - projectID string
-//   - postID string
 //   - mediaID string
-//   - platformID string
-func (_e *MockService_Expecter) UnLinkMediaFromPublishPost(ctx interface{}, projectID interface{}, postID interface{}, mediaID interface{}, platformID interface{}) *MockService_UnLinkMediaFromPublishPost_Call {
-	return &MockService_UnLinkMediaFromPublishPost_Call{Call: _e.mock.On("UnLinkMediaFromPublishPost", ctx, projectID, postID, mediaID, platformID)}
+func (_e *MockService_Expecter) ProcessMedia(ctx interface{}, mediaID interface{}) *MockService_ProcessMedia_Call {
+	return &MockService_ProcessMedia_Call{Call: _e.mock.On("ProcessMedia", ctx, mediaID)}
 }
 
-func (_c *MockService_UnLinkMediaFromPublishPost_Call) Run(run func(ctx context.Context, projectID string, postID string, mediaID string, platformID string)) *MockService_UnLinkMediaFromPublishPost_Call {
+func (_c *MockService_ProcessMedia_Call) Run(run func(ctx context.Context, mediaID string)) *MockService_ProcessMedia_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockService_UnLinkMediaFromPublishPost_Call) Return(_a0 error) *MockService_UnLinkMediaFromPublishPost_Call {
+func (_c *MockService_ProcessMedia_Call) Return(_a0 error) *MockService_ProcessMedia_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockService_UnLinkMediaFromPublishPost_Call) RunAndReturn(run func(context.Context, string, string, string, string) error) *MockService_UnLinkMediaFromPublishPost_Call {
+func (_c *MockService_ProcessMedia_Call) RunAndReturn(run func(context.Context, string) error) *MockService_ProcessMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeExpiredUploads provides a mock function with given fields: ctx
+func (_m *MockService) PurgeExpiredUploads(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpiredUploads")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_PurgeExpiredUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeExpiredUploads'
+type MockService_PurgeExpiredUploads_Call struct {
+	*mock.Call
+}
+
+// PurgeExpiredUploads is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockService_Expecter) PurgeExpiredUploads(ctx interface{}) *MockService_PurgeExpiredUploads_Call {
+	return &MockService_PurgeExpiredUploads_Call{Call: _e.mock.On("PurgeExpiredUploads", ctx)}
+}
+
+func (_c *MockService_PurgeExpiredUploads_Call) Run(run func(ctx context.Context)) *MockService_PurgeExpiredUploads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockService_PurgeExpiredUploads_Call) Return(_a0 int, _a1 error) *MockService_PurgeExpiredUploads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_PurgeExpiredUploads_Call) RunAndReturn(run func(context.Context) (int, error)) *MockService_PurgeExpiredUploads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderMedia provides a mock function with given fields: ctx, mediaID
+func (_m *MockService) RenderMedia(ctx context.Context, mediaID string) error {
+	ret := _m.Called(ctx, mediaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, mediaID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_RenderMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderMedia'
+type MockService_RenderMedia_Call struct {
+	*mock.Call
+}
+
+// RenderMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mediaID string
+func (_e *MockService_Expecter) RenderMedia(ctx interface{}, mediaID interface{}) *MockService_RenderMedia_Call {
+	return &MockService_RenderMedia_Call{Call: _e.mock.On("RenderMedia", ctx, mediaID)}
+}
+
+func (_c *MockService_RenderMedia_Call) Run(run func(ctx context.Context, mediaID string)) *MockService_RenderMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_RenderMedia_Call) Return(_a0 error) *MockService_RenderMedia_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_RenderMedia_Call) RunAndReturn(run func(context.Context, string) error) *MockService_RenderMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetBranding provides a mock function with given fields: ctx, b
+func (_m *MockService) SetBranding(ctx context.Context, b *Branding) (*Branding, error) {
+	ret := _m.Called(ctx, b)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBranding")
+	}
+
+	var r0 *Branding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Branding) (*Branding, error)); ok {
+		return rf(ctx, b)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *Branding) *Branding); ok {
+		r0 = rf(ctx, b)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Branding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *Branding) error); ok {
+		r1 = rf(ctx, b)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_SetBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBranding'
+type MockService_SetBranding_Call struct {
+	*mock.Call
+}
+
+// SetBranding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - b *Branding
+func (_e *MockService_Expecter) SetBranding(ctx interface{}, b interface{}) *MockService_SetBranding_Call {
+	return &MockService_SetBranding_Call{Call: _e.mock.On("SetBranding", ctx, b)}
+}
+
+func (_c *MockService_SetBranding_Call) Run(run func(ctx context.Context, b *Branding)) *MockService_SetBranding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Branding))
+	})
+	return _c
+}
+
+func (_c *MockService_SetBranding_Call) Return(_a0 *Branding, _a1 error) *MockService_SetBranding_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_SetBranding_Call) RunAndReturn(run func(context.Context, *Branding) (*Branding, error)) *MockService_SetBranding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFocalPoint provides a mock function with given fields: ctx, projectID, mediaID, x, y
+func (_m *MockService) SetFocalPoint(ctx context.Context, projectID string, mediaID string, x float64, y float64) (*MetaData, error) {
+	ret := _m.Called(ctx, projectID, mediaID, x, y)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFocalPoint")
+	}
+
+	var r0 *MetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64, float64) (*MetaData, error)); ok {
+		return rf(ctx, projectID, mediaID, x, y)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, float64, float64) *MetaData); ok {
+		r0 = rf(ctx, projectID, mediaID, x, y)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MetaData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, float64, float64) error); ok {
+		r1 = rf(ctx, projectID, mediaID, x, y)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_SetFocalPoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFocalPoint'
+type MockService_SetFocalPoint_Call struct {
+	*mock.Call
+}
+
+// SetFocalPoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - mediaID string
+//   - x float64
+//   - y float64
+func (_e *MockService_Expecter) SetFocalPoint(ctx interface{}, projectID interface{}, mediaID interface{}, x interface{}, y interface{}) *MockService_SetFocalPoint_Call {
+	return &MockService_SetFocalPoint_Call{Call: _e.mock.On("SetFocalPoint", ctx, projectID, mediaID, x, y)}
+}
+
+func (_c *MockService_SetFocalPoint_Call) Run(run func(ctx context.Context, projectID string, mediaID string, x float64, y float64)) *MockService_SetFocalPoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(float64), args[4].(float64))
+	})
+	return _c
+}
+
+func (_c *MockService_SetFocalPoint_Call) Return(_a0 *MetaData, _a1 error) *MockService_SetFocalPoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_SetFocalPoint_Call) RunAndReturn(run func(context.Context, string, string, float64, float64) (*MetaData, error)) *MockService_SetFocalPoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPostBranding provides a mock function with given fields: ctx, projectID, postID, platformID, apply
+func (_m *MockService) SetPostBranding(ctx context.Context, projectID string, postID string, platformID string, apply bool) error {
+	ret := _m.Called(ctx, projectID, postID, platformID, apply)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPostBranding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) error); ok {
+		r0 = rf(ctx, projectID, postID, platformID, apply)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_SetPostBranding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPostBranding'
+type MockService_SetPostBranding_Call struct {
+	*mock.Call
+}
+
+// SetPostBranding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - platformID string
+//   - apply bool
+func (_e *MockService_Expecter) SetPostBranding(ctx interface{}, projectID interface{}, postID interface{}, platformID interface{}, apply interface{}) *MockService_SetPostBranding_Call {
+	return &MockService_SetPostBranding_Call{Call: _e.mock.On("SetPostBranding", ctx, projectID, postID, platformID, apply)}
+}
+
+func (_c *MockService_SetPostBranding_Call) Run(run func(ctx context.Context, projectID string, postID string, platformID string, apply bool)) *MockService_SetPostBranding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockService_SetPostBranding_Call) Return(_a0 error) *MockService_SetPostBranding_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_SetPostBranding_Call) RunAndReturn(run func(context.Context, string, string, string, bool) error) *MockService_SetPostBranding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetProcessingQueue provides a mock function with given fields: q
+func (_m *MockService) SetProcessingQueue(q ProcessingQueue) {
+	_m.Called(q)
+}
+
+// MockService_SetProcessingQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetProcessingQueue'
+type MockService_SetProcessingQueue_Call struct {
+	*mock.Call
+}
+
+// SetProcessingQueue is a helper method to define mock.On call
+//   - q ProcessingQueue
+func (_e *MockService_Expecter) SetProcessingQueue(q interface{}) *MockService_SetProcessingQueue_Call {
+	return &MockService_SetProcessingQueue_Call{Call: _e.mock.On("SetProcessingQueue", q)}
+}
+
+func (_c *MockService_SetProcessingQueue_Call) Run(run func(q ProcessingQueue)) *MockService_SetProcessingQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(ProcessingQueue))
+	})
+	return _c
+}
+
+func (_c *MockService_SetProcessingQueue_Call) Return() *MockService_SetProcessingQueue_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockService_SetProcessingQueue_Call) RunAndReturn(run func(ProcessingQueue)) *MockService_SetProcessingQueue_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetSizeLimits provides a mock function with given fields: limits
+func (_m *MockService) SetSizeLimits(limits SizeLimits) {
+	_m.Called(limits)
+}
+
+// MockService_SetSizeLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSizeLimits'
+type MockService_SetSizeLimits_Call struct {
+	*mock.Call
+}
+
+// SetSizeLimits is a helper method to define mock.On call
+//   - limits SizeLimits
+func (_e *MockService_Expecter) SetSizeLimits(limits interface{}) *MockService_SetSizeLimits_Call {
+	return &MockService_SetSizeLimits_Call{Call: _e.mock.On("SetSizeLimits", limits)}
+}
+
+func (_c *MockService_SetSizeLimits_Call) Run(run func(limits SizeLimits)) *MockService_SetSizeLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(SizeLimits))
+	})
+	return _c
+}
+
+func (_c *MockService_SetSizeLimits_Call) Return() *MockService_SetSizeLimits_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockService_SetSizeLimits_Call) RunAndReturn(run func(SizeLimits)) *MockService_SetSizeLimits_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetStripMetadata provides a mock function with given fields: strip
+func (_m *MockService) SetStripMetadata(strip bool) {
+	_m.Called(strip)
+}
+
+// MockService_SetStripMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStripMetadata'
+type MockService_SetStripMetadata_Call struct {
+	*mock.Call
+}
+
+// SetStripMetadata is a helper method to define mock.On call
+//   - strip bool
+func (_e *MockService_Expecter) SetStripMetadata(strip interface{}) *MockService_SetStripMetadata_Call {
+	return &MockService_SetStripMetadata_Call{Call: _e.mock.On("SetStripMetadata", strip)}
+}
+
+func (_c *MockService_SetStripMetadata_Call) Run(run func(strip bool)) *MockService_SetStripMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *MockService_SetStripMetadata_Call) Return() *MockService_SetStripMetadata_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockService_SetStripMetadata_Call) RunAndReturn(run func(bool)) *MockService_SetStripMetadata_Call {
+	_c.Run(run)
+	return _c
+}
+
+// StoreMediaFiles provides a mock function with given fields: ctx, projectID, postID, fileName, altText, r
+func (_m *MockService) StoreMediaFiles(ctx context.Context, projectID string, postID string, fileName string, altText string, r io.Reader) ([]*MetaData, error) {
+	ret := _m.Called(ctx, projectID, postID, fileName, altText, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreMediaFiles")
+	}
+
+	var r0 []*MetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, io.Reader) ([]*MetaData, error)); ok {
+		return rf(ctx, projectID, postID, fileName, altText, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, io.Reader) []*MetaData); ok {
+		r0 = rf(ctx, projectID, postID, fileName, altText, r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*MetaData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, io.Reader) error); ok {
+		r1 = rf(ctx, projectID, postID, fileName, altText, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_StoreMediaFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreMediaFiles'
+type MockService_StoreMediaFiles_Call struct {
+	*mock.Call
+}
+
+// StoreMediaFiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - fileName string
+//   - altText string
+//   - r io.Reader
+func (_e *MockService_Expecter) StoreMediaFiles(ctx interface{}, projectID interface{}, postID interface{}, fileName interface{}, altText interface{}, r interface{}) *MockService_StoreMediaFiles_Call {
+	return &MockService_StoreMediaFiles_Call{Call: _e.mock.On("StoreMediaFiles", ctx, projectID, postID, fileName, altText, r)}
+}
+
+func (_c *MockService_StoreMediaFiles_Call) Run(run func(ctx context.Context, projectID string, postID string, fileName string, altText string, r io.Reader)) *MockService_StoreMediaFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockService_StoreMediaFiles_Call) Return(_a0 []*MetaData, _a1 error) *MockService_StoreMediaFiles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_StoreMediaFiles_Call) RunAndReturn(run func(context.Context, string, string, string, string, io.Reader) ([]*MetaData, error)) *MockService_StoreMediaFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnLinkMediaFromPublishPost provides a mock function with given fields: ctx, projectID, postID, mediaID, platformID
+func (_m *MockService) UnLinkMediaFromPublishPost(ctx context.Context, projectID string, postID string, mediaID string, platformID string) error {
+	ret := _m.Called(ctx, projectID, postID, mediaID, platformID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnLinkMediaFromPublishPost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID, mediaID, platformID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_UnLinkMediaFromPublishPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnLinkMediaFromPublishPost'
+type MockService_UnLinkMediaFromPublishPost_Call struct {
+	*mock.Call
+}
+
+// UnLinkMediaFromPublishPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - mediaID string
+//   - platformID string
+func (_e *MockService_Expecter) UnLinkMediaFromPublishPost(ctx interface{}, projectID interface{}, postID interface{}, mediaID interface{}, platformID interface{}) *MockService_UnLinkMediaFromPublishPost_Call {
+	return &MockService_UnLinkMediaFromPublishPost_Call{Call: _e.mock.On("UnLinkMediaFromPublishPost", ctx, projectID, postID, mediaID, platformID)}
+}
+
+func (_c *MockService_UnLinkMediaFromPublishPost_Call) Run(run func(ctx context.Context, projectID string, postID string, mediaID string, platformID string)) *MockService_UnLinkMediaFromPublishPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_UnLinkMediaFromPublishPost_Call) Return(_a0 error) *MockService_UnLinkMediaFromPublishPost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_UnLinkMediaFromPublishPost_Call) RunAndReturn(run func(context.Context, string, string, string, string) error) *MockService_UnLinkMediaFromPublishPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLibraryMedia provides a mock function with given fields: ctx, projectID, mediaID, altText, folder, tags
+func (_m *MockService) UpdateLibraryMedia(ctx context.Context, projectID string, mediaID string, altText string, folder string, tags []string) (*LibraryMedia, error) {
+	ret := _m.Called(ctx, projectID, mediaID, altText, folder, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLibraryMedia")
+	}
+
+	var r0 *LibraryMedia
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string) (*LibraryMedia, error)); ok {
+		return rf(ctx, projectID, mediaID, altText, folder, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string) *LibraryMedia); ok {
+		r0 = rf(ctx, projectID, mediaID, altText, folder, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*LibraryMedia)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, []string) error); ok {
+		r1 = rf(ctx, projectID, mediaID, altText, folder, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UpdateLibraryMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLibraryMedia'
+type MockService_UpdateLibraryMedia_Call struct {
+	*mock.Call
+}
+
+// UpdateLibraryMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - mediaID string
+//   - altText string
+//   - folder string
+//   - tags []string
+func (_e *MockService_Expecter) UpdateLibraryMedia(ctx interface{}, projectID interface{}, mediaID interface{}, altText interface{}, folder interface{}, tags interface{}) *MockService_UpdateLibraryMedia_Call {
+	return &MockService_UpdateLibraryMedia_Call{Call: _e.mock.On("UpdateLibraryMedia", ctx, projectID, mediaID, altText, folder, tags)}
+}
+
+func (_c *MockService_UpdateLibraryMedia_Call) Run(run func(ctx context.Context, projectID string, mediaID string, altText string, folder string, tags []string)) *MockService_UpdateLibraryMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].([]string))
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateLibraryMedia_Call) Return(_a0 *LibraryMedia, _a1 error) *MockService_UpdateLibraryMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UpdateLibraryMedia_Call) RunAndReturn(run func(context.Context, string, string, string, string, []string) (*LibraryMedia, error)) *MockService_UpdateLibraryMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadLibraryMedia provides a mock function with given fields: ctx, projectID, fileName, altText, folder, tags, r
+func (_m *MockService) UploadLibraryMedia(ctx context.Context, projectID string, fileName string, altText string, folder string, tags []string, r io.Reader) (DownloadMetaData, error) {
+	ret := _m.Called(ctx, projectID, fileName, altText, folder, tags, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadLibraryMedia")
+	}
+
+	var r0 DownloadMetaData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string, io.Reader) (DownloadMetaData, error)); ok {
+		return rf(ctx, projectID, fileName, altText, folder, tags, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []string, io.Reader) DownloadMetaData); ok {
+		r0 = rf(ctx, projectID, fileName, altText, folder, tags, r)
+	} else {
+		r0 = ret.Get(0).(DownloadMetaData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, []string, io.Reader) error); ok {
+		r1 = rf(ctx, projectID, fileName, altText, folder, tags, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UploadLibraryMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadLibraryMedia'
+type MockService_UploadLibraryMedia_Call struct {
+	*mock.Call
+}
+
+// UploadLibraryMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - fileName string
+//   - altText string
+//   - folder string
+//   - tags []string
+//   - r io.Reader
+func (_e *MockService_Expecter) UploadLibraryMedia(ctx interface{}, projectID interface{}, fileName interface{}, altText interface{}, folder interface{}, tags interface{}, r interface{}) *MockService_UploadLibraryMedia_Call {
+	return &MockService_UploadLibraryMedia_Call{Call: _e.mock.On("UploadLibraryMedia", ctx, projectID, fileName, altText, folder, tags, r)}
+}
+
+func (_c *MockService_UploadLibraryMedia_Call) Run(run func(ctx context.Context, projectID string, fileName string, altText string, folder string, tags []string, r io.Reader)) *MockService_UploadLibraryMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].([]string), args[6].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockService_UploadLibraryMedia_Call) Return(_a0 DownloadMetaData, _a1 error) *MockService_UploadLibraryMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UploadLibraryMedia_Call) RunAndReturn(run func(context.Context, string, string, string, string, []string, io.Reader) (DownloadMetaData, error)) *MockService_UploadLibraryMedia_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UploadMedia provides a mock function with given fields: ctx, projectID, postID, fileName, altText, data
-func (_m *MockService) UploadMedia(ctx context.Context, projectID string, postID string, fileName string, altText string, data []byte) (DownloadMetaData, error) {
-	ret := _m.Called(ctx, projectID, postID, fileName, altText, data)
+// UploadMedia provides a mock function with given fields: ctx, projectID, postID, fileName, altText, r
+func (_m *MockService) UploadMedia(ctx context.Context, projectID string, postID string, fileName string, altText string, r io.Reader) (DownloadMetaData, error) {
+	ret := _m.Called(ctx, projectID, postID, fileName, altText, r)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UploadMedia")
@@ -482,17 +1778,17 @@ func (_m *MockService) UploadMedia(ctx context.Context, projectID string, postID
 
 	var r0 DownloadMetaData
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []byte) (DownloadMetaData, error)); ok {
-		return rf(ctx, projectID, postID, fileName, altText, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, io.Reader) (DownloadMetaData, error)); ok {
+		return rf(ctx, projectID, postID, fileName, altText, r)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, []byte) DownloadMetaData); ok {
-		r0 = rf(ctx, projectID, postID, fileName, altText, data)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, io.Reader) DownloadMetaData); ok {
+		r0 = rf(ctx, projectID, postID, fileName, altText, r)
 	} else {
 		r0 = ret.Get(0).(DownloadMetaData)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, []byte) error); ok {
-		r1 = rf(ctx, projectID, postID, fileName, altText, data)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, io.Reader) error); ok {
+		r1 = rf(ctx, projectID, postID, fileName, altText, r)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -511,14 +1807,14 @@ type MockService_UploadMedia_Call struct {
 //   - postID string
 //   - fileName string
 //   - altText string
-//   - data []byte
-func (_e *MockService_Expecter) UploadMedia(ctx interface{}, projectID interface{}, postID interface{}, fileName interface{}, altText interface{}, data interface{}) *MockService_UploadMedia_Call {
-	return &MockService_UploadMedia_Call{Call: _e.mock.On("UploadMedia", ctx, projectID, postID, fileName, altText, data)}
+//   - r io.Reader
+func (_e *MockService_Expecter) UploadMedia(ctx interface{}, projectID interface{}, postID interface{}, fileName interface{}, altText interface{}, r interface{}) *MockService_UploadMedia_Call {
+	return &MockService_UploadMedia_Call{Call: _e.mock.On("UploadMedia", ctx, projectID, postID, fileName, altText, r)}
 }
 
-func (_c *MockService_UploadMedia_Call) Run(run func(ctx context.Context, projectID string, postID string, fileName string, altText string, data []byte)) *MockService_UploadMedia_Call {
+func (_c *MockService_UploadMedia_Call) Run(run func(ctx context.Context, projectID string, postID string, fileName string, altText string, r io.Reader)) *MockService_UploadMedia_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].([]byte))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(io.Reader))
 	})
 	return _c
 }
@@ -528,7 +1824,7 @@ func (_c *MockService_UploadMedia_Call) Return(_a0 DownloadMetaData, _a1 error)
 	return _c
 }
 
-func (_c *MockService_UploadMedia_Call) RunAndReturn(run func(context.Context, string, string, string, string, []byte) (DownloadMetaData, error)) *MockService_UploadMedia_Call {
+func (_c *MockService_UploadMedia_Call) RunAndReturn(run func(context.Context, string, string, string, string, io.Reader) (DownloadMetaData, error)) *MockService_UploadMedia_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package media
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockTranscoder is an autogenerated mock type for the Transcoder type
+type MockTranscoder struct {
+	mock.Mock
+}
+
+type MockTranscoder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTranscoder) EXPECT() *MockTranscoder_Expecter {
+	return &MockTranscoder_Expecter{mock: &_m.Mock}
+}
+
+// Transcode provides a mock function with given fields: f, info, profile
+func (_m *MockTranscoder) Transcode(f *File, info *MediaInfo, profile VideoProfile) (*File, error) {
+	ret := _m.Called(f, info, profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transcode")
+	}
+
+	var r0 *File
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*File, *MediaInfo, VideoProfile) (*File, error)); ok {
+		return rf(f, info, profile)
+	}
+	if rf, ok := ret.Get(0).(func(*File, *MediaInfo, VideoProfile) *File); ok {
+		r0 = rf(f, info, profile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*File)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*File, *MediaInfo, VideoProfile) error); ok {
+		r1 = rf(f, info, profile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTranscoder_Transcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transcode'
+type MockTranscoder_Transcode_Call struct {
+	*mock.Call
+}
+
+// Transcode is a helper method to define mock.On call
+//   - f *File
+//   - info *MediaInfo
+//   - profile VideoProfile
+func (_e *MockTranscoder_Expecter) Transcode(f interface{}, info interface{}, profile interface{}) *MockTranscoder_Transcode_Call {
+	return &MockTranscoder_Transcode_Call{Call: _e.mock.On("Transcode", f, info, profile)}
+}
+
+func (_c *MockTranscoder_Transcode_Call) Run(run func(f *File, info *MediaInfo, profile VideoProfile)) *MockTranscoder_Transcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*File), args[1].(*MediaInfo), args[2].(VideoProfile))
+	})
+	return _c
+}
+
+func (_c *MockTranscoder_Transcode_Call) Return(_a0 *File, _a1 error) *MockTranscoder_Transcode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTranscoder_Transcode_Call) RunAndReturn(run func(*File, *MediaInfo, VideoProfile) (*File, error)) *MockTranscoder_Transcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTranscoder creates a new instance of MockTranscoder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTranscoder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTranscoder {
+	mock := &MockTranscoder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
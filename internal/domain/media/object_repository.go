@@ -1,10 +1,47 @@
 package media
 
-import "context"
+import (
+	"context"
+	"io"
+	"time"
+)
 
+// ObjectRepository stores the files of the media. Files are stored by post, an empty postID is the project library.
 type ObjectRepository interface {
-	UploadFile(ctx context.Context, projectID, postID, filename string, data []byte, metadata *MetaData) error
+	UploadFile(ctx context.Context, projectID, postID, filename string, body io.ReadSeeker, metadata *MetaData) error
 	GetSignedURL(ctx context.Context, projectID, postID, fileName string) (string, error)
-	GetFile(ctx context.Context, projectID, postID, filename string) ([]byte, error)
+	// GetSignedUploadURL returns a URL where the file of an upload can be sent with a PUT request until it expires.
+	// The file is staged by upload, apart from the files of the media, until the upload is completed.
+	GetSignedUploadURL(ctx context.Context, uploadID string, expiry time.Duration) (string, error)
+	// GetStagedFile streams the file sent to an upload, or returns ErrFileNotFound. The caller must close it.
+	GetStagedFile(ctx context.Context, uploadID string) (io.ReadCloser, error)
+	DeleteStagedFile(ctx context.Context, uploadID string) error
+	// GetFile streams the content of a file, or returns ErrFileNotFound. The caller must close it.
+	GetFile(ctx context.Context, projectID, postID, filename string) (io.ReadCloser, error)
+	// StatFile describes a file without downloading it, or returns ErrFileNotFound
+	StatFile(ctx context.Context, projectID, postID, filename string) (*ObjectInfo, error)
 	DeleteFile(ctx context.Context, projectID, postID, filename string) error
+	// ListFiles calls fn with every page of the media files and blobs in the store. Objects that aren't either are
+	// skipped.
+	ListFiles(ctx context.Context, fn func(files []*ObjectInfo) error) error
+
+	// Blobs are the files of the media stored by content hash, once per project whatever their name and post
+	UploadBlob(ctx context.Context, projectID, hash string, body io.ReadSeeker) error
+	// GetSignedBlobURL returns a URL to download a blob as fileName
+	GetSignedBlobURL(ctx context.Context, projectID, hash, fileName string) (string, error)
+	// GetBlob streams the content of a blob, or returns ErrFileNotFound. The caller must close it.
+	GetBlob(ctx context.Context, projectID, hash string) (io.ReadCloser, error)
+	// StatBlob describes a blob without downloading it, or returns ErrFileNotFound
+	StatBlob(ctx context.Context, projectID, hash string) (*ObjectInfo, error)
+	DeleteBlob(ctx context.Context, projectID, hash string) error
+}
+
+// ObjectInfo describes a file in the object store
+type ObjectInfo struct {
+	ProjectID    string    `json:"project_id"`
+	PostID       string    `json:"post_id"`
+	FileName     string    `json:"file_name"`
+	ContentHash  string    `json:"content_hash,omitempty"` // set for blobs, which have no post nor file name
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"last_modified"`
 }
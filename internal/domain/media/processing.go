@@ -0,0 +1,191 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+)
+
+// MaxStatusIDs is the most media whose processing status can be asked at once
+const MaxStatusIDs = 100
+
+var ErrTooManyMediaIDs = errors.New("too many media ids")
+
+// ProcessingStatus is where a media is in its processing, polled by clients after an upload
+type ProcessingStatus struct {
+	ID     string      `json:"id"`
+	Status MediaStatus `json:"status"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// SetProcessingQueue makes uploads return as soon as the file is stored, leaving the analysis and the thumbnail
+// to the queue. Without a queue, media are processed during the upload.
+// It is set after construction because the queue runs the processing with this service.
+func (s *service) SetProcessingQueue(q ProcessingQueue) {
+	s.queue = q
+}
+
+// pendingMetadata returns the metadata of the media of an upload, to be saved before it's processed
+func (u *Upload) pendingMetadata(mediaType MediaType, size int64) *MetaData {
+	md := NewPendingMetadata(u.ProjectID, u.PostID, u.CreatedBy, u.FileName, u.AltText, mediaType, size)
+	md.Folder = u.Folder
+	if u.Tags != nil {
+		md.Tags = u.Tags
+	}
+	return md
+}
+
+// savePendingFile stores a file stripped of its metadata, where it waits to be processed, and saves it as a pending
+// media
+func (s *service) savePendingFile(ctx context.Context, u *Upload, processor MediaProcessor, file *File) (DownloadMetaData, error) {
+	file, stripped, err := s.strip(ctx, file, u.FileName)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	if stripped {
+		defer file.Close()
+	}
+	md := u.pendingMetadata(processor.GetMediaType(), file.Size())
+	md.MetadataStripped = stripped
+	err = s.objectRepo.UploadFile(ctx, u.ProjectID, u.PostID, u.FileName, file.Reader(), md)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	return s.savePending(ctx, md)
+}
+
+// savePending saves the metadata of a media stored but not processed yet, and enqueues its processing
+func (s *service) savePending(ctx context.Context, md *MetaData) (DownloadMetaData, error) {
+	mediaUrl, err := s.objectRepo.GetSignedURL(ctx, md.ProjectID, md.PostID, md.Filename)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	_, err = s.repo.SaveMetadata(ctx, md)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	s.queue.Enqueue(md.ID)
+
+	// The thumbnail is made while processing
+	return DownloadMetaData{
+		Url:      &mediaUrl,
+		MetaData: md,
+	}, nil
+}
+
+// ProcessMedia analyzes a stored media, stores its thumbnail and renditions, moves it to its content hash and marks
+// it as ready. Media that can't be analyzed are marked as failed, other errors leave the media processing so it's
+// tried again. Media that aren't processing are skipped.
+func (s *service) ProcessMedia(ctx context.Context, mediaID string) error {
+	md, err := s.repo.GetMetadataByID(ctx, mediaID)
+	if err != nil {
+		return err
+	}
+	if md == nil || md.Status != MediaStatusProcessing {
+		return nil
+	}
+
+	processor, err := GetProcessor(md.Filename)
+	if err != nil {
+		return s.failProcessing(ctx, md, err)
+	}
+
+	body, err := s.getContent(ctx, md)
+	if errors.Is(err, ErrFileNotFound) {
+		return s.failProcessing(ctx, md, err)
+	}
+	if err != nil {
+		return err
+	}
+	file, err := NewFile(body, 0)
+	body.Close()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	file, stripped, err := s.strip(ctx, file, md.Filename)
+	if err != nil {
+		return s.failProcessing(ctx, md, err)
+	}
+	if stripped {
+		defer file.Close()
+		md.MetadataStripped = true
+	}
+
+	mediaInfo, thumbnail, tMediaInfo, err := analyzeMedia(processor, file)
+	if err != nil {
+		return s.failProcessing(ctx, md, err)
+	}
+
+	if thumbnail != nil {
+		err = s.storeThumbnail(ctx, md, thumbnail, tMediaInfo)
+		if err != nil {
+			return err
+		}
+	}
+	s.storeRenditions(ctx, md, file, mediaInfo)
+
+	// The media waited by name to be processed, it's moved to its content hash
+	md.setMediaInfo(mediaInfo)
+	err = s.storeContent(ctx, md, file, func(repo Repository) error {
+		return repo.UpdateMetadata(ctx, md)
+	})
+	if err != nil {
+		return err
+	}
+	return s.objectRepo.DeleteFile(ctx, md.ProjectID, md.PostID, md.Filename)
+}
+
+// failProcessing marks a media as failed, and returns the error that made it fail. The file waiting to be processed
+// is removed, it may still have the metadata the processing strips.
+func (s *service) failProcessing(ctx context.Context, md *MetaData, cause error) error {
+	md.Status = MediaStatusFailed
+	md.ProcessingError = cause.Error()
+	return errors.Join(
+		cause,
+		s.repo.UpdateMetadata(ctx, md),
+		s.objectRepo.DeleteFile(ctx, md.ProjectID, md.PostID, md.Filename),
+	)
+}
+
+// storeThumbnail uploads the thumbnail of a media and saves its metadata. The metadata is kept if the media
+// was already processed once, by a run that stopped before marking it as ready.
+func (s *service) storeThumbnail(ctx context.Context, md *MetaData, thumbnail []byte, tMediaInfo *MediaInfo) error {
+	thumbnailFileName := getThumbnailName(md.Filename)
+	tmd, err := NewMetadata(md.ProjectID, md.PostID, md.AddedBy, thumbnailFileName, md.AltText, tMediaInfo)
+	if err != nil {
+		return err
+	}
+	err = s.objectRepo.UploadFile(ctx, md.ProjectID, md.PostID, thumbnailFileName, bytes.NewReader(thumbnail), tmd)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetMetadata(ctx, md.ProjectID, md.PostID, thumbnailFileName)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return nil
+	}
+	_, err = s.repo.SaveMetadata(ctx, tmd)
+	return err
+}
+
+// FindMediaToProcess returns up to limit media still processing that were created before createdBefore
+func (s *service) FindMediaToProcess(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
+	return s.repo.FindProcessingMedia(ctx, createdBefore, limit)
+}
+
+// GetProcessingStatus returns the status of the media of a project. Unknown ids are left out.
+func (s *service) GetProcessingStatus(ctx context.Context, projectID string, mediaIDs []string) ([]*ProcessingStatus, error) {
+	if len(mediaIDs) > MaxStatusIDs {
+		return nil, ErrTooManyMediaIDs
+	}
+	if len(mediaIDs) == 0 {
+		return []*ProcessingStatus{}, nil
+	}
+	return s.repo.FindProcessingStatus(ctx, projectID, mediaIDs)
+}
@@ -0,0 +1,144 @@
+package media
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/config"
+)
+
+// ProcessingQueue runs the processing of the uploaded media, and the renditions missing for ready media, in a pool
+// of workers
+type ProcessingQueue interface {
+	Start(ctx context.Context)
+	Stop()
+	Enqueue(mediaID string)
+	// EnqueueRenditions adds a ready media whose renditions must be made again
+	EnqueueRenditions(mediaID string)
+}
+
+// processingJob is a media to process, or to make the renditions of
+type processingJob struct {
+	mediaID    string
+	renditions bool
+}
+
+type processingQueue struct {
+	jobs    chan processingJob
+	service Service
+	cfg     *config.MediaProcessingConfig
+	wg      sync.WaitGroup
+	quit    chan struct{}
+	mu      sync.Mutex
+	pending map[processingJob]bool // jobs enqueued or running
+}
+
+func NewProcessingQueue(cfg *config.MediaProcessingConfig, service Service) ProcessingQueue {
+	return &processingQueue{
+		jobs:    make(chan processingJob, cfg.Buffer),
+		service: service,
+		cfg:     cfg,
+		quit:    make(chan struct{}),
+		pending: make(map[processingJob]bool),
+	}
+}
+
+// Start spins up the workers, and rescans the media left processing, by a restart or a full queue
+func (q *processingQueue) Start(ctx context.Context) {
+	for i := 0; i < q.cfg.WorkerNum; i++ {
+		q.wg.Add(1)
+		go q.runWorker(ctx)
+	}
+
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+		ticker := time.NewTicker(q.cfg.RescanInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				q.rescan(ctx)
+			case <-q.quit:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop signals the workers to finish and waits for the media being processed
+func (q *processingQueue) Stop() {
+	close(q.quit)
+	q.wg.Wait()
+}
+
+// Enqueue adds a media to process. It never blocks: when the queue is full the media is left to the next rescan.
+func (q *processingQueue) Enqueue(mediaID string) {
+	q.enqueue(processingJob{mediaID: mediaID})
+}
+
+// EnqueueRenditions never blocks either: when the queue is full the renditions are enqueued again the next time
+// they are found missing.
+func (q *processingQueue) EnqueueRenditions(mediaID string) {
+	q.enqueue(processingJob{mediaID: mediaID, renditions: true})
+}
+
+func (q *processingQueue) enqueue(job processingJob) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.pending[job] {
+		return
+	}
+	select {
+	case q.jobs <- job:
+		q.pending[job] = true
+	default:
+		log.Printf("Media processing queue is full, media %s will be processed later", job.mediaID)
+	}
+}
+
+func (q *processingQueue) run(ctx context.Context, job processingJob) {
+	if job.renditions {
+		if err := q.service.RenderMedia(ctx, job.mediaID); err != nil {
+			log.Printf("Error making the renditions of media %s: %v", job.mediaID, err)
+		}
+		return
+	}
+	if err := q.service.ProcessMedia(ctx, job.mediaID); err != nil {
+		log.Printf("Error processing media %s: %v", job.mediaID, err)
+	}
+}
+
+func (q *processingQueue) runWorker(ctx context.Context) {
+	defer q.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.quit:
+			return
+		case job := <-q.jobs:
+			q.run(ctx, job)
+			q.mu.Lock()
+			delete(q.pending, job)
+			q.mu.Unlock()
+		}
+	}
+}
+
+// rescan enqueues the media processing for longer than the rescan interval
+func (q *processingQueue) rescan(ctx context.Context) {
+	createdBefore := time.Now().UTC().Add(-q.cfg.RescanInterval)
+	ids, err := q.service.FindMediaToProcess(ctx, createdBefore, q.cfg.Buffer)
+	if err != nil {
+		log.Printf("Error finding media to process: %v", err)
+		return
+	}
+	for _, id := range ids {
+		q.Enqueue(id)
+	}
+}
@@ -0,0 +1,153 @@
+package media
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProcessMedia(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("skips media that aren't processing", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().GetMetadataByID(ctx, "media-1").Return(&MetaData{ID: "media-1", Status: MediaStatusReady}, nil)
+
+		err := NewService(repo, NewMockObjectRepository(t)).ProcessMedia(ctx, "media-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("marks media without a file as failed", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		md := NewPendingMetadata("project-1", "post-1", "user-1", "clip.mp4", "", MediaTypeVideo, 4)
+		repo.EXPECT().GetMetadataByID(ctx, md.ID).Return(md, nil)
+		objectRepo.EXPECT().GetFile(ctx, "project-1", "post-1", "clip.mp4").Return(nil, ErrFileNotFound)
+		repo.EXPECT().UpdateMetadata(ctx, mock.MatchedBy(func(m *MetaData) bool {
+			return m.Status == MediaStatusFailed && m.ProcessingError == ErrFileNotFound.Error()
+		})).Return(nil)
+		objectRepo.EXPECT().DeleteFile(ctx, "project-1", "post-1", "clip.mp4").Return(nil)
+
+		err := NewService(repo, objectRepo).ProcessMedia(ctx, md.ID)
+
+		assert.ErrorIs(t, err, ErrFileNotFound)
+	})
+
+	t.Run("removes the file of media that can't be analyzed", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		md := NewPendingMetadata("project-1", "post-1", "user-1", "photo.png", "", MediaTypeImage, 4)
+		repo.EXPECT().GetMetadataByID(ctx, md.ID).Return(md, nil)
+		objectRepo.EXPECT().GetFile(ctx, "project-1", "post-1", "photo.png").Return(io.NopCloser(strings.NewReader("not a png")), nil)
+		repo.EXPECT().UpdateMetadata(ctx, mock.MatchedBy(func(m *MetaData) bool {
+			return m.Status == MediaStatusFailed
+		})).Return(nil)
+		objectRepo.EXPECT().DeleteFile(ctx, "project-1", "post-1", "photo.png").Return(nil)
+		s := NewService(repo, objectRepo)
+		s.SetStripMetadata(true)
+
+		err := s.ProcessMedia(ctx, md.ID)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCompleteUploadWithQueue(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository(t)
+	objectRepo := NewMockObjectRepository(t)
+	queue := NewMockProcessingQueue(t)
+	u := NewUpload("project-1", "post-1", "user-1", "clip.mp4", "", "", nil, 4)
+	repo.EXPECT().FindUpload(ctx, "project-1", u.ID).Return(u, nil)
+	repo.EXPECT().GetMetadata(ctx, "project-1", "post-1", "clip.mp4").Return(nil, nil)
+	objectRepo.EXPECT().GetStagedFile(ctx, u.ID).Return(io.NopCloser(strings.NewReader("clip")), nil)
+	objectRepo.EXPECT().UploadFile(ctx, "project-1", "post-1", "clip.mp4", mock.Anything, mock.Anything).Return(nil)
+	objectRepo.EXPECT().GetSignedURL(ctx, "project-1", "post-1", "clip.mp4").Return("https://store/clip.mp4", nil)
+	repo.EXPECT().SaveMetadata(ctx, mock.MatchedBy(func(m *MetaData) bool {
+		return m.Status == MediaStatusProcessing && m.Type == MediaTypeVideo && m.Size == 4
+	})).RunAndReturn(func(ctx context.Context, m *MetaData) (*MetaData, error) {
+		queue.EXPECT().Enqueue(m.ID).Return()
+		return m, nil
+	})
+	objectRepo.EXPECT().DeleteStagedFile(ctx, u.ID).Return(nil)
+	repo.EXPECT().DeleteUpload(ctx, u.ID).Return(nil)
+	s := NewService(repo, objectRepo)
+	s.SetProcessingQueue(queue)
+
+	d, err := s.CompleteUpload(ctx, "project-1", u.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, MediaStatusProcessing, d.Status)
+	assert.Nil(t, d.UrlThumbnail)
+}
+
+func TestProcessingQueue_Rescan(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service := NewMockService(t)
+	processed := make(chan string, 1)
+	service.EXPECT().FindMediaToProcess(mock.Anything, mock.Anything, 10).Return([]string{"media-1"}, nil).Maybe()
+	service.EXPECT().ProcessMedia(mock.Anything, "media-1").RunAndReturn(func(ctx context.Context, mediaID string) error {
+		select {
+		case processed <- mediaID:
+		default:
+		}
+		return nil
+	}).Maybe()
+	q := NewProcessingQueue(&config.MediaProcessingConfig{
+		WorkerNum:      1,
+		Buffer:         10,
+		RescanInterval: 10 * time.Millisecond,
+	}, service)
+	q.Start(ctx)
+
+	select {
+	case id := <-processed:
+		assert.Equal(t, "media-1", id)
+	case <-time.After(time.Second):
+		t.Fatal("media left processing wasn't enqueued again")
+	}
+	q.Stop()
+}
+
+func TestProcessingQueue_EnqueueRenditions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	service := NewMockService(t)
+	rendered := make(chan string, 1)
+	service.EXPECT().RenderMedia(mock.Anything, "media-1").RunAndReturn(func(ctx context.Context, mediaID string) error {
+		rendered <- mediaID
+		return nil
+	})
+	q := NewProcessingQueue(&config.MediaProcessingConfig{
+		WorkerNum:      1,
+		Buffer:         10,
+		RescanInterval: time.Hour,
+	}, service)
+	q.Start(ctx)
+
+	q.EnqueueRenditions("media-1")
+
+	select {
+	case id := <-rendered:
+		assert.Equal(t, "media-1", id)
+	case <-time.After(time.Second):
+		t.Fatal("the renditions weren't made")
+	}
+	q.Stop()
+}
+
+func TestGetProcessingStatus(t *testing.T) {
+	ids := make([]string, MaxStatusIDs+1)
+
+	_, err := NewService(NewMockRepository(t), NewMockObjectRepository(t)).GetProcessingStatus(context.Background(), "project-1", ids)
+
+	assert.ErrorIs(t, err, ErrTooManyMediaIDs)
+}
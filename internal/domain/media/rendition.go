@@ -0,0 +1,432 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	renditionPrefix = "rendition_"
+	// RenditionFormat is the container of the video renditions, with H.264 video and AAC audio
+	RenditionFormat = "mp4"
+)
+
+// VideoProfile is what a platform accepts for videos. Videos outside of it are published from a rendition
+// transcoded to fit.
+type VideoProfile struct {
+	// MaxLongSide and MaxShortSide bound the resolution whatever the orientation of the video
+	MaxLongSide  int
+	MaxShortSide int
+	MaxBitrate   int // video bitrate, in kbps
+	AudioBitrate int // in kbps
+	MaxLength    int // in seconds, longer videos are cut
+	MaxSize      int64
+}
+
+// VideoProfiles are the video profiles of the platforms, by platform id. Videos are published as they are to
+// the platforms without a profile.
+var VideoProfiles = map[string]VideoProfile{
+	"linkedin": {
+		MaxLongSide:  4096,
+		MaxShortSide: 2304,
+		MaxBitrate:   10000,
+		AudioBitrate: 128,
+		MaxLength:    30 * 60,
+		MaxSize:      500 << 20,
+	},
+	"x": {
+		MaxLongSide:  1920,
+		MaxShortSide: 1200,
+		MaxBitrate:   5000,
+		AudioBitrate: 128,
+		MaxLength:    140,
+		MaxSize:      512 << 20,
+	},
+}
+
+// Accepts tells whether a video can be published as it is
+func (p VideoProfile) Accepts(info *MediaInfo) bool {
+	switch {
+	case info.VideoCodec != "h264":
+		return false
+	case info.AudioCodec != "" && info.AudioCodec != "aac":
+		return false
+	}
+	return p.fits(info)
+}
+
+// fits tells whether the container, the resolution, the length and the bitrate of a video are accepted, leaving
+// out its codecs
+func (p VideoProfile) fits(info *MediaInfo) bool {
+	long, short := max(info.Width, info.Height), min(info.Width, info.Height)
+	switch {
+	case info.Format != RenditionFormat:
+		return false
+	case long > p.MaxLongSide, short > p.MaxShortSide:
+		return false
+	case p.MaxLength > 0 && info.Length > p.MaxLength:
+		return false
+	case p.MaxSize > 0 && int64(info.Size) > p.MaxSize:
+		return false
+	case info.Length > 0 && info.Size*8/info.Length/1000 > p.MaxBitrate+p.AudioBitrate:
+		return false
+	}
+	return true
+}
+
+// Fit returns the largest even dimensions within the profile keeping the aspect ratio. Videos are never upscaled.
+func (p VideoProfile) Fit(width, height int) (int, int) {
+	long, short := p.MaxLongSide, p.MaxShortSide
+	if height > width {
+		long, short = short, long
+	}
+	scale := min(1, float64(long)/float64(width), float64(short)/float64(height))
+	return even(float64(width) * scale), even(float64(height) * scale)
+}
+
+// VideoBitrate returns the video bitrate, in kbps, keeping a video of the given length within the size limit
+func (p VideoProfile) VideoBitrate(length int) int {
+	if p.MaxLength > 0 && length > p.MaxLength {
+		length = p.MaxLength
+	}
+	bitrate := p.MaxBitrate
+	if p.MaxSize > 0 && length > 0 {
+		// 5% is left for the container
+		fit := int(p.MaxSize*8*95/100/int64(length)/1000) - p.AudioBitrate
+		bitrate = min(bitrate, fit)
+	}
+	return bitrate
+}
+
+func even(v float64) int {
+	return max(2, int(v)/2*2)
+}
+
+// Transcoder makes the renditions of videos
+type Transcoder interface {
+	// Transcode returns a H.264/AAC mp4 of the video fitting the profile. The caller must close it.
+	Transcode(f *File, info *MediaInfo, profile VideoProfile) (*File, error)
+}
+
+// Rendition is a copy of a media made to fit a platform, stored next to the original
+type Rendition struct {
+	ID         string    `json:"id"`
+	MediaID    string    `json:"media_id"`
+	PlatformID string    `json:"platform_id"`
+	FileName   string    `json:"file_name"`
+	Format     string    `json:"format"`
+	Width      int       `json:"width"`
+	Height     int       `json:"height"`
+	Length     int       `json:"length"`
+	Size       int       `json:"size"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func NewRendition(md *MetaData, platformID string, info *MediaInfo) *Rendition {
+	return &Rendition{
+		ID:         uuid.New().String(),
+		MediaID:    md.ID,
+		PlatformID: platformID,
+		FileName:   getRenditionName(md.Filename, platformID, info.Format),
+		Format:     info.Format,
+		Width:      info.Width,
+		Height:     info.Height,
+		Length:     info.Length,
+		Size:       info.Size,
+		CreatedAt:  time.Now().UTC(),
+	}
+}
+
+// metadata returns the metadata of the original media, with the details of the rendition
+func (r *Rendition) metadata(md *MetaData) *MetaData {
+	m := *md
+	m.Format = r.Format
+	m.Width = r.Width
+	m.Height = r.Height
+	m.Length = r.Length
+	m.Size = r.Size
+	return &m
+}
+
+func getRenditionName(name, platformID, format string) string {
+	return renditionPrefix + platformID + "_" + name + "." + format
+}
+
+// getRenditionNames returns the names the renditions of a file can have
+func getRenditionNames(name string) []string {
+	processor, err := GetProcessor(name)
+	if err != nil {
+		return nil
+	}
+
+	var (
+		platformIDs []string
+		formats     []string
+	)
+	switch processor.GetMediaType() {
+	case MediaTypeVideo:
+		platformIDs, formats = keys(VideoProfiles), []string{RenditionFormat}
+	case MediaTypeImage:
+		platformIDs, formats = keys(ImageProfiles), []string{"jpeg", "png"}
+	}
+
+	var names []string
+	for _, platformID := range platformIDs {
+		for _, format := range formats {
+			names = append(names, getRenditionName(name, platformID, format))
+		}
+	}
+	return names
+}
+
+func keys[T any](m map[string]T) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	return ks
+}
+
+// deleteRenditionFiles removes the renditions of a file from the object store. Their metadata goes with the media.
+func (s *service) deleteRenditionFiles(ctx context.Context, projectID, postID, fileName string) error {
+	var errs []error
+	for _, name := range getRenditionNames(fileName) {
+		errs = append(errs, s.objectRepo.DeleteFile(ctx, projectID, postID, name))
+	}
+	return errors.Join(errs...)
+}
+
+// hasProfile tells whether a platform has requirements for the media of a type
+func (s *service) hasProfile(mediaType MediaType, platformID string) bool {
+	switch mediaType {
+	case MediaTypeVideo:
+		_, ok := s.profiles[platformID]
+		return ok
+	case MediaTypeImage:
+		_, ok := s.imageProfiles[platformID]
+		return ok
+	}
+	return false
+}
+
+// storeRenditions makes the renditions of a media for the platforms that can't publish it as it is. They are
+// made again at publish time when this fails, so failures are only logged.
+func (s *service) storeRenditions(ctx context.Context, md *MetaData, file *File, info *MediaInfo) {
+	for _, platformID := range s.profiledPlatforms(info.Type) {
+		r, rFile, err := s.storeRendition(ctx, md, file, info, platformID)
+		if err != nil {
+			log.Printf("Error making the %s rendition of media %s: %v", platformID, md.ID, err)
+			continue
+		}
+		if r != nil {
+			_ = rFile.Close()
+		}
+	}
+}
+
+// RenderMedia makes the renditions missing for a ready media, when they failed while it was processed or when the
+// profiles changed since. Media that aren't ready are skipped.
+func (s *service) RenderMedia(ctx context.Context, mediaID string) error {
+	md, err := s.repo.GetMetadataByID(ctx, mediaID)
+	if err != nil {
+		return err
+	}
+	if md == nil || !md.IsReady() {
+		return nil
+	}
+
+	var missing []string
+	for _, platformID := range s.profiledPlatforms(md.Type) {
+		r, err := s.repo.FindRendition(ctx, md.ID, platformID)
+		if err != nil {
+			return err
+		}
+		if r == nil {
+			missing = append(missing, platformID)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	m, err := s.GetMediaFile(ctx, md.ProjectID, md.PostID, md.Filename)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+	processor, err := GetProcessor(md.Filename)
+	if err != nil {
+		return err
+	}
+	info, err := processor.Analyze(m.File)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, platformID := range missing {
+		r, rFile, err := s.storeRendition(ctx, m.MetaData, m.File, info, platformID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s rendition: %w", platformID, err))
+			continue
+		}
+		if r != nil {
+			_ = rFile.Close()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// profiledPlatforms returns the platforms with requirements for the media of a type
+func (s *service) profiledPlatforms(mediaType MediaType) []string {
+	switch mediaType {
+	case MediaTypeVideo:
+		return keys(s.profiles)
+	case MediaTypeImage:
+		return keys(s.imageProfiles)
+	}
+	return nil
+}
+
+// needsRendition tells, from its metadata, whether a media can't be published as it is to a platform. The codecs of
+// videos aren't stored, a video that fits otherwise is taken as accepted, as it was when it was processed.
+func (s *service) needsRendition(md *MetaData, platformID string) bool {
+	switch md.Type {
+	case MediaTypeVideo:
+		profile, ok := s.profiles[platformID]
+		return ok && !profile.fits(md.mediaInfo())
+	case MediaTypeImage:
+		profile, ok := s.imageProfiles[platformID]
+		return ok && !profile.Accepts(md.mediaInfo())
+	}
+	return false
+}
+
+// getDetailsForPlatform returns the metadata of a media the way it's published to a platform, from its rendition
+// for the platform when there is one. A rendition found missing is enqueued to be made, the media is described as
+// it is meanwhile.
+func (s *service) getDetailsForPlatform(ctx context.Context, md *MetaData, platformID string) (*MetaData, error) {
+	if !s.hasProfile(md.Type, platformID) || !md.IsReady() {
+		return md, nil
+	}
+	r, err := s.repo.FindRendition(ctx, md.ID, platformID)
+	if err != nil {
+		return nil, err
+	}
+	if r != nil {
+		return r.metadata(md), nil
+	}
+	if s.queue != nil && s.needsRendition(md, platformID) {
+		s.queue.EnqueueRenditions(md.ID)
+	}
+	return md, nil
+}
+
+// render makes the rendition of a media for a platform. It returns nil when the platform accepts the media as it is.
+func (s *service) render(md *MetaData, file *File, info *MediaInfo, platformID string) (*File, *MediaInfo, error) {
+	switch info.Type {
+	case MediaTypeVideo:
+		profile, ok := s.profiles[platformID]
+		if !ok || profile.Accepts(info) {
+			return nil, nil, nil
+		}
+		rFile, err := s.transcoder.Transcode(file, info, profile)
+		if err != nil {
+			return nil, nil, err
+		}
+		rInfo, err := (&VideoProcessor{ext: RenditionFormat}).Analyze(rFile)
+		if err != nil {
+			return nil, nil, errors.Join(err, rFile.Close())
+		}
+		return rFile, rInfo, nil
+	case MediaTypeImage:
+		profile, ok := s.imageProfiles[platformID]
+		if !ok || profile.Accepts(info) {
+			return nil, nil, nil
+		}
+		src, err := decodableImage(md.Filename, file)
+		if err != nil {
+			return nil, nil, err
+		}
+		return RenderImage(src, profile, md.FocalX, md.FocalY)
+	}
+	return nil, nil, nil
+}
+
+// storeRendition makes the rendition of a media for a platform, stores it and returns it with its file, which the
+// caller must close. It returns nil when the platform accepts the media as it is.
+func (s *service) storeRendition(ctx context.Context, md *MetaData, file *File, info *MediaInfo, platformID string) (*Rendition, *File, error) {
+	rFile, rInfo, err := s.render(md, file, info, platformID)
+	if err != nil || rFile == nil {
+		return nil, nil, err
+	}
+
+	r := NewRendition(md, platformID, rInfo)
+	err = s.objectRepo.UploadFile(ctx, md.ProjectID, md.PostID, r.FileName, rFile.Reader(), r.metadata(md))
+	if err != nil {
+		return nil, nil, errors.Join(err, rFile.Close())
+	}
+	err = s.repo.SaveRendition(ctx, r)
+	if err != nil {
+		return nil, nil, errors.Join(err, rFile.Close())
+	}
+	return r, rFile, nil
+}
+
+// getMediaForPlatform downloads a media the way it's published to a platform: images and videos are replaced by
+// their rendition for the platform, which is made now if it wasn't made while processing. Media still processing
+// are returned as they are, they can't be published yet. The caller must close it.
+// It's only used to publish, validations go by the metadata with getDetailsForPlatform.
+func (s *service) getMediaForPlatform(ctx context.Context, projectID string, md *MetaData, platformID string) (*Media, error) {
+	if !s.hasProfile(md.Type, platformID) || !md.IsReady() {
+		return s.GetMediaFile(ctx, projectID, md.PostID, md.Filename)
+	}
+
+	r, err := s.repo.FindRendition(ctx, md.ID, platformID)
+	if err != nil {
+		return nil, err
+	}
+	if r != nil {
+		body, err := s.objectRepo.GetFile(ctx, projectID, md.PostID, r.FileName)
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		file, err := NewFile(body, 0)
+		if err != nil {
+			return nil, err
+		}
+		return &Media{File: file, MetaData: r.metadata(md)}, nil
+	}
+
+	m, err := s.GetMediaFile(ctx, projectID, md.PostID, md.Filename)
+	if err != nil {
+		return nil, err
+	}
+	processor, err := GetProcessor(m.Filename)
+	if err != nil {
+		return nil, errors.Join(err, m.Close())
+	}
+	info, err := processor.Analyze(m.File)
+	if err != nil {
+		return nil, errors.Join(err, m.Close())
+	}
+	r, rFile, err := s.storeRendition(ctx, m.MetaData, m.File, info, platformID)
+	if err != nil {
+		return nil, errors.Join(err, m.Close())
+	}
+	if r == nil {
+		return m, nil
+	}
+	closeErr := m.File.Close()
+	m.File, m.MetaData = rFile, r.metadata(m.MetaData)
+	if closeErr != nil {
+		return nil, errors.Join(closeErr, m.Close())
+	}
+	return m, nil
+}
@@ -0,0 +1,172 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVideoProfile_Accepts(t *testing.T) {
+	profile := VideoProfiles["x"]
+	compliant := func() *MediaInfo {
+		return &MediaInfo{
+			Type: MediaTypeVideo, Format: "mp4", VideoCodec: "h264", AudioCodec: "aac",
+			Width: 1280, Height: 720, Length: 60, Size: 20 << 20,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		change func(*MediaInfo)
+		want   bool
+	}{
+		{name: "compliant video", change: func(*MediaInfo) {}, want: true},
+		{name: "portrait video", change: func(i *MediaInfo) { i.Width, i.Height = 720, 1280 }, want: true},
+		{name: "video without audio", change: func(i *MediaInfo) { i.AudioCodec = "" }, want: true},
+		{name: "mov container", change: func(i *MediaInfo) { i.Format = "mov" }, want: false},
+		{name: "hevc video", change: func(i *MediaInfo) { i.VideoCodec = "hevc" }, want: false},
+		{name: "opus audio", change: func(i *MediaInfo) { i.AudioCodec = "opus" }, want: false},
+		{name: "4k video", change: func(i *MediaInfo) { i.Width, i.Height = 3840, 2160 }, want: false},
+		{name: "too long", change: func(i *MediaInfo) { i.Length = 141 }, want: false},
+		{name: "bitrate too high", change: func(i *MediaInfo) { i.Size = 200 << 20 }, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := compliant()
+			tt.change(info)
+			assert.Equal(t, tt.want, profile.Accepts(info))
+		})
+	}
+}
+
+func TestVideoProfile_Fit(t *testing.T) {
+	profile := VideoProfiles["x"]
+
+	w, h := profile.Fit(3840, 2160)
+	assert.Equal(t, []int{1920, 1080}, []int{w, h})
+
+	w, h = profile.Fit(2160, 3840)
+	assert.Equal(t, []int{1080, 1920}, []int{w, h}, "portrait videos are bound by the long side too")
+
+	w, h = profile.Fit(1600, 1600)
+	assert.Equal(t, []int{1200, 1200}, []int{w, h})
+
+	w, h = profile.Fit(641, 361)
+	assert.Equal(t, []int{640, 360}, []int{w, h}, "small videos are only rounded to even sizes")
+}
+
+func TestVideoProfile_VideoBitrate(t *testing.T) {
+	profile := VideoProfile{MaxBitrate: 5000, AudioBitrate: 128, MaxLength: 600, MaxSize: 100 << 20}
+
+	assert.Equal(t, 5000, profile.VideoBitrate(60))
+	// 10 minutes have to fit in 100MB
+	assert.Equal(t, 1200, profile.VideoBitrate(3600))
+}
+
+func TestGetMediaForPlatform(t *testing.T) {
+	ctx := context.Background()
+	md := &MetaData{ID: "media-1", ProjectID: "project-1", PostID: "post-1", Filename: "clip.mov", Type: MediaTypeVideo, Format: "mov", Status: MediaStatusReady}
+
+	t.Run("uses the stored rendition", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		r := NewRendition(md, "linkedin", &MediaInfo{Format: "mp4", Width: 1280, Height: 720, Length: 12, Size: 5})
+		repo.EXPECT().FindRendition(ctx, "media-1", "linkedin").Return(r, nil)
+		objectRepo.EXPECT().GetFile(ctx, "project-1", "post-1", r.FileName).Return(io.NopCloser(strings.NewReader("video")), nil)
+
+		s := NewService(repo, objectRepo).(*service)
+		m, err := s.getMediaForPlatform(ctx, "project-1", md, "linkedin")
+
+		assert.NoError(t, err)
+		defer m.Close()
+		assert.Equal(t, "mp4", m.Format)
+		assert.Equal(t, "clip.mov", m.Filename)
+		assert.Equal(t, int64(5), m.File.Size())
+		assert.Equal(t, "mov", md.Format, "the metadata of the original is left untouched")
+	})
+
+	t.Run("publishes media still processing as they are", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		image := &MetaData{ID: "media-2", ProjectID: "project-1", PostID: "post-1", Filename: "logo.png", Type: MediaTypeImage, Status: MediaStatusProcessing}
+		objectRepo.EXPECT().GetFile(ctx, "project-1", "post-1", "logo.png").Return(io.NopCloser(strings.NewReader("image")), nil)
+		repo.EXPECT().GetMetadata(ctx, "project-1", "post-1", "logo.png").Return(image, nil)
+
+		s := NewService(repo, objectRepo).(*service)
+		m, err := s.getMediaForPlatform(ctx, "project-1", image, "linkedin")
+
+		assert.NoError(t, err)
+		defer m.Close()
+		assert.Equal(t, "logo.png", m.Filename)
+	})
+}
+
+func TestGetMediaDetailsForPublishPost(t *testing.T) {
+	ctx := context.Background()
+	video := &MetaData{ID: "media-1", ProjectID: "project-1", PostID: "post-1", Filename: "clip.mov", Type: MediaTypeVideo, Format: "mov", Status: MediaStatusReady}
+	banner := &MetaData{
+		ID: "media-2", ProjectID: "project-1", PostID: "post-1", Filename: "banner.png", Type: MediaTypeImage, Format: "png",
+		Width: 400, Height: 100, Size: 5, Status: MediaStatusReady,
+	}
+
+	repo := NewMockRepository(t)
+	queue := NewMockProcessingQueue(t)
+	r := NewRendition(video, "linkedin", &MediaInfo{Format: "mp4", Width: 1280, Height: 720, Length: 12, Size: 5})
+	repo.EXPECT().GetMediaForPublishPost(ctx, "post-1", "linkedin").Return([]*MetaData{video, banner}, nil)
+	repo.EXPECT().FindRendition(ctx, "media-1", "linkedin").Return(r, nil)
+	repo.EXPECT().FindRendition(ctx, "media-2", "linkedin").Return(nil, nil)
+	// The banner is too wide for LinkedIn, its rendition is made in the background
+	queue.EXPECT().EnqueueRenditions("media-2").Return()
+	s := NewService(repo, NewMockObjectRepository(t))
+	s.SetProcessingQueue(queue)
+
+	medias, err := s.GetMediaDetailsForPublishPost(ctx, "project-1", "post-1", "linkedin")
+
+	assert.NoError(t, err)
+	assert.Len(t, medias, 2)
+	assert.Nil(t, medias[0].File)
+	assert.Equal(t, "mp4", medias[0].Format)
+	assert.Equal(t, "clip.mov", medias[0].Filename)
+	assert.Equal(t, banner, medias[1].MetaData)
+}
+
+func TestRenderMedia(t *testing.T) {
+	ctx := context.Background()
+	md := &MetaData{ID: "media-1", ProjectID: "project-1", PostID: "post-1", Filename: "banner.png", Type: MediaTypeImage, Status: MediaStatusReady}
+
+	t.Run("makes the renditions that are missing", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		data := encodePNG(t, solidImage(400, 100))
+		repo.EXPECT().GetMetadataByID(ctx, "media-1").Return(md, nil)
+		repo.EXPECT().FindRendition(ctx, "media-1", "linkedin").Return(nil, nil)
+		repo.EXPECT().FindRendition(ctx, "media-1", "x").Return(&Rendition{}, nil)
+		repo.EXPECT().GetMetadata(ctx, "project-1", "post-1", "banner.png").Return(md, nil)
+		objectRepo.EXPECT().GetFile(ctx, "project-1", "post-1", "banner.png").Return(io.NopCloser(bytes.NewReader(data)), nil)
+		name := getRenditionName("banner.png", "linkedin", "png")
+		objectRepo.EXPECT().UploadFile(ctx, "project-1", "post-1", name, mock.Anything, mock.Anything).Return(nil)
+		repo.EXPECT().SaveRendition(ctx, mock.MatchedBy(func(r *Rendition) bool {
+			return r.PlatformID == "linkedin" && r.Width == 191
+		})).Return(nil)
+
+		err := NewService(repo, objectRepo).RenderMedia(ctx, "media-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("doesn't download media with all their renditions", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().GetMetadataByID(ctx, "media-1").Return(md, nil)
+		repo.EXPECT().FindRendition(ctx, "media-1", mock.Anything).Return(&Rendition{}, nil)
+
+		err := NewService(repo, NewMockObjectRepository(t)).RenderMedia(ctx, "media-1")
+
+		assert.NoError(t, err)
+	})
+}
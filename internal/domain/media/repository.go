@@ -1,19 +1,76 @@
 package media
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Repository interface {
 	SaveMetadata(ctx context.Context, media *MetaData) (*MetaData, error)
-	GetMetadata(ctx context.Context, postID, fileName string) (*MetaData, error)
-	GetMediaFileNamesForPublishPost(ctx context.Context, postID, platformID string) ([]string, error)
+	// GetMetadata returns the metadata of a file of a post, or of the project library when postID is empty
+	GetMetadata(ctx context.Context, projectID, postID, fileName string) (*MetaData, error)
+	// GetMetadataByID returns nil when there is no media with the id
+	GetMetadataByID(ctx context.Context, mediaID string) (*MetaData, error)
+	// UpdateMetadata saves what the processing of a media found, with its status
+	UpdateMetadata(ctx context.Context, md *MetaData) error
+	// FindProcessingMedia returns the ids of up to limit media still processing, created before createdBefore
+	FindProcessingMedia(ctx context.Context, createdBefore time.Time, limit int) ([]string, error)
+	FindProcessingStatus(ctx context.Context, projectID string, mediaIDs []string) ([]*ProcessingStatus, error)
+	GetMediaForPublishPost(ctx context.Context, postID, platformID string) ([]*MetaData, error)
 	LinkMediaToPublishPost(ctx context.Context, postID, fileName, platformID string) error
 	UnlinkMediaFromPublishPost(ctx context.Context, postID, fileName, platformID string) error
 	DoesPostBelongToProject(ctx context.Context, projectID, postID string) (bool, error)
 	DoesMediaBelongToPost(ctx context.Context, postID, mediaID string) (bool, error)
+	IsMediaInLibrary(ctx context.Context, projectID, mediaID string) (bool, error)
+	FindLibraryMedia(ctx context.Context, projectID string, filter *LibraryFilter) ([]*LibraryMedia, error)
+	FindLibraryMediaByID(ctx context.Context, projectID, mediaID string) (*LibraryMedia, error)
+	UpdateLibraryMedia(ctx context.Context, md *MetaData) error
+	// DeleteLibraryMedia deletes the metadata of a library media and of its thumbnail.
+	// It returns ErrMediaInUse, without deleting anything, if a post or the branding of the project still uses the media.
+	DeleteLibraryMedia(ctx context.Context, projectID, mediaID, thumbnailName string) error
 	IsPlatformEnabledForProject(ctx context.Context, projectID, platformID string) (bool, error)
 	IsThePostEnabledToPlatform(ctx context.Context, postID, platformID string) (bool, error)
 	IsMediaLinkedToPublishPost(ctx context.Context, postID, mediaID, platformID string) (bool, error)
 	ListMediaFilesForPost(ctx context.Context, postID string) ([]string, error)
+	// LockContent runs fn holding a lock on a content hash of the project, so its blob isn't stored and deleted at once.
+	// fn gets the repository to use while the lock is held.
+	LockContent(ctx context.Context, projectID, hash string, fn func(repo Repository) error) error
+	// IsContentReferenced tells whether a media of the project has the content hash
+	IsContentReferenced(ctx context.Context, projectID, hash string) (bool, error)
+	// FindUnsharedContent returns the content hashes of the media of a post that no media of the library or of
+	// another post of the project has
+	FindUnsharedContent(ctx context.Context, projectID, postID string) ([]string, error)
 	GetMediaFileName(ctx context.Context, mediaID string) (string, error)
-	DeleteMetadata(ctx context.Context, mediaID string) error
+	// FindPostMediaByID returns nil when the post of the project has no media with the id
+	FindPostMediaByID(ctx context.Context, projectID, postID, mediaID string) (*MetaData, error)
+	// DeletePostMedia deletes the metadata of a media of a post and of its thumbnail.
+	// It returns ErrMediaInUse, without deleting anything, if another post or the branding of the project uses the media.
+	DeletePostMedia(ctx context.Context, projectID, postID, mediaID, thumbnailName string) error
+	// HasMetadata tells, for each file, whether there is metadata for it, as a media stored by name, as a rendition,
+	// or for blobs as a media with its content hash
+	HasMetadata(ctx context.Context, files []*ObjectInfo) ([]bool, error)
+	// FindMetadataCreatedBefore returns up to limit metadata created before createdBefore, ordered by id after afterID
+	FindMetadataCreatedBefore(ctx context.Context, createdBefore time.Time, afterID string, limit int) ([]*MetaData, error)
+	// SaveRendition saves a rendition, replacing the one of the same media for the same platform
+	SaveRendition(ctx context.Context, r *Rendition) error
+	// FindRendition returns nil when the media has no rendition for the platform
+	FindRendition(ctx context.Context, mediaID, platformID string) (*Rendition, error)
+	// DeleteRenditions deletes the renditions of a media for all the platforms
+	DeleteRenditions(ctx context.Context, mediaID string) error
+	UpdateFocalPoint(ctx context.Context, mediaID string, x, y float64) error
+	// FindBranding returns nil when the project has no branding
+	FindBranding(ctx context.Context, projectID string) (*Branding, error)
+	// SaveBranding saves the branding of a project, replacing the previous one
+	SaveBranding(ctx context.Context, b *Branding) error
+	// DeleteBranding tells whether there was a branding to delete
+	DeleteBranding(ctx context.Context, projectID string) (bool, error)
+	// FindPublishBranding returns the branding of the project of a post when the post opted in for the platform,
+	// or nil
+	FindPublishBranding(ctx context.Context, postID, platformID string) (*Branding, error)
+	SetApplyBranding(ctx context.Context, postID, platformID string, apply bool) error
+	SaveUpload(ctx context.Context, u *Upload) error
+	FindUpload(ctx context.Context, projectID, uploadID string) (*Upload, error)
+	DeleteUpload(ctx context.Context, uploadID string) error
+	// DeleteExpiredUploads deletes the uploads expired at now and returns them
+	DeleteExpiredUploads(ctx context.Context, now time.Time) ([]*Upload, error)
 }
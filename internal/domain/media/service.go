@@ -1,42 +1,123 @@
 package media
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
 	"golang.org/x/sync/errgroup"
 )
 
 type Service interface {
-	UploadMedia(ctx context.Context, projectID, postID, fileName, altText string, data []byte) (DownloadMetaData, error)
+	UploadMedia(ctx context.Context, projectID, postID, fileName, altText string, r io.Reader) (DownloadMetaData, error)
 	DeleteMedia(ctx context.Context, projectID, postID, fileID string) error
 	GetDownloadMetaData(ctx context.Context, projectID, postID, fileName string) (DownloadMetaData, error)
 	GetMediaFile(ctx context.Context, projectID, postID, fileName string) (*Media, error)
 	GetMediaForPublishPost(ctx context.Context, projectID, postID, platformID string) ([]*Media, error)
+	// GetMediaDetailsForPublishPost returns the media of a publish post like GetMediaForPublishPost, without their
+	// files, to validate the post
+	GetMediaDetailsForPublishPost(ctx context.Context, projectID, postID, platformID string) ([]*Media, error)
 	GetDownloadMetadataForPublishPost(ctx context.Context, projectID, postID, platformID string) ([]*DownloadMetaData, error)
 	LinkMediaToPublishPost(ctx context.Context, projectID, postID, mediaID, platformID string) error
 	UnLinkMediaFromPublishPost(ctx context.Context, projectID, postID, mediaID, platformID string) error
 	GetDownloadMetadataDataForPost(ctx context.Context, projectID, postID string) ([]*DownloadMetaData, error)
+	StoreMediaFiles(ctx context.Context, projectID, postID, fileName, altText string, r io.Reader) ([]*MetaData, error)
+	DeleteMediaFiles(ctx context.Context, mds []*MetaData) error
+	PostFilesRemover(ctx context.Context, projectID, postID string) (func(ctx context.Context) error, error)
+	CollectGarbage(ctx context.Context, olderThan time.Time, dryRun bool) (*GCReport, error)
+	UploadLibraryMedia(ctx context.Context, projectID, fileName, altText, folder string, tags []string, r io.Reader) (DownloadMetaData, error)
+	ListLibraryMedia(ctx context.Context, projectID string, filter *LibraryFilter) ([]*LibraryMedia, error)
+	UpdateLibraryMedia(ctx context.Context, projectID, mediaID, altText, folder string, tags []string) (*LibraryMedia, error)
+	DeleteLibraryMedia(ctx context.Context, projectID, mediaID string) error
+	InitiateUpload(ctx context.Context, projectID, postID, fileName, altText, folder string, tags []string, size int64) (*Upload, error)
+	CompleteUpload(ctx context.Context, projectID, uploadID string) (DownloadMetaData, error)
+	PurgeExpiredUploads(ctx context.Context) (int, error)
+	SetSizeLimits(limits SizeLimits)
+	SetStripMetadata(strip bool)
+	ProcessMedia(ctx context.Context, mediaID string) error
+	// RenderMedia makes the renditions a ready media is missing
+	RenderMedia(ctx context.Context, mediaID string) error
+	FindMediaToProcess(ctx context.Context, createdBefore time.Time, limit int) ([]string, error)
+	GetProcessingStatus(ctx context.Context, projectID string, mediaIDs []string) ([]*ProcessingStatus, error)
+	SetFocalPoint(ctx context.Context, projectID, mediaID string, x, y float64) (*MetaData, error)
+	GetBranding(ctx context.Context, projectID string) (*Branding, error)
+	SetBranding(ctx context.Context, b *Branding) (*Branding, error)
+	DeleteBranding(ctx context.Context, projectID string) error
+	SetPostBranding(ctx context.Context, projectID, postID, platformID string, apply bool) error
+	SetProcessingQueue(q ProcessingQueue)
 }
 
 type service struct {
 	repo       Repository
 	objectRepo ObjectRepository
+	limits     SizeLimits
+	queue      ProcessingQueue
+	transcoder Transcoder
+	stripper   MetadataStripper
+	// stripMetadata removes the metadata of the media before they are stored
+	stripMetadata bool
+	// profiles and imageProfiles are what each platform accepts, by platform id
+	profiles      map[string]VideoProfile
+	imageProfiles map[string]ImageProfile
 }
 
 func NewService(repo Repository, objectRepo ObjectRepository) Service {
 	return &service{
 		repo:       repo,
 		objectRepo: objectRepo,
+		limits:     DefaultSizeLimits,
+		transcoder: NewFFmpegTranscoder(),
+		stripper:   NewPrivacyStripper(),
+		profiles:   VideoProfiles,
+
+		imageProfiles: ImageProfiles,
+	}
+}
+
+// SetSizeLimits sets the largest file accepted for each media type. Types without a limit accept any size.
+func (s *service) SetSizeLimits(limits SizeLimits) {
+	s.limits = limits
+}
+
+// SetStripMetadata sets whether the metadata of the media, like the location of photos, are removed before
+// they are stored
+func (s *service) SetStripMetadata(strip bool) {
+	s.stripMetadata = strip
+}
+
+// strip returns the file to store, which is a copy without metadata when they are stripped from its format.
+// stripped tells whether it's a copy, the caller must then close it too.
+func (s *service) strip(ctx context.Context, file *File, fileName string) (f *File, stripped bool, err error) {
+	if !s.stripMetadata {
+		return file, false, nil
+	}
+	f, err = s.stripper.Strip(ctx, file, strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), ".")))
+	if err != nil {
+		return nil, false, errors.Join(ErrFailedToAnalyzeMedia, err)
+	}
+	if f == nil {
+		return file, false, nil
 	}
+	return f, true, nil
+}
+
+func (s *service) UploadMedia(ctx context.Context, projectID, postID, fileName, altText string, r io.Reader) (DownloadMetaData, error) {
+	return s.upload(ctx, projectID, postID, fileName, altText, "", nil, r)
 }
 
-func (s *service) UploadMedia(ctx context.Context, projectID, postID, fileName, altText string, data []byte) (DownloadMetaData, error) {
+// upload stores a media and saves its metadata, leaving it to be processed when there is a processing queue.
+// The file waiting to be processed is stripped already, since it can be downloaded meanwhile.
+// An empty postID uploads to the project library.
+func (s *service) upload(ctx context.Context, projectID, postID, fileName, altText, folder string, tags []string, r io.Reader) (DownloadMetaData, error) {
 	userID := ctx.Value(middlewares.UserIDKey).(string)
 
-	existingMetadata, err := s.repo.GetMetadata(ctx, postID, fileName)
+	existingMetadata, err := s.repo.GetMetadata(ctx, projectID, postID, fileName)
 	if err == nil && existingMetadata != nil {
 		return DownloadMetaData{}, ErrFileAlreadyExists
 	}
@@ -46,71 +127,84 @@ func (s *service) UploadMedia(ctx context.Context, projectID, postID, fileName,
 		return DownloadMetaData{}, err
 	}
 
-	var (
-		g          errgroup.Group
-		mediaInfo  *MediaInfo
-		tMediaInfo *MediaInfo
-		thumnail   *[]byte
-		md         *MetaData
-		tmd        *MetaData
-	)
+	file, err := NewFile(r, s.limits[processor.GetMediaType()])
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	defer file.Close()
+
+	u := &Upload{
+		ProjectID: projectID,
+		PostID:    postID,
+		FileName:  fileName,
+		AltText:   altText,
+		Folder:    folder,
+		Tags:      tags,
+		CreatedBy: userID,
+	}
+	if s.queue == nil {
+		return s.save(ctx, u, processor, file, false)
+	}
+	return s.savePendingFile(ctx, u, processor, file)
+}
 
-	// Analyze the media and get the thumbnail with its info
-	g.Go(func() error {
-		var err error
-		mediaInfo, err = processor.Analyze(data)
-		return err
-	})
-	g.Go(func() error {
-		var err error
-		if processor.GetMediaType() == MediaTypeDocument {
-			return nil
-		}
-		thumnail, err = processor.GetThumbnail(data)
-		if err != nil {
-			return err
-		}
+// save analyzes a media, stores it by content hash with its thumbnail and saves their metadata. A staged media,
+// sent by a direct upload, is removed from where it was staged once stored.
+func (s *service) save(ctx context.Context, u *Upload, processor MediaProcessor, file *File, staged bool) (DownloadMetaData, error) {
+	projectID, postID, fileName, altText, userID := u.ProjectID, u.PostID, u.FileName, u.AltText, u.CreatedBy
 
-		tMediaInfo, err = processor.Analyze(*thumnail)
-		return err
-	})
+	file, stripped, err := s.strip(ctx, file, fileName)
+	if err != nil {
+		return DownloadMetaData{}, err
+	}
+	if stripped {
+		defer file.Close()
+	}
 
-	if err := g.Wait(); err != nil {
-		return DownloadMetaData{}, errors.Join(errors.New("failed to analyze media"), err)
+	mediaInfo, thumnail, tMediaInfo, err := analyzeMedia(processor, file)
+	if err != nil {
+		return DownloadMetaData{}, err
 	}
 
+	var (
+		g   errgroup.Group
+		md  *MetaData
+		tmd *MetaData
+	)
+
 	// Upload the media and thumbnail, save the metadata
 	var mediaUrl string
 	g.Go(func() error {
 		var err error
-		md, err = NewMetadata(postID, userID, fileName, altText, data, mediaInfo)
+		md, err = NewMetadata(projectID, postID, userID, fileName, altText, mediaInfo)
 		if err != nil {
 			return err
 		}
-		err = s.objectRepo.UploadFile(ctx, projectID, postID, fileName, data, md)
-		if err != nil {
-			return err
+		md.Folder = u.Folder
+		if u.Tags != nil {
+			md.Tags = u.Tags
 		}
-		mediaUrl, err = s.objectRepo.GetSignedURL(ctx, projectID, postID, fileName)
+		md.MetadataStripped = stripped
+		err = s.storeContent(ctx, md, file, func(repo Repository) error {
+			_, err := repo.SaveMetadata(ctx, md)
+			return err
+		})
 		if err != nil {
 			return err
 		}
-		_, err = s.repo.SaveMetadata(ctx, md)
+		mediaUrl, err = s.signContent(ctx, md)
 		return err
 	})
 
 	var thumbnailUrl string
 	g.Go(func() error {
 		var err error
-		if processor.GetMediaType() == MediaTypeDocument {
-			return nil
-		}
 		thumbnailFileName := getThumbnailName(fileName)
-		tmd, err = NewMetadata(postID, userID, thumbnailFileName, altText, *thumnail, tMediaInfo)
+		tmd, err = NewMetadata(projectID, postID, userID, thumbnailFileName, altText, tMediaInfo)
 		if err != nil {
 			return err
 		}
-		err = s.objectRepo.UploadFile(ctx, projectID, postID, thumbnailFileName, *thumnail, tmd)
+		err = s.objectRepo.UploadFile(ctx, projectID, postID, thumbnailFileName, bytes.NewReader(thumnail), tmd)
 		if err != nil {
 			return err
 		}
@@ -125,6 +219,12 @@ func (s *service) UploadMedia(ctx context.Context, projectID, postID, fileName,
 	if err = g.Wait(); err != nil {
 		return DownloadMetaData{}, err
 	}
+	if staged {
+		err = s.objectRepo.DeleteStagedFile(ctx, u.ID)
+		if err != nil {
+			return DownloadMetaData{}, err
+		}
+	}
 
 	fmt.Println("mediaUrl", mediaUrl)
 	fmt.Println("thumbnailUrl", thumbnailUrl)
@@ -136,39 +236,163 @@ func (s *service) UploadMedia(ctx context.Context, projectID, postID, fileName,
 	}, nil
 }
 
-func (s *service) DeleteMedia(ctx context.Context, projectID, postID, metaID string) error {
+// analyzeMedia gets the media info, and the thumbnail with its info
+func analyzeMedia(processor MediaProcessor, file *File) (*MediaInfo, []byte, *MediaInfo, error) {
 	var (
-		fileName string
-		eg       errgroup.Group
+		g          errgroup.Group
+		mediaInfo  *MediaInfo
+		tMediaInfo *MediaInfo
+		thumbnail  []byte
 	)
 
-	eg.Go(func() error {
+	g.Go(func() error {
 		var err error
-		fileName, err = s.repo.GetMediaFileName(ctx, metaID)
+		mediaInfo, err = processor.Analyze(file)
 		return err
 	})
+	g.Go(func() error {
+		t, err := processor.GetThumbnail(file)
+		if err != nil {
+			return err
+		}
+		thumbnail = *t
 
-	eg.Go(func() error {
-		return s.repo.DeleteMetadata(ctx, metaID)
+		tMediaInfo, err = analyzeThumbnail(thumbnail)
+		return err
 	})
 
-	if err := eg.Wait(); err != nil {
-		return err
+	if err := g.Wait(); err != nil {
+		return nil, nil, nil, errors.Join(ErrFailedToAnalyzeMedia, err)
 	}
+	return mediaInfo, thumbnail, tMediaInfo, nil
+}
 
-	if fileName == "" {
-		return nil
+// StoreMediaFiles analyzes the media and uploads it with its thumbnail to the object storage, without saving
+// the metadata. It returns the metadata of the media, followed by the one of its thumbnail if any, so the caller
+// can save them along with other changes. If saving fails, the files must be removed with DeleteMediaFiles.
+// The content is only locked while it is stored, until the metadata is saved the blob isn't kept from the deletion
+// of another media with the same content.
+func (s *service) StoreMediaFiles(ctx context.Context, projectID, postID, fileName, altText string, r io.Reader) ([]*MetaData, error) {
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+
+	processor, err := GetProcessor(fileName)
+	if err != nil {
+		return nil, err
 	}
 
+	file, err := NewFile(r, s.limits[processor.GetMediaType()])
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	file, stripped, err := s.strip(ctx, file, fileName)
+	if err != nil {
+		return nil, err
+	}
+	if stripped {
+		defer file.Close()
+	}
+
+	mediaInfo, thumbnail, tMediaInfo, err := analyzeMedia(processor, file)
+	if err != nil {
+		return nil, err
+	}
+
+	md, err := NewMetadata(projectID, postID, userID, fileName, altText, mediaInfo)
+	if err != nil {
+		return nil, err
+	}
+	md.MetadataStripped = stripped
+	err = s.storeContent(ctx, md, file, nil)
+	if err != nil {
+		return nil, err
+	}
+	if thumbnail == nil {
+		return []*MetaData{md}, nil
+	}
+
+	thumbnailFileName := getThumbnailName(fileName)
+	tmd, err := NewMetadata(projectID, postID, userID, thumbnailFileName, altText, tMediaInfo)
+	if err != nil {
+		return nil, errors.Join(err, s.deleteContent(ctx, md))
+	}
+	err = s.objectRepo.UploadFile(ctx, projectID, postID, thumbnailFileName, bytes.NewReader(thumbnail), tmd)
+	if err != nil {
+		return nil, errors.Join(err, s.deleteContent(ctx, md))
+	}
+	return []*MetaData{md, tmd}, nil
+}
+
+// DeleteMediaFiles removes the files of media whose metadata wasn't saved from the object storage. Blobs other
+// media have are kept.
+func (s *service) DeleteMediaFiles(ctx context.Context, mds []*MetaData) error {
+	var errs []error
+	for _, md := range mds {
+		errs = append(errs, s.deleteContent(ctx, md))
+	}
+	return errors.Join(errs...)
+}
+
+// PostFilesRemover lists the files, thumbnails, renditions and blobs of the media of a post, and returns what
+// removes them from the object store. It must run once the post and its metadata are deleted: blobs are then
+// only removed when no media has them anymore.
+func (s *service) PostFilesRemover(ctx context.Context, projectID, postID string) (func(ctx context.Context) error, error) {
+	fileNames, err := s.repo.ListMediaFilesForPost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := s.repo.FindUnsharedContent(ctx, projectID, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) error {
+		var errs []error
+		for _, fileName := range fileNames {
+			errs = append(errs, s.objectRepo.DeleteFile(ctx, projectID, postID, fileName))
+			errs = append(errs, s.objectRepo.DeleteFile(ctx, projectID, postID, getThumbnailName(fileName)))
+			errs = append(errs, s.deleteRenditionFiles(ctx, projectID, postID, fileName))
+		}
+		for _, hash := range hashes {
+			// A media out of the post may have been given the content meanwhile, deleteContent checks it again
+			errs = append(errs, s.deleteContent(ctx, &MetaData{ProjectID: projectID, ContentHash: hash}))
+		}
+		return errors.Join(errs...)
+	}, nil
+}
+
+// DeleteMedia deletes a media of a post with its thumbnail and renditions, unless another post or the branding of the
+// project uses it. Library media are deleted with DeleteLibraryMedia.
+func (s *service) DeleteMedia(ctx context.Context, projectID, postID, mediaID string) error {
+	// The metadata is read first, the content hash tells where the file is
+	md, err := s.repo.FindPostMediaByID(ctx, projectID, postID, mediaID)
+	if err != nil {
+		return err
+	}
+	if md == nil {
+		return ErrMediaNotFound
+	}
+	fileName := md.Filename
+	thumbnailFileName := getThumbnailName(fileName)
+	err = s.repo.DeletePostMedia(ctx, projectID, postID, mediaID, thumbnailFileName)
+	if err != nil {
+		return err
+	}
+
+	var eg errgroup.Group
 	eg.Go(func() error {
-		return s.objectRepo.DeleteFile(ctx, projectID, postID, fileName)
+		return s.deleteContent(ctx, md)
 	})
 
 	eg.Go(func() error {
-		thumbnailFileName := getThumbnailName(fileName)
 		return s.objectRepo.DeleteFile(ctx, projectID, postID, thumbnailFileName)
 	})
 
+	eg.Go(func() error {
+		return s.deleteRenditionFiles(ctx, projectID, postID, fileName)
+	})
+
 	if err := eg.Wait(); err != nil {
 		return err
 	}
@@ -176,57 +400,69 @@ func (s *service) DeleteMedia(ctx context.Context, projectID, postID, metaID str
 	return nil
 }
 
+// GetMediaFile downloads a media to a temporary file. The caller must close the media to remove it.
 func (s *service) GetMediaFile(ctx context.Context, projectID, postID, fileName string) (*Media, error) {
-	var (
-		file     []byte
-		metadata *MetaData
-		eg       errgroup.Group
-	)
-
-	eg.Go(func() error {
-		var err error
-		file, err = s.objectRepo.GetFile(ctx, projectID, postID, fileName)
-		return err
-	})
-
-	eg.Go(func() error {
-		var err error
-		metadata, err = s.repo.GetMetadata(ctx, postID, fileName)
-		return err
-	})
+	metadata, err := s.repo.GetMetadata(ctx, projectID, postID, fileName)
+	if err != nil {
+		return nil, err
+	}
+	if metadata == nil {
+		return nil, ErrFileNotFound
+	}
 
-	if err := eg.Wait(); err != nil {
+	body, err := s.getContent(ctx, metadata)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	file, err := NewFile(body, 0)
+	if err != nil {
 		return nil, err
 	}
 
 	return &Media{
-		Data:     file,
+		File:     file,
 		MetaData: metadata,
 	}, nil
 }
 
+// GetMediaForPublishPost downloads the media of a publish post to temporary files, with videos replaced by their
+// rendition for the platform when it can't publish them as they are. Images get the branding of the project when
+// the post opted in for the platform. It's meant for publishing only, since it may render and stamp the media,
+// validations use GetMediaDetailsForPublishPost. The caller must close them.
 func (s *service) GetMediaForPublishPost(ctx context.Context, projectID, postID, platformID string) ([]*Media, error) {
-	filenames, err := s.repo.GetMediaFileNamesForPublishPost(ctx, postID, platformID)
+	mds, err := s.repo.GetMediaForPublishPost(ctx, postID, platformID)
+	if err != nil {
+		return nil, err
+	}
+	branding, logo, err := s.getPublishBranding(ctx, projectID, postID, platformID)
 	if err != nil {
 		return nil, err
 	}
 	var (
-		medias  = make([]*Media, len(filenames))
+		medias  = make([]*Media, len(mds))
 		g, gCtx = errgroup.WithContext(ctx)
 	)
 
-	for i, mediaName := range filenames {
-		i, name := i, mediaName
+	// The media may come from the project library, so it's looked for where its metadata says
+	for i, md := range mds {
+		i, md := i, md
 		g.Go(func() error {
-			media, err := s.GetMediaFile(gCtx, projectID, postID, name)
+			media, err := s.getMediaForPlatform(gCtx, projectID, md, platformID)
 			if err != nil {
 				return err
 			}
+			if branding != nil && media.MetaData.IsImage() && media.MetaData.IsReady() {
+				err = s.stampMedia(media, branding, logo, platformID)
+				if err != nil {
+					return errors.Join(err, media.Close())
+				}
+			}
 			if media.MetaData.IsVideo() {
-				thumbnailName := getThumbnailName(name)
-				thumbnail, err := s.GetMediaFile(gCtx, projectID, postID, thumbnailName)
+				thumbnailName := getThumbnailName(md.Filename)
+				thumbnail, err := s.GetMediaFile(gCtx, projectID, md.PostID, thumbnailName)
 				if err != nil {
-					return err
+					return errors.Join(err, media.Close())
 				}
 				media.Thumbnail = thumbnail
 			}
@@ -236,26 +472,45 @@ func (s *service) GetMediaForPublishPost(ctx context.Context, projectID, postID,
 	}
 
 	if err := g.Wait(); err != nil {
-		return nil, err
+		return nil, errors.Join(err, CloseAll(medias))
 	}
 
 	return medias, nil
 }
 
+// GetMediaDetailsForPublishPost returns the media of a publish post without downloading them, with the metadata of
+// their renditions for the platform. Nothing is rendered nor stamped with the branding, which keeps the size and
+// the format of images within the profile of the platform, so the post can be validated within a request.
+func (s *service) GetMediaDetailsForPublishPost(ctx context.Context, projectID, postID, platformID string) ([]*Media, error) {
+	mds, err := s.repo.GetMediaForPublishPost(ctx, postID, platformID)
+	if err != nil {
+		return nil, err
+	}
+	medias := make([]*Media, len(mds))
+	for i, md := range mds {
+		details, err := s.getDetailsForPlatform(ctx, md, platformID)
+		if err != nil {
+			return nil, err
+		}
+		medias[i] = &Media{MetaData: details}
+	}
+	return medias, nil
+}
+
 func (s *service) GetDownloadMetadataForPublishPost(ctx context.Context, projectID, postID, platformID string) ([]*DownloadMetaData, error) {
-	filenames, err := s.repo.GetMediaFileNamesForPublishPost(ctx, postID, platformID)
+	mds, err := s.repo.GetMediaForPublishPost(ctx, postID, platformID)
 	if err != nil {
 		return nil, err
 	}
 	var (
-		downloadMetaDatas = make([]*DownloadMetaData, len(filenames))
+		downloadMetaDatas = make([]*DownloadMetaData, len(mds))
 		g, gCtx           = errgroup.WithContext(ctx)
 	)
 
-	for i, filename := range filenames {
-		i, filename := i, filename
+	for i, md := range mds {
+		i, md := i, md
 		g.Go(func() error {
-			downloadMetaData, err := s.GetDownloadMetaData(gCtx, projectID, postID, filename)
+			downloadMetaData, err := s.GetDownloadMetaData(gCtx, projectID, md.PostID, md.Filename)
 			if err != nil {
 				return err
 			}
@@ -276,6 +531,7 @@ func (s *service) LinkMediaToPublishPost(ctx context.Context, projectID, postID,
 	var (
 		doesPostBelongToProject   bool
 		doesMediaBelongToPost     bool
+		isMediaInLibrary          bool
 		isThePostLinkedToPlatform bool
 		isPlatformEnabled         bool
 		isAlreadyLinked           bool
@@ -295,6 +551,12 @@ func (s *service) LinkMediaToPublishPost(ctx context.Context, projectID, postID,
 		return err
 	})
 
+	g.Go(func() error {
+		var err error
+		isMediaInLibrary, err = s.repo.IsMediaInLibrary(gCtx, projectID, mediaID)
+		return err
+	})
+
 	g.Go(func() error {
 		var err error
 		isPlatformEnabled, err = s.repo.IsPlatformEnabledForProject(gCtx, projectID, platformID)
@@ -320,7 +582,7 @@ func (s *service) LinkMediaToPublishPost(ctx context.Context, projectID, postID,
 	if !doesPostBelongToProject {
 		return ErrPostDoesNotBelongToProject
 	}
-	if !doesMediaBelongToPost {
+	if !doesMediaBelongToPost && !isMediaInLibrary {
 		return ErrMediaDoesNotBelongToPost
 	}
 	if !isPlatformEnabled {
@@ -379,15 +641,18 @@ func (s *service) GetDownloadMetaData(ctx context.Context, projectID, postID, fi
 		eg            errgroup.Group
 	)
 
+	// The media is signed once its metadata tells where it's stored
 	eg.Go(func() error {
 		var err error
-		mediaUrl, err = s.objectRepo.GetSignedURL(ctx, projectID, postID, fileName)
-		return err
-	})
-
-	eg.Go(func() error {
-		var err error
-		metadata, err = s.repo.GetMetadata(ctx, postID, fileName)
+		metadata, err = s.repo.GetMetadata(ctx, projectID, postID, fileName)
+		if err != nil {
+			return err
+		}
+		if metadata == nil {
+			mediaUrl, err = s.objectRepo.GetSignedURL(ctx, projectID, postID, fileName)
+			return err
+		}
+		mediaUrl, err = s.signContent(ctx, metadata)
 		return err
 	})
 
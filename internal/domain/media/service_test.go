@@ -0,0 +1,50 @@
+package media
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteMedia(t *testing.T) {
+	ctx := context.Background()
+	md := &MetaData{ID: "media-1", ProjectID: "project-1", PostID: "post-1", Filename: "photo.png"}
+
+	t.Run("deletes the metadata and the files", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		repo.EXPECT().FindPostMediaByID(ctx, "project-1", "post-1", "media-1").Return(md, nil)
+		repo.EXPECT().DeletePostMedia(ctx, "project-1", "post-1", "media-1", getThumbnailName("photo.png")).Return(nil)
+		objectRepo.EXPECT().DeleteFile(ctx, "project-1", "post-1", mock.Anything).Return(nil)
+
+		err := NewService(repo, objectRepo).DeleteMedia(ctx, "project-1", "post-1", "media-1")
+
+		assert.NoError(t, err)
+		objectRepo.AssertCalled(t, "DeleteFile", ctx, "project-1", "post-1", "photo.png")
+		objectRepo.AssertCalled(t, "DeleteFile", ctx, "project-1", "post-1", getThumbnailName("photo.png"))
+	})
+
+	t.Run("refuses media used elsewhere", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		repo.EXPECT().FindPostMediaByID(ctx, "project-1", "post-1", "media-1").Return(md, nil)
+		repo.EXPECT().DeletePostMedia(ctx, "project-1", "post-1", "media-1", getThumbnailName("photo.png")).Return(ErrMediaInUse)
+
+		err := NewService(repo, objectRepo).DeleteMedia(ctx, "project-1", "post-1", "media-1")
+
+		assert.ErrorIs(t, err, ErrMediaInUse)
+	})
+
+	t.Run("returns not found for media out of the post", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		objectRepo := NewMockObjectRepository(t)
+		// Library media and media of other posts or projects aren't found
+		repo.EXPECT().FindPostMediaByID(ctx, "project-1", "post-1", "media-1").Return(nil, nil)
+
+		err := NewService(repo, objectRepo).DeleteMedia(ctx, "project-1", "post-1", "media-1")
+
+		assert.ErrorIs(t, err, ErrMediaNotFound)
+	})
+}
@@ -0,0 +1,670 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"golang.org/x/image/draw"
+)
+
+var errInvalidContainer = errors.New("invalid image container")
+
+// stripVideoTimeout bounds the copy of a video without its metadata, the streams aren't encoded again
+const stripVideoTimeout = 5 * time.Minute
+
+// MetadataStripper removes the metadata that tells where, when and with what a media was made, like the EXIF
+// and GPS tags of photos, before it's stored
+type MetadataStripper interface {
+	// Strip returns a copy of the file without metadata. format is the extension of the file. It returns nil
+	// when the format has no metadata it can strip.
+	Strip(ctx context.Context, f *File, format string) (*File, error)
+}
+
+// PrivacyStripper strips images itself, rewriting their container, and videos with ffmpeg.
+// JPEG and PNG images are rotated as their EXIF orientation says, since the tag goes away. WebP images are not,
+// their EXIF orientation is ignored when they are displayed. HEIC images keep their rotation in properties of the
+// container, their EXIF and XMP items are blanked in place.
+type PrivacyStripper struct{}
+
+func NewPrivacyStripper() *PrivacyStripper {
+	return &PrivacyStripper{}
+}
+
+func (p *PrivacyStripper) Strip(ctx context.Context, f *File, format string) (*File, error) {
+	var (
+		data []byte
+		err  error
+	)
+	switch format {
+	case "jpg", "jpeg":
+		data, err = readAndStrip(f, stripJPEG)
+	case "png":
+		data, err = readAndStrip(f, stripPNG)
+	case "webp":
+		data, err = readAndStrip(f, stripWebP)
+	case "gif":
+		data, err = readAndStrip(f, stripGIF)
+	case "heic", "heif":
+		data, err = readAndStrip(f, stripHEIF)
+	case "mp4", "mov":
+		return stripVideo(ctx, f, format)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewFile(bytes.NewReader(data), 0)
+}
+
+func readAndStrip(f *File, strip func([]byte) ([]byte, error)) ([]byte, error) {
+	data, err := io.ReadAll(f.Reader())
+	if err != nil {
+		return nil, err
+	}
+	return strip(data)
+}
+
+// stripJPEG drops the EXIF, XMP and IPTC segments, the comments, and the images embedded after the main one.
+// The color profile is kept.
+func stripJPEG(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, errInvalidContainer
+	}
+
+	var (
+		out         = bytes.NewBuffer(make([]byte, 0, len(data)))
+		iccSegments [][]byte
+		orientation = 1
+	)
+	out.Write(data[:2])
+	pos := 2
+	for {
+		if pos+4 > len(data) || data[pos] != 0xFF {
+			return nil, errInvalidContainer
+		}
+		marker := data[pos+1]
+		if marker == 0xDA {
+			// Start of scan, the compressed image runs until the end of image marker
+			end := jpegImageEnd(data, pos)
+			out.Write(data[pos:end])
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, errInvalidContainer
+		}
+		segment, payload := data[pos:end], data[pos+4:end]
+
+		switch {
+		case marker == 0xE1 && bytes.HasPrefix(payload, []byte("Exif\x00\x00")):
+			orientation = exifOrientation(payload[6:])
+		case marker == 0xE1, marker == 0xED, marker == 0xFE:
+			// XMP, IPTC and comments
+		case marker == 0xE2 && bytes.HasPrefix(payload, []byte("MPF\x00")):
+			// Index of the images embedded after the main one
+		case marker == 0xE2 && bytes.HasPrefix(payload, []byte("ICC_PROFILE\x00")):
+			iccSegments = append(iccSegments, segment)
+			out.Write(segment)
+		default:
+			out.Write(segment)
+		}
+		pos = end
+	}
+
+	if orientation == 1 {
+		return out.Bytes(), nil
+	}
+
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	var encoded bytes.Buffer
+	err = jpeg.Encode(&encoded, applyOrientation(img, orientation), &jpeg.Options{Quality: 95})
+	if err != nil {
+		return nil, err
+	}
+	// The color profile goes right after the start of image, as it was
+	rotated := append([]byte{}, encoded.Bytes()[:2]...)
+	for _, segment := range iccSegments {
+		rotated = append(rotated, segment...)
+	}
+	return append(rotated, encoded.Bytes()[2:]...), nil
+}
+
+// jpegImageEnd returns the position after the end of image marker, or the end of data without one.
+// Bytes 0xFF in the compressed data are followed by 0x00 or by a restart marker, so the first end of image
+// marker is the one of the main image.
+func jpegImageEnd(data []byte, pos int) int {
+	for i := pos; i+1 < len(data); i++ {
+		if data[i] == 0xFF && data[i+1] == 0xD9 {
+			return i + 2
+		}
+	}
+	return len(data)
+}
+
+// stripPNG drops the EXIF, text and time chunks. XMP is stored in a text chunk.
+func stripPNG(data []byte) ([]byte, error) {
+	const signature = "\x89PNG\r\n\x1a\n"
+	if !bytes.HasPrefix(data, []byte(signature)) {
+		return nil, errInvalidContainer
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.WriteString(signature)
+	orientation := 1
+	for pos := len(signature); pos < len(data); {
+		if pos+8 > len(data) {
+			return nil, errInvalidContainer
+		}
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		end := pos + 12 + length
+		if end > len(data) {
+			return nil, errInvalidContainer
+		}
+		switch string(data[pos+4 : pos+8]) {
+		case "eXIf":
+			orientation = exifOrientation(data[pos+8 : pos+8+length])
+		case "tEXt", "zTXt", "iTXt", "tIME":
+		default:
+			out.Write(data[pos:end])
+		}
+		pos = end
+	}
+
+	if orientation == 1 {
+		return out.Bytes(), nil
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	var encoded bytes.Buffer
+	err = png.Encode(&encoded, applyOrientation(img, orientation))
+	return encoded.Bytes(), err
+}
+
+// stripWebP drops the EXIF and XMP chunks, and clears their flags in the extended header
+func stripWebP(data []byte) ([]byte, error) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, errInvalidContainer
+	}
+
+	var chunks bytes.Buffer
+	for pos := 12; pos < len(data); {
+		if pos+8 > len(data) {
+			return nil, errInvalidContainer
+		}
+		fourCC := string(data[pos : pos+4])
+		length := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + length + length%2 // chunks are padded to an even size
+		if end > len(data) {
+			return nil, errInvalidContainer
+		}
+		switch fourCC {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			chunk := append([]byte{}, data[pos:end]...)
+			if length > 0 {
+				const exifFlag, xmpFlag = 0x08, 0x04
+				chunk[8] &^= exifFlag | xmpFlag
+			}
+			chunks.Write(chunk)
+		default:
+			chunks.Write(data[pos:end])
+		}
+		pos = end
+	}
+
+	out := make([]byte, 12, 12+chunks.Len())
+	copy(out, "RIFF")
+	binary.LittleEndian.PutUint32(out[4:], uint32(4+chunks.Len()))
+	copy(out[8:], "WEBP")
+	return append(out, chunks.Bytes()...), nil
+}
+
+// stripGIF drops the comments and the application extensions, where XMP is stored, but the one telling how many
+// times an animation loops
+func stripGIF(data []byte) ([]byte, error) {
+	const headerSize = 13
+	if len(data) < headerSize || (string(data[:6]) != "GIF87a" && string(data[:6]) != "GIF89a") {
+		return nil, errInvalidContainer
+	}
+	pos := headerSize + colorTableSize(data[10])
+	if pos > len(data) {
+		return nil, errInvalidContainer
+	}
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(data[:pos])
+
+	for {
+		if pos >= len(data) {
+			return nil, errInvalidContainer
+		}
+		switch data[pos] {
+		case 0x3B: // trailer
+			out.WriteByte(0x3B)
+			return out.Bytes(), nil
+		case 0x2C: // image descriptor, then its color table, the LZW code size and the image data
+			if pos+10 > len(data) {
+				return nil, errInvalidContainer
+			}
+			start := pos
+			pos += 10 + colorTableSize(data[pos+9]) + 1
+			end, err := gifSubBlocksEnd(data, pos)
+			if err != nil {
+				return nil, err
+			}
+			out.Write(data[start:end])
+			pos = end
+		case 0x21: // extension
+			if pos+2 > len(data) {
+				return nil, errInvalidContainer
+			}
+			end, err := gifSubBlocksEnd(data, pos+2)
+			if err != nil {
+				return nil, err
+			}
+			label := data[pos+1]
+			looping := label == 0xFF && pos+14 <= end &&
+				(string(data[pos+3:pos+14]) == "NETSCAPE2.0" || string(data[pos+3:pos+14]) == "ANIMEXTS1.0")
+			if (label != 0xFE && label != 0xFF) || looping {
+				out.Write(data[pos:end])
+			}
+			pos = end
+		default:
+			return nil, errInvalidContainer
+		}
+	}
+}
+
+// colorTableSize returns the size of the color table a packed field of a GIF descriptor announces
+func colorTableSize(packed byte) int {
+	if packed&0x80 == 0 {
+		return 0
+	}
+	return 3 << (packed&0x07 + 1)
+}
+
+// gifSubBlocksEnd returns the position after the sub-blocks starting at pos, ended by an empty one
+func gifSubBlocksEnd(data []byte, pos int) (int, error) {
+	for {
+		if pos >= len(data) {
+			return 0, errInvalidContainer
+		}
+		size := int(data[pos])
+		pos += 1 + size
+		if size == 0 {
+			return pos, nil
+		}
+	}
+}
+
+// heifBox is a box of an ISO base media file, its payload runs from start to end
+type heifBox struct {
+	boxType    string
+	start, end int
+}
+
+// heifBoxes lists the boxes in data[pos:end]
+func heifBoxes(data []byte, pos, end int) ([]heifBox, error) {
+	var boxes []heifBox
+	for pos < end {
+		if pos+8 > end {
+			return nil, errInvalidContainer
+		}
+		size := uint64(binary.BigEndian.Uint32(data[pos:]))
+		boxType := string(data[pos+4 : pos+8])
+		header := 8
+		switch size {
+		case 0:
+			size = uint64(end - pos)
+		case 1:
+			if pos+16 > end {
+				return nil, errInvalidContainer
+			}
+			size = binary.BigEndian.Uint64(data[pos+8:])
+			header = 16
+		}
+		if size < uint64(header) || size > uint64(end-pos) {
+			return nil, errInvalidContainer
+		}
+		boxes = append(boxes, heifBox{boxType: boxType, start: pos + header, end: pos + int(size)})
+		pos += int(size)
+	}
+	return boxes, nil
+}
+
+func findHEIFBox(boxes []heifBox, boxType string) (heifBox, bool) {
+	for _, b := range boxes {
+		if b.boxType == boxType {
+			return b, true
+		}
+	}
+	return heifBox{}, false
+}
+
+// stripHEIF blanks the EXIF and XMP items of a HEIF file. Removing them would move the image data the item
+// locations point to, so their bytes are zeroed where they are.
+func stripHEIF(data []byte) ([]byte, error) {
+	top, err := heifBoxes(data, 0, len(data))
+	if err != nil {
+		return nil, err
+	}
+	if len(top) == 0 || top[0].boxType != "ftyp" {
+		return nil, errInvalidContainer
+	}
+	meta, ok := findHEIFBox(top, "meta")
+	if !ok {
+		return data, nil
+	}
+	// meta is a full box, its version and flags come before the boxes it holds
+	if meta.start+4 > meta.end {
+		return nil, errInvalidContainer
+	}
+	boxes, err := heifBoxes(data, meta.start+4, meta.end)
+	if err != nil {
+		return nil, err
+	}
+	iinf, ok := findHEIFBox(boxes, "iinf")
+	if !ok {
+		return data, nil
+	}
+	items, err := heifMetadataItems(data, iinf)
+	if err != nil || len(items) == 0 {
+		return data, err
+	}
+	iloc, ok := findHEIFBox(boxes, "iloc")
+	if !ok {
+		return nil, errInvalidContainer
+	}
+	idat, _ := findHEIFBox(boxes, "idat")
+
+	out := append([]byte{}, data...)
+	err = forEachHEIFExtent(data, iloc, func(itemID uint32, method int, offset, length uint64) error {
+		if !items[itemID] {
+			return nil
+		}
+		base, end := 0, len(out)
+		switch method {
+		case 0: // offset in the file
+		case 1: // offset in the idat box
+			if idat.boxType == "" {
+				return errInvalidContainer
+			}
+			base, end = idat.start, idat.end
+		default:
+			return nil
+		}
+		size := uint64(end - base)
+		if offset > size {
+			return errInvalidContainer
+		}
+		if length == 0 {
+			// The extent runs until the end of the file, or of the idat box
+			length = size - offset
+		}
+		if length > size-offset {
+			return errInvalidContainer
+		}
+		clear(out[base+int(offset) : base+int(offset+length)])
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// heifMetadataItems returns the ids of the EXIF and XMP items listed in an iinf box
+func heifMetadataItems(data []byte, iinf heifBox) (map[uint32]bool, error) {
+	pos := iinf.start + 4
+	if iinf.start+4 > iinf.end {
+		return nil, errInvalidContainer
+	}
+	if data[iinf.start] == 0 {
+		pos += 2
+	} else {
+		pos += 4
+	}
+	if pos > iinf.end {
+		return nil, errInvalidContainer
+	}
+	entries, err := heifBoxes(data, pos, iinf.end)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[uint32]bool)
+	for _, infe := range entries {
+		if infe.boxType != "infe" || infe.start+4 > infe.end {
+			continue
+		}
+		version := data[infe.start]
+		pos := infe.start + 4
+		var itemID uint32
+		switch version {
+		case 2:
+			if pos+2 > infe.end {
+				return nil, errInvalidContainer
+			}
+			itemID = uint32(binary.BigEndian.Uint16(data[pos:]))
+			pos += 2
+		case 3:
+			if pos+4 > infe.end {
+				return nil, errInvalidContainer
+			}
+			itemID = binary.BigEndian.Uint32(data[pos:])
+			pos += 4
+		default:
+			// Older entries have no item type
+			continue
+		}
+		pos += 2 // protection index
+		if pos+4 > infe.end {
+			return nil, errInvalidContainer
+		}
+		switch string(data[pos : pos+4]) {
+		case "Exif":
+			items[itemID] = true
+		case "mime":
+			// XMP is a mime item, its content type follows its name
+			fields := bytes.SplitN(data[pos+4:infe.end], []byte{0}, 3)
+			if len(fields) > 1 && string(fields[1]) == "application/rdf+xml" {
+				items[itemID] = true
+			}
+		}
+	}
+	return items, nil
+}
+
+// forEachHEIFExtent calls fn with the extents of the items of an iloc box
+func forEachHEIFExtent(data []byte, iloc heifBox, fn func(itemID uint32, method int, offset, length uint64) error) error {
+	r := &boxReader{data: data[:iloc.end], pos: iloc.start}
+	version := r.uint(1)
+	r.pos += 3 // flags
+	sizes := r.uint(2)
+	offsetSize, lengthSize := int(sizes>>12&0xF), int(sizes>>8&0xF)
+	baseOffsetSize, indexSize := int(sizes>>4&0xF), int(sizes&0xF)
+	if version == 0 {
+		indexSize = 0
+	}
+	itemCount := r.uint(2)
+	if version == 2 {
+		itemCount = r.uint(4)
+	}
+
+	for i := uint64(0); i < itemCount && r.err == nil; i++ {
+		var itemID uint64
+		if version == 2 {
+			itemID = r.uint(4)
+		} else {
+			itemID = r.uint(2)
+		}
+		method := 0
+		if version > 0 {
+			method = int(r.uint(2) & 0xF)
+		}
+		r.pos += 2 // data reference index
+		baseOffset := r.uint(baseOffsetSize)
+		extents := r.uint(2)
+		for j := uint64(0); j < extents && r.err == nil; j++ {
+			r.pos += indexSize
+			offset, length := r.uint(offsetSize), r.uint(lengthSize)
+			if r.err != nil {
+				break
+			}
+			if err := fn(uint32(itemID), method, baseOffset+offset, length); err != nil {
+				return err
+			}
+		}
+	}
+	return r.err
+}
+
+// boxReader reads the big endian fields of a box, remembering the first read past its end
+type boxReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *boxReader) uint(size int) uint64 {
+	if r.err != nil || r.pos < 0 || r.pos+size > len(r.data) {
+		r.err = errInvalidContainer
+		return 0
+	}
+	var v uint64
+	for _, b := range r.data[r.pos : r.pos+size] {
+		v = v<<8 | uint64(b)
+	}
+	r.pos += size
+	return v
+}
+
+// exifOrientation reads the orientation tag of EXIF data starting with its TIFF header. It's 1, the image as it
+// is stored, when there is none.
+func exifOrientation(exif []byte) int {
+	if len(exif) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(exif[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(exif[4:]))
+	if ifd < 8 || ifd+2 > len(exif) {
+		return 1
+	}
+	entries := int(order.Uint16(exif[ifd:]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(exif) {
+			return 1
+		}
+		if order.Uint16(exif[entry:]) == 0x0112 {
+			orientation := int(order.Uint16(exif[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+			return orientation
+		}
+	}
+	return 1
+}
+
+// applyOrientation returns the image the way an EXIF orientation says it must be displayed
+func applyOrientation(img image.Image, orientation int) image.Image {
+	bounds := img.Bounds()
+	src := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+
+	width, height := bounds.Dx(), bounds.Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored
+				dx, dy = width-1-x, y
+			case 3: // rotated 180°
+				dx, dy = width-1-x, height-1-y
+			case 4: // mirrored vertically
+				dx, dy = x, height-1-y
+			case 5: // mirrored along the top left to bottom right diagonal
+				dx, dy = y, x
+			case 6: // rotated 90° clockwise
+				dx, dy = height-1-y, x
+			case 7: // mirrored along the top right to bottom left diagonal
+				dx, dy = height-1-y, width-1-x
+			case 8: // rotated 90° counterclockwise
+				dx, dy = y, width-1-x
+			default:
+				dx, dy = x, y
+			}
+			copy(dst.Pix[dst.PixOffset(dx, dy):dst.PixOffset(dx, dy)+4], src.Pix[src.PixOffset(x, y):src.PixOffset(x, y)+4])
+		}
+	}
+	return dst
+}
+
+// stripVideo copies the video and audio streams to a new file without the metadata of the container, like the
+// location and the device. Data streams, where some cameras record GPS tracks, are left out. The rotation of the
+// video is kept with the streams.
+func stripVideo(ctx context.Context, f *File, format string) (*File, error) {
+	ctx, cancel := context.WithTimeout(ctx, stripVideoTimeout)
+	defer cancel()
+
+	out, err := os.CreateTemp("", "stripped-*."+format)
+	if err != nil {
+		return nil, err
+	}
+	path := out.Name()
+	if err := out.Close(); err != nil {
+		return nil, errors.Join(err, os.Remove(path))
+	}
+
+	cmd := exec.CommandContext(ctx, "ffmpeg",
+		"-y", "-v", "error",
+		"-i", f.Path(),
+		"-map", "0:v",
+		"-map", "0:a?",
+		"-c", "copy",
+		"-map_metadata", "-1",
+		"-map_chapters", "-1",
+		"-movflags", "+faststart",
+		path,
+	)
+	cmd.Stdout = nil
+	cmd.Stderr = nil
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Join(fmt.Errorf("ffmpeg failed: %w", err), os.Remove(path))
+	}
+
+	return openFile(path)
+}
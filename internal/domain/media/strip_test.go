@@ -0,0 +1,322 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+)
+
+// exifWithOrientation returns EXIF data with only an orientation tag, in the given byte order
+func exifWithOrientation(order binary.ByteOrder, orientation uint16) []byte {
+	b := make([]byte, 8+2+12+4)
+	if order == binary.LittleEndian {
+		copy(b, "II")
+	} else {
+		copy(b, "MM")
+	}
+	order.PutUint16(b[2:], 42)
+	order.PutUint32(b[4:], 8)
+	order.PutUint16(b[8:], 1)
+	order.PutUint16(b[10:], 0x0112)
+	order.PutUint16(b[12:], 3) // SHORT
+	order.PutUint32(b[14:], 1)
+	order.PutUint16(b[18:], orientation)
+	return b
+}
+
+// jpegWithExif returns a JPEG of the given size, with an EXIF segment right after the start of image
+func jpegWithExif(t *testing.T, width, height int, orientation uint16) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+	var b bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&b, img, nil))
+
+	payload := append([]byte("Exif\x00\x00"), exifWithOrientation(binary.BigEndian, orientation)...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+	comment := []byte{0xFF, 0xFE, 0, 9, 'h', 'o', 'm', 'e', '!', '!', '!'}
+
+	data := append([]byte{}, b.Bytes()[:2]...)
+	data = append(data, segment...)
+	data = append(data, comment...)
+	return append(data, b.Bytes()[2:]...)
+}
+
+func TestExifOrientation(t *testing.T) {
+	assert.Equal(t, 6, exifOrientation(exifWithOrientation(binary.BigEndian, 6)))
+	assert.Equal(t, 8, exifOrientation(exifWithOrientation(binary.LittleEndian, 8)))
+	assert.Equal(t, 1, exifOrientation(exifWithOrientation(binary.LittleEndian, 42)), "invalid orientation")
+	assert.Equal(t, 1, exifOrientation([]byte("not exif")))
+}
+
+func TestApplyOrientation(t *testing.T) {
+	red := color.NRGBA{R: 255, A: 255}
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, red)
+
+	rotated := applyOrientation(img, 6).(*image.NRGBA)
+	assert.Equal(t, image.Rect(0, 0, 1, 2), rotated.Bounds())
+	assert.Equal(t, red, rotated.NRGBAAt(0, 0), "the top left corner stays on top once turned clockwise")
+
+	rotated = applyOrientation(img, 8).(*image.NRGBA)
+	assert.Equal(t, red, rotated.NRGBAAt(0, 1), "the top left corner goes down once turned counterclockwise")
+
+	mirrored := applyOrientation(img, 2).(*image.NRGBA)
+	assert.Equal(t, red, mirrored.NRGBAAt(1, 0))
+}
+
+func TestStripJPEG(t *testing.T) {
+	t.Run("drops the metadata segments", func(t *testing.T) {
+		data := jpegWithExif(t, 4, 2, 1)
+
+		stripped, err := stripJPEG(data)
+
+		assert.NoError(t, err)
+		assert.NotContains(t, string(stripped), "Exif")
+		assert.NotContains(t, string(stripped), "home!!!")
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(stripped))
+		assert.NoError(t, err)
+		assert.Equal(t, 4, cfg.Width)
+	})
+
+	t.Run("applies the orientation to the pixels", func(t *testing.T) {
+		data := jpegWithExif(t, 4, 2, 6)
+
+		stripped, err := stripJPEG(data)
+
+		assert.NoError(t, err)
+		assert.NotContains(t, string(stripped), "Exif")
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(stripped))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, cfg.Width)
+		assert.Equal(t, 4, cfg.Height)
+	})
+
+	t.Run("drops the images embedded after the main one", func(t *testing.T) {
+		data := append(jpegWithExif(t, 4, 2, 1), jpegWithExif(t, 2, 2, 1)...)
+
+		stripped, err := stripJPEG(data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, bytes.Count(stripped, []byte{0xFF, 0xD8}))
+	})
+
+	t.Run("rejects other formats", func(t *testing.T) {
+		_, err := stripJPEG([]byte("\x89PNG"))
+
+		assert.ErrorIs(t, err, errInvalidContainer)
+	})
+}
+
+func TestStripPNG(t *testing.T) {
+	var b bytes.Buffer
+	assert.NoError(t, png.Encode(&b, image.NewNRGBA(image.Rect(0, 0, 3, 1))))
+	text := []byte("Comment\x00taken at home")
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(text)))
+	chunk = append(chunk, "tEXt"...)
+	chunk = append(chunk, text...)
+	chunk = append(chunk, 0, 0, 0, 0) // the CRC isn't checked
+	const headerEnd = 8 + 12 + 13     // signature and IHDR
+	data := append(append(append([]byte{}, b.Bytes()[:headerEnd]...), chunk...), b.Bytes()[headerEnd:]...)
+
+	stripped, err := stripPNG(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, b.Bytes(), stripped)
+}
+
+func TestStripWebP(t *testing.T) {
+	chunk := func(fourCC string, data []byte) []byte {
+		c := append([]byte(fourCC), binary.LittleEndian.AppendUint32(nil, uint32(len(data)))...)
+		c = append(c, data...)
+		if len(data)%2 == 1 {
+			c = append(c, 0)
+		}
+		return c
+	}
+	var body []byte
+	body = append(body, chunk("VP8X", []byte{0x0C, 0, 0, 0, 0, 0, 0, 0, 0, 0})...)
+	body = append(body, chunk("VP8L", []byte{1, 2, 3})...)
+	body = append(body, chunk("EXIF", exifWithOrientation(binary.LittleEndian, 1))...)
+	body = append(body, chunk("XMP ", []byte("<x:xmpmeta/>"))...)
+	data := append([]byte("RIFF"), binary.LittleEndian.AppendUint32(nil, uint32(4+len(body)))...)
+	data = append(append(data, "WEBP"...), body...)
+
+	stripped, err := stripWebP(data)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, string(stripped), "EXIF")
+	assert.NotContains(t, string(stripped), "xmpmeta")
+	assert.Equal(t, byte(0), stripped[20], "the metadata flags are cleared")
+	assert.Equal(t, uint32(len(stripped)-8), binary.LittleEndian.Uint32(stripped[4:]))
+}
+
+func TestStripGIF(t *testing.T) {
+	palette := color.Palette{color.Black, color.White}
+	frame := func() *image.Paletted { return image.NewPaletted(image.Rect(0, 0, 2, 2), palette) }
+	var b bytes.Buffer
+	err := gif.EncodeAll(&b, &gif.GIF{Image: []*image.Paletted{frame(), frame()}, Delay: []int{10, 10}})
+	assert.NoError(t, err)
+	comment := []byte{0x21, 0xFE, 12, 't', 'a', 'k', 'e', 'n', ' ', 'a', 't', ' ', 'h', 'o', 'm', 0}
+	xmp := append([]byte{0x21, 0xFF, 11}, "XMP DataXMP"...)
+	xmp = append(xmp, 12)
+	xmp = append(xmp, "<x:xmpmeta/>"...)
+	xmp = append(xmp, 0)
+	const headerEnd = 13 // header and screen descriptor, the frames have their own color table
+	data := append(append(append(append([]byte{}, b.Bytes()[:headerEnd]...), comment...), xmp...), b.Bytes()[headerEnd:]...)
+
+	stripped, err := stripGIF(data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, b.Bytes(), stripped)
+	assert.Contains(t, string(stripped), "NETSCAPE2.0", "the loop count is kept")
+	decoded, err := gif.DecodeAll(bytes.NewReader(stripped))
+	assert.NoError(t, err)
+	assert.Len(t, decoded.Image, 2)
+}
+
+func TestStripHEIF(t *testing.T) {
+	box := func(boxType string, payload ...[]byte) []byte {
+		body := bytes.Join(payload, nil)
+		b := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+		return append(append(b, boxType...), body...)
+	}
+	infe := func(itemID uint16, itemType string, rest string) []byte {
+		b := binary.BigEndian.AppendUint16([]byte{2, 0, 0, 0}, itemID)
+		b = append(b, 0, 0)
+		return box("infe", append(append(b, itemType...), rest...))
+	}
+	pixels, exif, xmp := []byte("IMAGE"), []byte("Exif GPS"), []byte("<x:xmpmeta/>")
+	// The items are stored one after the other in mdat, at the end of the file
+	build := func(mdatStart uint32) []byte {
+		iloc := []byte{0, 0, 0, 0, 0x44, 0x00}
+		iloc = binary.BigEndian.AppendUint16(iloc, 3)
+		offset := mdatStart + 8
+		for i, item := range [][]byte{pixels, exif, xmp} {
+			iloc = binary.BigEndian.AppendUint16(iloc, uint16(i+1))
+			iloc = append(iloc, 0, 0)                          // data reference index
+			iloc = binary.BigEndian.AppendUint16(iloc, 1)      // extent count
+			iloc = binary.BigEndian.AppendUint32(iloc, offset) // extent offset
+			iloc = binary.BigEndian.AppendUint32(iloc, uint32(len(item)))
+			offset += uint32(len(item))
+		}
+		iinf := box("iinf", []byte{0, 0, 0, 0, 0, 3},
+			infe(1, "hvc1", "\x00"),
+			infe(2, "Exif", "\x00"),
+			infe(3, "mime", "\x00application/rdf+xml\x00"))
+		meta := box("meta", []byte{0, 0, 0, 0}, iinf, box("iloc", iloc))
+		return append(append(box("ftyp", []byte("heic\x00\x00\x00\x00heic")), meta...), box("mdat", pixels, exif, xmp)...)
+	}
+	data := build(0)
+	data = build(uint32(len(data) - 8 - len(pixels) - len(exif) - len(xmp)))
+
+	stripped, err := stripHEIF(data)
+
+	assert.NoError(t, err)
+	assert.Len(t, stripped, len(data))
+	assert.Contains(t, string(stripped), "IMAGE")
+	assert.NotContains(t, string(stripped), "Exif GPS")
+	assert.NotContains(t, string(stripped), "xmpmeta")
+}
+
+func TestUploadMediaWithQueue_StripMetadata(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.UserIDKey, "user-1")
+	repo := NewMockRepository(t)
+	objectRepo := NewMockObjectRepository(t)
+	queue := NewMockProcessingQueue(t)
+	repo.EXPECT().GetMetadata(ctx, "project-1", "post-1", "photo.jpg").Return(nil, nil)
+	// The file waiting to be processed can be downloaded, it's stored stripped
+	objectRepo.EXPECT().UploadFile(ctx, "project-1", "post-1", "photo.jpg", mock.MatchedBy(func(r io.ReadSeeker) bool {
+		data, _ := io.ReadAll(r)
+		return !bytes.Contains(data, []byte("Exif"))
+	}), mock.Anything).Return(nil)
+	objectRepo.EXPECT().GetSignedURL(ctx, "project-1", "post-1", "photo.jpg").Return("https://store/photo.jpg", nil)
+	repo.EXPECT().SaveMetadata(ctx, mock.MatchedBy(func(m *MetaData) bool {
+		return m.Status == MediaStatusProcessing && m.MetadataStripped
+	})).RunAndReturn(func(ctx context.Context, m *MetaData) (*MetaData, error) {
+		queue.EXPECT().Enqueue(m.ID).Return()
+		return m, nil
+	})
+	s := NewService(repo, objectRepo)
+	s.SetStripMetadata(true)
+	s.SetProcessingQueue(queue)
+
+	_, err := s.UploadMedia(ctx, "project-1", "post-1", "photo.jpg", "", bytes.NewReader(jpegWithExif(t, 4, 3, 1)))
+
+	assert.NoError(t, err)
+}
+
+func TestCompleteUploadWithQueue_StripMetadata(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository(t)
+	objectRepo := NewMockObjectRepository(t)
+	queue := NewMockProcessingQueue(t)
+	photo := jpegWithExif(t, 4, 3, 1)
+	u := NewUpload("project-1", "post-1", "user-1", "photo.jpg", "", "", nil, int64(len(photo)))
+	repo.EXPECT().FindUpload(ctx, "project-1", u.ID).Return(u, nil)
+	repo.EXPECT().GetMetadata(ctx, "project-1", "post-1", "photo.jpg").Return(nil, nil)
+	objectRepo.EXPECT().GetStagedFile(ctx, u.ID).Return(io.NopCloser(bytes.NewReader(photo)), nil)
+	// The raw file stays staged, only its stripped copy waits to be processed where the media is stored
+	objectRepo.EXPECT().UploadFile(ctx, "project-1", "post-1", "photo.jpg", mock.MatchedBy(func(r io.ReadSeeker) bool {
+		data, _ := io.ReadAll(r)
+		return !bytes.Contains(data, []byte("Exif"))
+	}), mock.Anything).Return(nil)
+	objectRepo.EXPECT().GetSignedURL(ctx, "project-1", "post-1", "photo.jpg").Return("https://store/photo.jpg", nil)
+	repo.EXPECT().SaveMetadata(ctx, mock.MatchedBy(func(m *MetaData) bool {
+		return m.Status == MediaStatusProcessing && m.MetadataStripped
+	})).RunAndReturn(func(ctx context.Context, m *MetaData) (*MetaData, error) {
+		queue.EXPECT().Enqueue(m.ID).Return()
+		return m, nil
+	})
+	objectRepo.EXPECT().DeleteStagedFile(ctx, u.ID).Return(nil)
+	repo.EXPECT().DeleteUpload(ctx, u.ID).Return(nil)
+	s := NewService(repo, objectRepo)
+	s.SetStripMetadata(true)
+	s.SetProcessingQueue(queue)
+
+	_, err := s.CompleteUpload(ctx, "project-1", u.ID)
+
+	assert.NoError(t, err)
+}
+
+func TestProcessMedia_StripMetadata(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository(t)
+	objectRepo := NewMockObjectRepository(t)
+	md := NewPendingMetadata("project-1", "post-1", "user-1", "photo.jpg", "", MediaTypeImage, 4)
+	repo.EXPECT().GetMetadataByID(ctx, md.ID).Return(md, nil)
+	objectRepo.EXPECT().GetFile(ctx, "project-1", "post-1", "photo.jpg").Return(io.NopCloser(bytes.NewReader(jpegWithExif(t, 40, 30, 6))), nil)
+	runLockedContent(repo)
+	objectRepo.EXPECT().StatBlob(ctx, "project-1", mock.Anything).Return(nil, ErrFileNotFound)
+	objectRepo.EXPECT().UploadBlob(ctx, "project-1", mock.Anything, mock.MatchedBy(func(r io.Reader) bool {
+		data, _ := io.ReadAll(r)
+		return !bytes.Contains(data, []byte("Exif"))
+	})).Return(nil)
+	objectRepo.EXPECT().DeleteFile(ctx, "project-1", "post-1", "photo.jpg").Return(nil)
+	thumbnailName := getThumbnailName("photo.jpg")
+	objectRepo.EXPECT().UploadFile(ctx, "project-1", "post-1", thumbnailName, mock.Anything, mock.Anything).Return(nil)
+	repo.EXPECT().GetMetadata(ctx, "project-1", "post-1", thumbnailName).Return(nil, nil)
+	repo.EXPECT().SaveMetadata(ctx, mock.Anything).Return(nil, nil)
+	repo.EXPECT().UpdateMetadata(ctx, mock.MatchedBy(func(m *MetaData) bool {
+		return m.IsReady() && m.MetadataStripped && m.Width == 30 && m.Height == 40 && m.ContentHash != ""
+	})).Return(nil)
+	s := NewService(repo, objectRepo)
+	s.SetStripMetadata(true)
+
+	err := s.ProcessMedia(ctx, md.ID)
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,59 @@
+package media
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+)
+
+// FFmpegTranscoder transcodes videos with ffmpeg
+type FFmpegTranscoder struct{}
+
+func NewFFmpegTranscoder() *FFmpegTranscoder {
+	return &FFmpegTranscoder{}
+}
+
+func (t *FFmpegTranscoder) Transcode(f *File, info *MediaInfo, profile VideoProfile) (*File, error) {
+	out, err := os.CreateTemp("", "rendition-*."+RenditionFormat)
+	if err != nil {
+		return nil, err
+	}
+	path := out.Name()
+	if err := out.Close(); err != nil {
+		return nil, errors.Join(err, os.Remove(path))
+	}
+
+	width, height := profile.Fit(info.Width, info.Height)
+	bitrate := profile.VideoBitrate(info.Length)
+
+	args := []string{"-y", "-v", "error", "-i", f.Path()}
+	if profile.MaxLength > 0 {
+		args = append(args, "-t", strconv.Itoa(profile.MaxLength))
+	}
+	args = append(args,
+		"-vf", fmt.Sprintf("scale=%d:%d", width, height),
+		"-c:v", "libx264",
+		"-preset", "veryfast",
+		"-profile:v", "high",
+		"-pix_fmt", "yuv420p",
+		"-b:v", fmt.Sprintf("%dk", bitrate),
+		"-maxrate", fmt.Sprintf("%dk", bitrate),
+		"-bufsize", fmt.Sprintf("%dk", 2*bitrate),
+		"-c:a", "aac",
+		"-b:a", fmt.Sprintf("%dk", profile.AudioBitrate),
+		// Puts the index first, so platforms can start processing before the whole file is read
+		"-movflags", "+faststart",
+		path,
+	)
+
+	cmd := exec.Command("ffmpeg", args...)
+	cmd.Stdout = nil
+	cmd.Stderr = nil
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Join(fmt.Errorf("ffmpeg failed: %w", err), os.Remove(path))
+	}
+
+	return openFile(path)
+}
@@ -0,0 +1,201 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package notification
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindByProjectID provides a mock function with given fields: ctx, projectID, unreadOnly
+func (_m *MockRepository) FindByProjectID(ctx context.Context, projectID string, unreadOnly bool) ([]*Notification, error) {
+	ret := _m.Called(ctx, projectID, unreadOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProjectID")
+	}
+
+	var r0 []*Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]*Notification, error)); ok {
+		return rf(ctx, projectID, unreadOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []*Notification); ok {
+		r0 = rf(ctx, projectID, unreadOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, projectID, unreadOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByProjectID'
+type MockRepository_FindByProjectID_Call struct {
+	*mock.Call
+}
+
+// FindByProjectID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - unreadOnly bool
+func (_e *MockRepository_Expecter) FindByProjectID(ctx interface{}, projectID interface{}, unreadOnly interface{}) *MockRepository_FindByProjectID_Call {
+	return &MockRepository_FindByProjectID_Call{Call: _e.mock.On("FindByProjectID", ctx, projectID, unreadOnly)}
+}
+
+func (_c *MockRepository_FindByProjectID_Call) Run(run func(ctx context.Context, projectID string, unreadOnly bool)) *MockRepository_FindByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByProjectID_Call) Return(_a0 []*Notification, _a1 error) *MockRepository_FindByProjectID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindByProjectID_Call) RunAndReturn(run func(context.Context, string, bool) ([]*Notification, error)) *MockRepository_FindByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsRead provides a mock function with given fields: ctx, projectID, id
+func (_m *MockRepository) MarkAsRead(ctx context.Context, projectID string, id string) (bool, error) {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsRead")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, projectID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_MarkAsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsRead'
+type MockRepository_MarkAsRead_Call struct {
+	*mock.Call
+}
+
+// MarkAsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+func (_e *MockRepository_Expecter) MarkAsRead(ctx interface{}, projectID interface{}, id interface{}) *MockRepository_MarkAsRead_Call {
+	return &MockRepository_MarkAsRead_Call{Call: _e.mock.On("MarkAsRead", ctx, projectID, id)}
+}
+
+func (_c *MockRepository_MarkAsRead_Call) Run(run func(ctx context.Context, projectID string, id string)) *MockRepository_MarkAsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_MarkAsRead_Call) Return(_a0 bool, _a1 error) *MockRepository_MarkAsRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_MarkAsRead_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_MarkAsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, n
+func (_m *MockRepository) Save(ctx context.Context, n *Notification) error {
+	ret := _m.Called(ctx, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Notification) error); ok {
+		r0 = rf(ctx, n)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - n *Notification
+func (_e *MockRepository_Expecter) Save(ctx interface{}, n interface{}) *MockRepository_Save_Call {
+	return &MockRepository_Save_Call{Call: _e.mock.On("Save", ctx, n)}
+}
+
+func (_c *MockRepository_Save_Call) Run(run func(ctx context.Context, n *Notification)) *MockRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Notification))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Save_Call) Return(_a0 error) *MockRepository_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Save_Call) RunAndReturn(run func(context.Context, *Notification) error) *MockRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
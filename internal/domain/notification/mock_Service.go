@@ -0,0 +1,194 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package notification
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// ListProjectNotifications provides a mock function with given fields: ctx, projectID, unreadOnly
+func (_m *MockService) ListProjectNotifications(ctx context.Context, projectID string, unreadOnly bool) ([]*Notification, error) {
+	ret := _m.Called(ctx, projectID, unreadOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectNotifications")
+	}
+
+	var r0 []*Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]*Notification, error)); ok {
+		return rf(ctx, projectID, unreadOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []*Notification); ok {
+		r0 = rf(ctx, projectID, unreadOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, projectID, unreadOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListProjectNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectNotifications'
+type MockService_ListProjectNotifications_Call struct {
+	*mock.Call
+}
+
+// ListProjectNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - unreadOnly bool
+func (_e *MockService_Expecter) ListProjectNotifications(ctx interface{}, projectID interface{}, unreadOnly interface{}) *MockService_ListProjectNotifications_Call {
+	return &MockService_ListProjectNotifications_Call{Call: _e.mock.On("ListProjectNotifications", ctx, projectID, unreadOnly)}
+}
+
+func (_c *MockService_ListProjectNotifications_Call) Run(run func(ctx context.Context, projectID string, unreadOnly bool)) *MockService_ListProjectNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockService_ListProjectNotifications_Call) Return(_a0 []*Notification, _a1 error) *MockService_ListProjectNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListProjectNotifications_Call) RunAndReturn(run func(context.Context, string, bool) ([]*Notification, error)) *MockService_ListProjectNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsRead provides a mock function with given fields: ctx, projectID, id
+func (_m *MockService) MarkAsRead(ctx context.Context, projectID string, id string) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_MarkAsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsRead'
+type MockService_MarkAsRead_Call struct {
+	*mock.Call
+}
+
+// MarkAsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+func (_e *MockService_Expecter) MarkAsRead(ctx interface{}, projectID interface{}, id interface{}) *MockService_MarkAsRead_Call {
+	return &MockService_MarkAsRead_Call{Call: _e.mock.On("MarkAsRead", ctx, projectID, id)}
+}
+
+func (_c *MockService_MarkAsRead_Call) Run(run func(ctx context.Context, projectID string, id string)) *MockService_MarkAsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_MarkAsRead_Call) Return(_a0 error) *MockService_MarkAsRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_MarkAsRead_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_MarkAsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Notify provides a mock function with given fields: ctx, projectID, postID, kind, message
+func (_m *MockService) Notify(ctx context.Context, projectID string, postID string, kind Kind, message string) error {
+	ret := _m.Called(ctx, projectID, postID, kind, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, Kind, string) error); ok {
+		r0 = rf(ctx, projectID, postID, kind, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type MockService_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+//   - kind Kind
+//   - message string
+func (_e *MockService_Expecter) Notify(ctx interface{}, projectID interface{}, postID interface{}, kind interface{}, message interface{}) *MockService_Notify_Call {
+	return &MockService_Notify_Call{Call: _e.mock.On("Notify", ctx, projectID, postID, kind, message)}
+}
+
+func (_c *MockService_Notify_Call) Run(run func(ctx context.Context, projectID string, postID string, kind Kind, message string)) *MockService_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(Kind), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_Notify_Call) Return(_a0 error) *MockService_Notify_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_Notify_Call) RunAndReturn(run func(context.Context, string, string, Kind, string) error) *MockService_Notify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
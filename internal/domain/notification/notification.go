@@ -0,0 +1,50 @@
+package notification
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
+type Kind string
+
+const (
+	// KindPostInvalid is sent when a scheduled or queued post would fail to publish
+	KindPostInvalid Kind = "post_invalid"
+	// KindPostValidationWarning is sent when a post was scheduled or queued with validation errors
+	// because the project is not refusing invalid posts
+	KindPostValidationWarning Kind = "post_validation_warning"
+)
+
+type Notification struct {
+	ID        string    `json:"id"`
+	ProjectID string    `json:"project_id"`
+	PostID    string    `json:"post_id,omitempty"`
+	Kind      Kind      `json:"kind"`
+	Message   string    `json:"message"`
+	IsRead    bool      `json:"is_read"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewNotification(projectID, postID string, kind Kind, message string) (*Notification, error) {
+	if projectID == "" {
+		return nil, errors.New("projectID cannot be empty")
+	}
+	if message == "" {
+		return nil, errors.New("message cannot be empty")
+	}
+
+	return &Notification{
+		ID:        uuid.New().String(),
+		ProjectID: projectID,
+		PostID:    postID,
+		Kind:      kind,
+		Message:   message,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
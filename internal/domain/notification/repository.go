@@ -0,0 +1,9 @@
+package notification
+
+import "context"
+
+type Repository interface {
+	Save(ctx context.Context, n *Notification) error
+	FindByProjectID(ctx context.Context, projectID string, unreadOnly bool) ([]*Notification, error)
+	MarkAsRead(ctx context.Context, projectID, id string) (bool, error)
+}
@@ -0,0 +1,40 @@
+package notification
+
+import "context"
+
+type Service interface {
+	Notify(ctx context.Context, projectID, postID string, kind Kind, message string) error
+	ListProjectNotifications(ctx context.Context, projectID string, unreadOnly bool) ([]*Notification, error)
+	MarkAsRead(ctx context.Context, projectID, id string) error
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) Notify(ctx context.Context, projectID, postID string, kind Kind, message string) error {
+	n, err := NewNotification(projectID, postID, kind, message)
+	if err != nil {
+		return err
+	}
+	return s.repo.Save(ctx, n)
+}
+
+func (s *service) ListProjectNotifications(ctx context.Context, projectID string, unreadOnly bool) ([]*Notification, error) {
+	return s.repo.FindByProjectID(ctx, projectID, unreadOnly)
+}
+
+func (s *service) MarkAsRead(ctx context.Context, projectID, id string) error {
+	found, err := s.repo.MarkAsRead(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrNotificationNotFound
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package post
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMediaRemover is an autogenerated mock type for the MediaRemover type
+type MockMediaRemover struct {
+	mock.Mock
+}
+
+type MockMediaRemover_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMediaRemover) EXPECT() *MockMediaRemover_Expecter {
+	return &MockMediaRemover_Expecter{mock: &_m.Mock}
+}
+
+// PostFilesRemover provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockMediaRemover) PostFilesRemover(ctx context.Context, projectID string, postID string) (func(context.Context) error, error) {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostFilesRemover")
+	}
+
+	var r0 func(context.Context) error
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (func(context.Context) error, error)); ok {
+		return rf(ctx, projectID, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) func(context.Context) error); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func(context.Context) error)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMediaRemover_PostFilesRemover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostFilesRemover'
+type MockMediaRemover_PostFilesRemover_Call struct {
+	*mock.Call
+}
+
+// PostFilesRemover is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockMediaRemover_Expecter) PostFilesRemover(ctx interface{}, projectID interface{}, postID interface{}) *MockMediaRemover_PostFilesRemover_Call {
+	return &MockMediaRemover_PostFilesRemover_Call{Call: _e.mock.On("PostFilesRemover", ctx, projectID, postID)}
+}
+
+func (_c *MockMediaRemover_PostFilesRemover_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockMediaRemover_PostFilesRemover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockMediaRemover_PostFilesRemover_Call) Return(_a0 func(context.Context) error, _a1 error) *MockMediaRemover_PostFilesRemover_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMediaRemover_PostFilesRemover_Call) RunAndReturn(run func(context.Context, string, string) (func(context.Context) error, error)) *MockMediaRemover_PostFilesRemover_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMediaRemover creates a new instance of MockMediaRemover. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMediaRemover(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMediaRemover {
+	mock := &MockMediaRemover{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
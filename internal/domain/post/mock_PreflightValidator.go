@@ -0,0 +1,84 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package post
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockPreflightValidator is an autogenerated mock type for the PreflightValidator type
+type MockPreflightValidator struct {
+	mock.Mock
+}
+
+type MockPreflightValidator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPreflightValidator) EXPECT() *MockPreflightValidator_Expecter {
+	return &MockPreflightValidator_Expecter{mock: &_m.Mock}
+}
+
+// PreflightCheck provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockPreflightValidator) PreflightCheck(ctx context.Context, projectID string, postID string) error {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreflightCheck")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPreflightValidator_PreflightCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreflightCheck'
+type MockPreflightValidator_PreflightCheck_Call struct {
+	*mock.Call
+}
+
+// PreflightCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockPreflightValidator_Expecter) PreflightCheck(ctx interface{}, projectID interface{}, postID interface{}) *MockPreflightValidator_PreflightCheck_Call {
+	return &MockPreflightValidator_PreflightCheck_Call{Call: _e.mock.On("PreflightCheck", ctx, projectID, postID)}
+}
+
+func (_c *MockPreflightValidator_PreflightCheck_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockPreflightValidator_PreflightCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPreflightValidator_PreflightCheck_Call) Return(_a0 error) *MockPreflightValidator_PreflightCheck_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPreflightValidator_PreflightCheck_Call) RunAndReturn(run func(context.Context, string, string) error) *MockPreflightValidator_PreflightCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPreflightValidator creates a new instance of MockPreflightValidator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPreflightValidator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPreflightValidator {
+	mock := &MockPreflightValidator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -118,17 +118,17 @@ func (_c *MockRepository_AddToProjectIdeaQueue_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// AddToProjectQueue provides a mock function with given fields: ctx, projectID, postID
-func (_m *MockRepository) AddToProjectQueue(ctx context.Context, projectID string, postID string) error {
-	ret := _m.Called(ctx, projectID, postID)
+// AddToProjectQueue provides a mock function with given fields: ctx, projectID, queue, postID
+func (_m *MockRepository) AddToProjectQueue(ctx context.Context, projectID string, queue string, postID string) error {
+	ret := _m.Called(ctx, projectID, queue, postID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddToProjectQueue")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, projectID, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, projectID, queue, postID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -144,14 +144,15 @@ type MockRepository_AddToProjectQueue_Call struct {
 // AddToProjectQueue is a helper method to define mock.On call
 //   - ctx context.Context
 //   - projectID string
+//   - queue string
 //   - postID string
-func (_e *MockRepository_Expecter) AddToProjectQueue(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_AddToProjectQueue_Call {
-	return &MockRepository_AddToProjectQueue_Call{Call: _e.mock.On("AddToProjectQueue", ctx, projectID, postID)}
+func (_e *MockRepository_Expecter) AddToProjectQueue(ctx interface{}, projectID interface{}, queue interface{}, postID interface{}) *MockRepository_AddToProjectQueue_Call {
+	return &MockRepository_AddToProjectQueue_Call{Call: _e.mock.On("AddToProjectQueue", ctx, projectID, queue, postID)}
 }
 
-func (_c *MockRepository_AddToProjectQueue_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_AddToProjectQueue_Call {
+func (_c *MockRepository_AddToProjectQueue_Call) Run(run func(ctx context.Context, projectID string, queue string, postID string)) *MockRepository_AddToProjectQueue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
@@ -161,7 +162,7 @@ func (_c *MockRepository_AddToProjectQueue_Call) Return(_a0 error) *MockReposito
 	return _c
 }
 
-func (_c *MockRepository_AddToProjectQueue_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_AddToProjectQueue_Call {
+func (_c *MockRepository_AddToProjectQueue_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockRepository_AddToProjectQueue_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -213,17 +214,17 @@ func (_c *MockRepository_ArchivePost_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
-// DeletePost provides a mock function with given fields: ctx, id
-func (_m *MockRepository) DeletePost(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// DeletePost provides a mock function with given fields: ctx, id, deletedBefore
+func (_m *MockRepository) DeletePost(ctx context.Context, id string, deletedBefore time.Time) error {
+	ret := _m.Called(ctx, id, deletedBefore)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeletePost")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, deletedBefore)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -239,13 +240,14 @@ type MockRepository_DeletePost_Call struct {
 // DeletePost is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockRepository_Expecter) DeletePost(ctx interface{}, id interface{}) *MockRepository_DeletePost_Call {
-	return &MockRepository_DeletePost_Call{Call: _e.mock.On("DeletePost", ctx, id)}
+//   - deletedBefore time.Time
+func (_e *MockRepository_Expecter) DeletePost(ctx interface{}, id interface{}, deletedBefore interface{}) *MockRepository_DeletePost_Call {
+	return &MockRepository_DeletePost_Call{Call: _e.mock.On("DeletePost", ctx, id, deletedBefore)}
 }
 
-func (_c *MockRepository_DeletePost_Call) Run(run func(ctx context.Context, id string)) *MockRepository_DeletePost_Call {
+func (_c *MockRepository_DeletePost_Call) Run(run func(ctx context.Context, id string, deletedBefore time.Time)) *MockRepository_DeletePost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
 	})
 	return _c
 }
@@ -255,7 +257,55 @@ func (_c *MockRepository_DeletePost_Call) Return(_a0 error) *MockRepository_Dele
 	return _c
 }
 
-func (_c *MockRepository_DeletePost_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_DeletePost_Call {
+func (_c *MockRepository_DeletePost_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockRepository_DeletePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteQueue provides a mock function with given fields: ctx, projectID, queue
+func (_m *MockRepository) DeleteQueue(ctx context.Context, projectID string, queue string) error {
+	ret := _m.Called(ctx, projectID, queue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, queue)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_DeleteQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueue'
+type MockRepository_DeleteQueue_Call struct {
+	*mock.Call
+}
+
+// DeleteQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - queue string
+func (_e *MockRepository_Expecter) DeleteQueue(ctx interface{}, projectID interface{}, queue interface{}) *MockRepository_DeleteQueue_Call {
+	return &MockRepository_DeleteQueue_Call{Call: _e.mock.On("DeleteQueue", ctx, projectID, queue)}
+}
+
+func (_c *MockRepository_DeleteQueue_Call) Run(run func(ctx context.Context, projectID string, queue string)) *MockRepository_DeleteQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteQueue_Call) Return(_a0 error) *MockRepository_DeleteQueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_DeleteQueue_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_DeleteQueue_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -378,29 +428,29 @@ func (_c *MockRepository_FindByProjectID_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
-// FindScheduledReadyPosts provides a mock function with given fields: ctx, offset, chunksize
-func (_m *MockRepository) FindScheduledReadyPosts(ctx context.Context, offset int, chunksize int) ([]*PublishPost, error) {
-	ret := _m.Called(ctx, offset, chunksize)
+// FindExpiredTrash provides a mock function with given fields: ctx, deletedBefore, offset, limit
+func (_m *MockRepository) FindExpiredTrash(ctx context.Context, deletedBefore time.Time, offset int, limit int) ([]*TrashedPost, error) {
+	ret := _m.Called(ctx, deletedBefore, offset, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindScheduledReadyPosts")
+		panic("no return value specified for FindExpiredTrash")
 	}
 
-	var r0 []*PublishPost
+	var r0 []*TrashedPost
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*PublishPost, error)); ok {
-		return rf(ctx, offset, chunksize)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, int) ([]*TrashedPost, error)); ok {
+		return rf(ctx, deletedBefore, offset, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*PublishPost); ok {
-		r0 = rf(ctx, offset, chunksize)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, int) []*TrashedPost); ok {
+		r0 = rf(ctx, deletedBefore, offset, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*PublishPost)
+			r0 = ret.Get(0).([]*TrashedPost)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
-		r1 = rf(ctx, offset, chunksize)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, int) error); ok {
+		r1 = rf(ctx, deletedBefore, offset, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -408,59 +458,58 @@ func (_m *MockRepository) FindScheduledReadyPosts(ctx context.Context, offset in
 	return r0, r1
 }
 
-// MockRepository_FindScheduledReadyPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindScheduledReadyPosts'
-type MockRepository_FindScheduledReadyPosts_Call struct {
+// MockRepository_FindExpiredTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExpiredTrash'
+type MockRepository_FindExpiredTrash_Call struct {
 	*mock.Call
 }
 
-// FindScheduledReadyPosts is a helper method to define mock.On call
+// FindExpiredTrash is a helper method to define mock.On call
 //   - ctx context.Context
+//   - deletedBefore time.Time
 //   - offset int
-//   - chunksize int
-func (_e *MockRepository_Expecter) FindScheduledReadyPosts(ctx interface{}, offset interface{}, chunksize interface{}) *MockRepository_FindScheduledReadyPosts_Call {
-	return &MockRepository_FindScheduledReadyPosts_Call{Call: _e.mock.On("FindScheduledReadyPosts", ctx, offset, chunksize)}
+//   - limit int
+func (_e *MockRepository_Expecter) FindExpiredTrash(ctx interface{}, deletedBefore interface{}, offset interface{}, limit interface{}) *MockRepository_FindExpiredTrash_Call {
+	return &MockRepository_FindExpiredTrash_Call{Call: _e.mock.On("FindExpiredTrash", ctx, deletedBefore, offset, limit)}
 }
 
-func (_c *MockRepository_FindScheduledReadyPosts_Call) Run(run func(ctx context.Context, offset int, chunksize int)) *MockRepository_FindScheduledReadyPosts_Call {
+func (_c *MockRepository_FindExpiredTrash_Call) Run(run func(ctx context.Context, deletedBefore time.Time, offset int, limit int)) *MockRepository_FindExpiredTrash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int), args[2].(int))
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int), args[3].(int))
 	})
 	return _c
 }
 
-func (_c *MockRepository_FindScheduledReadyPosts_Call) Return(_a0 []*PublishPost, _a1 error) *MockRepository_FindScheduledReadyPosts_Call {
+func (_c *MockRepository_FindExpiredTrash_Call) Return(_a0 []*TrashedPost, _a1 error) *MockRepository_FindExpiredTrash_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_FindScheduledReadyPosts_Call) RunAndReturn(run func(context.Context, int, int) ([]*PublishPost, error)) *MockRepository_FindScheduledReadyPosts_Call {
+func (_c *MockRepository_FindExpiredTrash_Call) RunAndReturn(run func(context.Context, time.Time, int, int) ([]*TrashedPost, error)) *MockRepository_FindExpiredTrash_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPostToPublish provides a mock function with given fields: ctx, id
-func (_m *MockRepository) GetPostToPublish(ctx context.Context, id string) (*PublishPost, error) {
-	ret := _m.Called(ctx, id)
+// FindPostQueue provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockRepository) FindPostQueue(ctx context.Context, projectID string, postID string) (string, error) {
+	ret := _m.Called(ctx, projectID, postID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPostToPublish")
+		panic("no return value specified for FindPostQueue")
 	}
 
-	var r0 *PublishPost
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*PublishPost, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, projectID, postID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *PublishPost); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, projectID, postID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*PublishPost)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -468,58 +517,59 @@ func (_m *MockRepository) GetPostToPublish(ctx context.Context, id string) (*Pub
 	return r0, r1
 }
 
-// MockRepository_GetPostToPublish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostToPublish'
-type MockRepository_GetPostToPublish_Call struct {
+// MockRepository_FindPostQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostQueue'
+type MockRepository_FindPostQueue_Call struct {
 	*mock.Call
 }
 
-// GetPostToPublish is a helper method to define mock.On call
+// FindPostQueue is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockRepository_Expecter) GetPostToPublish(ctx interface{}, id interface{}) *MockRepository_GetPostToPublish_Call {
-	return &MockRepository_GetPostToPublish_Call{Call: _e.mock.On("GetPostToPublish", ctx, id)}
+//   - projectID string
+//   - postID string
+func (_e *MockRepository_Expecter) FindPostQueue(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_FindPostQueue_Call {
+	return &MockRepository_FindPostQueue_Call{Call: _e.mock.On("FindPostQueue", ctx, projectID, postID)}
 }
 
-func (_c *MockRepository_GetPostToPublish_Call) Run(run func(ctx context.Context, id string)) *MockRepository_GetPostToPublish_Call {
+func (_c *MockRepository_FindPostQueue_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_FindPostQueue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetPostToPublish_Call) Return(_a0 *PublishPost, _a1 error) *MockRepository_GetPostToPublish_Call {
+func (_c *MockRepository_FindPostQueue_Call) Return(_a0 string, _a1 error) *MockRepository_FindPostQueue_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_GetPostToPublish_Call) RunAndReturn(run func(context.Context, string) (*PublishPost, error)) *MockRepository_GetPostToPublish_Call {
+func (_c *MockRepository_FindPostQueue_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *MockRepository_FindPostQueue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPostsForPublishQueue provides a mock function with given fields: ctx, postID
-func (_m *MockRepository) GetPostsForPublishQueue(ctx context.Context, postID string) ([]*PublishPost, error) {
-	ret := _m.Called(ctx, postID)
+// FindPostsDueForPreflight provides a mock function with given fields: ctx, before, offset, chunksize
+func (_m *MockRepository) FindPostsDueForPreflight(ctx context.Context, before time.Time, offset int, chunksize int) ([]*Post, error) {
+	ret := _m.Called(ctx, before, offset, chunksize)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPostsForPublishQueue")
+		panic("no return value specified for FindPostsDueForPreflight")
 	}
 
-	var r0 []*PublishPost
+	var r0 []*Post
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*PublishPost, error)); ok {
-		return rf(ctx, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, int) ([]*Post, error)); ok {
+		return rf(ctx, before, offset, chunksize)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*PublishPost); ok {
-		r0 = rf(ctx, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, int) []*Post); ok {
+		r0 = rf(ctx, before, offset, chunksize)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*PublishPost)
+			r0 = ret.Get(0).([]*Post)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, postID)
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, int) error); ok {
+		r1 = rf(ctx, before, offset, chunksize)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -527,53 +577,55 @@ func (_m *MockRepository) GetPostsForPublishQueue(ctx context.Context, postID st
 	return r0, r1
 }
 
-// MockRepository_GetPostsForPublishQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostsForPublishQueue'
-type MockRepository_GetPostsForPublishQueue_Call struct {
+// MockRepository_FindPostsDueForPreflight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostsDueForPreflight'
+type MockRepository_FindPostsDueForPreflight_Call struct {
 	*mock.Call
 }
 
-// GetPostsForPublishQueue is a helper method to define mock.On call
+// FindPostsDueForPreflight is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
-func (_e *MockRepository_Expecter) GetPostsForPublishQueue(ctx interface{}, postID interface{}) *MockRepository_GetPostsForPublishQueue_Call {
-	return &MockRepository_GetPostsForPublishQueue_Call{Call: _e.mock.On("GetPostsForPublishQueue", ctx, postID)}
+//   - before time.Time
+//   - offset int
+//   - chunksize int
+func (_e *MockRepository_Expecter) FindPostsDueForPreflight(ctx interface{}, before interface{}, offset interface{}, chunksize interface{}) *MockRepository_FindPostsDueForPreflight_Call {
+	return &MockRepository_FindPostsDueForPreflight_Call{Call: _e.mock.On("FindPostsDueForPreflight", ctx, before, offset, chunksize)}
 }
 
-func (_c *MockRepository_GetPostsForPublishQueue_Call) Run(run func(ctx context.Context, postID string)) *MockRepository_GetPostsForPublishQueue_Call {
+func (_c *MockRepository_FindPostsDueForPreflight_Call) Run(run func(ctx context.Context, before time.Time, offset int, chunksize int)) *MockRepository_FindPostsDueForPreflight_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int), args[3].(int))
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetPostsForPublishQueue_Call) Return(_a0 []*PublishPost, _a1 error) *MockRepository_GetPostsForPublishQueue_Call {
+func (_c *MockRepository_FindPostsDueForPreflight_Call) Return(_a0 []*Post, _a1 error) *MockRepository_FindPostsDueForPreflight_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_GetPostsForPublishQueue_Call) RunAndReturn(run func(context.Context, string) ([]*PublishPost, error)) *MockRepository_GetPostsForPublishQueue_Call {
+func (_c *MockRepository_FindPostsDueForPreflight_Call) RunAndReturn(run func(context.Context, time.Time, int, int) ([]*Post, error)) *MockRepository_FindPostsDueForPreflight_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProjectIdeaQueue provides a mock function with given fields: ctx, projectID
-func (_m *MockRepository) GetProjectIdeaQueue(ctx context.Context, projectID string) (*Queue, error) {
+// FindQueues provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindQueues(ctx context.Context, projectID string) ([]*NamedQueue, error) {
 	ret := _m.Called(ctx, projectID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProjectIdeaQueue")
+		panic("no return value specified for FindQueues")
 	}
 
-	var r0 *Queue
+	var r0 []*NamedQueue
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*Queue, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*NamedQueue, error)); ok {
 		return rf(ctx, projectID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *Queue); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*NamedQueue); ok {
 		r0 = rf(ctx, projectID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Queue)
+			r0 = ret.Get(0).([]*NamedQueue)
 		}
 	}
 
@@ -586,58 +638,58 @@ func (_m *MockRepository) GetProjectIdeaQueue(ctx context.Context, projectID str
 	return r0, r1
 }
 
-// MockRepository_GetProjectIdeaQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectIdeaQueue'
-type MockRepository_GetProjectIdeaQueue_Call struct {
+// MockRepository_FindQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQueues'
+type MockRepository_FindQueues_Call struct {
 	*mock.Call
 }
 
-// GetProjectIdeaQueue is a helper method to define mock.On call
+// FindQueues is a helper method to define mock.On call
 //   - ctx context.Context
 //   - projectID string
-func (_e *MockRepository_Expecter) GetProjectIdeaQueue(ctx interface{}, projectID interface{}) *MockRepository_GetProjectIdeaQueue_Call {
-	return &MockRepository_GetProjectIdeaQueue_Call{Call: _e.mock.On("GetProjectIdeaQueue", ctx, projectID)}
+func (_e *MockRepository_Expecter) FindQueues(ctx interface{}, projectID interface{}) *MockRepository_FindQueues_Call {
+	return &MockRepository_FindQueues_Call{Call: _e.mock.On("FindQueues", ctx, projectID)}
 }
 
-func (_c *MockRepository_GetProjectIdeaQueue_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_GetProjectIdeaQueue_Call {
+func (_c *MockRepository_FindQueues_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindQueues_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetProjectIdeaQueue_Call) Return(_a0 *Queue, _a1 error) *MockRepository_GetProjectIdeaQueue_Call {
+func (_c *MockRepository_FindQueues_Call) Return(_a0 []*NamedQueue, _a1 error) *MockRepository_FindQueues_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_GetProjectIdeaQueue_Call) RunAndReturn(run func(context.Context, string) (*Queue, error)) *MockRepository_GetProjectIdeaQueue_Call {
+func (_c *MockRepository_FindQueues_Call) RunAndReturn(run func(context.Context, string) ([]*NamedQueue, error)) *MockRepository_FindQueues_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProjectPostQueue provides a mock function with given fields: ctx, projectID
-func (_m *MockRepository) GetProjectPostQueue(ctx context.Context, projectID string) (*Queue, error) {
-	ret := _m.Called(ctx, projectID)
+// FindScheduledReadyPosts provides a mock function with given fields: ctx, offset, chunksize
+func (_m *MockRepository) FindScheduledReadyPosts(ctx context.Context, offset int, chunksize int) ([]*PublishPost, error) {
+	ret := _m.Called(ctx, offset, chunksize)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProjectPostQueue")
+		panic("no return value specified for FindScheduledReadyPosts")
 	}
 
-	var r0 *Queue
+	var r0 []*PublishPost
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*Queue, error)); ok {
-		return rf(ctx, projectID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*PublishPost, error)); ok {
+		return rf(ctx, offset, chunksize)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *Queue); ok {
-		r0 = rf(ctx, projectID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*PublishPost); ok {
+		r0 = rf(ctx, offset, chunksize)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*Queue)
+			r0 = ret.Get(0).([]*PublishPost)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, projectID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, offset, chunksize)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -645,58 +697,59 @@ func (_m *MockRepository) GetProjectPostQueue(ctx context.Context, projectID str
 	return r0, r1
 }
 
-// MockRepository_GetProjectPostQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectPostQueue'
-type MockRepository_GetProjectPostQueue_Call struct {
+// MockRepository_FindScheduledReadyPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindScheduledReadyPosts'
+type MockRepository_FindScheduledReadyPosts_Call struct {
 	*mock.Call
 }
 
-// GetProjectPostQueue is a helper method to define mock.On call
+// FindScheduledReadyPosts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - projectID string
-func (_e *MockRepository_Expecter) GetProjectPostQueue(ctx interface{}, projectID interface{}) *MockRepository_GetProjectPostQueue_Call {
-	return &MockRepository_GetProjectPostQueue_Call{Call: _e.mock.On("GetProjectPostQueue", ctx, projectID)}
+//   - offset int
+//   - chunksize int
+func (_e *MockRepository_Expecter) FindScheduledReadyPosts(ctx interface{}, offset interface{}, chunksize interface{}) *MockRepository_FindScheduledReadyPosts_Call {
+	return &MockRepository_FindScheduledReadyPosts_Call{Call: _e.mock.On("FindScheduledReadyPosts", ctx, offset, chunksize)}
 }
 
-func (_c *MockRepository_GetProjectPostQueue_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_GetProjectPostQueue_Call {
+func (_c *MockRepository_FindScheduledReadyPosts_Call) Run(run func(ctx context.Context, offset int, chunksize int)) *MockRepository_FindScheduledReadyPosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetProjectPostQueue_Call) Return(_a0 *Queue, _a1 error) *MockRepository_GetProjectPostQueue_Call {
+func (_c *MockRepository_FindScheduledReadyPosts_Call) Return(_a0 []*PublishPost, _a1 error) *MockRepository_FindScheduledReadyPosts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_GetProjectPostQueue_Call) RunAndReturn(run func(context.Context, string) (*Queue, error)) *MockRepository_GetProjectPostQueue_Call {
+func (_c *MockRepository_FindScheduledReadyPosts_Call) RunAndReturn(run func(context.Context, int, int) ([]*PublishPost, error)) *MockRepository_FindScheduledReadyPosts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProjectQueuedPosts provides a mock function with given fields: ctx, projectID, postIDs
-func (_m *MockRepository) GetProjectQueuedPosts(ctx context.Context, projectID string, postIDs []string) ([]*Post, error) {
-	ret := _m.Called(ctx, projectID, postIDs)
+// FindTrash provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindTrash(ctx context.Context, projectID string) ([]*TrashedPost, error) {
+	ret := _m.Called(ctx, projectID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProjectQueuedPosts")
+		panic("no return value specified for FindTrash")
 	}
 
-	var r0 []*Post
+	var r0 []*TrashedPost
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]*Post, error)); ok {
-		return rf(ctx, projectID, postIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*TrashedPost, error)); ok {
+		return rf(ctx, projectID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*Post); ok {
-		r0 = rf(ctx, projectID, postIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*TrashedPost); ok {
+		r0 = rf(ctx, projectID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*Post)
+			r0 = ret.Get(0).([]*TrashedPost)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
-		r1 = rf(ctx, projectID, postIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -704,59 +757,58 @@ func (_m *MockRepository) GetProjectQueuedPosts(ctx context.Context, projectID s
 	return r0, r1
 }
 
-// MockRepository_GetProjectQueuedPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectQueuedPosts'
-type MockRepository_GetProjectQueuedPosts_Call struct {
+// MockRepository_FindTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTrash'
+type MockRepository_FindTrash_Call struct {
 	*mock.Call
 }
 
-// GetProjectQueuedPosts is a helper method to define mock.On call
+// FindTrash is a helper method to define mock.On call
 //   - ctx context.Context
 //   - projectID string
-//   - postIDs []string
-func (_e *MockRepository_Expecter) GetProjectQueuedPosts(ctx interface{}, projectID interface{}, postIDs interface{}) *MockRepository_GetProjectQueuedPosts_Call {
-	return &MockRepository_GetProjectQueuedPosts_Call{Call: _e.mock.On("GetProjectQueuedPosts", ctx, projectID, postIDs)}
+func (_e *MockRepository_Expecter) FindTrash(ctx interface{}, projectID interface{}) *MockRepository_FindTrash_Call {
+	return &MockRepository_FindTrash_Call{Call: _e.mock.On("FindTrash", ctx, projectID)}
 }
 
-func (_c *MockRepository_GetProjectQueuedPosts_Call) Run(run func(ctx context.Context, projectID string, postIDs []string)) *MockRepository_GetProjectQueuedPosts_Call {
+func (_c *MockRepository_FindTrash_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindTrash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetProjectQueuedPosts_Call) Return(_a0 []*Post, _a1 error) *MockRepository_GetProjectQueuedPosts_Call {
+func (_c *MockRepository_FindTrash_Call) Return(_a0 []*TrashedPost, _a1 error) *MockRepository_FindTrash_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_GetProjectQueuedPosts_Call) RunAndReturn(run func(context.Context, string, []string) ([]*Post, error)) *MockRepository_GetProjectQueuedPosts_Call {
+func (_c *MockRepository_FindTrash_Call) RunAndReturn(run func(context.Context, string) ([]*TrashedPost, error)) *MockRepository_FindTrash_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSocialMediaPlatforms provides a mock function with given fields: ctx, postID
-func (_m *MockRepository) GetSocialMediaPlatforms(ctx context.Context, postID string) ([]Platform, error) {
-	ret := _m.Called(ctx, postID)
+// FindTrashedPost provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockRepository) FindTrashedPost(ctx context.Context, projectID string, postID string) (*TrashedPost, error) {
+	ret := _m.Called(ctx, projectID, postID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSocialMediaPlatforms")
+		panic("no return value specified for FindTrashedPost")
 	}
 
-	var r0 []Platform
+	var r0 *TrashedPost
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]Platform, error)); ok {
-		return rf(ctx, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*TrashedPost, error)); ok {
+		return rf(ctx, projectID, postID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []Platform); ok {
-		r0 = rf(ctx, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *TrashedPost); ok {
+		r0 = rf(ctx, projectID, postID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Platform)
+			r0 = ret.Get(0).(*TrashedPost)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, postID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -764,58 +816,59 @@ func (_m *MockRepository) GetSocialMediaPlatforms(ctx context.Context, postID st
 	return r0, r1
 }
 
-// MockRepository_GetSocialMediaPlatforms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSocialMediaPlatforms'
-type MockRepository_GetSocialMediaPlatforms_Call struct {
+// MockRepository_FindTrashedPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTrashedPost'
+type MockRepository_FindTrashedPost_Call struct {
 	*mock.Call
 }
 
-// GetSocialMediaPlatforms is a helper method to define mock.On call
+// FindTrashedPost is a helper method to define mock.On call
 //   - ctx context.Context
+//   - projectID string
 //   - postID string
-func (_e *MockRepository_Expecter) GetSocialMediaPlatforms(ctx interface{}, postID interface{}) *MockRepository_GetSocialMediaPlatforms_Call {
-	return &MockRepository_GetSocialMediaPlatforms_Call{Call: _e.mock.On("GetSocialMediaPlatforms", ctx, postID)}
+func (_e *MockRepository_Expecter) FindTrashedPost(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_FindTrashedPost_Call {
+	return &MockRepository_FindTrashedPost_Call{Call: _e.mock.On("FindTrashedPost", ctx, projectID, postID)}
 }
 
-func (_c *MockRepository_GetSocialMediaPlatforms_Call) Run(run func(ctx context.Context, postID string)) *MockRepository_GetSocialMediaPlatforms_Call {
+func (_c *MockRepository_FindTrashedPost_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_FindTrashedPost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetSocialMediaPlatforms_Call) Return(_a0 []Platform, _a1 error) *MockRepository_GetSocialMediaPlatforms_Call {
+func (_c *MockRepository_FindTrashedPost_Call) Return(_a0 *TrashedPost, _a1 error) *MockRepository_FindTrashedPost_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_GetSocialMediaPlatforms_Call) RunAndReturn(run func(context.Context, string) ([]Platform, error)) *MockRepository_GetSocialMediaPlatforms_Call {
+func (_c *MockRepository_FindTrashedPost_Call) RunAndReturn(run func(context.Context, string, string) (*TrashedPost, error)) *MockRepository_FindTrashedPost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSocialMediaPublishersIDs provides a mock function with given fields: ctx, postID
-func (_m *MockRepository) GetSocialMediaPublishersIDs(ctx context.Context, postID string) ([]string, error) {
-	ret := _m.Called(ctx, postID)
+// GetPostToPublish provides a mock function with given fields: ctx, id
+func (_m *MockRepository) GetPostToPublish(ctx context.Context, id string) (*PublishPost, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSocialMediaPublishersIDs")
+		panic("no return value specified for GetPostToPublish")
 	}
 
-	var r0 []string
+	var r0 *PublishPost
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
-		return rf(ctx, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*PublishPost, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
-		r0 = rf(ctx, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *PublishPost); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).(*PublishPost)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, postID)
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -823,104 +876,996 @@ func (_m *MockRepository) GetSocialMediaPublishersIDs(ctx context.Context, postI
 	return r0, r1
 }
 
-// MockRepository_GetSocialMediaPublishersIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSocialMediaPublishersIDs'
-type MockRepository_GetSocialMediaPublishersIDs_Call struct {
+// MockRepository_GetPostToPublish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostToPublish'
+type MockRepository_GetPostToPublish_Call struct {
 	*mock.Call
 }
 
-// GetSocialMediaPublishersIDs is a helper method to define mock.On call
+// GetPostToPublish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) GetPostToPublish(ctx interface{}, id interface{}) *MockRepository_GetPostToPublish_Call {
+	return &MockRepository_GetPostToPublish_Call{Call: _e.mock.On("GetPostToPublish", ctx, id)}
+}
+
+func (_c *MockRepository_GetPostToPublish_Call) Run(run func(ctx context.Context, id string)) *MockRepository_GetPostToPublish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetPostToPublish_Call) Return(_a0 *PublishPost, _a1 error) *MockRepository_GetPostToPublish_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetPostToPublish_Call) RunAndReturn(run func(context.Context, string) (*PublishPost, error)) *MockRepository_GetPostToPublish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPostsForPublishQueue provides a mock function with given fields: ctx, postID
+func (_m *MockRepository) GetPostsForPublishQueue(ctx context.Context, postID string) ([]*PublishPost, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostsForPublishQueue")
+	}
+
+	var r0 []*PublishPost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*PublishPost, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*PublishPost); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*PublishPost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetPostsForPublishQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostsForPublishQueue'
+type MockRepository_GetPostsForPublishQueue_Call struct {
+	*mock.Call
+}
+
+// GetPostsForPublishQueue is a helper method to define mock.On call
 //   - ctx context.Context
 //   - postID string
-func (_e *MockRepository_Expecter) GetSocialMediaPublishersIDs(ctx interface{}, postID interface{}) *MockRepository_GetSocialMediaPublishersIDs_Call {
-	return &MockRepository_GetSocialMediaPublishersIDs_Call{Call: _e.mock.On("GetSocialMediaPublishersIDs", ctx, postID)}
+func (_e *MockRepository_Expecter) GetPostsForPublishQueue(ctx interface{}, postID interface{}) *MockRepository_GetPostsForPublishQueue_Call {
+	return &MockRepository_GetPostsForPublishQueue_Call{Call: _e.mock.On("GetPostsForPublishQueue", ctx, postID)}
 }
 
-func (_c *MockRepository_GetSocialMediaPublishersIDs_Call) Run(run func(ctx context.Context, postID string)) *MockRepository_GetSocialMediaPublishersIDs_Call {
+func (_c *MockRepository_GetPostsForPublishQueue_Call) Run(run func(ctx context.Context, postID string)) *MockRepository_GetPostsForPublishQueue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetSocialMediaPublishersIDs_Call) Return(_a0 []string, _a1 error) *MockRepository_GetSocialMediaPublishersIDs_Call {
+func (_c *MockRepository_GetPostsForPublishQueue_Call) Return(_a0 []*PublishPost, _a1 error) *MockRepository_GetPostsForPublishQueue_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_GetSocialMediaPublishersIDs_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *MockRepository_GetSocialMediaPublishersIDs_Call {
+func (_c *MockRepository_GetPostsForPublishQueue_Call) RunAndReturn(run func(context.Context, string) ([]*PublishPost, error)) *MockRepository_GetPostsForPublishQueue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsPublisherPlatformEnabledForProject provides a mock function with given fields: ctx, projectID, publisherID
-func (_m *MockRepository) IsPublisherPlatformEnabledForProject(ctx context.Context, projectID string, publisherID string) (bool, error) {
-	ret := _m.Called(ctx, projectID, publisherID)
+// GetProjectIdeaQueue provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) GetProjectIdeaQueue(ctx context.Context, projectID string) (*Queue, error) {
+	ret := _m.Called(ctx, projectID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsPublisherPlatformEnabledForProject")
+		panic("no return value specified for GetProjectIdeaQueue")
 	}
 
-	var r0 bool
+	var r0 *Queue
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
-		return rf(ctx, projectID, publisherID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*Queue, error)); ok {
+		return rf(ctx, projectID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
-		r0 = rf(ctx, projectID, publisherID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *Queue); ok {
+		r0 = rf(ctx, projectID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Queue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetProjectIdeaQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectIdeaQueue'
+type MockRepository_GetProjectIdeaQueue_Call struct {
+	*mock.Call
+}
+
+// GetProjectIdeaQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) GetProjectIdeaQueue(ctx interface{}, projectID interface{}) *MockRepository_GetProjectIdeaQueue_Call {
+	return &MockRepository_GetProjectIdeaQueue_Call{Call: _e.mock.On("GetProjectIdeaQueue", ctx, projectID)}
+}
+
+func (_c *MockRepository_GetProjectIdeaQueue_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_GetProjectIdeaQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetProjectIdeaQueue_Call) Return(_a0 *Queue, _a1 error) *MockRepository_GetProjectIdeaQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetProjectIdeaQueue_Call) RunAndReturn(run func(context.Context, string) (*Queue, error)) *MockRepository_GetProjectIdeaQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectPostQueue provides a mock function with given fields: ctx, projectID, queue
+func (_m *MockRepository) GetProjectPostQueue(ctx context.Context, projectID string, queue string) (*Queue, error) {
+	ret := _m.Called(ctx, projectID, queue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectPostQueue")
+	}
+
+	var r0 *Queue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*Queue, error)); ok {
+		return rf(ctx, projectID, queue)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *Queue); ok {
+		r0 = rf(ctx, projectID, queue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Queue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, queue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetProjectPostQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectPostQueue'
+type MockRepository_GetProjectPostQueue_Call struct {
+	*mock.Call
+}
+
+// GetProjectPostQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - queue string
+func (_e *MockRepository_Expecter) GetProjectPostQueue(ctx interface{}, projectID interface{}, queue interface{}) *MockRepository_GetProjectPostQueue_Call {
+	return &MockRepository_GetProjectPostQueue_Call{Call: _e.mock.On("GetProjectPostQueue", ctx, projectID, queue)}
+}
+
+func (_c *MockRepository_GetProjectPostQueue_Call) Run(run func(ctx context.Context, projectID string, queue string)) *MockRepository_GetProjectPostQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetProjectPostQueue_Call) Return(_a0 *Queue, _a1 error) *MockRepository_GetProjectPostQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetProjectPostQueue_Call) RunAndReturn(run func(context.Context, string, string) (*Queue, error)) *MockRepository_GetProjectPostQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectQueuedPosts provides a mock function with given fields: ctx, projectID, postIDs
+func (_m *MockRepository) GetProjectQueuedPosts(ctx context.Context, projectID string, postIDs []string) ([]*Post, error) {
+	ret := _m.Called(ctx, projectID, postIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectQueuedPosts")
+	}
+
+	var r0 []*Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]*Post, error)); ok {
+		return rf(ctx, projectID, postIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*Post); ok {
+		r0 = rf(ctx, projectID, postIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, projectID, postIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetProjectQueuedPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectQueuedPosts'
+type MockRepository_GetProjectQueuedPosts_Call struct {
+	*mock.Call
+}
+
+// GetProjectQueuedPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postIDs []string
+func (_e *MockRepository_Expecter) GetProjectQueuedPosts(ctx interface{}, projectID interface{}, postIDs interface{}) *MockRepository_GetProjectQueuedPosts_Call {
+	return &MockRepository_GetProjectQueuedPosts_Call{Call: _e.mock.On("GetProjectQueuedPosts", ctx, projectID, postIDs)}
+}
+
+func (_c *MockRepository_GetProjectQueuedPosts_Call) Run(run func(ctx context.Context, projectID string, postIDs []string)) *MockRepository_GetProjectQueuedPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetProjectQueuedPosts_Call) Return(_a0 []*Post, _a1 error) *MockRepository_GetProjectQueuedPosts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetProjectQueuedPosts_Call) RunAndReturn(run func(context.Context, string, []string) ([]*Post, error)) *MockRepository_GetProjectQueuedPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSocialMediaPlatforms provides a mock function with given fields: ctx, postID
+func (_m *MockRepository) GetSocialMediaPlatforms(ctx context.Context, postID string) ([]Platform, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSocialMediaPlatforms")
+	}
+
+	var r0 []Platform
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]Platform, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []Platform); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Platform)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetSocialMediaPlatforms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSocialMediaPlatforms'
+type MockRepository_GetSocialMediaPlatforms_Call struct {
+	*mock.Call
+}
+
+// GetSocialMediaPlatforms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockRepository_Expecter) GetSocialMediaPlatforms(ctx interface{}, postID interface{}) *MockRepository_GetSocialMediaPlatforms_Call {
+	return &MockRepository_GetSocialMediaPlatforms_Call{Call: _e.mock.On("GetSocialMediaPlatforms", ctx, postID)}
+}
+
+func (_c *MockRepository_GetSocialMediaPlatforms_Call) Run(run func(ctx context.Context, postID string)) *MockRepository_GetSocialMediaPlatforms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetSocialMediaPlatforms_Call) Return(_a0 []Platform, _a1 error) *MockRepository_GetSocialMediaPlatforms_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetSocialMediaPlatforms_Call) RunAndReturn(run func(context.Context, string) ([]Platform, error)) *MockRepository_GetSocialMediaPlatforms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSocialMediaPublishersIDs provides a mock function with given fields: ctx, postID
+func (_m *MockRepository) GetSocialMediaPublishersIDs(ctx context.Context, postID string) ([]string, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSocialMediaPublishersIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetSocialMediaPublishersIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSocialMediaPublishersIDs'
+type MockRepository_GetSocialMediaPublishersIDs_Call struct {
+	*mock.Call
+}
+
+// GetSocialMediaPublishersIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockRepository_Expecter) GetSocialMediaPublishersIDs(ctx interface{}, postID interface{}) *MockRepository_GetSocialMediaPublishersIDs_Call {
+	return &MockRepository_GetSocialMediaPublishersIDs_Call{Call: _e.mock.On("GetSocialMediaPublishersIDs", ctx, postID)}
+}
+
+func (_c *MockRepository_GetSocialMediaPublishersIDs_Call) Run(run func(ctx context.Context, postID string)) *MockRepository_GetSocialMediaPublishersIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetSocialMediaPublishersIDs_Call) Return(_a0 []string, _a1 error) *MockRepository_GetSocialMediaPublishersIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetSocialMediaPublishersIDs_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *MockRepository_GetSocialMediaPublishersIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasProcessingMedia provides a mock function with given fields: ctx, postID
+func (_m *MockRepository) HasProcessingMedia(ctx context.Context, postID string) (bool, error) {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasProcessingMedia")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_HasProcessingMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasProcessingMedia'
+type MockRepository_HasProcessingMedia_Call struct {
+	*mock.Call
+}
+
+// HasProcessingMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockRepository_Expecter) HasProcessingMedia(ctx interface{}, postID interface{}) *MockRepository_HasProcessingMedia_Call {
+	return &MockRepository_HasProcessingMedia_Call{Call: _e.mock.On("HasProcessingMedia", ctx, postID)}
+}
+
+func (_c *MockRepository_HasProcessingMedia_Call) Run(run func(ctx context.Context, postID string)) *MockRepository_HasProcessingMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_HasProcessingMedia_Call) Return(_a0 bool, _a1 error) *MockRepository_HasProcessingMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_HasProcessingMedia_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockRepository_HasProcessingMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsPublisherPlatformEnabledForProject provides a mock function with given fields: ctx, projectID, publisherID
+func (_m *MockRepository) IsPublisherPlatformEnabledForProject(ctx context.Context, projectID string, publisherID string) (bool, error) {
+	ret := _m.Called(ctx, projectID, publisherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsPublisherPlatformEnabledForProject")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, projectID, publisherID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, projectID, publisherID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, publisherID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_IsPublisherPlatformEnabledForProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsPublisherPlatformEnabledForProject'
+type MockRepository_IsPublisherPlatformEnabledForProject_Call struct {
+	*mock.Call
+}
+
+// IsPublisherPlatformEnabledForProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - publisherID string
+func (_e *MockRepository_Expecter) IsPublisherPlatformEnabledForProject(ctx interface{}, projectID interface{}, publisherID interface{}) *MockRepository_IsPublisherPlatformEnabledForProject_Call {
+	return &MockRepository_IsPublisherPlatformEnabledForProject_Call{Call: _e.mock.On("IsPublisherPlatformEnabledForProject", ctx, projectID, publisherID)}
+}
+
+func (_c *MockRepository_IsPublisherPlatformEnabledForProject_Call) Run(run func(ctx context.Context, projectID string, publisherID string)) *MockRepository_IsPublisherPlatformEnabledForProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_IsPublisherPlatformEnabledForProject_Call) Return(_a0 bool, _a1 error) *MockRepository_IsPublisherPlatformEnabledForProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_IsPublisherPlatformEnabledForProject_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_IsPublisherPlatformEnabledForProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPreflightChecked provides a mock function with given fields: ctx, postID
+func (_m *MockRepository) MarkPreflightChecked(ctx context.Context, postID string) error {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPreflightChecked")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_MarkPreflightChecked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPreflightChecked'
+type MockRepository_MarkPreflightChecked_Call struct {
+	*mock.Call
+}
+
+// MarkPreflightChecked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockRepository_Expecter) MarkPreflightChecked(ctx interface{}, postID interface{}) *MockRepository_MarkPreflightChecked_Call {
+	return &MockRepository_MarkPreflightChecked_Call{Call: _e.mock.On("MarkPreflightChecked", ctx, postID)}
+}
+
+func (_c *MockRepository_MarkPreflightChecked_Call) Run(run func(ctx context.Context, postID string)) *MockRepository_MarkPreflightChecked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_MarkPreflightChecked_Call) Return(_a0 error) *MockRepository_MarkPreflightChecked_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_MarkPreflightChecked_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_MarkPreflightChecked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveIdeaInProjectQueue provides a mock function with given fields: ctx, projectID, from, to
+func (_m *MockRepository) MoveIdeaInProjectQueue(ctx context.Context, projectID string, from int, to int) error {
+	ret := _m.Called(ctx, projectID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveIdeaInProjectQueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) error); ok {
+		r0 = rf(ctx, projectID, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_MoveIdeaInProjectQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveIdeaInProjectQueue'
+type MockRepository_MoveIdeaInProjectQueue_Call struct {
+	*mock.Call
+}
+
+// MoveIdeaInProjectQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - from int
+//   - to int
+func (_e *MockRepository_Expecter) MoveIdeaInProjectQueue(ctx interface{}, projectID interface{}, from interface{}, to interface{}) *MockRepository_MoveIdeaInProjectQueue_Call {
+	return &MockRepository_MoveIdeaInProjectQueue_Call{Call: _e.mock.On("MoveIdeaInProjectQueue", ctx, projectID, from, to)}
+}
+
+func (_c *MockRepository_MoveIdeaInProjectQueue_Call) Run(run func(ctx context.Context, projectID string, from int, to int)) *MockRepository_MoveIdeaInProjectQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_MoveIdeaInProjectQueue_Call) Return(_a0 error) *MockRepository_MoveIdeaInProjectQueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_MoveIdeaInProjectQueue_Call) RunAndReturn(run func(context.Context, string, int, int) error) *MockRepository_MoveIdeaInProjectQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MovePostInProjectQueue provides a mock function with given fields: ctx, projectID, queue, from, to
+func (_m *MockRepository) MovePostInProjectQueue(ctx context.Context, projectID string, queue string, from int, to int) error {
+	ret := _m.Called(ctx, projectID, queue, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MovePostInProjectQueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int) error); ok {
+		r0 = rf(ctx, projectID, queue, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_MovePostInProjectQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MovePostInProjectQueue'
+type MockRepository_MovePostInProjectQueue_Call struct {
+	*mock.Call
+}
+
+// MovePostInProjectQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - queue string
+//   - from int
+//   - to int
+func (_e *MockRepository_Expecter) MovePostInProjectQueue(ctx interface{}, projectID interface{}, queue interface{}, from interface{}, to interface{}) *MockRepository_MovePostInProjectQueue_Call {
+	return &MockRepository_MovePostInProjectQueue_Call{Call: _e.mock.On("MovePostInProjectQueue", ctx, projectID, queue, from, to)}
+}
+
+func (_c *MockRepository_MovePostInProjectQueue_Call) Run(run func(ctx context.Context, projectID string, queue string, from int, to int)) *MockRepository_MovePostInProjectQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_MovePostInProjectQueue_Call) Return(_a0 error) *MockRepository_MovePostInProjectQueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_MovePostInProjectQueue_Call) RunAndReturn(run func(context.Context, string, string, int, int) error) *MockRepository_MovePostInProjectQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFromProjectIdeaQueue provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockRepository) RemoveFromProjectIdeaQueue(ctx context.Context, projectID string, postID string) error {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromProjectIdeaQueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RemoveFromProjectIdeaQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromProjectIdeaQueue'
+type MockRepository_RemoveFromProjectIdeaQueue_Call struct {
+	*mock.Call
+}
+
+// RemoveFromProjectIdeaQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockRepository_Expecter) RemoveFromProjectIdeaQueue(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_RemoveFromProjectIdeaQueue_Call {
+	return &MockRepository_RemoveFromProjectIdeaQueue_Call{Call: _e.mock.On("RemoveFromProjectIdeaQueue", ctx, projectID, postID)}
+}
+
+func (_c *MockRepository_RemoveFromProjectIdeaQueue_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_RemoveFromProjectIdeaQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RemoveFromProjectIdeaQueue_Call) Return(_a0 error) *MockRepository_RemoveFromProjectIdeaQueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RemoveFromProjectIdeaQueue_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_RemoveFromProjectIdeaQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFromProjectQueue provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockRepository) RemoveFromProjectQueue(ctx context.Context, projectID string, postID string) error {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromProjectQueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RemoveFromProjectQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromProjectQueue'
+type MockRepository_RemoveFromProjectQueue_Call struct {
+	*mock.Call
+}
+
+// RemoveFromProjectQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockRepository_Expecter) RemoveFromProjectQueue(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_RemoveFromProjectQueue_Call {
+	return &MockRepository_RemoveFromProjectQueue_Call{Call: _e.mock.On("RemoveFromProjectQueue", ctx, projectID, postID)}
+}
+
+func (_c *MockRepository_RemoveFromProjectQueue_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_RemoveFromProjectQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RemoveFromProjectQueue_Call) Return(_a0 error) *MockRepository_RemoveFromProjectQueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RemoveFromProjectQueue_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_RemoveFromProjectQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSocialMediaPublisher provides a mock function with given fields: ctx, postID, publisherID
+func (_m *MockRepository) RemoveSocialMediaPublisher(ctx context.Context, postID string, publisherID string) error {
+	ret := _m.Called(ctx, postID, publisherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSocialMediaPublisher")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, postID, publisherID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RemoveSocialMediaPublisher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSocialMediaPublisher'
+type MockRepository_RemoveSocialMediaPublisher_Call struct {
+	*mock.Call
+}
+
+// RemoveSocialMediaPublisher is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+//   - publisherID string
+func (_e *MockRepository_Expecter) RemoveSocialMediaPublisher(ctx interface{}, postID interface{}, publisherID interface{}) *MockRepository_RemoveSocialMediaPublisher_Call {
+	return &MockRepository_RemoveSocialMediaPublisher_Call{Call: _e.mock.On("RemoveSocialMediaPublisher", ctx, postID, publisherID)}
+}
+
+func (_c *MockRepository_RemoveSocialMediaPublisher_Call) Run(run func(ctx context.Context, postID string, publisherID string)) *MockRepository_RemoveSocialMediaPublisher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RemoveSocialMediaPublisher_Call) Return(_a0 error) *MockRepository_RemoveSocialMediaPublisher_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RemoveSocialMediaPublisher_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_RemoveSocialMediaPublisher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreFromTrash provides a mock function with given fields: ctx, id
+func (_m *MockRepository) RestoreFromTrash(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreFromTrash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RestoreFromTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreFromTrash'
+type MockRepository_RestoreFromTrash_Call struct {
+	*mock.Call
+}
+
+// RestoreFromTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) RestoreFromTrash(ctx interface{}, id interface{}) *MockRepository_RestoreFromTrash_Call {
+	return &MockRepository_RestoreFromTrash_Call{Call: _e.mock.On("RestoreFromTrash", ctx, id)}
+}
+
+func (_c *MockRepository_RestoreFromTrash_Call) Run(run func(ctx context.Context, id string)) *MockRepository_RestoreFromTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RestoreFromTrash_Call) Return(_a0 error) *MockRepository_RestoreFromTrash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RestoreFromTrash_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_RestoreFromTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestorePost provides a mock function with given fields: ctx, id
+func (_m *MockRepository) RestorePost(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestorePost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RestorePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestorePost'
+type MockRepository_RestorePost_Call struct {
+	*mock.Call
+}
+
+// RestorePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) RestorePost(ctx interface{}, id interface{}) *MockRepository_RestorePost_Call {
+	return &MockRepository_RestorePost_Call{Call: _e.mock.On("RestorePost", ctx, id)}
+}
+
+func (_c *MockRepository_RestorePost_Call) Run(run func(ctx context.Context, id string)) *MockRepository_RestorePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RestorePost_Call) Return(_a0 error) *MockRepository_RestorePost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RestorePost_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_RestorePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, _a1
+func (_m *MockRepository) Save(ctx context.Context, _a1 *Post) error {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Post) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *Post
+func (_e *MockRepository_Expecter) Save(ctx interface{}, _a1 interface{}) *MockRepository_Save_Call {
+	return &MockRepository_Save_Call{Call: _e.mock.On("Save", ctx, _a1)}
+}
+
+func (_c *MockRepository_Save_Call) Run(run func(ctx context.Context, _a1 *Post)) *MockRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Post))
+	})
+	return _c
+}
+
+func (_c *MockRepository_Save_Call) Return(_a0 error) *MockRepository_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_Save_Call) RunAndReturn(run func(context.Context, *Post) error) *MockRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveQueue provides a mock function with given fields: ctx, projectID, q
+func (_m *MockRepository) SaveQueue(ctx context.Context, projectID string, q *NamedQueue) error {
+	ret := _m.Called(ctx, projectID, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveQueue")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, projectID, publisherID)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *NamedQueue) error); ok {
+		r0 = rf(ctx, projectID, q)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockRepository_IsPublisherPlatformEnabledForProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsPublisherPlatformEnabledForProject'
-type MockRepository_IsPublisherPlatformEnabledForProject_Call struct {
+// MockRepository_SaveQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveQueue'
+type MockRepository_SaveQueue_Call struct {
 	*mock.Call
 }
 
-// IsPublisherPlatformEnabledForProject is a helper method to define mock.On call
+// SaveQueue is a helper method to define mock.On call
 //   - ctx context.Context
 //   - projectID string
-//   - publisherID string
-func (_e *MockRepository_Expecter) IsPublisherPlatformEnabledForProject(ctx interface{}, projectID interface{}, publisherID interface{}) *MockRepository_IsPublisherPlatformEnabledForProject_Call {
-	return &MockRepository_IsPublisherPlatformEnabledForProject_Call{Call: _e.mock.On("IsPublisherPlatformEnabledForProject", ctx, projectID, publisherID)}
+//   - q *NamedQueue
+func (_e *MockRepository_Expecter) SaveQueue(ctx interface{}, projectID interface{}, q interface{}) *MockRepository_SaveQueue_Call {
+	return &MockRepository_SaveQueue_Call{Call: _e.mock.On("SaveQueue", ctx, projectID, q)}
 }
 
-func (_c *MockRepository_IsPublisherPlatformEnabledForProject_Call) Run(run func(ctx context.Context, projectID string, publisherID string)) *MockRepository_IsPublisherPlatformEnabledForProject_Call {
+func (_c *MockRepository_SaveQueue_Call) Run(run func(ctx context.Context, projectID string, q *NamedQueue)) *MockRepository_SaveQueue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(*NamedQueue))
 	})
 	return _c
 }
 
-func (_c *MockRepository_IsPublisherPlatformEnabledForProject_Call) Return(_a0 bool, _a1 error) *MockRepository_IsPublisherPlatformEnabledForProject_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockRepository_SaveQueue_Call) Return(_a0 error) *MockRepository_SaveQueue_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockRepository_IsPublisherPlatformEnabledForProject_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_IsPublisherPlatformEnabledForProject_Call {
+func (_c *MockRepository_SaveQueue_Call) RunAndReturn(run func(context.Context, string, *NamedQueue) error) *MockRepository_SaveQueue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveFromProjectIdeaQueue provides a mock function with given fields: ctx, projectID, postID
-func (_m *MockRepository) RemoveFromProjectIdeaQueue(ctx context.Context, projectID string, postID string) error {
-	ret := _m.Called(ctx, projectID, postID)
+// SaveWithPlatforms provides a mock function with given fields: ctx, _a1, platformIDs
+func (_m *MockRepository) SaveWithPlatforms(ctx context.Context, _a1 *Post, platformIDs []string) error {
+	ret := _m.Called(ctx, _a1, platformIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveFromProjectIdeaQueue")
+		panic("no return value specified for SaveWithPlatforms")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, projectID, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, *Post, []string) error); ok {
+		r0 = rf(ctx, _a1, platformIDs)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -928,47 +1873,47 @@ func (_m *MockRepository) RemoveFromProjectIdeaQueue(ctx context.Context, projec
 	return r0
 }
 
-// MockRepository_RemoveFromProjectIdeaQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromProjectIdeaQueue'
-type MockRepository_RemoveFromProjectIdeaQueue_Call struct {
+// MockRepository_SaveWithPlatforms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveWithPlatforms'
+type MockRepository_SaveWithPlatforms_Call struct {
 	*mock.Call
 }
 
-// RemoveFromProjectIdeaQueue is a helper method to define mock.On call
+// SaveWithPlatforms is a helper method to define mock.On call
 //   - ctx context.Context
-//   - projectID string
-//   - postID string
-func (_e *MockRepository_Expecter) RemoveFromProjectIdeaQueue(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_RemoveFromProjectIdeaQueue_Call {
-	return &MockRepository_RemoveFromProjectIdeaQueue_Call{Call: _e.mock.On("RemoveFromProjectIdeaQueue", ctx, projectID, postID)}
+//   - _a1 *Post
+//   - platformIDs []string
+func (_e *MockRepository_Expecter) SaveWithPlatforms(ctx interface{}, _a1 interface{}, platformIDs interface{}) *MockRepository_SaveWithPlatforms_Call {
+	return &MockRepository_SaveWithPlatforms_Call{Call: _e.mock.On("SaveWithPlatforms", ctx, _a1, platformIDs)}
 }
 
-func (_c *MockRepository_RemoveFromProjectIdeaQueue_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_RemoveFromProjectIdeaQueue_Call {
+func (_c *MockRepository_SaveWithPlatforms_Call) Run(run func(ctx context.Context, _a1 *Post, platformIDs []string)) *MockRepository_SaveWithPlatforms_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(*Post), args[2].([]string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_RemoveFromProjectIdeaQueue_Call) Return(_a0 error) *MockRepository_RemoveFromProjectIdeaQueue_Call {
+func (_c *MockRepository_SaveWithPlatforms_Call) Return(_a0 error) *MockRepository_SaveWithPlatforms_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockRepository_RemoveFromProjectIdeaQueue_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_RemoveFromProjectIdeaQueue_Call {
+func (_c *MockRepository_SaveWithPlatforms_Call) RunAndReturn(run func(context.Context, *Post, []string) error) *MockRepository_SaveWithPlatforms_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveFromProjectQueue provides a mock function with given fields: ctx, projectID, postID
-func (_m *MockRepository) RemoveFromProjectQueue(ctx context.Context, projectID string, postID string) error {
-	ret := _m.Called(ctx, projectID, postID)
+// SchedulePost provides a mock function with given fields: ctx, id, sheduled_at
+func (_m *MockRepository) SchedulePost(ctx context.Context, id string, sheduled_at time.Time) error {
+	ret := _m.Called(ctx, id, sheduled_at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveFromProjectQueue")
+		panic("no return value specified for SchedulePost")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, projectID, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, sheduled_at)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -976,142 +1921,172 @@ func (_m *MockRepository) RemoveFromProjectQueue(ctx context.Context, projectID
 	return r0
 }
 
-// MockRepository_RemoveFromProjectQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromProjectQueue'
-type MockRepository_RemoveFromProjectQueue_Call struct {
+// MockRepository_SchedulePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SchedulePost'
+type MockRepository_SchedulePost_Call struct {
 	*mock.Call
 }
 
-// RemoveFromProjectQueue is a helper method to define mock.On call
+// SchedulePost is a helper method to define mock.On call
 //   - ctx context.Context
-//   - projectID string
-//   - postID string
-func (_e *MockRepository_Expecter) RemoveFromProjectQueue(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_RemoveFromProjectQueue_Call {
-	return &MockRepository_RemoveFromProjectQueue_Call{Call: _e.mock.On("RemoveFromProjectQueue", ctx, projectID, postID)}
+//   - id string
+//   - sheduled_at time.Time
+func (_e *MockRepository_Expecter) SchedulePost(ctx interface{}, id interface{}, sheduled_at interface{}) *MockRepository_SchedulePost_Call {
+	return &MockRepository_SchedulePost_Call{Call: _e.mock.On("SchedulePost", ctx, id, sheduled_at)}
 }
 
-func (_c *MockRepository_RemoveFromProjectQueue_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_RemoveFromProjectQueue_Call {
+func (_c *MockRepository_SchedulePost_Call) Run(run func(ctx context.Context, id string, sheduled_at time.Time)) *MockRepository_SchedulePost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockRepository_RemoveFromProjectQueue_Call) Return(_a0 error) *MockRepository_RemoveFromProjectQueue_Call {
+func (_c *MockRepository_SchedulePost_Call) Return(_a0 error) *MockRepository_SchedulePost_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockRepository_RemoveFromProjectQueue_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_RemoveFromProjectQueue_Call {
+func (_c *MockRepository_SchedulePost_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockRepository_SchedulePost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveSocialMediaPublisher provides a mock function with given fields: ctx, postID, publisherID
-func (_m *MockRepository) RemoveSocialMediaPublisher(ctx context.Context, postID string, publisherID string) error {
-	ret := _m.Called(ctx, postID, publisherID)
+// SearchByProjectID provides a mock function with given fields: ctx, projectID, filter
+func (_m *MockRepository) SearchByProjectID(ctx context.Context, projectID string, filter *PostFilter) ([]*Post, int, error) {
+	ret := _m.Called(ctx, projectID, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveSocialMediaPublisher")
+		panic("no return value specified for SearchByProjectID")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, postID, publisherID)
+	var r0 []*Post
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *PostFilter) ([]*Post, int, error)); ok {
+		return rf(ctx, projectID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *PostFilter) []*Post); ok {
+		r0 = rf(ctx, projectID, filter)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Post)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, *PostFilter) int); ok {
+		r1 = rf(ctx, projectID, filter)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, *PostFilter) error); ok {
+		r2 = rf(ctx, projectID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// MockRepository_RemoveSocialMediaPublisher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSocialMediaPublisher'
-type MockRepository_RemoveSocialMediaPublisher_Call struct {
+// MockRepository_SearchByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchByProjectID'
+type MockRepository_SearchByProjectID_Call struct {
 	*mock.Call
 }
 
-// RemoveSocialMediaPublisher is a helper method to define mock.On call
+// SearchByProjectID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
-//   - publisherID string
-func (_e *MockRepository_Expecter) RemoveSocialMediaPublisher(ctx interface{}, postID interface{}, publisherID interface{}) *MockRepository_RemoveSocialMediaPublisher_Call {
-	return &MockRepository_RemoveSocialMediaPublisher_Call{Call: _e.mock.On("RemoveSocialMediaPublisher", ctx, postID, publisherID)}
+//   - projectID string
+//   - filter *PostFilter
+func (_e *MockRepository_Expecter) SearchByProjectID(ctx interface{}, projectID interface{}, filter interface{}) *MockRepository_SearchByProjectID_Call {
+	return &MockRepository_SearchByProjectID_Call{Call: _e.mock.On("SearchByProjectID", ctx, projectID, filter)}
 }
 
-func (_c *MockRepository_RemoveSocialMediaPublisher_Call) Run(run func(ctx context.Context, postID string, publisherID string)) *MockRepository_RemoveSocialMediaPublisher_Call {
+func (_c *MockRepository_SearchByProjectID_Call) Run(run func(ctx context.Context, projectID string, filter *PostFilter)) *MockRepository_SearchByProjectID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(*PostFilter))
 	})
 	return _c
 }
 
-func (_c *MockRepository_RemoveSocialMediaPublisher_Call) Return(_a0 error) *MockRepository_RemoveSocialMediaPublisher_Call {
-	_c.Call.Return(_a0)
+func (_c *MockRepository_SearchByProjectID_Call) Return(_a0 []*Post, _a1 int, _a2 error) *MockRepository_SearchByProjectID_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockRepository_RemoveSocialMediaPublisher_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_RemoveSocialMediaPublisher_Call {
+func (_c *MockRepository_SearchByProjectID_Call) RunAndReturn(run func(context.Context, string, *PostFilter) ([]*Post, int, error)) *MockRepository_SearchByProjectID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RestorePost provides a mock function with given fields: ctx, id
-func (_m *MockRepository) RestorePost(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// ShiftProjectQueue provides a mock function with given fields: ctx, projectID, queue
+func (_m *MockRepository) ShiftProjectQueue(ctx context.Context, projectID string, queue string) (string, error) {
+	ret := _m.Called(ctx, projectID, queue)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RestorePost")
+		panic("no return value specified for ShiftProjectQueue")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, projectID, queue)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, projectID, queue)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, queue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockRepository_RestorePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestorePost'
-type MockRepository_RestorePost_Call struct {
+// MockRepository_ShiftProjectQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShiftProjectQueue'
+type MockRepository_ShiftProjectQueue_Call struct {
 	*mock.Call
 }
 
-// RestorePost is a helper method to define mock.On call
+// ShiftProjectQueue is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockRepository_Expecter) RestorePost(ctx interface{}, id interface{}) *MockRepository_RestorePost_Call {
-	return &MockRepository_RestorePost_Call{Call: _e.mock.On("RestorePost", ctx, id)}
+//   - projectID string
+//   - queue string
+func (_e *MockRepository_Expecter) ShiftProjectQueue(ctx interface{}, projectID interface{}, queue interface{}) *MockRepository_ShiftProjectQueue_Call {
+	return &MockRepository_ShiftProjectQueue_Call{Call: _e.mock.On("ShiftProjectQueue", ctx, projectID, queue)}
 }
 
-func (_c *MockRepository_RestorePost_Call) Run(run func(ctx context.Context, id string)) *MockRepository_RestorePost_Call {
+func (_c *MockRepository_ShiftProjectQueue_Call) Run(run func(ctx context.Context, projectID string, queue string)) *MockRepository_ShiftProjectQueue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_RestorePost_Call) Return(_a0 error) *MockRepository_RestorePost_Call {
-	_c.Call.Return(_a0)
+func (_c *MockRepository_ShiftProjectQueue_Call) Return(_a0 string, _a1 error) *MockRepository_ShiftProjectQueue_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRepository_RestorePost_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_RestorePost_Call {
+func (_c *MockRepository_ShiftProjectQueue_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *MockRepository_ShiftProjectQueue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Save provides a mock function with given fields: ctx, _a1
-func (_m *MockRepository) Save(ctx context.Context, _a1 *Post) error {
-	ret := _m.Called(ctx, _a1)
+// TrashPost provides a mock function with given fields: ctx, id, deletedAt
+func (_m *MockRepository) TrashPost(ctx context.Context, id string, deletedAt time.Time) error {
+	ret := _m.Called(ctx, id, deletedAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Save")
+		panic("no return value specified for TrashPost")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *Post) error); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, deletedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1119,46 +2094,47 @@ func (_m *MockRepository) Save(ctx context.Context, _a1 *Post) error {
 	return r0
 }
 
-// MockRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
-type MockRepository_Save_Call struct {
+// MockRepository_TrashPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashPost'
+type MockRepository_TrashPost_Call struct {
 	*mock.Call
 }
 
-// Save is a helper method to define mock.On call
+// TrashPost is a helper method to define mock.On call
 //   - ctx context.Context
-//   - _a1 *Post
-func (_e *MockRepository_Expecter) Save(ctx interface{}, _a1 interface{}) *MockRepository_Save_Call {
-	return &MockRepository_Save_Call{Call: _e.mock.On("Save", ctx, _a1)}
+//   - id string
+//   - deletedAt time.Time
+func (_e *MockRepository_Expecter) TrashPost(ctx interface{}, id interface{}, deletedAt interface{}) *MockRepository_TrashPost_Call {
+	return &MockRepository_TrashPost_Call{Call: _e.mock.On("TrashPost", ctx, id, deletedAt)}
 }
 
-func (_c *MockRepository_Save_Call) Run(run func(ctx context.Context, _a1 *Post)) *MockRepository_Save_Call {
+func (_c *MockRepository_TrashPost_Call) Run(run func(ctx context.Context, id string, deletedAt time.Time)) *MockRepository_TrashPost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*Post))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockRepository_Save_Call) Return(_a0 error) *MockRepository_Save_Call {
+func (_c *MockRepository_TrashPost_Call) Return(_a0 error) *MockRepository_TrashPost_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockRepository_Save_Call) RunAndReturn(run func(context.Context, *Post) error) *MockRepository_Save_Call {
+func (_c *MockRepository_TrashPost_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockRepository_TrashPost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SchedulePost provides a mock function with given fields: ctx, id, sheduled_at
-func (_m *MockRepository) SchedulePost(ctx context.Context, id string, sheduled_at time.Time) error {
-	ret := _m.Called(ctx, id, sheduled_at)
+// UnqueuePost provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockRepository) UnqueuePost(ctx context.Context, projectID string, postID string) error {
+	ret := _m.Called(ctx, projectID, postID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SchedulePost")
+		panic("no return value specified for UnqueuePost")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
-		r0 = rf(ctx, id, sheduled_at)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1166,32 +2142,32 @@ func (_m *MockRepository) SchedulePost(ctx context.Context, id string, sheduled_
 	return r0
 }
 
-// MockRepository_SchedulePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SchedulePost'
-type MockRepository_SchedulePost_Call struct {
+// MockRepository_UnqueuePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnqueuePost'
+type MockRepository_UnqueuePost_Call struct {
 	*mock.Call
 }
 
-// SchedulePost is a helper method to define mock.On call
+// UnqueuePost is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-//   - sheduled_at time.Time
-func (_e *MockRepository_Expecter) SchedulePost(ctx interface{}, id interface{}, sheduled_at interface{}) *MockRepository_SchedulePost_Call {
-	return &MockRepository_SchedulePost_Call{Call: _e.mock.On("SchedulePost", ctx, id, sheduled_at)}
+//   - projectID string
+//   - postID string
+func (_e *MockRepository_Expecter) UnqueuePost(ctx interface{}, projectID interface{}, postID interface{}) *MockRepository_UnqueuePost_Call {
+	return &MockRepository_UnqueuePost_Call{Call: _e.mock.On("UnqueuePost", ctx, projectID, postID)}
 }
 
-func (_c *MockRepository_SchedulePost_Call) Run(run func(ctx context.Context, id string, sheduled_at time.Time)) *MockRepository_SchedulePost_Call {
+func (_c *MockRepository_UnqueuePost_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockRepository_UnqueuePost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_SchedulePost_Call) Return(_a0 error) *MockRepository_SchedulePost_Call {
+func (_c *MockRepository_UnqueuePost_Call) Return(_a0 error) *MockRepository_UnqueuePost_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockRepository_SchedulePost_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *MockRepository_SchedulePost_Call {
+func (_c *MockRepository_UnqueuePost_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_UnqueuePost_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1290,65 +2266,17 @@ func (_c *MockRepository_Update_Call) RunAndReturn(run func(context.Context, *Po
 	return _c
 }
 
-// UpdateProjectIdeaQueue provides a mock function with given fields: ctx, projectID, queue
-func (_m *MockRepository) UpdateProjectIdeaQueue(ctx context.Context, projectID string, queue []string) error {
-	ret := _m.Called(ctx, projectID, queue)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UpdateProjectIdeaQueue")
-	}
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
-		r0 = rf(ctx, projectID, queue)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// MockRepository_UpdateProjectIdeaQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectIdeaQueue'
-type MockRepository_UpdateProjectIdeaQueue_Call struct {
-	*mock.Call
-}
-
-// UpdateProjectIdeaQueue is a helper method to define mock.On call
-//   - ctx context.Context
-//   - projectID string
-//   - queue []string
-func (_e *MockRepository_Expecter) UpdateProjectIdeaQueue(ctx interface{}, projectID interface{}, queue interface{}) *MockRepository_UpdateProjectIdeaQueue_Call {
-	return &MockRepository_UpdateProjectIdeaQueue_Call{Call: _e.mock.On("UpdateProjectIdeaQueue", ctx, projectID, queue)}
-}
-
-func (_c *MockRepository_UpdateProjectIdeaQueue_Call) Run(run func(ctx context.Context, projectID string, queue []string)) *MockRepository_UpdateProjectIdeaQueue_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]string))
-	})
-	return _c
-}
-
-func (_c *MockRepository_UpdateProjectIdeaQueue_Call) Return(_a0 error) *MockRepository_UpdateProjectIdeaQueue_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *MockRepository_UpdateProjectIdeaQueue_Call) RunAndReturn(run func(context.Context, string, []string) error) *MockRepository_UpdateProjectIdeaQueue_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// UpdateProjectPostQueue provides a mock function with given fields: ctx, projectID, queue
-func (_m *MockRepository) UpdateProjectPostQueue(ctx context.Context, projectID string, queue []string) error {
-	ret := _m.Called(ctx, projectID, queue)
+// UpdatePublishPostStatus provides a mock function with given fields: ctx, postID, platformID, status
+func (_m *MockRepository) UpdatePublishPostStatus(ctx context.Context, postID string, platformID string, status string) error {
+	ret := _m.Called(ctx, postID, platformID, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateProjectPostQueue")
+		panic("no return value specified for UpdatePublishPostStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
-		r0 = rf(ctx, projectID, queue)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, postID, platformID, status)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1356,47 +2284,48 @@ func (_m *MockRepository) UpdateProjectPostQueue(ctx context.Context, projectID
 	return r0
 }
 
-// MockRepository_UpdateProjectPostQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProjectPostQueue'
-type MockRepository_UpdateProjectPostQueue_Call struct {
+// MockRepository_UpdatePublishPostStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePublishPostStatus'
+type MockRepository_UpdatePublishPostStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateProjectPostQueue is a helper method to define mock.On call
+// UpdatePublishPostStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - projectID string
-//   - queue []string
-func (_e *MockRepository_Expecter) UpdateProjectPostQueue(ctx interface{}, projectID interface{}, queue interface{}) *MockRepository_UpdateProjectPostQueue_Call {
-	return &MockRepository_UpdateProjectPostQueue_Call{Call: _e.mock.On("UpdateProjectPostQueue", ctx, projectID, queue)}
+//   - postID string
+//   - platformID string
+//   - status string
+func (_e *MockRepository_Expecter) UpdatePublishPostStatus(ctx interface{}, postID interface{}, platformID interface{}, status interface{}) *MockRepository_UpdatePublishPostStatus_Call {
+	return &MockRepository_UpdatePublishPostStatus_Call{Call: _e.mock.On("UpdatePublishPostStatus", ctx, postID, platformID, status)}
 }
 
-func (_c *MockRepository_UpdateProjectPostQueue_Call) Run(run func(ctx context.Context, projectID string, queue []string)) *MockRepository_UpdateProjectPostQueue_Call {
+func (_c *MockRepository_UpdatePublishPostStatus_Call) Run(run func(ctx context.Context, postID string, platformID string, status string)) *MockRepository_UpdatePublishPostStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_UpdateProjectPostQueue_Call) Return(_a0 error) *MockRepository_UpdateProjectPostQueue_Call {
+func (_c *MockRepository_UpdatePublishPostStatus_Call) Return(_a0 error) *MockRepository_UpdatePublishPostStatus_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockRepository_UpdateProjectPostQueue_Call) RunAndReturn(run func(context.Context, string, []string) error) *MockRepository_UpdateProjectPostQueue_Call {
+func (_c *MockRepository_UpdatePublishPostStatus_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockRepository_UpdatePublishPostStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePublishPostStatus provides a mock function with given fields: ctx, postID, platformID, status
-func (_m *MockRepository) UpdatePublishPostStatus(ctx context.Context, postID string, platformID string, status string) error {
-	ret := _m.Called(ctx, postID, platformID, status)
+// UpdateStatus provides a mock function with given fields: ctx, id, status
+func (_m *MockRepository) UpdateStatus(ctx context.Context, id string, status string) error {
+	ret := _m.Called(ctx, id, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePublishPostStatus")
+		panic("no return value specified for UpdateStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
-		r0 = rf(ctx, postID, platformID, status)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, status)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1404,33 +2333,32 @@ func (_m *MockRepository) UpdatePublishPostStatus(ctx context.Context, postID st
 	return r0
 }
 
-// MockRepository_UpdatePublishPostStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePublishPostStatus'
-type MockRepository_UpdatePublishPostStatus_Call struct {
+// MockRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockRepository_UpdateStatus_Call struct {
 	*mock.Call
 }
 
-// UpdatePublishPostStatus is a helper method to define mock.On call
+// UpdateStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - postID string
-//   - platformID string
+//   - id string
 //   - status string
-func (_e *MockRepository_Expecter) UpdatePublishPostStatus(ctx interface{}, postID interface{}, platformID interface{}, status interface{}) *MockRepository_UpdatePublishPostStatus_Call {
-	return &MockRepository_UpdatePublishPostStatus_Call{Call: _e.mock.On("UpdatePublishPostStatus", ctx, postID, platformID, status)}
+func (_e *MockRepository_Expecter) UpdateStatus(ctx interface{}, id interface{}, status interface{}) *MockRepository_UpdateStatus_Call {
+	return &MockRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, id, status)}
 }
 
-func (_c *MockRepository_UpdatePublishPostStatus_Call) Run(run func(ctx context.Context, postID string, platformID string, status string)) *MockRepository_UpdatePublishPostStatus_Call {
+func (_c *MockRepository_UpdateStatus_Call) Run(run func(ctx context.Context, id string, status string)) *MockRepository_UpdateStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockRepository_UpdatePublishPostStatus_Call) Return(_a0 error) *MockRepository_UpdatePublishPostStatus_Call {
+func (_c *MockRepository_UpdateStatus_Call) Return(_a0 error) *MockRepository_UpdateStatus_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockRepository_UpdatePublishPostStatus_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockRepository_UpdatePublishPostStatus_Call {
+func (_c *MockRepository_UpdateStatus_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_UpdateStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -71,17 +71,17 @@ func (_c *MockService_AddSocialMediaPublisher_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// AddToProjectQueue provides a mock function with given fields: ctx, projectID, postID
-func (_m *MockService) AddToProjectQueue(ctx context.Context, projectID string, postID string) error {
-	ret := _m.Called(ctx, projectID, postID)
+// AddToProjectQueue provides a mock function with given fields: ctx, projectID, postID, queue
+func (_m *MockService) AddToProjectQueue(ctx context.Context, projectID string, postID string, queue string) error {
+	ret := _m.Called(ctx, projectID, postID, queue)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddToProjectQueue")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, projectID, postID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID, queue)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -98,13 +98,14 @@ type MockService_AddToProjectQueue_Call struct {
 //   - ctx context.Context
 //   - projectID string
 //   - postID string
-func (_e *MockService_Expecter) AddToProjectQueue(ctx interface{}, projectID interface{}, postID interface{}) *MockService_AddToProjectQueue_Call {
-	return &MockService_AddToProjectQueue_Call{Call: _e.mock.On("AddToProjectQueue", ctx, projectID, postID)}
+//   - queue string
+func (_e *MockService_Expecter) AddToProjectQueue(ctx interface{}, projectID interface{}, postID interface{}, queue interface{}) *MockService_AddToProjectQueue_Call {
+	return &MockService_AddToProjectQueue_Call{Call: _e.mock.On("AddToProjectQueue", ctx, projectID, postID, queue)}
 }
 
-func (_c *MockService_AddToProjectQueue_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockService_AddToProjectQueue_Call {
+func (_c *MockService_AddToProjectQueue_Call) Run(run func(ctx context.Context, projectID string, postID string, queue string)) *MockService_AddToProjectQueue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
@@ -114,7 +115,7 @@ func (_c *MockService_AddToProjectQueue_Call) Return(_a0 error) *MockService_Add
 	return _c
 }
 
-func (_c *MockService_AddToProjectQueue_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_AddToProjectQueue_Call {
+func (_c *MockService_AddToProjectQueue_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockService_AddToProjectQueue_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -231,6 +232,130 @@ func (_c *MockService_CreatePost_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// CreatePostWithPlatforms provides a mock function with given fields: ctx, projectID, title, postType, textContent, scheduledAt, platformIDs
+func (_m *MockService) CreatePostWithPlatforms(ctx context.Context, projectID string, title string, postType string, textContent string, scheduledAt time.Time, platformIDs []string) (*Post, error) {
+	ret := _m.Called(ctx, projectID, title, postType, textContent, scheduledAt, platformIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePostWithPlatforms")
+	}
+
+	var r0 *Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, time.Time, []string) (*Post, error)); ok {
+		return rf(ctx, projectID, title, postType, textContent, scheduledAt, platformIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, time.Time, []string) *Post); ok {
+		r0 = rf(ctx, projectID, title, postType, textContent, scheduledAt, platformIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, time.Time, []string) error); ok {
+		r1 = rf(ctx, projectID, title, postType, textContent, scheduledAt, platformIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreatePostWithPlatforms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePostWithPlatforms'
+type MockService_CreatePostWithPlatforms_Call struct {
+	*mock.Call
+}
+
+// CreatePostWithPlatforms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - title string
+//   - postType string
+//   - textContent string
+//   - scheduledAt time.Time
+//   - platformIDs []string
+func (_e *MockService_Expecter) CreatePostWithPlatforms(ctx interface{}, projectID interface{}, title interface{}, postType interface{}, textContent interface{}, scheduledAt interface{}, platformIDs interface{}) *MockService_CreatePostWithPlatforms_Call {
+	return &MockService_CreatePostWithPlatforms_Call{Call: _e.mock.On("CreatePostWithPlatforms", ctx, projectID, title, postType, textContent, scheduledAt, platformIDs)}
+}
+
+func (_c *MockService_CreatePostWithPlatforms_Call) Run(run func(ctx context.Context, projectID string, title string, postType string, textContent string, scheduledAt time.Time, platformIDs []string)) *MockService_CreatePostWithPlatforms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(time.Time), args[6].([]string))
+	})
+	return _c
+}
+
+func (_c *MockService_CreatePostWithPlatforms_Call) Return(_a0 *Post, _a1 error) *MockService_CreatePostWithPlatforms_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreatePostWithPlatforms_Call) RunAndReturn(run func(context.Context, string, string, string, string, time.Time, []string) (*Post, error)) *MockService_CreatePostWithPlatforms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateQueue provides a mock function with given fields: ctx, projectID, name
+func (_m *MockService) CreateQueue(ctx context.Context, projectID string, name string) (*NamedQueue, error) {
+	ret := _m.Called(ctx, projectID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQueue")
+	}
+
+	var r0 *NamedQueue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*NamedQueue, error)); ok {
+		return rf(ctx, projectID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *NamedQueue); ok {
+		r0 = rf(ctx, projectID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*NamedQueue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQueue'
+type MockService_CreateQueue_Call struct {
+	*mock.Call
+}
+
+// CreateQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - name string
+func (_e *MockService_Expecter) CreateQueue(ctx interface{}, projectID interface{}, name interface{}) *MockService_CreateQueue_Call {
+	return &MockService_CreateQueue_Call{Call: _e.mock.On("CreateQueue", ctx, projectID, name)}
+}
+
+func (_c *MockService_CreateQueue_Call) Run(run func(ctx context.Context, projectID string, name string)) *MockService_CreateQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateQueue_Call) Return(_a0 *NamedQueue, _a1 error) *MockService_CreateQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateQueue_Call) RunAndReturn(run func(context.Context, string, string) (*NamedQueue, error)) *MockService_CreateQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeletePost provides a mock function with given fields: ctx, id
 func (_m *MockService) DeletePost(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -278,9 +403,57 @@ func (_c *MockService_DeletePost_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
-// DequeuePostsToPublish provides a mock function with given fields: ctx, projectID
-func (_m *MockService) DequeuePostsToPublish(ctx context.Context, projectID string) ([]*PublishPost, error) {
-	ret := _m.Called(ctx, projectID)
+// DeleteQueue provides a mock function with given fields: ctx, projectID, name
+func (_m *MockService) DeleteQueue(ctx context.Context, projectID string, name string) error {
+	ret := _m.Called(ctx, projectID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQueue'
+type MockService_DeleteQueue_Call struct {
+	*mock.Call
+}
+
+// DeleteQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - name string
+func (_e *MockService_Expecter) DeleteQueue(ctx interface{}, projectID interface{}, name interface{}) *MockService_DeleteQueue_Call {
+	return &MockService_DeleteQueue_Call{Call: _e.mock.On("DeleteQueue", ctx, projectID, name)}
+}
+
+func (_c *MockService_DeleteQueue_Call) Run(run func(ctx context.Context, projectID string, name string)) *MockService_DeleteQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteQueue_Call) Return(_a0 error) *MockService_DeleteQueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteQueue_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_DeleteQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DequeuePostsToPublish provides a mock function with given fields: ctx, projectID, queue
+func (_m *MockService) DequeuePostsToPublish(ctx context.Context, projectID string, queue string) ([]*PublishPost, error) {
+	ret := _m.Called(ctx, projectID, queue)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DequeuePostsToPublish")
@@ -288,19 +461,19 @@ func (_m *MockService) DequeuePostsToPublish(ctx context.Context, projectID stri
 
 	var r0 []*PublishPost
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*PublishPost, error)); ok {
-		return rf(ctx, projectID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*PublishPost, error)); ok {
+		return rf(ctx, projectID, queue)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*PublishPost); ok {
-		r0 = rf(ctx, projectID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*PublishPost); ok {
+		r0 = rf(ctx, projectID, queue)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*PublishPost)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, projectID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, queue)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -316,13 +489,14 @@ type MockService_DequeuePostsToPublish_Call struct {
 // DequeuePostsToPublish is a helper method to define mock.On call
 //   - ctx context.Context
 //   - projectID string
-func (_e *MockService_Expecter) DequeuePostsToPublish(ctx interface{}, projectID interface{}) *MockService_DequeuePostsToPublish_Call {
-	return &MockService_DequeuePostsToPublish_Call{Call: _e.mock.On("DequeuePostsToPublish", ctx, projectID)}
+//   - queue string
+func (_e *MockService_Expecter) DequeuePostsToPublish(ctx interface{}, projectID interface{}, queue interface{}) *MockService_DequeuePostsToPublish_Call {
+	return &MockService_DequeuePostsToPublish_Call{Call: _e.mock.On("DequeuePostsToPublish", ctx, projectID, queue)}
 }
 
-func (_c *MockService_DequeuePostsToPublish_Call) Run(run func(ctx context.Context, projectID string)) *MockService_DequeuePostsToPublish_Call {
+func (_c *MockService_DequeuePostsToPublish_Call) Run(run func(ctx context.Context, projectID string, queue string)) *MockService_DequeuePostsToPublish_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -332,7 +506,68 @@ func (_c *MockService_DequeuePostsToPublish_Call) Return(_a0 []*PublishPost, _a1
 	return _c
 }
 
-func (_c *MockService_DequeuePostsToPublish_Call) RunAndReturn(run func(context.Context, string) ([]*PublishPost, error)) *MockService_DequeuePostsToPublish_Call {
+func (_c *MockService_DequeuePostsToPublish_Call) RunAndReturn(run func(context.Context, string, string) ([]*PublishPost, error)) *MockService_DequeuePostsToPublish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPostsDueForPreflight provides a mock function with given fields: ctx, before, offset, chunkSize
+func (_m *MockService) FindPostsDueForPreflight(ctx context.Context, before time.Time, offset int, chunkSize int) ([]*Post, error) {
+	ret := _m.Called(ctx, before, offset, chunkSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPostsDueForPreflight")
+	}
+
+	var r0 []*Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, int) ([]*Post, error)); ok {
+		return rf(ctx, before, offset, chunkSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, int) []*Post); ok {
+		r0 = rf(ctx, before, offset, chunkSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, int) error); ok {
+		r1 = rf(ctx, before, offset, chunkSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_FindPostsDueForPreflight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPostsDueForPreflight'
+type MockService_FindPostsDueForPreflight_Call struct {
+	*mock.Call
+}
+
+// FindPostsDueForPreflight is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - offset int
+//   - chunkSize int
+func (_e *MockService_Expecter) FindPostsDueForPreflight(ctx interface{}, before interface{}, offset interface{}, chunkSize interface{}) *MockService_FindPostsDueForPreflight_Call {
+	return &MockService_FindPostsDueForPreflight_Call{Call: _e.mock.On("FindPostsDueForPreflight", ctx, before, offset, chunkSize)}
+}
+
+func (_c *MockService_FindPostsDueForPreflight_Call) Run(run func(ctx context.Context, before time.Time, offset int, chunkSize int)) *MockService_FindPostsDueForPreflight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_FindPostsDueForPreflight_Call) Return(_a0 []*Post, _a1 error) *MockService_FindPostsDueForPreflight_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_FindPostsDueForPreflight_Call) RunAndReturn(run func(context.Context, time.Time, int, int) ([]*Post, error)) *MockService_FindPostsDueForPreflight_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -562,9 +797,9 @@ func (_c *MockService_GetPostToPublish_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// GetProjectQueuedPosts provides a mock function with given fields: ctx, projectID
-func (_m *MockService) GetProjectQueuedPosts(ctx context.Context, projectID string) ([]*Post, error) {
-	ret := _m.Called(ctx, projectID)
+// GetProjectQueuedPosts provides a mock function with given fields: ctx, projectID, queue
+func (_m *MockService) GetProjectQueuedPosts(ctx context.Context, projectID string, queue string) ([]*Post, error) {
+	ret := _m.Called(ctx, projectID, queue)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetProjectQueuedPosts")
@@ -572,19 +807,19 @@ func (_m *MockService) GetProjectQueuedPosts(ctx context.Context, projectID stri
 
 	var r0 []*Post
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Post, error)); ok {
-		return rf(ctx, projectID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*Post, error)); ok {
+		return rf(ctx, projectID, queue)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*Post); ok {
-		r0 = rf(ctx, projectID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*Post); ok {
+		r0 = rf(ctx, projectID, queue)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*Post)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, projectID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, queue)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -600,13 +835,14 @@ type MockService_GetProjectQueuedPosts_Call struct {
 // GetProjectQueuedPosts is a helper method to define mock.On call
 //   - ctx context.Context
 //   - projectID string
-func (_e *MockService_Expecter) GetProjectQueuedPosts(ctx interface{}, projectID interface{}) *MockService_GetProjectQueuedPosts_Call {
-	return &MockService_GetProjectQueuedPosts_Call{Call: _e.mock.On("GetProjectQueuedPosts", ctx, projectID)}
+//   - queue string
+func (_e *MockService_Expecter) GetProjectQueuedPosts(ctx interface{}, projectID interface{}, queue interface{}) *MockService_GetProjectQueuedPosts_Call {
+	return &MockService_GetProjectQueuedPosts_Call{Call: _e.mock.On("GetProjectQueuedPosts", ctx, projectID, queue)}
 }
 
-func (_c *MockService_GetProjectQueuedPosts_Call) Run(run func(ctx context.Context, projectID string)) *MockService_GetProjectQueuedPosts_Call {
+func (_c *MockService_GetProjectQueuedPosts_Call) Run(run func(ctx context.Context, projectID string, queue string)) *MockService_GetProjectQueuedPosts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -616,7 +852,7 @@ func (_c *MockService_GetProjectQueuedPosts_Call) Return(_a0 []*Post, _a1 error)
 	return _c
 }
 
-func (_c *MockService_GetProjectQueuedPosts_Call) RunAndReturn(run func(context.Context, string) ([]*Post, error)) *MockService_GetProjectQueuedPosts_Call {
+func (_c *MockService_GetProjectQueuedPosts_Call) RunAndReturn(run func(context.Context, string, string) ([]*Post, error)) *MockService_GetProjectQueuedPosts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -680,24 +916,84 @@ func (_c *MockService_GetSocialMediaPublishers_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// ListProjectPosts provides a mock function with given fields: ctx, projectID
-func (_m *MockService) ListProjectPosts(ctx context.Context, projectID string) ([]*Post, error) {
-	ret := _m.Called(ctx, projectID)
+// ListProjectPosts provides a mock function with given fields: ctx, projectID, filter
+func (_m *MockService) ListProjectPosts(ctx context.Context, projectID string, filter *PostFilter) (*PostPage, error) {
+	ret := _m.Called(ctx, projectID, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListProjectPosts")
 	}
 
-	var r0 []*Post
+	var r0 *PostPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *PostFilter) (*PostPage, error)); ok {
+		return rf(ctx, projectID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *PostFilter) *PostPage); ok {
+		r0 = rf(ctx, projectID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*PostPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *PostFilter) error); ok {
+		r1 = rf(ctx, projectID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListProjectPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectPosts'
+type MockService_ListProjectPosts_Call struct {
+	*mock.Call
+}
+
+// ListProjectPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - filter *PostFilter
+func (_e *MockService_Expecter) ListProjectPosts(ctx interface{}, projectID interface{}, filter interface{}) *MockService_ListProjectPosts_Call {
+	return &MockService_ListProjectPosts_Call{Call: _e.mock.On("ListProjectPosts", ctx, projectID, filter)}
+}
+
+func (_c *MockService_ListProjectPosts_Call) Run(run func(ctx context.Context, projectID string, filter *PostFilter)) *MockService_ListProjectPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*PostFilter))
+	})
+	return _c
+}
+
+func (_c *MockService_ListProjectPosts_Call) Return(_a0 *PostPage, _a1 error) *MockService_ListProjectPosts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListProjectPosts_Call) RunAndReturn(run func(context.Context, string, *PostFilter) (*PostPage, error)) *MockService_ListProjectPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListQueues provides a mock function with given fields: ctx, projectID
+func (_m *MockService) ListQueues(ctx context.Context, projectID string) ([]*NamedQueue, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQueues")
+	}
+
+	var r0 []*NamedQueue
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Post, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*NamedQueue, error)); ok {
 		return rf(ctx, projectID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*Post); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*NamedQueue); ok {
 		r0 = rf(ctx, projectID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*Post)
+			r0 = ret.Get(0).([]*NamedQueue)
 		}
 	}
 
@@ -710,31 +1006,137 @@ func (_m *MockService) ListProjectPosts(ctx context.Context, projectID string) (
 	return r0, r1
 }
 
-// MockService_ListProjectPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectPosts'
-type MockService_ListProjectPosts_Call struct {
+// MockService_ListQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueues'
+type MockService_ListQueues_Call struct {
 	*mock.Call
 }
 
-// ListProjectPosts is a helper method to define mock.On call
+// ListQueues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockService_Expecter) ListQueues(ctx interface{}, projectID interface{}) *MockService_ListQueues_Call {
+	return &MockService_ListQueues_Call{Call: _e.mock.On("ListQueues", ctx, projectID)}
+}
+
+func (_c *MockService_ListQueues_Call) Run(run func(ctx context.Context, projectID string)) *MockService_ListQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListQueues_Call) Return(_a0 []*NamedQueue, _a1 error) *MockService_ListQueues_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListQueues_Call) RunAndReturn(run func(context.Context, string) ([]*NamedQueue, error)) *MockService_ListQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrash provides a mock function with given fields: ctx, projectID
+func (_m *MockService) ListTrash(ctx context.Context, projectID string) ([]*TrashedPost, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 []*TrashedPost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*TrashedPost, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*TrashedPost); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*TrashedPost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrash'
+type MockService_ListTrash_Call struct {
+	*mock.Call
+}
+
+// ListTrash is a helper method to define mock.On call
 //   - ctx context.Context
 //   - projectID string
-func (_e *MockService_Expecter) ListProjectPosts(ctx interface{}, projectID interface{}) *MockService_ListProjectPosts_Call {
-	return &MockService_ListProjectPosts_Call{Call: _e.mock.On("ListProjectPosts", ctx, projectID)}
+func (_e *MockService_Expecter) ListTrash(ctx interface{}, projectID interface{}) *MockService_ListTrash_Call {
+	return &MockService_ListTrash_Call{Call: _e.mock.On("ListTrash", ctx, projectID)}
 }
 
-func (_c *MockService_ListProjectPosts_Call) Run(run func(ctx context.Context, projectID string)) *MockService_ListProjectPosts_Call {
+func (_c *MockService_ListTrash_Call) Run(run func(ctx context.Context, projectID string)) *MockService_ListTrash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockService_ListProjectPosts_Call) Return(_a0 []*Post, _a1 error) *MockService_ListProjectPosts_Call {
+func (_c *MockService_ListTrash_Call) Return(_a0 []*TrashedPost, _a1 error) *MockService_ListTrash_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockService_ListProjectPosts_Call) RunAndReturn(run func(context.Context, string) ([]*Post, error)) *MockService_ListProjectPosts_Call {
+func (_c *MockService_ListTrash_Call) RunAndReturn(run func(context.Context, string) ([]*TrashedPost, error)) *MockService_ListTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPreflightChecked provides a mock function with given fields: ctx, postID
+func (_m *MockService) MarkPreflightChecked(ctx context.Context, postID string) error {
+	ret := _m.Called(ctx, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPreflightChecked")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_MarkPreflightChecked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPreflightChecked'
+type MockService_MarkPreflightChecked_Call struct {
+	*mock.Call
+}
+
+// MarkPreflightChecked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - postID string
+func (_e *MockService_Expecter) MarkPreflightChecked(ctx interface{}, postID interface{}) *MockService_MarkPreflightChecked_Call {
+	return &MockService_MarkPreflightChecked_Call{Call: _e.mock.On("MarkPreflightChecked", ctx, postID)}
+}
+
+func (_c *MockService_MarkPreflightChecked_Call) Run(run func(ctx context.Context, postID string)) *MockService_MarkPreflightChecked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_MarkPreflightChecked_Call) Return(_a0 error) *MockService_MarkPreflightChecked_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_MarkPreflightChecked_Call) RunAndReturn(run func(context.Context, string) error) *MockService_MarkPreflightChecked_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -788,17 +1190,17 @@ func (_c *MockService_MoveIdeaInQueue_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// MovePostInQueue provides a mock function with given fields: ctx, projectID, currentIndex, newIndex
-func (_m *MockService) MovePostInQueue(ctx context.Context, projectID string, currentIndex int, newIndex int) error {
-	ret := _m.Called(ctx, projectID, currentIndex, newIndex)
+// MovePostInQueue provides a mock function with given fields: ctx, projectID, queue, currentIndex, newIndex
+func (_m *MockService) MovePostInQueue(ctx context.Context, projectID string, queue string, currentIndex int, newIndex int) error {
+	ret := _m.Called(ctx, projectID, queue, currentIndex, newIndex)
 
 	if len(ret) == 0 {
 		panic("no return value specified for MovePostInQueue")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) error); ok {
-		r0 = rf(ctx, projectID, currentIndex, newIndex)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, int) error); ok {
+		r0 = rf(ctx, projectID, queue, currentIndex, newIndex)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -814,15 +1216,16 @@ type MockService_MovePostInQueue_Call struct {
 // MovePostInQueue is a helper method to define mock.On call
 //   - ctx context.Context
 //   - projectID string
+//   - queue string
 //   - currentIndex int
 //   - newIndex int
-func (_e *MockService_Expecter) MovePostInQueue(ctx interface{}, projectID interface{}, currentIndex interface{}, newIndex interface{}) *MockService_MovePostInQueue_Call {
-	return &MockService_MovePostInQueue_Call{Call: _e.mock.On("MovePostInQueue", ctx, projectID, currentIndex, newIndex)}
+func (_e *MockService_Expecter) MovePostInQueue(ctx interface{}, projectID interface{}, queue interface{}, currentIndex interface{}, newIndex interface{}) *MockService_MovePostInQueue_Call {
+	return &MockService_MovePostInQueue_Call{Call: _e.mock.On("MovePostInQueue", ctx, projectID, queue, currentIndex, newIndex)}
 }
 
-func (_c *MockService_MovePostInQueue_Call) Run(run func(ctx context.Context, projectID string, currentIndex int, newIndex int)) *MockService_MovePostInQueue_Call {
+func (_c *MockService_MovePostInQueue_Call) Run(run func(ctx context.Context, projectID string, queue string, currentIndex int, newIndex int)) *MockService_MovePostInQueue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int), args[4].(int))
 	})
 	return _c
 }
@@ -832,7 +1235,55 @@ func (_c *MockService_MovePostInQueue_Call) Return(_a0 error) *MockService_MoveP
 	return _c
 }
 
-func (_c *MockService_MovePostInQueue_Call) RunAndReturn(run func(context.Context, string, int, int) error) *MockService_MovePostInQueue_Call {
+func (_c *MockService_MovePostInQueue_Call) RunAndReturn(run func(context.Context, string, string, int, int) error) *MockService_MovePostInQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeTrash provides a mock function with given fields: ctx, deletedBefore, batchSize
+func (_m *MockService) PurgeTrash(ctx context.Context, deletedBefore time.Time, batchSize int) error {
+	ret := _m.Called(ctx, deletedBefore, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) error); ok {
+		r0 = rf(ctx, deletedBefore, batchSize)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_PurgeTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeTrash'
+type MockService_PurgeTrash_Call struct {
+	*mock.Call
+}
+
+// PurgeTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+//   - batchSize int
+func (_e *MockService_Expecter) PurgeTrash(ctx interface{}, deletedBefore interface{}, batchSize interface{}) *MockService_PurgeTrash_Call {
+	return &MockService_PurgeTrash_Call{Call: _e.mock.On("PurgeTrash", ctx, deletedBefore, batchSize)}
+}
+
+func (_c *MockService_PurgeTrash_Call) Run(run func(ctx context.Context, deletedBefore time.Time, batchSize int)) *MockService_PurgeTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockService_PurgeTrash_Call) Return(_a0 error) *MockService_PurgeTrash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_PurgeTrash_Call) RunAndReturn(run func(context.Context, time.Time, int) error) *MockService_PurgeTrash_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -982,6 +1433,54 @@ func (_c *MockService_RemoveSocialMediaPublisher_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// RestoreFromTrash provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockService) RestoreFromTrash(ctx context.Context, projectID string, postID string) error {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreFromTrash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_RestoreFromTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreFromTrash'
+type MockService_RestoreFromTrash_Call struct {
+	*mock.Call
+}
+
+// RestoreFromTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockService_Expecter) RestoreFromTrash(ctx interface{}, projectID interface{}, postID interface{}) *MockService_RestoreFromTrash_Call {
+	return &MockService_RestoreFromTrash_Call{Call: _e.mock.On("RestoreFromTrash", ctx, projectID, postID)}
+}
+
+func (_c *MockService_RestoreFromTrash_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockService_RestoreFromTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_RestoreFromTrash_Call) Return(_a0 error) *MockService_RestoreFromTrash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_RestoreFromTrash_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_RestoreFromTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestorePost provides a mock function with given fields: ctx, projectID, postID
 func (_m *MockService) RestorePost(ctx context.Context, projectID string, postID string) error {
 	ret := _m.Called(ctx, projectID, postID)
@@ -1078,6 +1577,105 @@ func (_c *MockService_SchedulePost_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// SetMediaRemover provides a mock function with given fields: r
+func (_m *MockService) SetMediaRemover(r MediaRemover) {
+	_m.Called(r)
+}
+
+// MockService_SetMediaRemover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMediaRemover'
+type MockService_SetMediaRemover_Call struct {
+	*mock.Call
+}
+
+// SetMediaRemover is a helper method to define mock.On call
+//   - r MediaRemover
+func (_e *MockService_Expecter) SetMediaRemover(r interface{}) *MockService_SetMediaRemover_Call {
+	return &MockService_SetMediaRemover_Call{Call: _e.mock.On("SetMediaRemover", r)}
+}
+
+func (_c *MockService_SetMediaRemover_Call) Run(run func(r MediaRemover)) *MockService_SetMediaRemover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(MediaRemover))
+	})
+	return _c
+}
+
+func (_c *MockService_SetMediaRemover_Call) Return() *MockService_SetMediaRemover_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockService_SetMediaRemover_Call) RunAndReturn(run func(MediaRemover)) *MockService_SetMediaRemover_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetPreflightValidator provides a mock function with given fields: v
+func (_m *MockService) SetPreflightValidator(v PreflightValidator) {
+	_m.Called(v)
+}
+
+// MockService_SetPreflightValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPreflightValidator'
+type MockService_SetPreflightValidator_Call struct {
+	*mock.Call
+}
+
+// SetPreflightValidator is a helper method to define mock.On call
+//   - v PreflightValidator
+func (_e *MockService_Expecter) SetPreflightValidator(v interface{}) *MockService_SetPreflightValidator_Call {
+	return &MockService_SetPreflightValidator_Call{Call: _e.mock.On("SetPreflightValidator", v)}
+}
+
+func (_c *MockService_SetPreflightValidator_Call) Run(run func(v PreflightValidator)) *MockService_SetPreflightValidator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(PreflightValidator))
+	})
+	return _c
+}
+
+func (_c *MockService_SetPreflightValidator_Call) Return() *MockService_SetPreflightValidator_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockService_SetPreflightValidator_Call) RunAndReturn(run func(PreflightValidator)) *MockService_SetPreflightValidator_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetSnippetInserter provides a mock function with given fields: i
+func (_m *MockService) SetSnippetInserter(i SnippetInserter) {
+	_m.Called(i)
+}
+
+// MockService_SetSnippetInserter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSnippetInserter'
+type MockService_SetSnippetInserter_Call struct {
+	*mock.Call
+}
+
+// SetSnippetInserter is a helper method to define mock.On call
+//   - i SnippetInserter
+func (_e *MockService_Expecter) SetSnippetInserter(i interface{}) *MockService_SetSnippetInserter_Call {
+	return &MockService_SetSnippetInserter_Call{Call: _e.mock.On("SetSnippetInserter", i)}
+}
+
+func (_c *MockService_SetSnippetInserter_Call) Run(run func(i SnippetInserter)) *MockService_SetSnippetInserter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(SnippetInserter))
+	})
+	return _c
+}
+
+func (_c *MockService_SetSnippetInserter_Call) Return() *MockService_SetSnippetInserter_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockService_SetSnippetInserter_Call) RunAndReturn(run func(SnippetInserter)) *MockService_SetSnippetInserter_Call {
+	_c.Run(run)
+	return _c
+}
+
 // UnschedulePost provides a mock function with given fields: ctx, id
 func (_m *MockService) UnschedulePost(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -1125,9 +1723,9 @@ func (_c *MockService_UnschedulePost_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
-// UpdatePost provides a mock function with given fields: ctx, id, projectID, title, postType, textContent, isIdea
-func (_m *MockService) UpdatePost(ctx context.Context, id string, projectID string, title string, postType string, textContent string, isIdea bool) (*Post, error) {
-	ret := _m.Called(ctx, id, projectID, title, postType, textContent, isIdea)
+// UpdatePost provides a mock function with given fields: ctx, id, projectID, title, postType, textContent, isIdea, version
+func (_m *MockService) UpdatePost(ctx context.Context, id string, projectID string, title string, postType string, textContent string, isIdea bool, version int) (*Post, error) {
+	ret := _m.Called(ctx, id, projectID, title, postType, textContent, isIdea, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdatePost")
@@ -1135,19 +1733,19 @@ func (_m *MockService) UpdatePost(ctx context.Context, id string, projectID stri
 
 	var r0 *Post
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, bool) (*Post, error)); ok {
-		return rf(ctx, id, projectID, title, postType, textContent, isIdea)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, bool, int) (*Post, error)); ok {
+		return rf(ctx, id, projectID, title, postType, textContent, isIdea, version)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, bool) *Post); ok {
-		r0 = rf(ctx, id, projectID, title, postType, textContent, isIdea)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, bool, int) *Post); ok {
+		r0 = rf(ctx, id, projectID, title, postType, textContent, isIdea, version)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*Post)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string, bool) error); ok {
-		r1 = rf(ctx, id, projectID, title, postType, textContent, isIdea)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string, bool, int) error); ok {
+		r1 = rf(ctx, id, projectID, title, postType, textContent, isIdea, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1168,13 +1766,14 @@ type MockService_UpdatePost_Call struct {
 //   - postType string
 //   - textContent string
 //   - isIdea bool
-func (_e *MockService_Expecter) UpdatePost(ctx interface{}, id interface{}, projectID interface{}, title interface{}, postType interface{}, textContent interface{}, isIdea interface{}) *MockService_UpdatePost_Call {
-	return &MockService_UpdatePost_Call{Call: _e.mock.On("UpdatePost", ctx, id, projectID, title, postType, textContent, isIdea)}
+//   - version int
+func (_e *MockService_Expecter) UpdatePost(ctx interface{}, id interface{}, projectID interface{}, title interface{}, postType interface{}, textContent interface{}, isIdea interface{}, version interface{}) *MockService_UpdatePost_Call {
+	return &MockService_UpdatePost_Call{Call: _e.mock.On("UpdatePost", ctx, id, projectID, title, postType, textContent, isIdea, version)}
 }
 
-func (_c *MockService_UpdatePost_Call) Run(run func(ctx context.Context, id string, projectID string, title string, postType string, textContent string, isIdea bool)) *MockService_UpdatePost_Call {
+func (_c *MockService_UpdatePost_Call) Run(run func(ctx context.Context, id string, projectID string, title string, postType string, textContent string, isIdea bool, version int)) *MockService_UpdatePost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].(bool))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].(bool), args[7].(int))
 	})
 	return _c
 }
@@ -1184,7 +1783,7 @@ func (_c *MockService_UpdatePost_Call) Return(_a0 *Post, _a1 error) *MockService
 	return _c
 }
 
-func (_c *MockService_UpdatePost_Call) RunAndReturn(run func(context.Context, string, string, string, string, string, bool) (*Post, error)) *MockService_UpdatePost_Call {
+func (_c *MockService_UpdatePost_Call) RunAndReturn(run func(context.Context, string, string, string, string, string, bool, int) (*Post, error)) *MockService_UpdatePost_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package post
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSnippetInserter is an autogenerated mock type for the SnippetInserter type
+type MockSnippetInserter struct {
+	mock.Mock
+}
+
+type MockSnippetInserter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSnippetInserter) EXPECT() *MockSnippetInserter_Expecter {
+	return &MockSnippetInserter_Expecter{mock: &_m.Mock}
+}
+
+// InsertSnippets provides a mock function with given fields: ctx, projectID, text
+func (_m *MockSnippetInserter) InsertSnippets(ctx context.Context, projectID string, text string) (string, error) {
+	ret := _m.Called(ctx, projectID, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertSnippets")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, projectID, text)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, projectID, text)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSnippetInserter_InsertSnippets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertSnippets'
+type MockSnippetInserter_InsertSnippets_Call struct {
+	*mock.Call
+}
+
+// InsertSnippets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - text string
+func (_e *MockSnippetInserter_Expecter) InsertSnippets(ctx interface{}, projectID interface{}, text interface{}) *MockSnippetInserter_InsertSnippets_Call {
+	return &MockSnippetInserter_InsertSnippets_Call{Call: _e.mock.On("InsertSnippets", ctx, projectID, text)}
+}
+
+func (_c *MockSnippetInserter_InsertSnippets_Call) Run(run func(ctx context.Context, projectID string, text string)) *MockSnippetInserter_InsertSnippets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSnippetInserter_InsertSnippets_Call) Return(_a0 string, _a1 error) *MockSnippetInserter_InsertSnippets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSnippetInserter_InsertSnippets_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *MockSnippetInserter_InsertSnippets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSnippetInserter creates a new instance of MockSnippetInserter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSnippetInserter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSnippetInserter {
+	mock := &MockSnippetInserter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -77,6 +77,12 @@ var (
 	ErrPostNotInQueue             = errors.New("post not in queue")
 	ErrPostIsNotIdea              = errors.New("post is not an idea")
 	ErrPostNotArchived            = errors.New("post not archived")
+	ErrQueueNotFound              = errors.New("queue not found")
+	ErrQueueAlreadyExists         = errors.New("queue already exists")
+	ErrInvalidQueueName           = errors.New("invalid queue name")
+	ErrVersionConflict            = errors.New("post was modified by someone else")
+	ErrPostNotInTrash             = errors.New("post not in trash")
+	ErrPostMediaProcessing        = errors.New("post has media still processing")
 )
 
 type Post struct {
@@ -88,9 +94,12 @@ type Post struct {
 	IsIdea      bool      `json:"is_idea"`
 	Status      string    `json:"status"`
 	CreatedBy   string    `json:"created_by"`
-	ScheduledAt time.Time `json:"scheduled_at"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	IdeaID      *string    `json:"idea_id,omitempty"` // Idea the post was promoted from
+	Version     int        `json:"version"`           // Incremented on every edit, used as ETag
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
 }
 
 type Platform struct {
@@ -142,6 +151,7 @@ func NewPost(
 		Status:      string(PostStatusDraft),
 		CreatedBy:   userID,
 		ScheduledAt: scheduledAt,
+		Version:     1,
 		CreatedAt:   time.Now().UTC(),
 		UpdatedAt:   time.Now().UTC(),
 	}, nil
@@ -1,5 +1,39 @@
 package post
 
+import (
+	"strings"
+	"time"
+)
+
+// DefaultQueue is the queue every project has. Time slots without a queue draw from it.
+const DefaultQueue = "default"
+
+const maxQueueNameLength = 50
+
+// NamedQueue is a category of queued posts with its own order, e.g. "tips" or "promotions"
+type NamedQueue struct {
+	Name      string    `json:"name"`
+	Size      int       `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// QueueName returns the name of the queue a request refers to, an empty name being the default queue
+func QueueName(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return DefaultQueue
+	}
+	return name
+}
+
+// ValidateQueueName checks the name of a queue being created
+func ValidateQueueName(name string) error {
+	if name == "" || name == DefaultQueue || len(name) > maxQueueNameLength || strings.ContainsAny(name, "/?#") {
+		return ErrInvalidQueueName
+	}
+	return nil
+}
+
 // FIFO queue of postIDs
 type Queue []string
 
@@ -1,6 +1,7 @@
 package post
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -152,4 +153,17 @@ func TestPostQueue_Pop(t *testing.T) {
 	assert.Equal(t, "2", q.Pop(1))
 	assert.Equal(t, 2, q.Len())
 	assert.Equal(t, "3", q.Get(1))
-}
\ No newline at end of file
+}
+func TestQueueName(t *testing.T) {
+	assert.Equal(t, DefaultQueue, QueueName(""))
+	assert.Equal(t, DefaultQueue, QueueName("  "))
+	assert.Equal(t, "tips", QueueName("tips"))
+}
+
+func TestValidateQueueName(t *testing.T) {
+	assert.NoError(t, ValidateQueueName("promotions"))
+	assert.ErrorIs(t, ValidateQueueName(""), ErrInvalidQueueName)
+	assert.ErrorIs(t, ValidateQueueName(DefaultQueue), ErrInvalidQueueName)
+	assert.ErrorIs(t, ValidateQueueName("tips/old"), ErrInvalidQueueName)
+	assert.ErrorIs(t, ValidateQueueName(strings.Repeat("a", 51)), ErrInvalidQueueName)
+}
@@ -0,0 +1,49 @@
+package post
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/config"
+)
+
+// Purger periodically removes for good the posts that stayed in the trash longer than the retention window
+type Purger struct {
+	service Service
+	cfg     *config.TrashConfig
+	quit    chan struct{}
+}
+
+func NewPurger(service Service, cfg *config.TrashConfig) *Purger {
+	return &Purger{
+		service: service,
+		cfg:     cfg,
+		quit:    make(chan struct{}),
+	}
+}
+
+func (p *Purger) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.Interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				deletedBefore := time.Now().UTC().Add(-p.cfg.Retention)
+				if err := p.service.PurgeTrash(ctx, deletedBefore, p.cfg.BatchSize); err != nil {
+					log.Printf("Error purging trash: %v", err)
+				}
+			case <-p.quit:
+				ticker.Stop()
+				return
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (p *Purger) Stop() {
+	close(p.quit)
+}
@@ -7,29 +7,56 @@ import (
 
 type Repository interface {
 	Save(ctx context.Context, post *Post) error
+	// SaveWithPlatforms saves a post and links it to the platforms in a single transaction
+	SaveWithPlatforms(ctx context.Context, post *Post, platformIDs []string) error
 	Update(ctx context.Context, post *Post) error
+	// UpdateStatus sets the status of a post whatever its version, which it increments. It returns ErrPostNotFound
+	// if there is no such post.
+	UpdateStatus(ctx context.Context, id, status string) error
 	FindByID(ctx context.Context, id string) (*Post, error)
 	FindByProjectID(ctx context.Context, projecID string) ([]*Post, error)
+	// SearchByProjectID returns up to filter.Limit+1 posts after the filter cursor, and the total count of posts matching the filter
+	SearchByProjectID(ctx context.Context, projectID string, filter *PostFilter) ([]*Post, int, error)
 	ArchivePost(ctx context.Context, id string) error
 	RestorePost(ctx context.Context, id string) error
-	DeletePost(ctx context.Context, id string) error
+	// TrashPost moves a post to the trash. Scheduled and queued posts are turned back into drafts.
+	TrashPost(ctx context.Context, id string, deletedAt time.Time) error
+	FindTrashedPost(ctx context.Context, projectID, postID string) (*TrashedPost, error)
+	FindTrash(ctx context.Context, projectID string) ([]*TrashedPost, error)
+	RestoreFromTrash(ctx context.Context, id string) error
+	// FindExpiredTrash returns up to limit posts moved to the trash before deletedBefore, oldest first, after skipping offset
+	FindExpiredTrash(ctx context.Context, deletedBefore time.Time, offset, limit int) ([]*TrashedPost, error)
+	// DeletePost removes for good a post moved to the trash before deletedBefore. It returns ErrPostNotInTrash,
+	// deleting nothing, if the post isn't in the trash or was moved there later.
+	DeletePost(ctx context.Context, id string, deletedBefore time.Time) error
 	AddSocialMediaPublisher(ctx context.Context, postID, publisherID string) error
 	RemoveSocialMediaPublisher(ctx context.Context, postID, publisherID string) error
 	GetSocialMediaPublishersIDs(ctx context.Context, postID string) ([]string, error)
 	GetSocialMediaPlatforms(ctx context.Context, postID string) ([]Platform, error)
 	FindScheduledReadyPosts(ctx context.Context, offset, chunksize int) ([]*PublishPost, error)
+	FindPostsDueForPreflight(ctx context.Context, before time.Time, offset, chunksize int) ([]*Post, error)
+	MarkPreflightChecked(ctx context.Context, postID string) error
+	// HasProcessingMedia tells whether a media of the post, or a library media linked to it, is still processing
+	HasProcessingMedia(ctx context.Context, postID string) (bool, error)
 	SchedulePost(ctx context.Context, id string, sheduled_at time.Time) error
 	UnschedulePost(ctx context.Context, id string) error
 	IsPublisherPlatformEnabledForProject(ctx context.Context, projectID, publisherID string) (bool, error)
-	GetProjectPostQueue(ctx context.Context, projectID string) (*Queue, error)
+	GetProjectPostQueue(ctx context.Context, projectID, queue string) (*Queue, error)
 	GetProjectIdeaQueue(ctx context.Context, projectID string) (*Queue, error)
-	AddToProjectQueue(ctx context.Context, projectID, postID string) error
+	AddToProjectQueue(ctx context.Context, projectID, queue, postID string) error
 	RemoveFromProjectQueue(ctx context.Context, projectID, postID string) error
+	// UnqueuePost removes the post from the post queues of the project and makes it a draft again, at once
+	UnqueuePost(ctx context.Context, projectID, postID string) error
 	AddToProjectIdeaQueue(ctx context.Context, projectID, postID string) error
 	RemoveFromProjectIdeaQueue(ctx context.Context, projectID, postID string) error
 	GetProjectQueuedPosts(ctx context.Context, projectID string, postIDs []string) ([]*Post, error)
-	UpdateProjectPostQueue(ctx context.Context, projectID string, queue []string) error
-	UpdateProjectIdeaQueue(ctx context.Context, projectID string, queue []string) error
+	MovePostInProjectQueue(ctx context.Context, projectID, queue string, from, to int) error
+	ShiftProjectQueue(ctx context.Context, projectID, queue string) (string, error)
+	FindPostQueue(ctx context.Context, projectID, postID string) (string, error)
+	SaveQueue(ctx context.Context, projectID string, q *NamedQueue) error
+	FindQueues(ctx context.Context, projectID string) ([]*NamedQueue, error)
+	DeleteQueue(ctx context.Context, projectID, queue string) error
+	MoveIdeaInProjectQueue(ctx context.Context, projectID string, from, to int) error
 	GetPostsForPublishQueue(ctx context.Context, postID string) ([]*PublishPost, error)
 	GetPostToPublish(ctx context.Context, id string) (*PublishPost, error)
 	UpdatePublishPostStatus(ctx context.Context, postID, platformID, status string) error
@@ -0,0 +1,201 @@
+package post
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+var (
+	ErrInvalidSortField  = errors.New("invalid sort field")
+	ErrInvalidSortOrder  = errors.New("invalid sort order")
+	ErrInvalidPostStatus = errors.New("invalid post status")
+	ErrInvalidCursor     = errors.New("invalid cursor")
+)
+
+type SortField string
+
+// Fields posts can be sorted by
+const (
+	SortByCreatedAt   SortField = "created_at"
+	SortByUpdatedAt   SortField = "updated_at"
+	SortByScheduledAt SortField = "scheduled_at"
+	SortByPublishedAt SortField = "published_at"
+	SortByTitle       SortField = "title"
+)
+
+func (sf SortField) IsValid() bool {
+	switch sf {
+	case SortByCreatedAt, SortByUpdatedAt, SortByScheduledAt, SortByPublishedAt, SortByTitle:
+		return true
+	default:
+		return false
+	}
+}
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+func (so SortOrder) IsValid() bool {
+	return so == SortOrderAsc || so == SortOrderDesc
+}
+
+func (ps PostStatus) IsValid() bool {
+	switch ps {
+	case PostStatusDraft, PostStatusQueued, PostStatusScheduled, PostStatusPublished,
+		PostStatusPartialyPublished, PostStatusFailed, PostStatusArchived:
+		return true
+	default:
+		return false
+	}
+}
+
+// TimeRange is an inclusive range. A nil bound leaves that side open.
+type TimeRange struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// PostFilter holds the criteria used to search the posts of a project. Zero values mean "no filter".
+type PostFilter struct {
+	Status      PostStatus
+	Type        PostType
+	PlatformID  string
+	CreatedBy   string
+	IsIdea      *bool
+	LabelID     string
+	CampaignID  string
+	Created     TimeRange
+	Scheduled   TimeRange
+	Published   TimeRange
+	Query       string // Free-text search over title and content
+	SortBy      SortField
+	SortOrder   SortOrder
+	Limit       int
+	Cursor      string // Opaque cursor returned as next_cursor by a previous search
+	afterCursor *PostCursor
+}
+
+// Normalize applies the defaults and validates the filter
+func (f *PostFilter) Normalize() error {
+	if f.SortBy == "" {
+		f.SortBy = SortByCreatedAt
+	}
+	if !f.SortBy.IsValid() {
+		return ErrInvalidSortField
+	}
+	if f.SortOrder == "" {
+		f.SortOrder = SortOrderDesc
+	}
+	if !f.SortOrder.IsValid() {
+		return ErrInvalidSortOrder
+	}
+	if f.Status != "" && !f.Status.IsValid() {
+		return ErrInvalidPostStatus
+	}
+	if f.Type != "" && !f.Type.IsValid() {
+		return ErrInvalidPostType
+	}
+	if f.Limit <= 0 {
+		f.Limit = DefaultSearchLimit
+	}
+	if f.Limit > MaxSearchLimit {
+		f.Limit = MaxSearchLimit
+	}
+	if f.Cursor != "" {
+		c, err := DecodePostCursor(f.Cursor)
+		if err != nil {
+			return err
+		}
+		if c.SortBy != f.SortBy || c.SortOrder != f.SortOrder {
+			return ErrInvalidCursor
+		}
+		f.afterCursor = c
+	}
+	return nil
+}
+
+// After returns the decoded cursor, or nil when the first page is requested
+func (f *PostFilter) After() *PostCursor {
+	return f.afterCursor
+}
+
+// PostCursor points at the last post of a page. The sort field and order are kept so a cursor
+// can't be reused with a different sort.
+type PostCursor struct {
+	SortBy    SortField `json:"s"`
+	SortOrder SortOrder `json:"o"`
+	Value     string    `json:"v"`
+	ID        string    `json:"id"`
+}
+
+func NewPostCursor(p *Post, sortBy SortField, order SortOrder) *PostCursor {
+	var value string
+	switch sortBy {
+	case SortByTitle:
+		value = p.Title
+	case SortByUpdatedAt:
+		value = p.UpdatedAt.Format(time.RFC3339Nano)
+	case SortByScheduledAt:
+		value = p.ScheduledAt.Format(time.RFC3339Nano)
+	case SortByPublishedAt:
+		if p.PublishedAt != nil {
+			value = p.PublishedAt.Format(time.RFC3339Nano)
+		} else {
+			value = time.Unix(0, 0).UTC().Format(time.RFC3339Nano)
+		}
+	default:
+		value = p.CreatedAt.Format(time.RFC3339Nano)
+	}
+	return &PostCursor{SortBy: sortBy, SortOrder: order, Value: value, ID: p.ID}
+}
+
+func (c *PostCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// TimeValue parses the cursor value of a timestamp sort field
+func (c *PostCursor) TimeValue() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.Value)
+	if err != nil {
+		return time.Time{}, ErrInvalidCursor
+	}
+	return t, nil
+}
+
+func DecodePostCursor(s string) (*PostCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	c := &PostCursor{}
+	if err := json.Unmarshal(data, c); err != nil || c.ID == "" || !c.SortBy.IsValid() || !c.SortOrder.IsValid() {
+		return nil, ErrInvalidCursor
+	}
+	if c.SortBy != SortByTitle {
+		if _, err := c.TimeValue(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// PostPage is a page of search results
+type PostPage struct {
+	Posts      []*Post `json:"posts"`
+	Total      int     `json:"total"`
+	Limit      int     `json:"limit"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+	HasMore    bool    `json:"has_more"`
+}
@@ -0,0 +1,71 @@
+package post
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostFilter_Normalize(t *testing.T) {
+	f := &PostFilter{Limit: 500}
+	assert.NoError(t, f.Normalize())
+	assert.Equal(t, SortByCreatedAt, f.SortBy)
+	assert.Equal(t, SortOrderDesc, f.SortOrder)
+	assert.Equal(t, MaxSearchLimit, f.Limit)
+	assert.Nil(t, f.After())
+
+	assert.ErrorIs(t, (&PostFilter{SortBy: "id"}).Normalize(), ErrInvalidSortField)
+	assert.ErrorIs(t, (&PostFilter{SortOrder: "up"}).Normalize(), ErrInvalidSortOrder)
+	assert.ErrorIs(t, (&PostFilter{Status: "done"}).Normalize(), ErrInvalidPostStatus)
+	assert.ErrorIs(t, (&PostFilter{Cursor: "not a cursor"}).Normalize(), ErrInvalidCursor)
+}
+
+func TestPostCursor_RoundTrip(t *testing.T) {
+	p := &Post{ID: "post-1", Title: "Hello", CreatedAt: time.Date(2024, 5, 1, 10, 0, 0, 500, time.UTC)}
+
+	c, err := DecodePostCursor(NewPostCursor(p, SortByCreatedAt, SortOrderDesc).Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, "post-1", c.ID)
+	createdAt, err := c.TimeValue()
+	assert.NoError(t, err)
+	assert.True(t, createdAt.Equal(p.CreatedAt))
+
+	// A cursor can't be reused with a different sort
+	f := &PostFilter{SortBy: SortByTitle, Cursor: NewPostCursor(p, SortByCreatedAt, SortOrderDesc).Encode()}
+	assert.ErrorIs(t, f.Normalize(), ErrInvalidCursor)
+}
+
+func TestListProjectPosts_Pagination(t *testing.T) {
+	ctx := context.Background()
+	posts := []*Post{
+		{ID: "post-3", CreatedAt: time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)},
+		{ID: "post-2", CreatedAt: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: "post-1", CreatedAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	mockRepo := NewMockRepository(t)
+	mockRepo.On("SearchByProjectID", ctx, "project-1", mock.MatchedBy(func(f *PostFilter) bool {
+		return f.Limit == 2 && f.After() == nil
+	})).Return(posts, 5, nil)
+	mockRepo.On("SearchByProjectID", ctx, "project-1", mock.MatchedBy(func(f *PostFilter) bool {
+		return f.Limit == 2 && f.After() != nil && f.After().ID == "post-2"
+	})).Return(posts[2:], 5, nil)
+
+	s := NewService(mockRepo)
+
+	page, err := s.ListProjectPosts(ctx, "project-1", &PostFilter{Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, page.Posts, 2)
+	assert.Equal(t, 5, page.Total)
+	assert.True(t, page.HasMore)
+	assert.NotEmpty(t, page.NextCursor)
+
+	page, err = s.ListProjectPosts(ctx, "project-1", &PostFilter{Limit: 2, Cursor: page.NextCursor})
+	assert.NoError(t, err)
+	assert.Len(t, page.Posts, 1)
+	assert.False(t, page.HasMore)
+	assert.Empty(t, page.NextCursor)
+}
@@ -2,6 +2,9 @@ package post
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
@@ -14,12 +17,18 @@ type Service interface {
 		projectID, title, postType, textContent string,
 		isIdea bool,
 		scheduledAt time.Time) (*Post, error)
-	UpdatePost(ctx context.Context, id, projectID, title, postType, textContent string, isIdea bool) (*Post, error)
+	// CreatePostWithPlatforms creates a draft linked to the platforms, all at once. The text is saved as given,
+	// snippets aren't inserted.
+	CreatePostWithPlatforms(ctx context.Context, projectID, title, postType, textContent string, scheduledAt time.Time, platformIDs []string) (*Post, error)
+	UpdatePost(ctx context.Context, id, projectID, title, postType, textContent string, isIdea bool, version int) (*Post, error)
 	GetPost(ctx context.Context, id string) (*PostResponse, error)
-	ListProjectPosts(ctx context.Context, projectID string) ([]*Post, error)
+	ListProjectPosts(ctx context.Context, projectID string, filter *PostFilter) (*PostPage, error)
 	RestorePost(ctx context.Context, projectID, postID string) error
 	ArchivePost(ctx context.Context, projectID, postID string) error
 	DeletePost(ctx context.Context, id string) error
+	ListTrash(ctx context.Context, projectID string) ([]*TrashedPost, error)
+	RestoreFromTrash(ctx context.Context, projectID, postID string) error
+	PurgeTrash(ctx context.Context, deletedBefore time.Time, batchSize int) error
 	AddSocialMediaPublisher(ctx context.Context, projectID, postID, publisherID string) error
 	RemoveSocialMediaPublisher(ctx context.Context, projectID, postID, publisherID string) error
 	GetSocialMediaPublishers(ctx context.Context, postID string) ([]string, error)
@@ -27,20 +36,42 @@ type Service interface {
 	GetPostToPublish(ctx context.Context, id string) (*PublishPost, error)
 	SchedulePost(ctx context.Context, id string, scheduledAt time.Time) error
 	UnschedulePost(ctx context.Context, id string) error
-	AddToProjectQueue(ctx context.Context, projectID, postID string) error
+	AddToProjectQueue(ctx context.Context, projectID, postID, queue string) error
 	RemovePostFromProjectQueue(ctx context.Context, projectID, postID string) error
 	RemoveIdeaFromProjectQueue(ctx context.Context, projectID, postID string) error
-	GetProjectQueuedPosts(ctx context.Context, projectID string) ([]*Post, error)
-	MovePostInQueue(ctx context.Context, projectID string, currentIndex, newIndex int) error
+	GetProjectQueuedPosts(ctx context.Context, projectID, queue string) ([]*Post, error)
+	MovePostInQueue(ctx context.Context, projectID, queue string, currentIndex, newIndex int) error
 	MoveIdeaInQueue(ctx context.Context, projectID string, currentIndex, newIndex int) error
-	DequeuePostsToPublish(ctx context.Context, projectID string) ([]*PublishPost, error)
+	DequeuePostsToPublish(ctx context.Context, projectID, queue string) ([]*PublishPost, error)
+	CreateQueue(ctx context.Context, projectID, name string) (*NamedQueue, error)
+	ListQueues(ctx context.Context, projectID string) ([]*NamedQueue, error)
+	DeleteQueue(ctx context.Context, projectID, name string) error
 	GetAvailablePostTypes() []string
 	UpdatePostStatus(ctx context.Context, id string, status PostStatus) error
 	UpdatePublishPostStatus(ctx context.Context, postID, platformID string, status PublishPostStatus) error
+	SetPreflightValidator(v PreflightValidator)
+	SetSnippetInserter(i SnippetInserter)
+	SetMediaRemover(r MediaRemover)
+	FindPostsDueForPreflight(ctx context.Context, before time.Time, offset, chunkSize int) ([]*Post, error)
+	MarkPreflightChecked(ctx context.Context, postID string) error
+}
+
+// PreflightValidator runs the publishers' validation for every platform a post is linked to.
+// It returns an error when the post must not be scheduled or queued.
+type PreflightValidator interface {
+	PreflightCheck(ctx context.Context, projectID, postID string) error
+}
+
+// SnippetInserter replaces the {{snippet:name}} references of a post content with the project snippets
+type SnippetInserter interface {
+	InsertSnippets(ctx context.Context, projectID, text string) (string, error)
 }
 
 type service struct {
-	repo Repository
+	repo      Repository
+	validator PreflightValidator
+	snippets  SnippetInserter
+	media     MediaRemover
 }
 
 func NewService(repo Repository) Service {
@@ -59,6 +90,11 @@ func (s *service) CreatePost(
 		return nil, ErrInvalidPostType
 	}
 
+	textContent, err := s.insertSnippets(ctx, projectID, textContent)
+	if err != nil {
+		return nil, err
+	}
+
 	p, err := NewPost(
 		projectID,
 		userID,
@@ -71,47 +107,94 @@ func (s *service) CreatePost(
 		return &Post{}, err
 	}
 
+	err = s.repo.Save(ctx, p)
+	if err != nil {
+		return &Post{}, err
+	}
+
 	if p.IsIdea {
 		err := s.repo.AddToProjectIdeaQueue(ctx, projectID, p.ID)
 		if err != nil {
 			return &Post{}, err
 		}
 	}
-
-	err = s.repo.Save(ctx, p)
-	if err != nil {
-		return &Post{}, err
-	}
 	return p, nil
 }
 
-func (s *service) UpdatePost(ctx context.Context, id, projectID, title, postType, textContent string, isIdea bool) (*Post, error) {
+func (s *service) CreatePostWithPlatforms(
+	ctx context.Context,
+	projectID, title, postType, textContent string,
+	scheduledAt time.Time,
+	platformIDs []string,
+) (*Post, error) {
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+
 	if !PostType(postType).IsValid() {
 		return nil, ErrInvalidPostType
 	}
 
-	p, err := s.repo.FindByID(ctx, id)
+	for _, platformID := range platformIDs {
+		ok, err := s.repo.IsPublisherPlatformEnabledForProject(ctx, projectID, platformID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrPublisherNotInProject, platformID)
+		}
+	}
+
+	p, err := NewPost(projectID, userID, title, postType, textContent, false, scheduledAt)
 	if err != nil {
 		return nil, err
 	}
-	if p == nil {
-		return nil, ErrPostNotFound
+
+	err = s.repo.SaveWithPlatforms(ctx, p, platformIDs)
+	if err != nil {
+		return nil, err
 	}
+	return p, nil
+}
 
-	if p.ProjectID != projectID {
-		return nil, ErrPostNotInProject
+// maxUpdateAttempts bounds the edits made without a version, applied again when the post changes meanwhile
+const maxUpdateAttempts = 3
+
+// UpdatePost edits a post. A non zero version must match the current one, otherwise ErrVersionConflict is returned.
+// Without a version the edit is applied over the changes made since the post was read, such as a new status.
+func (s *service) UpdatePost(ctx context.Context, id, projectID, title, postType, textContent string, isIdea bool, version int) (*Post, error) {
+	if !PostType(postType).IsValid() {
+		return nil, ErrInvalidPostType
 	}
 
-	p.Title = title
-	p.Type = PostType(postType)
-	p.TextContent = textContent
-	p.IsIdea = isIdea
+	for attempt := 1; ; attempt++ {
+		p, err := s.repo.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			return nil, ErrPostNotFound
+		}
 
-	err = s.repo.Update(ctx, p)
-	if err != nil {
-		return nil, err
+		if p.ProjectID != projectID {
+			return nil, ErrPostNotInProject
+		}
+		if version != 0 && version != p.Version {
+			return nil, ErrVersionConflict
+		}
+
+		p.Title = title
+		p.Type = PostType(postType)
+		p.TextContent = textContent
+		p.IsIdea = isIdea
+
+		err = s.repo.Update(ctx, p)
+		if errors.Is(err, ErrVersionConflict) && version == 0 && attempt < maxUpdateAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
 	}
-	return p, nil
 }
 
 func (s *service) GetPost(ctx context.Context, id string) (*PostResponse, error) {
@@ -146,8 +229,35 @@ func (s *service) GetPost(ctx context.Context, id string) (*PostResponse, error)
 	}, nil
 }
 
-func (s *service) ListProjectPosts(ctx context.Context, projectID string) ([]*Post, error) {
-	return s.repo.FindByProjectID(ctx, projectID)
+func (s *service) ListProjectPosts(ctx context.Context, projectID string, filter *PostFilter) (*PostPage, error) {
+	if filter == nil {
+		filter = &PostFilter{}
+	}
+	err := filter.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	posts, total, err := s.repo.SearchByProjectID(ctx, projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &PostPage{
+		Posts: posts,
+		Total: total,
+		Limit: filter.Limit,
+	}
+	if page.Posts == nil {
+		page.Posts = []*Post{}
+	}
+	// The repository fetches one extra post to know whether there is a next page
+	if len(page.Posts) > filter.Limit {
+		page.Posts = page.Posts[:filter.Limit]
+		page.HasMore = true
+		page.NextCursor = NewPostCursor(page.Posts[len(page.Posts)-1], filter.SortBy, filter.SortOrder).Encode()
+	}
+	return page, nil
 }
 
 func (s *service) ArchivePost(ctx context.Context, projectID, postID string) error {
@@ -200,6 +310,7 @@ func (s *service) RestorePost(ctx context.Context, projectID, postID string) err
 	return s.repo.RestorePost(ctx, postID)
 }
 
+// DeletePost moves a post to the trash of its project, it is removed for good once the retention expires
 func (s *service) DeletePost(ctx context.Context, id string) error {
 	p, err := s.repo.FindByID(ctx, id)
 	if err != nil {
@@ -218,11 +329,76 @@ func (s *service) DeletePost(ctx context.Context, id string) error {
 		return s.repo.RemoveFromProjectIdeaQueue(gCtx, p.ProjectID, id)
 	})
 
-	g.Go(func() error {
-		return s.repo.DeletePost(gCtx, id)
-	})
+	err = g.Wait()
+	if err != nil {
+		return err
+	}
 
-	return g.Wait()
+	return s.repo.TrashPost(ctx, id, time.Now().UTC())
+}
+
+func (s *service) ListTrash(ctx context.Context, projectID string) ([]*TrashedPost, error) {
+	return s.repo.FindTrash(ctx, projectID)
+}
+
+func (s *service) RestoreFromTrash(ctx context.Context, projectID, postID string) error {
+	p, err := s.repo.FindTrashedPost(ctx, projectID, postID)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return ErrPostNotInTrash
+	}
+
+	return s.repo.RestoreFromTrash(ctx, postID)
+}
+
+// PurgeTrash removes for good the posts deleted before deletedBefore, with the files of their media, a batch
+// at a time. A post that can't be deleted stays in the trash, so it's tried again on the next run, and the next
+// batches are read past it. Posts restored meanwhile are left as they are.
+func (s *service) PurgeTrash(ctx context.Context, deletedBefore time.Time, batchSize int) error {
+	var (
+		errs   []error
+		failed int
+	)
+	for {
+		posts, err := s.repo.FindExpiredTrash(ctx, deletedBefore, failed, batchSize)
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+
+		for _, p := range posts {
+			var removeFiles func(ctx context.Context) error
+			if s.media != nil {
+				removeFiles, err = s.media.PostFilesRemover(ctx, p.ProjectID, p.ID)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("post %s: %w", p.ID, err))
+					failed++
+					continue
+				}
+			}
+			// The post is deleted first, its files are only removed if it was still in the trash
+			err = s.repo.DeletePost(ctx, p.ID, deletedBefore)
+			if errors.Is(err, ErrPostNotInTrash) {
+				continue
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("post %s: %w", p.ID, err))
+				failed++
+				continue
+			}
+			// Files left behind have no metadata anymore, the garbage collection removes them
+			if removeFiles != nil {
+				if err := removeFiles(ctx); err != nil {
+					errs = append(errs, fmt.Errorf("post %s: %w", p.ID, err))
+				}
+			}
+		}
+
+		if len(posts) < batchSize || ctx.Err() != nil {
+			return errors.Join(errs...)
+		}
+	}
 }
 
 func (s *service) AddSocialMediaPublisher(ctx context.Context, projectID, postID, publisherID string) error {
@@ -295,12 +471,19 @@ func (s *service) GetPostToPublish(ctx context.Context, postID string) (*Publish
 
 func (s *service) SchedulePost(ctx context.Context, id string, scheduletAt time.Time) error {
 	var (
-		p         *Post
-		platforms []Platform
+		p          *Post
+		platforms  []Platform
+		processing bool
 	)
 
 	g, gCtx := errgroup.WithContext(ctx)
 
+	g.Go(func() error {
+		var err error
+		processing, err = s.repo.HasProcessingMedia(gCtx, id)
+		return err
+	})
+
 	g.Go(func() error {
 		var err error
 		p, err = s.repo.FindByID(gCtx, id)
@@ -333,6 +516,14 @@ func (s *service) SchedulePost(ctx context.Context, id string, scheduletAt time.
 	if scheduletAt.Before(time.Now().UTC()) {
 		return ErrPostScheduledTime
 	}
+	if processing {
+		return ErrPostMediaProcessing
+	}
+
+	if err := s.preflightCheck(ctx, p.ProjectID, id); err != nil {
+		return err
+	}
+
 	return s.repo.SchedulePost(ctx, id, scheduletAt)
 }
 
@@ -350,15 +541,24 @@ func (s *service) UnschedulePost(ctx context.Context, id string) error {
 	return s.repo.UnschedulePost(ctx, id)
 }
 
-func (s *service) AddToProjectQueue(ctx context.Context, projectID, postID string) error {
+func (s *service) AddToProjectQueue(ctx context.Context, projectID, postID, queue string) error {
 	var (
-		p         *Post
-		queue     *Queue
-		platforms []Platform
+		p          *Post
+		q          *Queue
+		current    string
+		platforms  []Platform
+		processing bool
 	)
+	queue = QueueName(queue)
 
 	g, gCtx := errgroup.WithContext(ctx)
 
+	g.Go(func() error {
+		var err error
+		processing, err = s.repo.HasProcessingMedia(gCtx, postID)
+		return err
+	})
+
 	g.Go(func() error {
 		var err error
 		p, err = s.repo.FindByID(gCtx, postID)
@@ -367,7 +567,16 @@ func (s *service) AddToProjectQueue(ctx context.Context, projectID, postID strin
 
 	g.Go(func() error {
 		var err error
-		queue, err = s.repo.GetProjectPostQueue(gCtx, projectID)
+		q, err = s.repo.GetProjectPostQueue(gCtx, projectID, queue)
+		if q == nil && err == nil {
+			return ErrQueueNotFound
+		}
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		current, err = s.repo.FindPostQueue(gCtx, projectID, postID)
 		return err
 	})
 
@@ -393,9 +602,16 @@ func (s *service) AddToProjectQueue(ctx context.Context, projectID, postID strin
 	if p.IsIdea {
 		return ErrPostIsIdea
 	}
-	if queue.Contains(p.ID) {
+	if current != "" {
 		return ErrPostAlreadyInQueue
 	}
+	if processing {
+		return ErrPostMediaProcessing
+	}
+
+	if err := s.preflightCheck(ctx, projectID, postID); err != nil {
+		return err
+	}
 
 	p.Status = string(PostStatusQueued)
 	p.ScheduledAt = time.Time{}
@@ -405,7 +621,7 @@ func (s *service) AddToProjectQueue(ctx context.Context, projectID, postID strin
 		return err
 	}
 
-	return s.repo.AddToProjectQueue(ctx, projectID, postID)
+	return s.repo.AddToProjectQueue(ctx, projectID, queue, postID)
 }
 
 func (s *service) RemovePostFromProjectQueue(ctx context.Context, projectID, postID string) error {
@@ -422,15 +638,14 @@ func (s *service) RemovePostFromProjectQueue(ctx context.Context, projectID, pos
 	})
 
 	g.Go(func() error {
-		var err error
-		queue, err := s.repo.GetProjectPostQueue(gCtx, projectID)
+		queue, err := s.repo.FindPostQueue(gCtx, projectID, postID)
 		if err != nil {
 			return err
 		}
-		if !queue.Contains(postID) {
+		if queue == "" {
 			return ErrPostNotInQueue
 		}
-		return err
+		return nil
 	})
 
 	if err := g.Wait(); err != nil {
@@ -447,19 +662,8 @@ func (s *service) RemovePostFromProjectQueue(ctx context.Context, projectID, pos
 		return ErrPostIsIdea
 	}
 
-	p.Status = string(PostStatusDraft)
-
-	g2, gCtx2 := errgroup.WithContext(ctx)
-
-	g2.Go(func() error {
-		return s.repo.Update(gCtx2, p)
-	})
-
-	g2.Go(func() error {
-		return s.repo.RemoveFromProjectQueue(gCtx2, projectID, postID)
-	})
-
-	return g2.Wait()
+	// Edits of the post made meanwhile are kept, only its status changes
+	return s.repo.UnqueuePost(ctx, projectID, postID)
 }
 
 func (s *service) RemoveIdeaFromProjectQueue(ctx context.Context, projectID, postID string) error {
@@ -503,11 +707,14 @@ func (s *service) RemoveIdeaFromProjectQueue(ctx context.Context, projectID, pos
 	return s.repo.RemoveFromProjectIdeaQueue(ctx, projectID, postID)
 }
 
-func (s *service) GetProjectQueuedPosts(ctx context.Context, projectID string) ([]*Post, error) {
-	q, err := s.repo.GetProjectPostQueue(ctx, projectID)
+func (s *service) GetProjectQueuedPosts(ctx context.Context, projectID, queue string) ([]*Post, error) {
+	q, err := s.repo.GetProjectPostQueue(ctx, projectID, QueueName(queue))
 	if err != nil {
 		return nil, err
 	}
+	if q == nil {
+		return nil, ErrQueueNotFound
+	}
 	if q.IsEmpty() {
 		return []*Post{}, nil
 	}
@@ -532,16 +739,19 @@ func sortPostsByQueue(posts []*Post, queue *Queue) []*Post {
 	return sortedPosts
 }
 
-func (s *service) MovePostInQueue(ctx context.Context, projectID string, currentIndex, newIndex int) error {
-	q, err := s.repo.GetProjectPostQueue(ctx, projectID)
+func (s *service) MovePostInQueue(ctx context.Context, projectID, queue string, currentIndex, newIndex int) error {
+	queue = QueueName(queue)
+	q, err := s.repo.GetProjectPostQueue(ctx, projectID, queue)
 	if err != nil {
 		return err
 	}
+	if q == nil {
+		return ErrQueueNotFound
+	}
 	if q.IsEmpty() {
 		return nil
 	}
-	q.Move(currentIndex, newIndex)
-	return s.repo.UpdateProjectPostQueue(ctx, projectID, q.Arr())
+	return s.repo.MovePostInProjectQueue(ctx, projectID, queue, currentIndex, newIndex)
 }
 
 func (s *service) MoveIdeaInQueue(ctx context.Context, projectID string, currentIndex, newIndex int) error {
@@ -554,24 +764,73 @@ func (s *service) MoveIdeaInQueue(ctx context.Context, projectID string, current
 		return nil
 	}
 
-	q.Move(currentIndex, newIndex)
-	return s.repo.UpdateProjectIdeaQueue(ctx, projectID, q.Arr())
+	return s.repo.MoveIdeaInProjectQueue(ctx, projectID, currentIndex, newIndex)
 }
 
-func (s *service) DequeuePostsToPublish(ctx context.Context, projectID string) ([]*PublishPost, error) {
-	q, err := s.repo.GetProjectPostQueue(ctx, projectID)
+// DequeuePostsToPublish takes the first post out of the given queue.
+// Slots assigned to a queue that was deleted draw from the default queue.
+func (s *service) DequeuePostsToPublish(ctx context.Context, projectID, queue string) ([]*PublishPost, error) {
+	queue = QueueName(queue)
+	if queue != DefaultQueue {
+		q, err := s.repo.GetProjectPostQueue(ctx, projectID, queue)
+		if err != nil {
+			return nil, err
+		}
+		if q == nil {
+			queue = DefaultQueue
+		}
+	}
+	postID, err := s.repo.ShiftProjectQueue(ctx, projectID, queue)
 	if err != nil {
 		return nil, err
 	}
-	if q.IsEmpty() {
+	if postID == "" {
 		return nil, nil
 	}
-	postID := q.Shift()
-	err = s.repo.UpdateProjectPostQueue(ctx, projectID, q.Arr())
+	return s.repo.GetPostsForPublishQueue(ctx, postID)
+}
+
+func (s *service) CreateQueue(ctx context.Context, projectID, name string) (*NamedQueue, error) {
+	name = strings.TrimSpace(name)
+	if err := ValidateQueueName(name); err != nil {
+		return nil, err
+	}
+	q, err := s.repo.GetProjectPostQueue(ctx, projectID, name)
 	if err != nil {
 		return nil, err
 	}
-	return s.repo.GetPostsForPublishQueue(ctx, postID)
+	if q != nil {
+		return nil, ErrQueueAlreadyExists
+	}
+
+	nq := &NamedQueue{
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+	err = s.repo.SaveQueue(ctx, projectID, nq)
+	if err != nil {
+		return nil, err
+	}
+	return nq, nil
+}
+
+func (s *service) ListQueues(ctx context.Context, projectID string) ([]*NamedQueue, error) {
+	return s.repo.FindQueues(ctx, projectID)
+}
+
+// DeleteQueue removes a named queue. Its posts are moved to the end of the default queue.
+func (s *service) DeleteQueue(ctx context.Context, projectID, name string) error {
+	if name == DefaultQueue {
+		return ErrInvalidQueueName
+	}
+	q, err := s.repo.GetProjectPostQueue(ctx, projectID, name)
+	if err != nil {
+		return err
+	}
+	if q == nil {
+		return ErrQueueNotFound
+	}
+	return s.repo.DeleteQueue(ctx, projectID, name)
 }
 
 func (s *service) GetAvailablePostTypes() []string {
@@ -586,18 +845,52 @@ func (s *service) GetAvailablePostTypes() []string {
 	}
 }
 
+// UpdatePostStatus sets the status of a post while publishing it. It doesn't check the version of the post, so
+// an edit made meanwhile doesn't make the publication fail.
 func (s *service) UpdatePostStatus(ctx context.Context, id string, status PostStatus) error {
-	p, err := s.repo.FindByID(ctx, id)
-	if err != nil {
-		return err
-	}
-	if p == nil {
-		return ErrPostNotFound
-	}
-	p.Status = string(status)
-	return s.repo.Update(ctx, p)
+	return s.repo.UpdateStatus(ctx, id, string(status))
 }
 
 func (s *service) UpdatePublishPostStatus(ctx context.Context, postID, platformID string, status PublishPostStatus) error {
 	return s.repo.UpdatePublishPostStatus(ctx, postID, platformID, string(status))
 }
+
+// SetPreflightValidator sets the validator run before a post is scheduled or queued.
+// It is set after construction because the publisher service, which validates posts, depends on this service.
+func (s *service) SetPreflightValidator(v PreflightValidator) {
+	s.validator = v
+}
+
+// SetSnippetInserter sets what inserts the project snippets in the content of the posts being created.
+// It is set after construction because the template service, which owns the snippets, depends on this service.
+func (s *service) SetSnippetInserter(i SnippetInserter) {
+	s.snippets = i
+}
+
+// SetMediaRemover sets what removes the media files of the posts purged from the trash.
+func (s *service) SetMediaRemover(r MediaRemover) {
+	s.media = r
+}
+
+func (s *service) insertSnippets(ctx context.Context, projectID, text string) (string, error) {
+	if s.snippets == nil {
+		return text, nil
+	}
+	return s.snippets.InsertSnippets(ctx, projectID, text)
+}
+
+func (s *service) preflightCheck(ctx context.Context, projectID, postID string) error {
+	if s.validator == nil {
+		return nil
+	}
+	return s.validator.PreflightCheck(ctx, projectID, postID)
+}
+
+// FindPostsDueForPreflight returns scheduled posts due before the given time that haven't been re-validated yet
+func (s *service) FindPostsDueForPreflight(ctx context.Context, before time.Time, offset, chunkSize int) ([]*Post, error) {
+	return s.repo.FindPostsDueForPreflight(ctx, before, offset, chunkSize)
+}
+
+func (s *service) MarkPreflightChecked(ctx context.Context, postID string) error {
+	return s.repo.MarkPreflightChecked(ctx, postID)
+}
@@ -0,0 +1,266 @@
+package post
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreatePostWithPlatforms(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.UserIDKey, "user-1")
+
+	t.Run("saves the post with its platforms", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().IsPublisherPlatformEnabledForProject(ctx, "project-1", "linkedin").Return(true, nil)
+		repo.EXPECT().SaveWithPlatforms(ctx, mock.MatchedBy(func(p *Post) bool {
+			return p.TextContent == "{{snippet:signature}}" && p.Status == string(PostStatusDraft)
+		}), []string{"linkedin"}).Return(nil)
+
+		p, err := NewService(repo).CreatePostWithPlatforms(ctx, "project-1", "Title", string(PostTypeText), "{{snippet:signature}}", time.Time{}, []string{"linkedin"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "project-1", p.ProjectID)
+	})
+
+	t.Run("saves nothing when a platform isn't enabled", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().IsPublisherPlatformEnabledForProject(ctx, "project-1", "linkedin").Return(false, nil)
+
+		_, err := NewService(repo).CreatePostWithPlatforms(ctx, "project-1", "Title", string(PostTypeText), "text", time.Time{}, []string{"linkedin"})
+
+		assert.ErrorIs(t, err, ErrPublisherNotInProject)
+		assert.Contains(t, err.Error(), "linkedin")
+	})
+}
+
+func TestUpdatePost(t *testing.T) {
+	ctx := context.Background()
+	current := func() *Post {
+		return &Post{ID: "post-1", ProjectID: "project-1", Title: "Old", Type: PostTypeText, Version: 3}
+	}
+
+	t.Run("updates the post when the version matches", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().FindByID(ctx, "post-1").Return(current(), nil)
+		repo.EXPECT().Update(ctx, mock.MatchedBy(func(p *Post) bool {
+			return p.Title == "New" && p.Version == 3
+		})).Return(nil)
+
+		p, err := NewService(repo).UpdatePost(ctx, "post-1", "project-1", "New", string(PostTypeText), "text", false, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "New", p.Title)
+	})
+
+	t.Run("updates the post when no version is given", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().FindByID(ctx, "post-1").Return(current(), nil)
+		repo.EXPECT().Update(ctx, mock.Anything).Return(nil)
+
+		_, err := NewService(repo).UpdatePost(ctx, "post-1", "project-1", "New", string(PostTypeText), "text", false, 0)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("applies an edit without version again after a concurrent update", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().FindByID(ctx, "post-1").Return(current(), nil).Once()
+		repo.EXPECT().Update(ctx, mock.Anything).Return(ErrVersionConflict).Once()
+		published := current()
+		published.Status = string(PostStatusPublished)
+		published.Version = 4
+		repo.EXPECT().FindByID(ctx, "post-1").Return(published, nil).Once()
+		repo.EXPECT().Update(ctx, mock.MatchedBy(func(p *Post) bool {
+			return p.Title == "New" && p.Status == string(PostStatusPublished) && p.Version == 4
+		})).Return(nil).Once()
+
+		p, err := NewService(repo).UpdatePost(ctx, "post-1", "project-1", "New", string(PostTypeText), "text", false, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, string(PostStatusPublished), p.Status)
+	})
+
+	t.Run("rejects a stale version", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().FindByID(ctx, "post-1").Return(current(), nil)
+
+		_, err := NewService(repo).UpdatePost(ctx, "post-1", "project-1", "New", string(PostTypeText), "text", false, 2)
+
+		assert.ErrorIs(t, err, ErrVersionConflict)
+	})
+
+	t.Run("reports a concurrent update", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().FindByID(ctx, "post-1").Return(current(), nil)
+		repo.EXPECT().Update(ctx, mock.Anything).Return(ErrVersionConflict)
+
+		_, err := NewService(repo).UpdatePost(ctx, "post-1", "project-1", "New", string(PostTypeText), "text", false, 3)
+
+		assert.ErrorIs(t, err, ErrVersionConflict)
+	})
+}
+
+func TestRemovePostFromProjectQueue(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository(t)
+	repo.EXPECT().FindByID(mock.Anything, "post-1").Return(&Post{ID: "post-1", ProjectID: "project-1", Status: string(PostStatusQueued), Version: 3}, nil)
+	repo.EXPECT().FindPostQueue(mock.Anything, "project-1", "post-1").Return(DefaultQueue, nil)
+	// The post is made a draft whatever its version, an edit made meanwhile doesn't make it fail
+	repo.EXPECT().UnqueuePost(ctx, "project-1", "post-1").Return(nil)
+
+	err := NewService(repo).RemovePostFromProjectQueue(ctx, "project-1", "post-1")
+
+	assert.NoError(t, err)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestDeletePost(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("moves the post to the trash", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().FindByID(ctx, "post-1").Return(&Post{ID: "post-1", ProjectID: "project-1"}, nil)
+		repo.EXPECT().RemoveFromProjectQueue(mock.Anything, "project-1", "post-1").Return(nil)
+		repo.EXPECT().RemoveFromProjectIdeaQueue(mock.Anything, "project-1", "post-1").Return(nil)
+		repo.EXPECT().TrashPost(ctx, "post-1", mock.Anything).Return(nil)
+
+		err := NewService(repo).DeletePost(ctx, "post-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("fails when the post doesn't exist", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().FindByID(ctx, "post-1").Return(nil, nil)
+
+		err := NewService(repo).DeletePost(ctx, "post-1")
+
+		assert.ErrorIs(t, err, ErrPostNotFound)
+	})
+}
+
+func TestRestoreFromTrash(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("restores a trashed post", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().FindTrashedPost(ctx, "project-1", "post-1").Return(&TrashedPost{ID: "post-1", ProjectID: "project-1"}, nil)
+		repo.EXPECT().RestoreFromTrash(ctx, "post-1").Return(nil)
+
+		err := NewService(repo).RestoreFromTrash(ctx, "project-1", "post-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("fails when the post isn't in the trash", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().FindTrashedPost(ctx, "project-1", "post-1").Return(nil, nil)
+
+		err := NewService(repo).RestoreFromTrash(ctx, "project-1", "post-1")
+
+		assert.ErrorIs(t, err, ErrPostNotInTrash)
+	})
+}
+
+func TestPurgeTrash(t *testing.T) {
+	ctx := context.Background()
+	deletedBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expired := []*TrashedPost{
+		{ID: "post-1", ProjectID: "project-1"},
+		{ID: "post-2", ProjectID: "project-1"},
+	}
+
+	noFiles := func(ctx context.Context) error { return nil }
+
+	t.Run("deletes the posts then their files", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		media := NewMockMediaRemover(t)
+		removed := 0
+		removeFiles := func(ctx context.Context) error {
+			removed++
+			return nil
+		}
+		repo.EXPECT().FindExpiredTrash(ctx, deletedBefore, 0, 10).Return(expired, nil)
+		media.EXPECT().PostFilesRemover(ctx, "project-1", "post-1").Return(removeFiles, nil)
+		media.EXPECT().PostFilesRemover(ctx, "project-1", "post-2").Return(removeFiles, nil)
+		repo.EXPECT().DeletePost(ctx, "post-1", deletedBefore).Return(nil)
+		repo.EXPECT().DeletePost(ctx, "post-2", deletedBefore).Return(nil)
+
+		s := NewService(repo)
+		s.SetMediaRemover(media)
+		err := s.PurgeTrash(ctx, deletedBefore, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, removed)
+	})
+
+	t.Run("keeps the files of posts restored meanwhile", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		media := NewMockMediaRemover(t)
+		repo.EXPECT().FindExpiredTrash(ctx, deletedBefore, 0, 10).Return(expired[:1], nil)
+		media.EXPECT().PostFilesRemover(ctx, "project-1", "post-1").Return(func(ctx context.Context) error {
+			t.Error("the files of a restored post were removed")
+			return nil
+		}, nil)
+		repo.EXPECT().DeletePost(ctx, "post-1", deletedBefore).Return(ErrPostNotInTrash)
+
+		s := NewService(repo)
+		s.SetMediaRemover(media)
+		err := s.PurgeTrash(ctx, deletedBefore, 10)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("keeps the post when its files can't be listed", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		media := NewMockMediaRemover(t)
+		repo.EXPECT().FindExpiredTrash(ctx, deletedBefore, 0, 10).Return(expired, nil)
+		media.EXPECT().PostFilesRemover(ctx, "project-1", "post-1").Return(nil, errors.New("database down"))
+		media.EXPECT().PostFilesRemover(ctx, "project-1", "post-2").Return(noFiles, nil)
+		repo.EXPECT().DeletePost(ctx, "post-2", deletedBefore).Return(nil)
+
+		s := NewService(repo)
+		s.SetMediaRemover(media)
+		err := s.PurgeTrash(ctx, deletedBefore, 10)
+
+		assert.ErrorContains(t, err, "post post-1: database down")
+	})
+
+	t.Run("reads the next batch past the posts that can't be deleted", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		media := NewMockMediaRemover(t)
+		repo.EXPECT().FindExpiredTrash(ctx, deletedBefore, 0, 2).Return(expired, nil)
+		repo.EXPECT().FindExpiredTrash(ctx, deletedBefore, 2, 2).
+			Return([]*TrashedPost{{ID: "post-3", ProjectID: "project-1"}}, nil)
+		media.EXPECT().PostFilesRemover(ctx, "project-1", mock.Anything).Return(noFiles, nil)
+		repo.EXPECT().DeletePost(ctx, "post-1", deletedBefore).Return(errors.New("database down"))
+		repo.EXPECT().DeletePost(ctx, "post-2", deletedBefore).Return(errors.New("database down"))
+		repo.EXPECT().DeletePost(ctx, "post-3", deletedBefore).Return(nil)
+
+		s := NewService(repo)
+		s.SetMediaRemover(media)
+		err := s.PurgeTrash(ctx, deletedBefore, 2)
+
+		assert.ErrorContains(t, err, "post post-2: database down")
+	})
+}
+
+func TestSchedulePost(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("refuses posts with media still processing", func(t *testing.T) {
+		repo := NewMockRepository(t)
+		repo.EXPECT().FindByID(mock.Anything, "post-1").Return(&Post{ID: "post-1", ProjectID: "project-1", Status: string(PostStatusDraft)}, nil)
+		repo.EXPECT().GetSocialMediaPlatforms(mock.Anything, "post-1").Return([]Platform{{ID: "x"}}, nil)
+		repo.EXPECT().HasProcessingMedia(mock.Anything, "post-1").Return(true, nil)
+
+		err := NewService(repo).SchedulePost(ctx, "post-1", time.Now().UTC().Add(time.Hour))
+
+		assert.ErrorIs(t, err, ErrPostMediaProcessing)
+	})
+}
@@ -0,0 +1,23 @@
+package post
+
+import (
+	"context"
+	"time"
+)
+
+// TrashedPost is a deleted post, kept in the trash of its project until the retention window expires
+type TrashedPost struct {
+	ID        string    `json:"id"`
+	ProjectID string    `json:"project_id"`
+	Title     string    `json:"title"`
+	Type      PostType  `json:"type"`
+	Status    string    `json:"status"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// MediaRemover removes the files of the media of a post from the object store
+type MediaRemover interface {
+	// PostFilesRemover lists the files of the media of a post while the post still has them, and returns what
+	// removes the files once the post is deleted
+	PostFilesRemover(ctx context.Context, projectID, postID string) (func(ctx context.Context) error, error)
+}
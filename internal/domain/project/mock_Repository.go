@@ -367,6 +367,64 @@ func (_c *MockRepository_DoesProjectNameExist_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// DoesQueueExist provides a mock function with given fields: ctx, projectID, queue
+func (_m *MockRepository) DoesQueueExist(ctx context.Context, projectID string, queue string) (bool, error) {
+	ret := _m.Called(ctx, projectID, queue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DoesQueueExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, projectID, queue)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, projectID, queue)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, queue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_DoesQueueExist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DoesQueueExist'
+type MockRepository_DoesQueueExist_Call struct {
+	*mock.Call
+}
+
+// DoesQueueExist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - queue string
+func (_e *MockRepository_Expecter) DoesQueueExist(ctx interface{}, projectID interface{}, queue interface{}) *MockRepository_DoesQueueExist_Call {
+	return &MockRepository_DoesQueueExist_Call{Call: _e.mock.On("DoesQueueExist", ctx, projectID, queue)}
+}
+
+func (_c *MockRepository_DoesQueueExist_Call) Run(run func(ctx context.Context, projectID string, queue string)) *MockRepository_DoesQueueExist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DoesQueueExist_Call) Return(_a0 bool, _a1 error) *MockRepository_DoesQueueExist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_DoesQueueExist_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockRepository_DoesQueueExist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DoesSocialPlatformExist provides a mock function with given fields: ctx, socialPlatformID
 func (_m *MockRepository) DoesSocialPlatformExist(ctx context.Context, socialPlatformID string) (bool, error) {
 	ret := _m.Called(ctx, socialPlatformID)
@@ -767,6 +825,63 @@ func (_c *MockRepository_GetPlatformInfo_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetPreflightMode provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) GetPreflightMode(ctx context.Context, projectID string) (PreflightMode, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreflightMode")
+	}
+
+	var r0 PreflightMode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (PreflightMode, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) PreflightMode); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(PreflightMode)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetPreflightMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreflightMode'
+type MockRepository_GetPreflightMode_Call struct {
+	*mock.Call
+}
+
+// GetPreflightMode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) GetPreflightMode(ctx interface{}, projectID interface{}) *MockRepository_GetPreflightMode_Call {
+	return &MockRepository_GetPreflightMode_Call{Call: _e.mock.On("GetPreflightMode", ctx, projectID)}
+}
+
+func (_c *MockRepository_GetPreflightMode_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_GetPreflightMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetPreflightMode_Call) Return(_a0 PreflightMode, _a1 error) *MockRepository_GetPreflightMode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetPreflightMode_Call) RunAndReturn(run func(context.Context, string) (PreflightMode, error)) *MockRepository_GetPreflightMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProjectSchedule provides a mock function with given fields: ctx, projectID
 func (_m *MockRepository) GetProjectSchedule(ctx context.Context, projectID string) (*WeeklyPostSchedule, error) {
 	ret := _m.Called(ctx, projectID)
@@ -1430,6 +1545,54 @@ func (_c *MockRepository_SetDefaultUser_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// SetPreflightMode provides a mock function with given fields: ctx, projectID, mode
+func (_m *MockRepository) SetPreflightMode(ctx context.Context, projectID string, mode PreflightMode) error {
+	ret := _m.Called(ctx, projectID, mode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPreflightMode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, PreflightMode) error); ok {
+		r0 = rf(ctx, projectID, mode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SetPreflightMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPreflightMode'
+type MockRepository_SetPreflightMode_Call struct {
+	*mock.Call
+}
+
+// SetPreflightMode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - mode PreflightMode
+func (_e *MockRepository_Expecter) SetPreflightMode(ctx interface{}, projectID interface{}, mode interface{}) *MockRepository_SetPreflightMode_Call {
+	return &MockRepository_SetPreflightMode_Call{Call: _e.mock.On("SetPreflightMode", ctx, projectID, mode)}
+}
+
+func (_c *MockRepository_SetPreflightMode_Call) Run(run func(ctx context.Context, projectID string, mode PreflightMode)) *MockRepository_SetPreflightMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(PreflightMode))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SetPreflightMode_Call) Return(_a0 error) *MockRepository_SetPreflightMode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SetPreflightMode_Call) RunAndReturn(run func(context.Context, string, PreflightMode) error) *MockRepository_SetPreflightMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, _a1
 func (_m *MockRepository) Update(ctx context.Context, _a1 *Project) (*Project, error) {
 	ret := _m.Called(ctx, _a1)
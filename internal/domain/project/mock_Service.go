@@ -22,22 +22,34 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
-// AddTimeSlot provides a mock function with given fields: ctx, projectID, dayOfWeek, hour, minute
-func (_m *MockService) AddTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour int, minute int) error {
-	ret := _m.Called(ctx, projectID, dayOfWeek, hour, minute)
+// AddTimeSlot provides a mock function with given fields: ctx, projectID, dayOfWeek, hour, minute, queue, version
+func (_m *MockService) AddTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour int, minute int, queue string, version int) (*WeeklyPostSchedule, error) {
+	ret := _m.Called(ctx, projectID, dayOfWeek, hour, minute, queue, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddTimeSlot")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, time.Weekday, int, int) error); ok {
-		r0 = rf(ctx, projectID, dayOfWeek, hour, minute)
+	var r0 *WeeklyPostSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Weekday, int, int, string, int) (*WeeklyPostSchedule, error)); ok {
+		return rf(ctx, projectID, dayOfWeek, hour, minute, queue, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Weekday, int, int, string, int) *WeeklyPostSchedule); ok {
+		r0 = rf(ctx, projectID, dayOfWeek, hour, minute, queue, version)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*WeeklyPostSchedule)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Weekday, int, int, string, int) error); ok {
+		r1 = rf(ctx, projectID, dayOfWeek, hour, minute, queue, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockService_AddTimeSlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTimeSlot'
@@ -51,23 +63,25 @@ type MockService_AddTimeSlot_Call struct {
 //   - dayOfWeek time.Weekday
 //   - hour int
 //   - minute int
-func (_e *MockService_Expecter) AddTimeSlot(ctx interface{}, projectID interface{}, dayOfWeek interface{}, hour interface{}, minute interface{}) *MockService_AddTimeSlot_Call {
-	return &MockService_AddTimeSlot_Call{Call: _e.mock.On("AddTimeSlot", ctx, projectID, dayOfWeek, hour, minute)}
+//   - queue string
+//   - version int
+func (_e *MockService_Expecter) AddTimeSlot(ctx interface{}, projectID interface{}, dayOfWeek interface{}, hour interface{}, minute interface{}, queue interface{}, version interface{}) *MockService_AddTimeSlot_Call {
+	return &MockService_AddTimeSlot_Call{Call: _e.mock.On("AddTimeSlot", ctx, projectID, dayOfWeek, hour, minute, queue, version)}
 }
 
-func (_c *MockService_AddTimeSlot_Call) Run(run func(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour int, minute int)) *MockService_AddTimeSlot_Call {
+func (_c *MockService_AddTimeSlot_Call) Run(run func(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour int, minute int, queue string, version int)) *MockService_AddTimeSlot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(time.Weekday), args[3].(int), args[4].(int))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Weekday), args[3].(int), args[4].(int), args[5].(string), args[6].(int))
 	})
 	return _c
 }
 
-func (_c *MockService_AddTimeSlot_Call) Return(_a0 error) *MockService_AddTimeSlot_Call {
-	_c.Call.Return(_a0)
+func (_c *MockService_AddTimeSlot_Call) Return(_a0 *WeeklyPostSchedule, _a1 error) *MockService_AddTimeSlot_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockService_AddTimeSlot_Call) RunAndReturn(run func(context.Context, string, time.Weekday, int, int) error) *MockService_AddTimeSlot_Call {
+func (_c *MockService_AddTimeSlot_Call) RunAndReturn(run func(context.Context, string, time.Weekday, int, int, string, int) (*WeeklyPostSchedule, error)) *MockService_AddTimeSlot_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -551,6 +565,63 @@ func (_c *MockService_GetEnabledSocialPlatforms_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetPreflightMode provides a mock function with given fields: ctx, projectID
+func (_m *MockService) GetPreflightMode(ctx context.Context, projectID string) (PreflightMode, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreflightMode")
+	}
+
+	var r0 PreflightMode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (PreflightMode, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) PreflightMode); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(PreflightMode)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetPreflightMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreflightMode'
+type MockService_GetPreflightMode_Call struct {
+	*mock.Call
+}
+
+// GetPreflightMode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockService_Expecter) GetPreflightMode(ctx interface{}, projectID interface{}) *MockService_GetPreflightMode_Call {
+	return &MockService_GetPreflightMode_Call{Call: _e.mock.On("GetPreflightMode", ctx, projectID)}
+}
+
+func (_c *MockService_GetPreflightMode_Call) Run(run func(ctx context.Context, projectID string)) *MockService_GetPreflightMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetPreflightMode_Call) Return(_a0 PreflightMode, _a1 error) *MockService_GetPreflightMode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetPreflightMode_Call) RunAndReturn(run func(context.Context, string) (PreflightMode, error)) *MockService_GetPreflightMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProject provides a mock function with given fields: ctx, projectID
 func (_m *MockService) GetProject(ctx context.Context, projectID string) (*ProjectResponse, error) {
 	ret := _m.Called(ctx, projectID)
@@ -669,87 +740,93 @@ func (_c *MockService_GetProjectSchedule_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
-// GetUserRoles provides a mock function with given fields: ctx, userID, projectID
-func (_m *MockService) GetUserRoles(ctx context.Context, userID string, projectID string) ([]string, error) {
-	ret := _m.Called(ctx, userID, projectID)
+// GetQueueToPublish provides a mock function with given fields: ctx, projectID
+func (_m *MockService) GetQueueToPublish(ctx context.Context, projectID string) (string, bool, error) {
+	ret := _m.Called(ctx, projectID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserRoles")
+		panic("no return value specified for GetQueueToPublish")
 	}
 
-	var r0 []string
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
-		return rf(ctx, userID, projectID)
+	var r0 string
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, bool, error)); ok {
+		return rf(ctx, projectID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
-		r0 = rf(ctx, userID, projectID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, projectID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, userID, projectID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = rf(ctx, projectID)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(bool)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, projectID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// MockService_GetUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRoles'
-type MockService_GetUserRoles_Call struct {
+// MockService_GetQueueToPublish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQueueToPublish'
+type MockService_GetQueueToPublish_Call struct {
 	*mock.Call
 }
 
-// GetUserRoles is a helper method to define mock.On call
+// GetQueueToPublish is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
 //   - projectID string
-func (_e *MockService_Expecter) GetUserRoles(ctx interface{}, userID interface{}, projectID interface{}) *MockService_GetUserRoles_Call {
-	return &MockService_GetUserRoles_Call{Call: _e.mock.On("GetUserRoles", ctx, userID, projectID)}
+func (_e *MockService_Expecter) GetQueueToPublish(ctx interface{}, projectID interface{}) *MockService_GetQueueToPublish_Call {
+	return &MockService_GetQueueToPublish_Call{Call: _e.mock.On("GetQueueToPublish", ctx, projectID)}
 }
 
-func (_c *MockService_GetUserRoles_Call) Run(run func(ctx context.Context, userID string, projectID string)) *MockService_GetUserRoles_Call {
+func (_c *MockService_GetQueueToPublish_Call) Run(run func(ctx context.Context, projectID string)) *MockService_GetQueueToPublish_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockService_GetUserRoles_Call) Return(_a0 []string, _a1 error) *MockService_GetUserRoles_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockService_GetQueueToPublish_Call) Return(_a0 string, _a1 bool, _a2 error) *MockService_GetQueueToPublish_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockService_GetUserRoles_Call) RunAndReturn(run func(context.Context, string, string) ([]string, error)) *MockService_GetUserRoles_Call {
+func (_c *MockService_GetQueueToPublish_Call) RunAndReturn(run func(context.Context, string) (string, bool, error)) *MockService_GetQueueToPublish_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsProjectTimeToPublish provides a mock function with given fields: ctx, projectID
-func (_m *MockService) IsProjectTimeToPublish(ctx context.Context, projectID string) (bool, error) {
-	ret := _m.Called(ctx, projectID)
+// GetUserRoles provides a mock function with given fields: ctx, userID, projectID
+func (_m *MockService) GetUserRoles(ctx context.Context, userID string, projectID string) ([]string, error) {
+	ret := _m.Called(ctx, userID, projectID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsProjectTimeToPublish")
+		panic("no return value specified for GetUserRoles")
 	}
 
-	var r0 bool
+	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
-		return rf(ctx, projectID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return rf(ctx, userID, projectID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
-		r0 = rf(ctx, projectID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = rf(ctx, userID, projectID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, projectID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, projectID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -757,31 +834,32 @@ func (_m *MockService) IsProjectTimeToPublish(ctx context.Context, projectID str
 	return r0, r1
 }
 
-// MockService_IsProjectTimeToPublish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsProjectTimeToPublish'
-type MockService_IsProjectTimeToPublish_Call struct {
+// MockService_GetUserRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRoles'
+type MockService_GetUserRoles_Call struct {
 	*mock.Call
 }
 
-// IsProjectTimeToPublish is a helper method to define mock.On call
+// GetUserRoles is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID string
 //   - projectID string
-func (_e *MockService_Expecter) IsProjectTimeToPublish(ctx interface{}, projectID interface{}) *MockService_IsProjectTimeToPublish_Call {
-	return &MockService_IsProjectTimeToPublish_Call{Call: _e.mock.On("IsProjectTimeToPublish", ctx, projectID)}
+func (_e *MockService_Expecter) GetUserRoles(ctx interface{}, userID interface{}, projectID interface{}) *MockService_GetUserRoles_Call {
+	return &MockService_GetUserRoles_Call{Call: _e.mock.On("GetUserRoles", ctx, userID, projectID)}
 }
 
-func (_c *MockService_IsProjectTimeToPublish_Call) Run(run func(ctx context.Context, projectID string)) *MockService_IsProjectTimeToPublish_Call {
+func (_c *MockService_GetUserRoles_Call) Run(run func(ctx context.Context, userID string, projectID string)) *MockService_GetUserRoles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockService_IsProjectTimeToPublish_Call) Return(_a0 bool, _a1 error) *MockService_IsProjectTimeToPublish_Call {
+func (_c *MockService_GetUserRoles_Call) Return(_a0 []string, _a1 error) *MockService_GetUserRoles_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockService_IsProjectTimeToPublish_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockService_IsProjectTimeToPublish_Call {
+func (_c *MockService_GetUserRoles_Call) RunAndReturn(run func(context.Context, string, string) ([]string, error)) *MockService_GetUserRoles_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -844,22 +922,34 @@ func (_c *MockService_ListProjects_Call) RunAndReturn(run func(context.Context)
 	return _c
 }
 
-// RemoveTimeSlot provides a mock function with given fields: ctx, projectID, dayOfWeek, hour, minute
-func (_m *MockService) RemoveTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour int, minute int) error {
-	ret := _m.Called(ctx, projectID, dayOfWeek, hour, minute)
+// RemoveTimeSlot provides a mock function with given fields: ctx, projectID, dayOfWeek, hour, minute, version
+func (_m *MockService) RemoveTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour int, minute int, version int) (*WeeklyPostSchedule, error) {
+	ret := _m.Called(ctx, projectID, dayOfWeek, hour, minute, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RemoveTimeSlot")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, time.Weekday, int, int) error); ok {
-		r0 = rf(ctx, projectID, dayOfWeek, hour, minute)
+	var r0 *WeeklyPostSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Weekday, int, int, int) (*WeeklyPostSchedule, error)); ok {
+		return rf(ctx, projectID, dayOfWeek, hour, minute, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Weekday, int, int, int) *WeeklyPostSchedule); ok {
+		r0 = rf(ctx, projectID, dayOfWeek, hour, minute, version)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*WeeklyPostSchedule)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Weekday, int, int, int) error); ok {
+		r1 = rf(ctx, projectID, dayOfWeek, hour, minute, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockService_RemoveTimeSlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTimeSlot'
@@ -873,23 +963,24 @@ type MockService_RemoveTimeSlot_Call struct {
 //   - dayOfWeek time.Weekday
 //   - hour int
 //   - minute int
-func (_e *MockService_Expecter) RemoveTimeSlot(ctx interface{}, projectID interface{}, dayOfWeek interface{}, hour interface{}, minute interface{}) *MockService_RemoveTimeSlot_Call {
-	return &MockService_RemoveTimeSlot_Call{Call: _e.mock.On("RemoveTimeSlot", ctx, projectID, dayOfWeek, hour, minute)}
+//   - version int
+func (_e *MockService_Expecter) RemoveTimeSlot(ctx interface{}, projectID interface{}, dayOfWeek interface{}, hour interface{}, minute interface{}, version interface{}) *MockService_RemoveTimeSlot_Call {
+	return &MockService_RemoveTimeSlot_Call{Call: _e.mock.On("RemoveTimeSlot", ctx, projectID, dayOfWeek, hour, minute, version)}
 }
 
-func (_c *MockService_RemoveTimeSlot_Call) Run(run func(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour int, minute int)) *MockService_RemoveTimeSlot_Call {
+func (_c *MockService_RemoveTimeSlot_Call) Run(run func(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour int, minute int, version int)) *MockService_RemoveTimeSlot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(time.Weekday), args[3].(int), args[4].(int))
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Weekday), args[3].(int), args[4].(int), args[5].(int))
 	})
 	return _c
 }
 
-func (_c *MockService_RemoveTimeSlot_Call) Return(_a0 error) *MockService_RemoveTimeSlot_Call {
-	_c.Call.Return(_a0)
+func (_c *MockService_RemoveTimeSlot_Call) Return(_a0 *WeeklyPostSchedule, _a1 error) *MockService_RemoveTimeSlot_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockService_RemoveTimeSlot_Call) RunAndReturn(run func(context.Context, string, time.Weekday, int, int) error) *MockService_RemoveTimeSlot_Call {
+func (_c *MockService_RemoveTimeSlot_Call) RunAndReturn(run func(context.Context, string, time.Weekday, int, int, int) (*WeeklyPostSchedule, error)) *MockService_RemoveTimeSlot_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1039,9 +1130,57 @@ func (_c *MockService_SetDefaultUser_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
-// UpdateProject provides a mock function with given fields: ctx, projectID, name, description
-func (_m *MockService) UpdateProject(ctx context.Context, projectID string, name string, description string) (*Project, error) {
-	ret := _m.Called(ctx, projectID, name, description)
+// SetPreflightMode provides a mock function with given fields: ctx, projectID, mode
+func (_m *MockService) SetPreflightMode(ctx context.Context, projectID string, mode PreflightMode) error {
+	ret := _m.Called(ctx, projectID, mode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPreflightMode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, PreflightMode) error); ok {
+		r0 = rf(ctx, projectID, mode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_SetPreflightMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPreflightMode'
+type MockService_SetPreflightMode_Call struct {
+	*mock.Call
+}
+
+// SetPreflightMode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - mode PreflightMode
+func (_e *MockService_Expecter) SetPreflightMode(ctx interface{}, projectID interface{}, mode interface{}) *MockService_SetPreflightMode_Call {
+	return &MockService_SetPreflightMode_Call{Call: _e.mock.On("SetPreflightMode", ctx, projectID, mode)}
+}
+
+func (_c *MockService_SetPreflightMode_Call) Run(run func(ctx context.Context, projectID string, mode PreflightMode)) *MockService_SetPreflightMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(PreflightMode))
+	})
+	return _c
+}
+
+func (_c *MockService_SetPreflightMode_Call) Return(_a0 error) *MockService_SetPreflightMode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_SetPreflightMode_Call) RunAndReturn(run func(context.Context, string, PreflightMode) error) *MockService_SetPreflightMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProject provides a mock function with given fields: ctx, projectID, name, description, version
+func (_m *MockService) UpdateProject(ctx context.Context, projectID string, name string, description string, version int) (*Project, error) {
+	ret := _m.Called(ctx, projectID, name, description, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateProject")
@@ -1049,19 +1188,19 @@ func (_m *MockService) UpdateProject(ctx context.Context, projectID string, name
 
 	var r0 *Project
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*Project, error)); ok {
-		return rf(ctx, projectID, name, description)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int) (*Project, error)); ok {
+		return rf(ctx, projectID, name, description, version)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *Project); ok {
-		r0 = rf(ctx, projectID, name, description)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int) *Project); ok {
+		r0 = rf(ctx, projectID, name, description, version)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*Project)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, projectID, name, description)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int) error); ok {
+		r1 = rf(ctx, projectID, name, description, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1079,13 +1218,14 @@ type MockService_UpdateProject_Call struct {
 //   - projectID string
 //   - name string
 //   - description string
-func (_e *MockService_Expecter) UpdateProject(ctx interface{}, projectID interface{}, name interface{}, description interface{}) *MockService_UpdateProject_Call {
-	return &MockService_UpdateProject_Call{Call: _e.mock.On("UpdateProject", ctx, projectID, name, description)}
+//   - version int
+func (_e *MockService_Expecter) UpdateProject(ctx interface{}, projectID interface{}, name interface{}, description interface{}, version interface{}) *MockService_UpdateProject_Call {
+	return &MockService_UpdateProject_Call{Call: _e.mock.On("UpdateProject", ctx, projectID, name, description, version)}
 }
 
-func (_c *MockService_UpdateProject_Call) Run(run func(ctx context.Context, projectID string, name string, description string)) *MockService_UpdateProject_Call {
+func (_c *MockService_UpdateProject_Call) Run(run func(ctx context.Context, projectID string, name string, description string, version int)) *MockService_UpdateProject_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(int))
 	})
 	return _c
 }
@@ -1095,7 +1235,7 @@ func (_c *MockService_UpdateProject_Call) Return(_a0 *Project, _a1 error) *MockS
 	return _c
 }
 
-func (_c *MockService_UpdateProject_Call) RunAndReturn(run func(context.Context, string, string, string) (*Project, error)) *MockService_UpdateProject_Call {
+func (_c *MockService_UpdateProject_Call) RunAndReturn(run func(context.Context, string, string, string, int) (*Project, error)) *MockService_UpdateProject_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -20,6 +20,9 @@ var (
 	ErrSocialPlatformNotFound       = errors.New("social network not found")
 	ErrSocialPlatformAlreadyEnabled = errors.New("social network already enabled")
 	ErrSocialPlatformNotEnabled     = errors.New("social network not enabled")
+	ErrInvalidPreflightMode         = errors.New("invalid preflight mode")
+	ErrQueueNotFound                = errors.New("queue not found")
+	ErrVersionConflict              = errors.New("project was modified by someone else")
 )
 
 type TeamRoleOptions string
@@ -49,6 +52,7 @@ type Project struct {
 	Description string    `json:"description"`
 	IdeaQueue   []string  `json:"idea_queue"`
 	PostQueue   []string  `json:"post_queue"`
+	Version     int       `json:"version"` // Incremented on every edit, used as ETag
 	CreatedBy   string    `json:"created_by"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
@@ -73,6 +77,24 @@ type TeamMember struct {
 	MaxRole     int       `json:"max_role"`
 }
 
+// PreflightMode decides what happens when a post fails validation while it is being scheduled or queued
+type PreflightMode string
+
+const (
+	// PreflightModeStrict refuses to schedule or queue posts that would fail to publish
+	PreflightModeStrict PreflightMode = "strict"
+	// PreflightModeWarn schedules or queues them anyway and notifies the team
+	PreflightModeWarn PreflightMode = "warn"
+)
+
+func (m PreflightMode) IsValid() bool {
+	switch m {
+	case PreflightModeStrict, PreflightModeWarn:
+		return true
+	}
+	return false
+}
+
 type UserPlatformInfo struct {
 	IsAuthenticated bool
 	AuthTTL         time.Time
@@ -95,6 +117,7 @@ func NewProject(name, description, createdBy string) (*Project, error) {
 		Description: description,
 		PostQueue:   []string{},
 		IdeaQueue:   []string{},
+		Version:     1,
 		CreatedBy:   createdBy,
 		CreatedAt:   time.Now().UTC(),
 		UpdatedAt:   time.Now().UTC(),
@@ -10,17 +10,20 @@ var (
 	ErrInvalidDayOfWeek = errors.New("invalid day of week")
 	ErrInvalidHour      = errors.New("invalid hour")
 	ErrInvalidMinute    = errors.New("invalid minute")
+	ErrSlotNotFound     = errors.New("time slot not found")
 )
 
 type TimeSlot struct {
 	DayOfWeek time.Weekday `json:"day_of_week" swaggertype:"integer" example:"1"` // 0 = Sunday, 1 = Monday, etc.
 	Hour      int          `json:"hour"`
 	Minute    int          `json:"minute"`
+	Queue     string       `json:"queue,omitempty"` // Queue the slot draws posts from, the default queue when empty
 }
 
 type WeeklyPostSchedule struct {
 	Slots      []TimeSlot    `json:"slots"`
 	TimeMargin time.Duration `json:"time_margin" swaggertype:"integer" example:"300000000"` // 5 minutes in nanoseconds
+	Version    int           `json:"version"`                                                 // Incremented on every change, used as ETag
 }
 
 // NewWeeklyPostSchedule creates a new WeeklyPostSchedule.
@@ -28,6 +31,7 @@ func NewWeeklyPostSchedule(slots []TimeSlot) *WeeklyPostSchedule {
 	return &WeeklyPostSchedule{
 		Slots:      slots,
 		TimeMargin: 5 * time.Minute,
+		Version:    1,
 	}
 }
 
@@ -52,6 +56,12 @@ func DecodeSchedule(encoded string) (*WeeklyPostSchedule, error) {
 
 // IsTime checks if the given time matches any scheduled slot within the time margin.
 func (w *WeeklyPostSchedule) IsTime(t time.Time) bool {
+	_, ok := w.DueSlot(t)
+	return ok
+}
+
+// DueSlot returns the first slot matching the given time within the time margin.
+func (w *WeeklyPostSchedule) DueSlot(t time.Time) (TimeSlot, bool) {
 	utcTime := t.UTC()
 	for _, slot := range w.Slots {
 		slotTime := time.Date(utcTime.Year(), utcTime.Month(), utcTime.Day(),
@@ -59,10 +69,21 @@ func (w *WeeklyPostSchedule) IsTime(t time.Time) bool {
 		if utcTime.Weekday() == slot.DayOfWeek &&
 			utcTime.After(slotTime.Add(-w.TimeMargin)) &&
 			utcTime.Before(slotTime.Add(w.TimeMargin)) {
-			return true
+			return slot, true
+		}
+	}
+	return TimeSlot{}, false
+}
+
+// SetSlotQueue assigns a slot to the queue it draws posts from.
+func (w *WeeklyPostSchedule) SetSlotQueue(dayOfWeek time.Weekday, hour, minute int, queue string) error {
+	for i, slot := range w.Slots {
+		if slot.DayOfWeek == dayOfWeek && slot.Hour == hour && slot.Minute == minute {
+			w.Slots[i].Queue = queue
+			return nil
 		}
 	}
-	return false
+	return ErrSlotNotFound
 }
 
 // AddSlot adds a new slot to the schedule.
@@ -128,3 +128,41 @@ func TestRemoveSlot(t *testing.T) {
 		t.Errorf("expected no error for removing non-existent slot, got %v", err)
 	}
 }
+
+func TestDueSlot(t *testing.T) {
+	slots := []TimeSlot{
+		{DayOfWeek: time.Monday, Hour: 10, Minute: 30, Queue: "tips"},
+		{DayOfWeek: time.Friday, Hour: 18, Minute: 0, Queue: "promotions"},
+	}
+	schedule := NewWeeklyPostSchedule(slots)
+
+	slot, ok := schedule.DueSlot(time.Date(2023, time.October, 6, 18, 2, 0, 0, time.UTC)) // Friday
+	if !ok {
+		t.Fatalf("expected a due slot")
+	}
+	if slot.Queue != "promotions" {
+		t.Errorf("expected queue promotions, got %q", slot.Queue)
+	}
+
+	_, ok = schedule.DueSlot(time.Date(2023, time.October, 3, 10, 30, 0, 0, time.UTC)) // Tuesday
+	if ok {
+		t.Errorf("expected no due slot")
+	}
+}
+
+func TestSetSlotQueue(t *testing.T) {
+	schedule := NewWeeklyPostSchedule([]TimeSlot{{DayOfWeek: time.Monday, Hour: 10, Minute: 30}})
+
+	err := schedule.SetSlotQueue(time.Monday, 10, 30, "tips")
+	if err != nil {
+		t.Fatalf("failed to set slot queue: %v", err)
+	}
+	if schedule.Slots[0].Queue != "tips" {
+		t.Errorf("expected queue tips, got %q", schedule.Slots[0].Queue)
+	}
+
+	err = schedule.SetSlotQueue(time.Tuesday, 10, 30, "tips")
+	if err != ErrSlotNotFound {
+		t.Errorf("expected ErrSlotNotFound, got %v", err)
+	}
+}
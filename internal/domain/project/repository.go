@@ -30,4 +30,7 @@ type Repository interface {
 	SetDefaultUser(ctx context.Context, projectID, userID string) error
 	GetDefaultUserID(ctx context.Context, projectID string) (string, error)
 	GetPlatformInfo(ctx context.Context, userID, platformID string) (*UserPlatformInfo, error)
+	GetPreflightMode(ctx context.Context, projectID string) (PreflightMode, error)
+	SetPreflightMode(ctx context.Context, projectID string, mode PreflightMode) error
+	DoesQueueExist(ctx context.Context, projectID, queue string) (bool, error)
 }
@@ -2,8 +2,10 @@ package project
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
 	"github.com/redplanettribe/social-media-manager/internal/domain/user"
 	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
 	"golang.org/x/sync/errgroup"
@@ -11,7 +13,7 @@ import (
 
 type Service interface {
 	CreateProject(ctx context.Context, name, description string) (*Project, error)
-	UpdateProject(ctx context.Context, projectID, name, description string) (*Project, error)
+	UpdateProject(ctx context.Context, projectID, name, description string, version int) (*Project, error)
 	DeleteProject(ctx context.Context, projectID string) error
 	ListProjects(ctx context.Context) ([]*Project, error)
 	GetUserRoles(ctx context.Context, userID, projectID string) ([]string, error)
@@ -23,13 +25,15 @@ type Service interface {
 	EnableSocialPlatform(ctx context.Context, projectID, socialPlatformID string) error
 	DisableSocialPlatform(ctx context.Context, projectID, socialPlatformID string) error
 	GetEnabledSocialPlatforms(ctx context.Context, projectID string) ([]SocialPlatform, error)
-	AddTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour, minute int) error
-	RemoveTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour, minute int) error
+	AddTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour, minute int, queue string, version int) (*WeeklyPostSchedule, error)
+	RemoveTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour, minute int, version int) (*WeeklyPostSchedule, error)
 	GetProjectSchedule(ctx context.Context, projectID string) (*WeeklyPostSchedule, error)
-	IsProjectTimeToPublish(ctx context.Context, projectID string) (bool, error)
+	GetQueueToPublish(ctx context.Context, projectID string) (string, bool, error)
 	FindActiveProjectsChunk(ctx context.Context, offset, chunkSize int) ([]*Project, error)
 	SetDefaultUser(ctx context.Context, projectID, userID string) error
 	GetDefaultUserPlatformInfo(ctx context.Context, projecID, platformID string) (*UserPlatformInfo, error)
+	GetPreflightMode(ctx context.Context, projectID string) (PreflightMode, error)
+	SetPreflightMode(ctx context.Context, projectID string, mode PreflightMode) error
 }
 
 type service struct {
@@ -85,14 +89,29 @@ func (s *service) CreateProject(ctx context.Context, name, description string) (
 	return project, nil
 }
 
-func (s *service) UpdateProject(ctx context.Context, projectID, name, description string) (*Project, error) {
-	p, err := s.repo.FindProjectByID(ctx, projectID)
-	if err != nil {
-		return nil, err
+// maxUpdateAttempts bounds the edits made without a version, applied again when the project changes meanwhile
+const maxUpdateAttempts = 3
+
+// UpdateProject edits a project. A non zero version must match the current one, otherwise ErrVersionConflict is returned.
+// Without a version the edit is applied over the changes made since the project was read.
+func (s *service) UpdateProject(ctx context.Context, projectID, name, description string, version int) (*Project, error) {
+	for attempt := 1; ; attempt++ {
+		p, err := s.repo.FindProjectByID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		if version != 0 && version != p.Version {
+			return nil, ErrVersionConflict
+		}
+		p.Name = name
+		p.Description = description
+
+		p, err = s.repo.Update(ctx, p)
+		if errors.Is(err, ErrVersionConflict) && version == 0 && attempt < maxUpdateAttempts {
+			continue
+		}
+		return p, err
 	}
-	p.Name = name
-	p.Description = description
-	return s.repo.Update(ctx, p)
 }
 
 func (s *service) DeleteProject(ctx context.Context, projectID string) error {
@@ -292,48 +311,78 @@ func (s *service) GetEnabledSocialPlatforms(ctx context.Context, projectID strin
 	return s.repo.GetEnabledSocialPlatforms(ctx, projectID)
 }
 
-func (s *service) AddTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour, minute int) error {
+// AddTimeSlot adds a slot to the project schedule, or assigns the queue of an existing one.
+// A non zero version must match the current one of the schedule, otherwise ErrVersionConflict is returned.
+func (s *service) AddTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour, minute int, queue string, version int) (*WeeklyPostSchedule, error) {
+	queue = post.QueueName(queue)
+	if queue != post.DefaultQueue {
+		exists, err := s.repo.DoesQueueExist(ctx, projectID, queue)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrQueueNotFound
+		}
+	}
+
 	sch, err := s.repo.GetProjectSchedule(ctx, projectID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if version != 0 && version != sch.Version {
+		return nil, ErrVersionConflict
 	}
 	err = sch.AddSlot(dayOfWeek, hour, minute)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	err = sch.SetSlotQueue(dayOfWeek, hour, minute, queue)
+	if err != nil {
+		return nil, err
 	}
 	err = s.repo.SaveSchedule(ctx, projectID, sch)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return sch, nil
 }
 
-func (s *service) RemoveTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour, minute int) error {
+// RemoveTimeSlot removes a slot from the project schedule.
+// A non zero version must match the current one of the schedule, otherwise ErrVersionConflict is returned.
+func (s *service) RemoveTimeSlot(ctx context.Context, projectID string, dayOfWeek time.Weekday, hour, minute int, version int) (*WeeklyPostSchedule, error) {
 	sch, err := s.repo.GetProjectSchedule(ctx, projectID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if version != 0 && version != sch.Version {
+		return nil, ErrVersionConflict
 	}
 	err = sch.RemoveSlot(dayOfWeek, hour, minute)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = s.repo.SaveSchedule(ctx, projectID, sch)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return sch, nil
 }
 
 func (s *service) GetProjectSchedule(ctx context.Context, projectID string) (*WeeklyPostSchedule, error) {
 	return s.repo.GetProjectSchedule(ctx, projectID)
 }
 
-func (s *service) IsProjectTimeToPublish(ctx context.Context, projectID string) (bool, error) {
+// GetQueueToPublish returns the queue of the slot that is due now, if any.
+func (s *service) GetQueueToPublish(ctx context.Context, projectID string) (string, bool, error) {
 	sch, err := s.repo.GetProjectSchedule(ctx, projectID)
 	if err != nil {
-		return false, err
+		return "", false, err
 	}
-	return sch.IsTime(time.Now().UTC()), nil
+	slot, ok := sch.DueSlot(time.Now().UTC())
+	if !ok {
+		return "", false, nil
+	}
+	return post.QueueName(slot.Queue), true, nil
 }
 
 func (s *service) FindActiveProjectsChunk(ctx context.Context, offset, chunkSize int) ([]*Project, error) {
@@ -365,3 +414,14 @@ func (s *service) GetDefaultUserPlatformInfo(ctx context.Context, projectID, pla
 	}
 	return pInfo, nil
 }
+
+func (s *service) GetPreflightMode(ctx context.Context, projectID string) (PreflightMode, error) {
+	return s.repo.GetPreflightMode(ctx, projectID)
+}
+
+func (s *service) SetPreflightMode(ctx context.Context, projectID string, mode PreflightMode) error {
+	if !mode.IsValid() {
+		return ErrInvalidPreflightMode
+	}
+	return s.repo.SetPreflightMode(ctx, projectID, mode)
+}
@@ -196,18 +196,20 @@ func (_c *MockPublisher_Publish_Call) RunAndReturn(run func(context.Context, *po
 }
 
 // ValidatePost provides a mock function with given fields: ctx, _a1, _a2
-func (_m *MockPublisher) ValidatePost(ctx context.Context, _a1 *post.PublishPost, _a2 []*media.Media) error {
+func (_m *MockPublisher) ValidatePost(ctx context.Context, _a1 *post.PublishPost, _a2 []*media.Media) ValidationIssues {
 	ret := _m.Called(ctx, _a1, _a2)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ValidatePost")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *post.PublishPost, []*media.Media) error); ok {
+	var r0 ValidationIssues
+	if rf, ok := ret.Get(0).(func(context.Context, *post.PublishPost, []*media.Media) ValidationIssues); ok {
 		r0 = rf(ctx, _a1, _a2)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ValidationIssues)
+		}
 	}
 
 	return r0
@@ -233,12 +235,12 @@ func (_c *MockPublisher_ValidatePost_Call) Run(run func(ctx context.Context, _a1
 	return _c
 }
 
-func (_c *MockPublisher_ValidatePost_Call) Return(_a0 error) *MockPublisher_ValidatePost_Call {
+func (_c *MockPublisher_ValidatePost_Call) Return(_a0 ValidationIssues) *MockPublisher_ValidatePost_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockPublisher_ValidatePost_Call) RunAndReturn(run func(context.Context, *post.PublishPost, []*media.Media) error) *MockPublisher_ValidatePost_Call {
+func (_c *MockPublisher_ValidatePost_Call) RunAndReturn(run func(context.Context, *post.PublishPost, []*media.Media) ValidationIssues) *MockPublisher_ValidatePost_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,6 +6,7 @@ import (
 	context "context"
 	time "time"
 
+	project "github.com/redplanettribe/social-media-manager/internal/domain/project"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -305,6 +306,63 @@ func (_c *MockRepository_GetPlatformSecrets_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetPreflightMode provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) GetPreflightMode(ctx context.Context, projectID string) (project.PreflightMode, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreflightMode")
+	}
+
+	var r0 project.PreflightMode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (project.PreflightMode, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) project.PreflightMode); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(project.PreflightMode)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetPreflightMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreflightMode'
+type MockRepository_GetPreflightMode_Call struct {
+	*mock.Call
+}
+
+// GetPreflightMode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) GetPreflightMode(ctx interface{}, projectID interface{}) *MockRepository_GetPreflightMode_Call {
+	return &MockRepository_GetPreflightMode_Call{Call: _e.mock.On("GetPreflightMode", ctx, projectID)}
+}
+
+func (_c *MockRepository_GetPreflightMode_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_GetPreflightMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetPreflightMode_Call) Return(_a0 project.PreflightMode, _a1 error) *MockRepository_GetPreflightMode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetPreflightMode_Call) RunAndReturn(run func(context.Context, string) (project.PreflightMode, error)) *MockRepository_GetPreflightMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserPlatformSecrets provides a mock function with given fields: ctx, platformID, userID
 func (_m *MockRepository) GetUserPlatformSecrets(ctx context.Context, platformID string, userID string) (string, error) {
 	ret := _m.Called(ctx, platformID, userID)
@@ -240,6 +240,54 @@ func (_c *MockService_GetPublishPostInfo_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// PreflightCheck provides a mock function with given fields: ctx, projectID, postID
+func (_m *MockService) PreflightCheck(ctx context.Context, projectID string, postID string) error {
+	ret := _m.Called(ctx, projectID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreflightCheck")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_PreflightCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreflightCheck'
+type MockService_PreflightCheck_Call struct {
+	*mock.Call
+}
+
+// PreflightCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - postID string
+func (_e *MockService_Expecter) PreflightCheck(ctx interface{}, projectID interface{}, postID interface{}) *MockService_PreflightCheck_Call {
+	return &MockService_PreflightCheck_Call{Call: _e.mock.On("PreflightCheck", ctx, projectID, postID)}
+}
+
+func (_c *MockService_PreflightCheck_Call) Run(run func(ctx context.Context, projectID string, postID string)) *MockService_PreflightCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_PreflightCheck_Call) Return(_a0 error) *MockService_PreflightCheck_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_PreflightCheck_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_PreflightCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PublishPostToAssignedSocialNetworks provides a mock function with given fields: ctx, projecID, postID
 func (_m *MockService) PublishPostToAssignedSocialNetworks(ctx context.Context, projecID string, postID string) error {
 	ret := _m.Called(ctx, projecID, postID)
@@ -338,21 +386,33 @@ func (_c *MockService_PublishPostToSocialNetwork_Call) RunAndReturn(run func(con
 }
 
 // ValidatePostForAssignedSocialNetworks provides a mock function with given fields: ctx, projecID, postID
-func (_m *MockService) ValidatePostForAssignedSocialNetworks(ctx context.Context, projecID string, postID string) error {
+func (_m *MockService) ValidatePostForAssignedSocialNetworks(ctx context.Context, projecID string, postID string) ([]*ValidationReport, error) {
 	ret := _m.Called(ctx, projecID, postID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ValidatePostForAssignedSocialNetworks")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+	var r0 []*ValidationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*ValidationReport, error)); ok {
+		return rf(ctx, projecID, postID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*ValidationReport); ok {
 		r0 = rf(ctx, projecID, postID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ValidationReport)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projecID, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockService_ValidatePostForAssignedSocialNetworks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidatePostForAssignedSocialNetworks'
@@ -375,32 +435,44 @@ func (_c *MockService_ValidatePostForAssignedSocialNetworks_Call) Run(run func(c
 	return _c
 }
 
-func (_c *MockService_ValidatePostForAssignedSocialNetworks_Call) Return(_a0 error) *MockService_ValidatePostForAssignedSocialNetworks_Call {
-	_c.Call.Return(_a0)
+func (_c *MockService_ValidatePostForAssignedSocialNetworks_Call) Return(_a0 []*ValidationReport, _a1 error) *MockService_ValidatePostForAssignedSocialNetworks_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockService_ValidatePostForAssignedSocialNetworks_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_ValidatePostForAssignedSocialNetworks_Call {
+func (_c *MockService_ValidatePostForAssignedSocialNetworks_Call) RunAndReturn(run func(context.Context, string, string) ([]*ValidationReport, error)) *MockService_ValidatePostForAssignedSocialNetworks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ValidatePostForSocialNetwork provides a mock function with given fields: ctx, projectID, postID, platformID
-func (_m *MockService) ValidatePostForSocialNetwork(ctx context.Context, projectID string, postID string, platformID string) error {
+func (_m *MockService) ValidatePostForSocialNetwork(ctx context.Context, projectID string, postID string, platformID string) (*ValidationReport, error) {
 	ret := _m.Called(ctx, projectID, postID, platformID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ValidatePostForSocialNetwork")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+	var r0 *ValidationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*ValidationReport, error)); ok {
+		return rf(ctx, projectID, postID, platformID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *ValidationReport); ok {
 		r0 = rf(ctx, projectID, postID, platformID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ValidationReport)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, postID, platformID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockService_ValidatePostForSocialNetwork_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidatePostForSocialNetwork'
@@ -424,12 +496,12 @@ func (_c *MockService_ValidatePostForSocialNetwork_Call) Run(run func(ctx contex
 	return _c
 }
 
-func (_c *MockService_ValidatePostForSocialNetwork_Call) Return(_a0 error) *MockService_ValidatePostForSocialNetwork_Call {
-	_c.Call.Return(_a0)
+func (_c *MockService_ValidatePostForSocialNetwork_Call) Return(_a0 *ValidationReport, _a1 error) *MockService_ValidatePostForSocialNetwork_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockService_ValidatePostForSocialNetwork_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockService_ValidatePostForSocialNetwork_Call {
+func (_c *MockService_ValidatePostForSocialNetwork_Call) RunAndReturn(run func(context.Context, string, string, string) (*ValidationReport, error)) *MockService_ValidatePostForSocialNetwork_Call {
 	_c.Call.Return(run)
 	return _c
 }
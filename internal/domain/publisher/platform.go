@@ -23,6 +23,16 @@ const (
 	// ...
 )
 
+// IsValid tells whether the platform is a known one
+func (p PlatformID) IsValid() bool {
+	switch p {
+	case Facebook, X, LinkedIn, Instagram:
+		return true
+	default:
+		return false
+	}
+}
+
 type Platform struct {
 	ID   PlatformID
 	Name string
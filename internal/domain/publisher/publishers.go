@@ -13,8 +13,8 @@ type Publisher interface {
 	Authenticate(ctx context.Context, params any) (string, time.Time, error)
 	// Publish a post with media to the platform. Media could be nil
 	Publish(ctx context.Context, post *post.PublishPost, media []*media.Media) error
-	// Validate Post
-	ValidatePost(ctx context.Context, post *post.PublishPost, media []*media.Media) error
+	// ValidatePost returns every issue that would prevent the post from being published on the platform, plus warnings
+	ValidatePost(ctx context.Context, post *post.PublishPost, media []*media.Media) ValidationIssues
 	// MemberLookup returns the platform user ID for the given username. This is useful for tagging users in posts
 	MemberLookup(ctx context.Context, username string) (string, error)
 }
@@ -3,6 +3,8 @@ package publisher
 import (
 	"context"
 	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/project"
 )
 
 type Repository interface {
@@ -16,4 +18,5 @@ type Repository interface {
 	GetDefaultUserID(ctx context.Context, platformID string) (string, error)
 	SetUserPlatformAuthSecretsWithTTL(ctx context.Context, platformID, userID, secrets string, ttl time.Time) error
 	AddProfileTag(ctx context.Context, platformID, postID, tag string) error
+	GetPreflightMode(ctx context.Context, projectID string) (project.PreflightMode, error)
 }
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/notification"
 	post "github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/project"
 	"github.com/redplanettribe/social-media-manager/internal/infrastructure/encrypting"
 	"golang.org/x/sync/errgroup"
 )
@@ -13,12 +15,13 @@ import (
 type Service interface {
 	GetAvailableSocialNetworks(ctx context.Context) ([]Platform, error)
 	PublishPostToAssignedSocialNetworks(ctx context.Context, projecID, postID string) error
-	ValidatePostForAssignedSocialNetworks(ctx context.Context, projecID, postID string) error
+	ValidatePostForAssignedSocialNetworks(ctx context.Context, projecID, postID string) ([]*ValidationReport, error)
 	PublishPostToSocialNetwork(ctx context.Context, projectID, postID, platformID string) error
-	ValidatePostForSocialNetwork(ctx context.Context, projectID, postID, platformID string) error
+	ValidatePostForSocialNetwork(ctx context.Context, projectID, postID, platformID string) (*ValidationReport, error)
 	Authenticate(ctx context.Context, platformID, projectID, userID string, params any) error
 	GetPublishPostInfo(ctx context.Context, projectID, postID, platformID string) (*PublishPostInfo, error)
 	AddProfileTagToPost(ctx context.Context, projectID, postID, platformID, userPlatformID string) error
+	PreflightCheck(ctx context.Context, projectID, postID string) error
 }
 
 type service struct {
@@ -27,15 +30,24 @@ type service struct {
 	encrypter        encrypting.Encrypter
 	postService      post.Service
 	mediaService     media.Service
+	notifications    notification.Service
 }
 
-func NewService(r Repository, e encrypting.Encrypter, pf PublisherFactory, ps post.Service, m media.Service) Service {
+func NewService(
+	r Repository,
+	e encrypting.Encrypter,
+	pf PublisherFactory,
+	ps post.Service,
+	m media.Service,
+	n notification.Service,
+) Service {
 	return &service{
 		repo:             r,
 		publisherFactory: pf,
 		encrypter:        e,
 		postService:      ps,
 		mediaService:     m,
+		notifications:    n,
 	}
 }
 
@@ -177,30 +189,61 @@ func (s *service) PublishPostToAssignedSocialNetworks(ctx context.Context, proje
 	return s.postService.UpdatePostStatus(ctx, postID, post.PostStatusPublished)
 }
 
-func (s *service) ValidatePostForAssignedSocialNetworks(ctx context.Context, projectID, postID string) error {
+func (s *service) ValidatePostForAssignedSocialNetworks(ctx context.Context, projectID, postID string) ([]*ValidationReport, error) {
 	publishers, err := s.postService.GetSocialMediaPublishers(ctx, postID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(publishers) == 0 {
-		return ErrNoPublishersAssigned
+		return nil, ErrNoPublishersAssigned
 	}
 
+	// Each goroutine writes to its own index, so the reports keep the order of the publishers
+	reports := make([]*ValidationReport, len(publishers))
 	g, gCtx := errgroup.WithContext(ctx)
-	for _, publisherID := range publishers {
-		pid := publisherID
+	for i, publisherID := range publishers {
+		i, pid := i, publisherID
 		g.Go(func() error {
-			err := s.ValidatePostForSocialNetwork(gCtx, projectID, postID, pid)
-			return fmt.Errorf("failed to validate post for %s: %w", pid, err)
+			report, err := s.ValidatePostForSocialNetwork(gCtx, projectID, postID, pid)
+			if err != nil {
+				return fmt.Errorf("failed to validate post for %s: %w", pid, err)
+			}
+			reports[i] = report
+			return nil
 		})
 	}
 
 	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// PreflightCheck validates the post for every platform it is linked to before it is scheduled or queued.
+// Projects in strict mode refuse invalid posts, in warn mode the post is accepted and the team is notified.
+func (s *service) PreflightCheck(ctx context.Context, projectID, postID string) error {
+	reports, err := s.ValidatePostForAssignedSocialNetworks(ctx, projectID, postID)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	summary := SummarizeReports(reports)
+	if summary == "" {
+		return nil
+	}
+
+	mode, err := s.repo.GetPreflightMode(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if mode == project.PreflightModeWarn {
+		return s.notifications.Notify(ctx, projectID, postID, notification.KindPostValidationWarning,
+			fmt.Sprintf("post was accepted with validation errors, it will fail to publish unless they are fixed. %s", summary))
+	}
+
+	return fmt.Errorf("%w: %s", ErrPostValidationFailed, summary)
 }
 
 func (s *service) PublishPostToSocialNetwork(ctx context.Context, projectID, postID, platformID string) error {
@@ -238,6 +281,12 @@ func (s *service) PublishPostToSocialNetwork(ctx context.Context, projectID, pos
 		media, err = s.mediaService.GetMediaForPublishPost(ctx, projectID, postID, platformID)
 		return err
 	})
+	// The media are downloaded to temporary files, removed once done
+	defer func() {
+		for _, m := range media {
+			_ = m.Close()
+		}
+	}()
 
 	g.Go(func() error {
 		var err error
@@ -282,22 +331,22 @@ func (s *service) PublishPostToSocialNetwork(ctx context.Context, projectID, pos
 	return nil
 }
 
-func (s *service) ValidatePostForSocialNetwork(ctx context.Context, projectID, postID, platformID string) error {
+// ValidatePostForSocialNetwork collects every issue found for the post on the platform instead of stopping at the first one.
+// An error is only returned when the validation itself could not be run.
+func (s *service) ValidatePostForSocialNetwork(ctx context.Context, projectID, postID, platformID string) (*ValidationReport, error) {
 	var (
 		isEnabled     bool
 		publishPost   *post.PublishPost
 		media         []*media.Media
 		secrets       string
 		defaultUserID string
+		issues        ValidationIssues
 		g             errgroup.Group
 	)
 
 	defaultUserID, err := s.repo.GetDefaultUserID(ctx, projectID)
 	if err != nil {
-		return err
-	}
-	if defaultUserID == "" {
-		return ErrDefaultUserNotSet
+		return nil, err
 	}
 
 	g.Go(func() error {
@@ -312,44 +361,58 @@ func (s *service) ValidatePostForSocialNetwork(ctx context.Context, projectID, p
 		return err
 	})
 
+	// The media are checked by their metadata, they are only downloaded to be published
 	g.Go(func() error {
 		var err error
-		media, err = s.mediaService.GetMediaForPublishPost(ctx, projectID, postID, platformID)
+		media, err = s.mediaService.GetMediaDetailsForPublishPost(ctx, projectID, postID, platformID)
 		return err
 	})
 
-	g.Go(func() error {
-		var err error
-		secrets, err = s.repo.GetUserPlatformSecrets(ctx, platformID, defaultUserID)
-		return err
-	})
+	if defaultUserID != "" {
+		g.Go(func() error {
+			var err error
+			secrets, err = s.repo.GetUserPlatformSecrets(ctx, platformID, defaultUserID)
+			return err
+		})
+	}
 
 	if err := g.Wait(); err != nil {
-		return err
+		return nil, err
+	}
+
+	if publishPost == nil {
+		return nil, post.ErrPostNotFound
 	}
 
 	if !isEnabled {
-		return ErrSocialPlatformNotEnabledForProject
+		issues.AddError(IssueCodePlatformNotEnabled, "", ErrSocialPlatformNotEnabledForProject.Error())
 	}
 
-	if publishPost == nil {
-		return post.ErrPostNotFound
+	if defaultUserID == "" {
+		issues.AddError(IssueCodeDefaultUserNotSet, "", ErrDefaultUserNotSet.Error())
+	} else if secrets == "" {
+		issues.AddError(IssueCodeUserSecretsNotSet, "", ErrUserSecretsNotSet.Error())
 	}
 
-	if secrets == "" {
-		return ErrUserSecretsNotSet
+	// Media still processing have no details to check yet, and failed ones can't be published
+	for _, m := range media {
+		if !m.IsReady() {
+			issues.AddError(IssueCodeMediaNotReady, m.Filename, fmt.Sprintf("media %s is %s", m.Filename, m.Status))
+		}
 	}
 
+	// The checks of the post itself run without secrets as well, the missing credentials are already reported
 	publisher, err := s.publisherFactory.Create(platformID, secrets)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	if err := publisher.ValidatePost(ctx, publishPost, media); err != nil {
-		return err
+	postIssues := publisher.ValidatePost(ctx, publishPost, media)
+	if secrets == "" {
+		postIssues = postIssues.withoutCodes(IssueCodeMissingAccessToken, IssueCodeMissingAccountID, IssueCodeTokenExpired)
 	}
+	issues = append(issues, postIssues...)
 
-	return nil
+	return NewValidationReport(platformID, issues), nil
 }
 
 func (s *service) AddProfileTagToPost(ctx context.Context, projectID, postID, platformID, userPlatformID string) error {
@@ -0,0 +1,173 @@
+package publisher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/notification"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/project"
+)
+
+func TestValidatePostForAssignedSocialNetworks(t *testing.T) {
+	ctx := context.Background()
+	projectID, postID := "project-1", "post-1"
+	publishPost := &post.PublishPost{Post: &post.Post{ID: postID, ProjectID: projectID, Type: post.PostTypeText}}
+
+	mockRepo := NewMockRepository(t)
+	mockFactory := NewMockPublisherFactory(t)
+	mockPostSvc := post.NewMockService(t)
+	mockMediaSvc := media.NewMockService(t)
+	mockLinkedin := NewMockPublisher(t)
+	mockX := NewMockPublisher(t)
+
+	mockPostSvc.On("GetSocialMediaPublishers", ctx, postID).Return([]string{"linkedin", "x"}, nil)
+	mockPostSvc.On("GetPostToPublish", mock.Anything, postID).Return(publishPost, nil)
+	mockMediaSvc.On("GetMediaDetailsForPublishPost", mock.Anything, projectID, postID, mock.Anything).Return([]*media.Media{}, nil)
+	mockRepo.On("GetDefaultUserID", mock.Anything, projectID).Return("user-1", nil)
+	mockRepo.On("IsSocialNetworkEnabledForProject", mock.Anything, projectID, "linkedin").Return(true, nil)
+	mockRepo.On("IsSocialNetworkEnabledForProject", mock.Anything, projectID, "x").Return(false, nil)
+	mockRepo.On("GetUserPlatformSecrets", mock.Anything, mock.Anything, "user-1").Return("secrets", nil)
+	mockFactory.On("Create", "linkedin", "secrets").Return(mockLinkedin, nil)
+	mockFactory.On("Create", "x", "secrets").Return(mockX, nil)
+
+	var linkedinIssues ValidationIssues
+	linkedinIssues.AddWarning(IssueCodeMissingAltText, "alt_text", "image has no alt text")
+	mockLinkedin.On("ValidatePost", mock.Anything, publishPost, []*media.Media{}).Return(linkedinIssues)
+
+	var xIssues ValidationIssues
+	xIssues.AddError(IssueCodeEmptyText, "text_content", "text content is empty")
+	xIssues.AddError(IssueCodeTextTooLong, "text_content", "text content exceeds 280 characters")
+	mockX.On("ValidatePost", mock.Anything, publishPost, []*media.Media{}).Return(xIssues)
+
+	s := NewService(mockRepo, nil, mockFactory, mockPostSvc, mockMediaSvc, nil)
+	reports, err := s.ValidatePostForAssignedSocialNetworks(ctx, projectID, postID)
+
+	assert.NoError(t, err)
+	assert.Len(t, reports, 2)
+
+	assert.Equal(t, "linkedin", reports[0].PlatformID)
+	assert.True(t, reports[0].Valid)
+	assert.Len(t, reports[0].Issues, 1)
+
+	// Every issue is reported, including the platform not being enabled
+	assert.Equal(t, "x", reports[1].PlatformID)
+	assert.False(t, reports[1].Valid)
+	assert.Len(t, reports[1].Issues, 3)
+	assert.Equal(t, IssueCodePlatformNotEnabled, reports[1].Issues[0].Code)
+}
+
+func TestValidatePostForSocialNetwork_DefaultUserNotSet(t *testing.T) {
+	ctx := context.Background()
+	projectID, postID := "project-1", "post-1"
+	publishPost := &post.PublishPost{Post: &post.Post{ID: postID, ProjectID: projectID}}
+
+	mockRepo := NewMockRepository(t)
+	mockFactory := NewMockPublisherFactory(t)
+	mockPostSvc := post.NewMockService(t)
+	mockMediaSvc := media.NewMockService(t)
+	mockLinkedin := NewMockPublisher(t)
+
+	mockRepo.On("GetDefaultUserID", ctx, projectID).Return("", nil)
+	mockRepo.On("IsSocialNetworkEnabledForProject", ctx, projectID, "linkedin").Return(true, nil)
+	mockPostSvc.On("GetPostToPublish", ctx, postID).Return(publishPost, nil)
+	mockMediaSvc.On("GetMediaDetailsForPublishPost", ctx, projectID, postID, "linkedin").Return(nil, nil)
+	mockFactory.On("Create", "linkedin", "").Return(mockLinkedin, nil)
+
+	var linkedinIssues ValidationIssues
+	linkedinIssues.AddError(IssueCodeMissingAccessToken, "", "user access token is not set")
+	linkedinIssues.AddError(IssueCodeEmptyText, "text_content", "text content is empty")
+	mockLinkedin.On("ValidatePost", ctx, publishPost, []*media.Media(nil)).Return(linkedinIssues)
+
+	s := NewService(mockRepo, nil, mockFactory, mockPostSvc, mockMediaSvc, nil)
+	report, err := s.ValidatePostForSocialNetwork(ctx, projectID, postID, "linkedin")
+
+	assert.NoError(t, err)
+	assert.False(t, report.Valid)
+	// The post is still checked, the credentials are only reported once
+	assert.Equal(t, ValidationIssues{
+		{Code: IssueCodeDefaultUserNotSet, Severity: SeverityError, Message: ErrDefaultUserNotSet.Error()},
+		{Code: IssueCodeEmptyText, Severity: SeverityError, Field: "text_content", Message: "text content is empty"},
+	}, report.Issues)
+}
+
+func TestValidationIssues_Err(t *testing.T) {
+	var issues ValidationIssues
+	assert.NoError(t, issues.Err())
+
+	issues.AddWarning(IssueCodeMissingAltText, "alt_text", "image has no alt text")
+	assert.False(t, issues.HasErrors())
+	assert.NoError(t, issues.Err())
+
+	issues.AddError(IssueCodeMissingMedia, "media", "no media to upload")
+	issues.AddError(IssueCodeEmptyText, "text_content", "text content is empty")
+	assert.True(t, issues.HasErrors())
+	err := issues.Err()
+	assert.ErrorIs(t, err, ErrPostValidationFailed)
+	assert.Contains(t, err.Error(), "no media to upload; text content is empty")
+}
+
+func TestPreflightCheck(t *testing.T) {
+	ctx := context.Background()
+	projectID, postID := "project-1", "post-1"
+	publishPost := &post.PublishPost{Post: &post.Post{ID: postID, ProjectID: projectID, Type: post.PostTypeVideo}}
+
+	var videoIssues ValidationIssues
+	videoIssues.AddError(IssueCodeTooManyMedia, "media", "exactly one video is required")
+
+	tests := []struct {
+		name        string
+		mode        project.PreflightMode
+		expectedErr error
+		notifies    bool
+	}{
+		{
+			name:        "strict mode refuses invalid posts",
+			mode:        project.PreflightModeStrict,
+			expectedErr: ErrPostValidationFailed,
+		},
+		{
+			name:     "warn mode accepts invalid posts and notifies",
+			mode:     project.PreflightModeWarn,
+			notifies: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := NewMockRepository(t)
+			mockFactory := NewMockPublisherFactory(t)
+			mockPostSvc := post.NewMockService(t)
+			mockMediaSvc := media.NewMockService(t)
+			mockNotificationSvc := notification.NewMockService(t)
+			mockLinkedin := NewMockPublisher(t)
+
+			mockPostSvc.On("GetSocialMediaPublishers", ctx, postID).Return([]string{"linkedin"}, nil)
+			mockPostSvc.On("GetPostToPublish", mock.Anything, postID).Return(publishPost, nil)
+			mockMediaSvc.On("GetMediaDetailsForPublishPost", mock.Anything, projectID, postID, "linkedin").Return([]*media.Media{}, nil)
+			mockRepo.On("GetDefaultUserID", mock.Anything, projectID).Return("user-1", nil)
+			mockRepo.On("IsSocialNetworkEnabledForProject", mock.Anything, projectID, "linkedin").Return(true, nil)
+			mockRepo.On("GetUserPlatformSecrets", mock.Anything, "linkedin", "user-1").Return("secrets", nil)
+			mockRepo.On("GetPreflightMode", ctx, projectID).Return(tt.mode, nil)
+			mockFactory.On("Create", "linkedin", "secrets").Return(mockLinkedin, nil)
+			mockLinkedin.On("ValidatePost", mock.Anything, publishPost, []*media.Media{}).Return(videoIssues)
+			if tt.notifies {
+				mockNotificationSvc.On("Notify", ctx, projectID, postID, notification.KindPostValidationWarning, mock.Anything).Return(nil)
+			}
+
+			s := NewService(mockRepo, nil, mockFactory, mockPostSvc, mockMediaSvc, mockNotificationSvc)
+			err := s.PreflightCheck(ctx, projectID, postID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Contains(t, err.Error(), "linkedin: exactly one video is required")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package publisher
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var ErrPostValidationFailed = errors.New("post validation failed")
+
+type IssueSeverity string
+
+const (
+	SeverityError   IssueSeverity = "error"
+	SeverityWarning IssueSeverity = "warning"
+)
+
+// Issue codes shared by every platform, so the UI can map them to its own messages
+const (
+	IssueCodeMissingPost         = "missing_post"
+	IssueCodePlatformNotEnabled  = "platform_not_enabled"
+	IssueCodeDefaultUserNotSet   = "default_user_not_set"
+	IssueCodeUserSecretsNotSet   = "user_secrets_not_set"
+	IssueCodeMissingAccessToken  = "missing_access_token"
+	IssueCodeMissingAccountID    = "missing_account_id"
+	IssueCodeTokenExpired        = "token_expired"
+	IssueCodeUnsupportedPostType = "unsupported_post_type"
+	IssueCodeEmptyText           = "empty_text"
+	IssueCodeTextTooLong         = "text_too_long"
+	IssueCodeMissingMedia        = "missing_media"
+	IssueCodeTooManyMedia        = "too_many_media"
+	IssueCodeTooFewMedia         = "too_few_media"
+	IssueCodeInvalidMediaType    = "invalid_media_type"
+	IssueCodeInvalidMediaFormat  = "invalid_media_format"
+	IssueCodeMediaTooLarge       = "media_too_large"
+	IssueCodeMediaTooLong        = "media_too_long"
+	IssueCodeMediaTooShort       = "media_too_short"
+	IssueCodeMissingAltText      = "missing_alt_text"
+	IssueCodeMediaNotReady       = "media_not_ready"
+	IssueCodeInvalidAspectRatio  = "invalid_aspect_ratio"
+	IssueCodeTooManyPages        = "too_many_pages"
+)
+
+// ValidationIssue describes a single problem found while validating a post for a platform
+type ValidationIssue struct {
+	Code     string        `json:"code"`
+	Severity IssueSeverity `json:"severity"`
+	Field    string        `json:"field,omitempty"`
+	Message  string        `json:"message"`
+}
+
+type ValidationIssues []ValidationIssue
+
+func (vi *ValidationIssues) AddError(code, field, message string) {
+	*vi = append(*vi, ValidationIssue{Code: code, Severity: SeverityError, Field: field, Message: message})
+}
+
+func (vi *ValidationIssues) AddWarning(code, field, message string) {
+	*vi = append(*vi, ValidationIssue{Code: code, Severity: SeverityWarning, Field: field, Message: message})
+}
+
+func (vi ValidationIssues) HasErrors() bool {
+	for _, issue := range vi {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func (vi ValidationIssues) withoutCodes(codes ...string) ValidationIssues {
+	var kept ValidationIssues
+	for _, issue := range vi {
+		if !slices.Contains(codes, issue.Code) {
+			kept = append(kept, issue)
+		}
+	}
+	return kept
+}
+
+// Err returns nil when there are no blocking issues, otherwise an error wrapping ErrPostValidationFailed
+// with the messages of every blocking issue. Warnings are ignored.
+func (vi ValidationIssues) Err() error {
+	msgs := vi.errorMessages()
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrPostValidationFailed, strings.Join(msgs, "; "))
+}
+
+func (vi ValidationIssues) errorMessages() []string {
+	var msgs []string
+	for _, issue := range vi {
+		if issue.Severity == SeverityError {
+			msgs = append(msgs, issue.Message)
+		}
+	}
+	return msgs
+}
+
+// ValidationReport holds every issue found for a post on a single platform
+type ValidationReport struct {
+	PlatformID string           `json:"platform_id"`
+	Valid      bool             `json:"valid"`
+	Issues     ValidationIssues `json:"issues"`
+}
+
+func NewValidationReport(platformID string, issues ValidationIssues) *ValidationReport {
+	if issues == nil {
+		issues = ValidationIssues{}
+	}
+	return &ValidationReport{
+		PlatformID: platformID,
+		Valid:      !issues.HasErrors(),
+		Issues:     issues,
+	}
+}
+
+// SummarizeReports joins the blocking issues of every report into a single message.
+// It returns an empty string when all the reports are valid.
+func SummarizeReports(reports []*ValidationReport) string {
+	var parts []string
+	for _, r := range reports {
+		if r.Valid {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s", r.PlatformID, strings.Join(r.Issues.errorMessages(), ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
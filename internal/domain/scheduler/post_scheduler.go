@@ -2,10 +2,12 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/redplanettribe/social-media-manager/internal/domain/notification"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
 	"github.com/redplanettribe/social-media-manager/internal/domain/project"
 	pq "github.com/redplanettribe/social-media-manager/internal/domain/publisher"
@@ -14,25 +16,31 @@ import (
 )
 
 type PostScheduler struct {
-	postService    post.Service
-	projectService project.Service
-	cfg            *config.SchedulerConfig
-	publisherQueue pq.PublisherQueue
-	quit           chan struct{}
+	postService         post.Service
+	projectService      project.Service
+	publisherService    pq.Service
+	notificationService notification.Service
+	cfg                 *config.SchedulerConfig
+	publisherQueue      pq.PublisherQueue
+	quit                chan struct{}
 }
 
 func NewPostScheduler(
 	postSvc post.Service,
 	projectSvc project.Service,
+	publisherSvc pq.Service,
+	notificationSvc notification.Service,
 	publisherQueue pq.PublisherQueue,
 	cfg *config.SchedulerConfig,
 ) *PostScheduler {
 	return &PostScheduler{
-		postService:    postSvc,
-		projectService: projectSvc,
-		cfg:            cfg,
-		publisherQueue: publisherQueue,
-		quit:           make(chan struct{}),
+		postService:         postSvc,
+		projectService:      projectSvc,
+		publisherService:    publisherSvc,
+		notificationService: notificationSvc,
+		cfg:                 cfg,
+		publisherQueue:      publisherQueue,
+		quit:                make(chan struct{}),
 	}
 }
 
@@ -45,6 +53,9 @@ func (s *PostScheduler) Start(ctx context.Context) {
 				if err := s.scanAndEnqueue(ctx); err != nil {
 					log.Printf("Error S: %v", err)
 				}
+				if err := s.revalidateDuePosts(ctx); err != nil {
+					log.Printf("Error revalidating due posts: %v", err)
+				}
 			case <-s.quit:
 				ticker.Stop()
 				return
@@ -172,7 +183,7 @@ func (s *PostScheduler) scanProjectQueues(ctx context.Context, out chan<- *post.
 			projectID := proj.ID
 			g.Go(func() error {
 				// Check if it's time to publish for this project according to its configured schedule
-				ok, err := s.projectService.IsProjectTimeToPublish(gCtx, projectID)
+				queue, ok, err := s.projectService.GetQueueToPublish(gCtx, projectID)
 				if err != nil {
 					return err
 				}
@@ -180,8 +191,8 @@ func (s *PostScheduler) scanProjectQueues(ctx context.Context, out chan<- *post.
 					return nil // not time to publish
 				}
 				fmt.Println("Project", projectID, "is ready to publish")
-				// Each post can have multiple platforms to publish
-				qps, err := s.postService.DequeuePostsToPublish(gCtx, projectID)
+				// Each post can have multiple platforms to publish, the due slot decides which queue it is taken from
+				qps, err := s.postService.DequeuePostsToPublish(gCtx, projectID, queue)
 				if err != nil {
 					return err
 				}
@@ -209,3 +220,63 @@ func (s *PostScheduler) scanProjectQueues(ctx context.Context, out chan<- *post.
 
 	return nil
 }
+
+// revalidateDuePosts validates once more the scheduled posts that are about to be published,
+// so the team is notified in time when a post became invalid, e.g. because a token expired.
+// Each post is only checked once per scheduling. A post that can't be checked is skipped
+// and left unmarked, so it is checked again on the next tick.
+func (s *PostScheduler) revalidateDuePosts(ctx context.Context) error {
+	const chunkSize = 100
+	before := time.Now().Add(s.cfg.PreflightWindow)
+	// Checked posts are left out of the query, so only the skipped ones are paged past
+	skipped := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		posts, err := s.postService.FindPostsDueForPreflight(ctx, before, skipped, chunkSize)
+		if err != nil {
+			return err
+		}
+		if len(posts) == 0 {
+			return nil
+		}
+
+		for _, p := range posts {
+			if err := s.revalidatePost(ctx, p); err != nil {
+				log.Printf("Error revalidating post %s: %v", p.ID, err)
+				skipped++
+				continue
+			}
+			if err := s.postService.MarkPreflightChecked(ctx, p.ID); err != nil {
+				log.Printf("Error marking post %s as checked: %v", p.ID, err)
+				skipped++
+				continue
+			}
+		}
+	}
+}
+
+func (s *PostScheduler) revalidatePost(ctx context.Context, p *post.Post) error {
+	var summary string
+	reports, err := s.publisherService.ValidatePostForAssignedSocialNetworks(ctx, p.ProjectID, p.ID)
+	switch {
+	case errors.Is(err, pq.ErrNoPublishersAssigned):
+		summary = err.Error()
+	case err != nil:
+		return err
+	default:
+		summary = pq.SummarizeReports(reports)
+	}
+	if summary == "" {
+		return nil
+	}
+
+	message := fmt.Sprintf("post %q scheduled for %s will fail to publish. %s",
+		p.Title, p.ScheduledAt.UTC().Format(time.RFC3339), summary)
+	return s.notificationService.Notify(ctx, p.ProjectID, p.ID, notification.KindPostInvalid, message)
+}
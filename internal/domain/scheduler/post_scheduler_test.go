@@ -3,12 +3,14 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/redplanettribe/social-media-manager/internal/domain/notification"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
 	"github.com/redplanettribe/social-media-manager/internal/domain/project"
 	pq "github.com/redplanettribe/social-media-manager/internal/domain/publisher"
@@ -26,7 +28,8 @@ func TestPostScheduler_Start(t *testing.T) {
 			interval: 100 * time.Millisecond,
 			setup: func(mps *post.MockService, mpjs *project.MockService, mpq *pq.MockPublisherQueue) {
 				mps.On("FindScheduledReadyPosts", mock.Anything, 0, 100).Return([]*post.PublishPost{}, nil)
-				mpjs.On("FindActiveProjectsChunk", mock.Anything, 0, 100).Return([]*project.Project{}, nil)
+				mps.On("FindPostsDueForPreflight", mock.Anything, mock.Anything, 0, 100).Return([]*post.Post{}, nil)
+				mpjs.On("FindActiveProjectsChunk", mock.Anything, 0, 20).Return([]*project.Project{}, nil)
 			},
 		},
 	}
@@ -47,7 +50,7 @@ func TestPostScheduler_Start(t *testing.T) {
 				ChannelBuffer: 10,
 			}
 
-			scheduler := NewPostScheduler(mockPostSvc, mockProjectSvc, mockPubQueue, cfg)
+			scheduler := NewPostScheduler(mockPostSvc, mockProjectSvc, pq.NewMockService(t), notification.NewMockService(t), mockPubQueue, cfg)
 			scheduler.Start(ctx)
 
 			time.Sleep(150 * time.Millisecond)
@@ -118,18 +121,18 @@ func TestPostScheduler_ScanAndEnqueue(t *testing.T) {
 				Return([]*post.PublishPost{}, nil)
 
 			// Setup projects
-			mockProjectSvc.On("FindActiveProjectsChunk", mock.Anything, 0, 100).
+			mockProjectSvc.On("FindActiveProjectsChunk", mock.Anything, 0, 20).
 				Return(tt.projects, nil)
-			mockProjectSvc.On("FindActiveProjectsChunk", mock.Anything, 100, 100).
+			mockProjectSvc.On("FindActiveProjectsChunk", mock.Anything, 20, 20).
 				Return([]*project.Project{}, nil)
 
 			// Setup project posts
 			for _, proj := range tt.projects {
-				if post, exists := tt.projectPosts[proj.ID]; exists {
-					mockProjectSvc.On("FindOneReadyPostInQueue", mock.Anything, proj.ID).
-						Return(post.ID, nil)
-					mockPostSvc.On("GetQueuePost", mock.Anything, post.ID).
-						Return(post, nil)
+				if qp, exists := tt.projectPosts[proj.ID]; exists {
+					mockProjectSvc.On("GetQueueToPublish", mock.Anything, proj.ID).
+						Return(post.DefaultQueue, true, nil)
+					mockPostSvc.On("DequeuePostsToPublish", mock.Anything, proj.ID, post.DefaultQueue).
+						Return([]*post.PublishPost{qp}, nil)
 				}
 			}
 
@@ -141,7 +144,7 @@ func TestPostScheduler_ScanAndEnqueue(t *testing.T) {
 				ChannelBuffer: 10,
 			}
 
-			scheduler := NewPostScheduler(mockPostSvc, mockProjectSvc, mockPubQueue, cfg)
+			scheduler := NewPostScheduler(mockPostSvc, mockProjectSvc, pq.NewMockService(t), notification.NewMockService(t), mockPubQueue, cfg)
 			err := scheduler.scanAndEnqueue(ctx)
 
 			if tt.expectedErrors {
@@ -223,7 +226,7 @@ func TestPostScheduler_ScanScheduledPosts(t *testing.T) {
 				ChannelBuffer: 10,
 			}
 
-			scheduler := NewPostScheduler(mockPostSvc, mockProjectSvc, mockPubQueue, cfg)
+			scheduler := NewPostScheduler(mockPostSvc, mockProjectSvc, pq.NewMockService(t), notification.NewMockService(t), mockPubQueue, cfg)
 
 			// Create channel to collect posts
 			posts := make(chan *post.PublishPost, 100)
@@ -299,20 +302,20 @@ func TestPostScheduler_ScanProjectQueues(t *testing.T) {
 			// Setup project chunks
 			for i, chunk := range tt.projects {
 				if tt.expectedError != nil && i == len(tt.projects)-1 {
-					mockProjectSvc.On("FindActiveProjectsChunk", mock.Anything, i*100, 100).
+					mockProjectSvc.On("FindActiveProjectsChunk", mock.Anything, i*20, 20).
 						Return(nil, tt.expectedError)
 					break
 				}
-				mockProjectSvc.On("FindActiveProjectsChunk", mock.Anything, i*100, 100).
+				mockProjectSvc.On("FindActiveProjectsChunk", mock.Anything, i*20, 20).
 					Return(chunk, nil)
 			}
 
 			// Setup project posts
 			for projectID, qPost := range tt.projectPosts {
-				mockProjectSvc.On("FindOneReadyPostInQueue", mock.Anything, projectID).
-					Return(qPost.ID, nil)
-				mockPostSvc.On("GetQueuePost", mock.Anything, qPost.ID).
-					Return(qPost, nil)
+				mockProjectSvc.On("GetQueueToPublish", mock.Anything, projectID).
+					Return("tips", true, nil)
+				mockPostSvc.On("DequeuePostsToPublish", mock.Anything, projectID, "tips").
+					Return([]*post.PublishPost{qPost}, nil)
 			}
 
 			cfg := &config.SchedulerConfig{
@@ -320,7 +323,7 @@ func TestPostScheduler_ScanProjectQueues(t *testing.T) {
 				ChannelBuffer: 10,
 			}
 
-			scheduler := NewPostScheduler(mockPostSvc, mockProjectSvc, mockPubQueue, cfg)
+			scheduler := NewPostScheduler(mockPostSvc, mockProjectSvc, pq.NewMockService(t), notification.NewMockService(t), mockPubQueue, cfg)
 
 			// Create channel to collect posts
 			posts := make(chan *post.PublishPost, 100)
@@ -343,3 +346,89 @@ func TestPostScheduler_ScanProjectQueues(t *testing.T) {
 		})
 	}
 }
+
+func TestPostScheduler_RevalidateDuePosts(t *testing.T) {
+	validPost := &post.Post{ID: "valid", ProjectID: "proj1", Title: "Valid"}
+	invalidPost := &post.Post{ID: "invalid", ProjectID: "proj1", Title: "Invalid"}
+	unlinkedPost := &post.Post{ID: "unlinked", ProjectID: "proj1", Title: "Unlinked"}
+
+	var expiredToken pq.ValidationIssues
+	expiredToken.AddError(pq.IssueCodeMissingAccessToken, "", "user access token is not set")
+
+	mockPostSvc := post.NewMockService(t)
+	mockPublisherSvc := pq.NewMockService(t)
+	mockNotificationSvc := notification.NewMockService(t)
+
+	mockPostSvc.On("FindPostsDueForPreflight", mock.Anything, mock.Anything, 0, 100).
+		Return([]*post.Post{validPost, invalidPost, unlinkedPost}, nil).Once()
+	mockPostSvc.On("FindPostsDueForPreflight", mock.Anything, mock.Anything, 0, 100).
+		Return([]*post.Post{}, nil).Once()
+
+	mockPublisherSvc.On("ValidatePostForAssignedSocialNetworks", mock.Anything, "proj1", "valid").
+		Return([]*pq.ValidationReport{pq.NewValidationReport("linkedin", nil)}, nil)
+	mockPublisherSvc.On("ValidatePostForAssignedSocialNetworks", mock.Anything, "proj1", "invalid").
+		Return([]*pq.ValidationReport{pq.NewValidationReport("linkedin", expiredToken)}, nil)
+	mockPublisherSvc.On("ValidatePostForAssignedSocialNetworks", mock.Anything, "proj1", "unlinked").
+		Return(nil, pq.ErrNoPublishersAssigned)
+
+	mockNotificationSvc.On("Notify", mock.Anything, "proj1", "invalid", notification.KindPostInvalid,
+		mock.MatchedBy(func(msg string) bool {
+			return strings.Contains(msg, "linkedin: user access token is not set")
+		})).Return(nil)
+	mockNotificationSvc.On("Notify", mock.Anything, "proj1", "unlinked", notification.KindPostInvalid, mock.Anything).
+		Return(nil)
+
+	for _, p := range []*post.Post{validPost, invalidPost, unlinkedPost} {
+		mockPostSvc.On("MarkPreflightChecked", mock.Anything, p.ID).Return(nil)
+	}
+
+	cfg := &config.SchedulerConfig{
+		Interval:        time.Second,
+		ChannelBuffer:   10,
+		PreflightWindow: 30 * time.Minute,
+	}
+
+	scheduler := NewPostScheduler(mockPostSvc, project.NewMockService(t), mockPublisherSvc, mockNotificationSvc, pq.NewMockPublisherQueue(t), cfg)
+	err := scheduler.revalidateDuePosts(context.Background())
+
+	assert.NoError(t, err)
+	mockNotificationSvc.AssertNumberOfCalls(t, "Notify", 2)
+}
+
+func TestPostScheduler_RevalidateDuePosts_SkipsFailingPosts(t *testing.T) {
+	failingPost := &post.Post{ID: "failing", ProjectID: "proj1", Title: "Failing"}
+	unmarkedPost := &post.Post{ID: "unmarked", ProjectID: "proj1", Title: "Unmarked"}
+	validPost := &post.Post{ID: "valid", ProjectID: "proj1", Title: "Valid"}
+
+	mockPostSvc := post.NewMockService(t)
+	mockPublisherSvc := pq.NewMockService(t)
+
+	mockPostSvc.On("FindPostsDueForPreflight", mock.Anything, mock.Anything, 0, 100).
+		Return([]*post.Post{failingPost, unmarkedPost, validPost}, nil).Once()
+	// The failing and unmarked posts are still due, so the next read pages past them
+	mockPostSvc.On("FindPostsDueForPreflight", mock.Anything, mock.Anything, 2, 100).
+		Return([]*post.Post{}, nil).Once()
+
+	mockPublisherSvc.On("ValidatePostForAssignedSocialNetworks", mock.Anything, "proj1", "failing").
+		Return(nil, fmt.Errorf("database error"))
+	mockPublisherSvc.On("ValidatePostForAssignedSocialNetworks", mock.Anything, "proj1", "unmarked").
+		Return([]*pq.ValidationReport{pq.NewValidationReport("linkedin", nil)}, nil)
+	mockPublisherSvc.On("ValidatePostForAssignedSocialNetworks", mock.Anything, "proj1", "valid").
+		Return([]*pq.ValidationReport{pq.NewValidationReport("linkedin", nil)}, nil)
+
+	mockPostSvc.On("MarkPreflightChecked", mock.Anything, "unmarked").Return(fmt.Errorf("database error"))
+	mockPostSvc.On("MarkPreflightChecked", mock.Anything, "valid").Return(nil)
+
+	cfg := &config.SchedulerConfig{
+		Interval:        time.Second,
+		ChannelBuffer:   10,
+		PreflightWindow: 30 * time.Minute,
+	}
+
+	scheduler := NewPostScheduler(mockPostSvc, project.NewMockService(t), mockPublisherSvc, notification.NewMockService(t), pq.NewMockPublisherQueue(t), cfg)
+	err := scheduler.revalidateDuePosts(context.Background())
+
+	assert.NoError(t, err)
+	mockPostSvc.AssertNotCalled(t, "MarkPreflightChecked", mock.Anything, "failing")
+	mockPostSvc.AssertCalled(t, "MarkPreflightChecked", mock.Anything, "valid")
+}
@@ -0,0 +1,614 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package template
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteSnippet provides a mock function with given fields: ctx, id
+func (_m *MockRepository) DeleteSnippet(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSnippet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_DeleteSnippet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSnippet'
+type MockRepository_DeleteSnippet_Call struct {
+	*mock.Call
+}
+
+// DeleteSnippet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) DeleteSnippet(ctx interface{}, id interface{}) *MockRepository_DeleteSnippet_Call {
+	return &MockRepository_DeleteSnippet_Call{Call: _e.mock.On("DeleteSnippet", ctx, id)}
+}
+
+func (_c *MockRepository_DeleteSnippet_Call) Run(run func(ctx context.Context, id string)) *MockRepository_DeleteSnippet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteSnippet_Call) Return(_a0 error) *MockRepository_DeleteSnippet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_DeleteSnippet_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_DeleteSnippet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTemplate provides a mock function with given fields: ctx, id
+func (_m *MockRepository) DeleteTemplate(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_DeleteTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTemplate'
+type MockRepository_DeleteTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) DeleteTemplate(ctx interface{}, id interface{}) *MockRepository_DeleteTemplate_Call {
+	return &MockRepository_DeleteTemplate_Call{Call: _e.mock.On("DeleteTemplate", ctx, id)}
+}
+
+func (_c *MockRepository_DeleteTemplate_Call) Run(run func(ctx context.Context, id string)) *MockRepository_DeleteTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteTemplate_Call) Return(_a0 error) *MockRepository_DeleteTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_DeleteTemplate_Call) RunAndReturn(run func(context.Context, string) error) *MockRepository_DeleteTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSnippetByID provides a mock function with given fields: ctx, id
+func (_m *MockRepository) FindSnippetByID(ctx context.Context, id string) (*Snippet, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSnippetByID")
+	}
+
+	var r0 *Snippet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*Snippet, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *Snippet); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Snippet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindSnippetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSnippetByID'
+type MockRepository_FindSnippetByID_Call struct {
+	*mock.Call
+}
+
+// FindSnippetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) FindSnippetByID(ctx interface{}, id interface{}) *MockRepository_FindSnippetByID_Call {
+	return &MockRepository_FindSnippetByID_Call{Call: _e.mock.On("FindSnippetByID", ctx, id)}
+}
+
+func (_c *MockRepository_FindSnippetByID_Call) Run(run func(ctx context.Context, id string)) *MockRepository_FindSnippetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindSnippetByID_Call) Return(_a0 *Snippet, _a1 error) *MockRepository_FindSnippetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindSnippetByID_Call) RunAndReturn(run func(context.Context, string) (*Snippet, error)) *MockRepository_FindSnippetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSnippetsByNames provides a mock function with given fields: ctx, projectID, names
+func (_m *MockRepository) FindSnippetsByNames(ctx context.Context, projectID string, names []string) (map[string]string, error) {
+	ret := _m.Called(ctx, projectID, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSnippetsByNames")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (map[string]string, error)); ok {
+		return rf(ctx, projectID, names)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) map[string]string); ok {
+		r0 = rf(ctx, projectID, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, projectID, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindSnippetsByNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSnippetsByNames'
+type MockRepository_FindSnippetsByNames_Call struct {
+	*mock.Call
+}
+
+// FindSnippetsByNames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - names []string
+func (_e *MockRepository_Expecter) FindSnippetsByNames(ctx interface{}, projectID interface{}, names interface{}) *MockRepository_FindSnippetsByNames_Call {
+	return &MockRepository_FindSnippetsByNames_Call{Call: _e.mock.On("FindSnippetsByNames", ctx, projectID, names)}
+}
+
+func (_c *MockRepository_FindSnippetsByNames_Call) Run(run func(ctx context.Context, projectID string, names []string)) *MockRepository_FindSnippetsByNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindSnippetsByNames_Call) Return(_a0 map[string]string, _a1 error) *MockRepository_FindSnippetsByNames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindSnippetsByNames_Call) RunAndReturn(run func(context.Context, string, []string) (map[string]string, error)) *MockRepository_FindSnippetsByNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSnippetsByProjectID provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindSnippetsByProjectID(ctx context.Context, projectID string) ([]*Snippet, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSnippetsByProjectID")
+	}
+
+	var r0 []*Snippet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Snippet, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Snippet); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Snippet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindSnippetsByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSnippetsByProjectID'
+type MockRepository_FindSnippetsByProjectID_Call struct {
+	*mock.Call
+}
+
+// FindSnippetsByProjectID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) FindSnippetsByProjectID(ctx interface{}, projectID interface{}) *MockRepository_FindSnippetsByProjectID_Call {
+	return &MockRepository_FindSnippetsByProjectID_Call{Call: _e.mock.On("FindSnippetsByProjectID", ctx, projectID)}
+}
+
+func (_c *MockRepository_FindSnippetsByProjectID_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindSnippetsByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindSnippetsByProjectID_Call) Return(_a0 []*Snippet, _a1 error) *MockRepository_FindSnippetsByProjectID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindSnippetsByProjectID_Call) RunAndReturn(run func(context.Context, string) ([]*Snippet, error)) *MockRepository_FindSnippetsByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTemplateByID provides a mock function with given fields: ctx, id
+func (_m *MockRepository) FindTemplateByID(ctx context.Context, id string) (*Template, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTemplateByID")
+	}
+
+	var r0 *Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*Template, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *Template); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindTemplateByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTemplateByID'
+type MockRepository_FindTemplateByID_Call struct {
+	*mock.Call
+}
+
+// FindTemplateByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRepository_Expecter) FindTemplateByID(ctx interface{}, id interface{}) *MockRepository_FindTemplateByID_Call {
+	return &MockRepository_FindTemplateByID_Call{Call: _e.mock.On("FindTemplateByID", ctx, id)}
+}
+
+func (_c *MockRepository_FindTemplateByID_Call) Run(run func(ctx context.Context, id string)) *MockRepository_FindTemplateByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindTemplateByID_Call) Return(_a0 *Template, _a1 error) *MockRepository_FindTemplateByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindTemplateByID_Call) RunAndReturn(run func(context.Context, string) (*Template, error)) *MockRepository_FindTemplateByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTemplatesByProjectID provides a mock function with given fields: ctx, projectID
+func (_m *MockRepository) FindTemplatesByProjectID(ctx context.Context, projectID string) ([]*Template, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTemplatesByProjectID")
+	}
+
+	var r0 []*Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Template, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Template); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_FindTemplatesByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTemplatesByProjectID'
+type MockRepository_FindTemplatesByProjectID_Call struct {
+	*mock.Call
+}
+
+// FindTemplatesByProjectID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockRepository_Expecter) FindTemplatesByProjectID(ctx interface{}, projectID interface{}) *MockRepository_FindTemplatesByProjectID_Call {
+	return &MockRepository_FindTemplatesByProjectID_Call{Call: _e.mock.On("FindTemplatesByProjectID", ctx, projectID)}
+}
+
+func (_c *MockRepository_FindTemplatesByProjectID_Call) Run(run func(ctx context.Context, projectID string)) *MockRepository_FindTemplatesByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindTemplatesByProjectID_Call) Return(_a0 []*Template, _a1 error) *MockRepository_FindTemplatesByProjectID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_FindTemplatesByProjectID_Call) RunAndReturn(run func(context.Context, string) ([]*Template, error)) *MockRepository_FindTemplatesByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveSnippet provides a mock function with given fields: ctx, s
+func (_m *MockRepository) SaveSnippet(ctx context.Context, s *Snippet) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSnippet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Snippet) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SaveSnippet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSnippet'
+type MockRepository_SaveSnippet_Call struct {
+	*mock.Call
+}
+
+// SaveSnippet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *Snippet
+func (_e *MockRepository_Expecter) SaveSnippet(ctx interface{}, s interface{}) *MockRepository_SaveSnippet_Call {
+	return &MockRepository_SaveSnippet_Call{Call: _e.mock.On("SaveSnippet", ctx, s)}
+}
+
+func (_c *MockRepository_SaveSnippet_Call) Run(run func(ctx context.Context, s *Snippet)) *MockRepository_SaveSnippet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Snippet))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveSnippet_Call) Return(_a0 error) *MockRepository_SaveSnippet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SaveSnippet_Call) RunAndReturn(run func(context.Context, *Snippet) error) *MockRepository_SaveSnippet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTemplate provides a mock function with given fields: ctx, t
+func (_m *MockRepository) SaveTemplate(ctx context.Context, t *Template) error {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Template) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SaveTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTemplate'
+type MockRepository_SaveTemplate_Call struct {
+	*mock.Call
+}
+
+// SaveTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *Template
+func (_e *MockRepository_Expecter) SaveTemplate(ctx interface{}, t interface{}) *MockRepository_SaveTemplate_Call {
+	return &MockRepository_SaveTemplate_Call{Call: _e.mock.On("SaveTemplate", ctx, t)}
+}
+
+func (_c *MockRepository_SaveTemplate_Call) Run(run func(ctx context.Context, t *Template)) *MockRepository_SaveTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Template))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveTemplate_Call) Return(_a0 error) *MockRepository_SaveTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SaveTemplate_Call) RunAndReturn(run func(context.Context, *Template) error) *MockRepository_SaveTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSnippet provides a mock function with given fields: ctx, s
+func (_m *MockRepository) UpdateSnippet(ctx context.Context, s *Snippet) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSnippet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Snippet) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateSnippet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSnippet'
+type MockRepository_UpdateSnippet_Call struct {
+	*mock.Call
+}
+
+// UpdateSnippet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s *Snippet
+func (_e *MockRepository_Expecter) UpdateSnippet(ctx interface{}, s interface{}) *MockRepository_UpdateSnippet_Call {
+	return &MockRepository_UpdateSnippet_Call{Call: _e.mock.On("UpdateSnippet", ctx, s)}
+}
+
+func (_c *MockRepository_UpdateSnippet_Call) Run(run func(ctx context.Context, s *Snippet)) *MockRepository_UpdateSnippet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Snippet))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateSnippet_Call) Return(_a0 error) *MockRepository_UpdateSnippet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateSnippet_Call) RunAndReturn(run func(context.Context, *Snippet) error) *MockRepository_UpdateSnippet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTemplate provides a mock function with given fields: ctx, t
+func (_m *MockRepository) UpdateTemplate(ctx context.Context, t *Template) error {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *Template) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTemplate'
+type MockRepository_UpdateTemplate_Call struct {
+	*mock.Call
+}
+
+// UpdateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *Template
+func (_e *MockRepository_Expecter) UpdateTemplate(ctx interface{}, t interface{}) *MockRepository_UpdateTemplate_Call {
+	return &MockRepository_UpdateTemplate_Call{Call: _e.mock.On("UpdateTemplate", ctx, t)}
+}
+
+func (_c *MockRepository_UpdateTemplate_Call) Run(run func(ctx context.Context, t *Template)) *MockRepository_UpdateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*Template))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateTemplate_Call) Return(_a0 error) *MockRepository_UpdateTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateTemplate_Call) RunAndReturn(run func(context.Context, *Template) error) *MockRepository_UpdateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,685 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package template
+
+import (
+	context "context"
+
+	post "github.com/redplanettribe/social-media-manager/internal/domain/post"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockService is an autogenerated mock type for the Service type
+type MockService struct {
+	mock.Mock
+}
+
+type MockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockService) EXPECT() *MockService_Expecter {
+	return &MockService_Expecter{mock: &_m.Mock}
+}
+
+// CreatePostFromTemplate provides a mock function with given fields: ctx, projectID, templateID, variables, scheduledAt
+func (_m *MockService) CreatePostFromTemplate(ctx context.Context, projectID string, templateID string, variables map[string]string, scheduledAt time.Time) (*post.Post, error) {
+	ret := _m.Called(ctx, projectID, templateID, variables, scheduledAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePostFromTemplate")
+	}
+
+	var r0 *post.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]string, time.Time) (*post.Post, error)); ok {
+		return rf(ctx, projectID, templateID, variables, scheduledAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]string, time.Time) *post.Post); ok {
+		r0 = rf(ctx, projectID, templateID, variables, scheduledAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*post.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, map[string]string, time.Time) error); ok {
+		r1 = rf(ctx, projectID, templateID, variables, scheduledAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreatePostFromTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePostFromTemplate'
+type MockService_CreatePostFromTemplate_Call struct {
+	*mock.Call
+}
+
+// CreatePostFromTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - templateID string
+//   - variables map[string]string
+//   - scheduledAt time.Time
+func (_e *MockService_Expecter) CreatePostFromTemplate(ctx interface{}, projectID interface{}, templateID interface{}, variables interface{}, scheduledAt interface{}) *MockService_CreatePostFromTemplate_Call {
+	return &MockService_CreatePostFromTemplate_Call{Call: _e.mock.On("CreatePostFromTemplate", ctx, projectID, templateID, variables, scheduledAt)}
+}
+
+func (_c *MockService_CreatePostFromTemplate_Call) Run(run func(ctx context.Context, projectID string, templateID string, variables map[string]string, scheduledAt time.Time)) *MockService_CreatePostFromTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(map[string]string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockService_CreatePostFromTemplate_Call) Return(_a0 *post.Post, _a1 error) *MockService_CreatePostFromTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreatePostFromTemplate_Call) RunAndReturn(run func(context.Context, string, string, map[string]string, time.Time) (*post.Post, error)) *MockService_CreatePostFromTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSnippet provides a mock function with given fields: ctx, projectID, name, content
+func (_m *MockService) CreateSnippet(ctx context.Context, projectID string, name string, content string) (*Snippet, error) {
+	ret := _m.Called(ctx, projectID, name, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSnippet")
+	}
+
+	var r0 *Snippet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*Snippet, error)); ok {
+		return rf(ctx, projectID, name, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *Snippet); ok {
+		r0 = rf(ctx, projectID, name, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Snippet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, name, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateSnippet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnippet'
+type MockService_CreateSnippet_Call struct {
+	*mock.Call
+}
+
+// CreateSnippet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - name string
+//   - content string
+func (_e *MockService_Expecter) CreateSnippet(ctx interface{}, projectID interface{}, name interface{}, content interface{}) *MockService_CreateSnippet_Call {
+	return &MockService_CreateSnippet_Call{Call: _e.mock.On("CreateSnippet", ctx, projectID, name, content)}
+}
+
+func (_c *MockService_CreateSnippet_Call) Run(run func(ctx context.Context, projectID string, name string, content string)) *MockService_CreateSnippet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateSnippet_Call) Return(_a0 *Snippet, _a1 error) *MockService_CreateSnippet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateSnippet_Call) RunAndReturn(run func(context.Context, string, string, string) (*Snippet, error)) *MockService_CreateSnippet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTemplate provides a mock function with given fields: ctx, projectID, name, title, postType, content, platforms
+func (_m *MockService) CreateTemplate(ctx context.Context, projectID string, name string, title string, postType string, content string, platforms []string) (*Template, error) {
+	ret := _m.Called(ctx, projectID, name, title, postType, content, platforms)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTemplate")
+	}
+
+	var r0 *Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, []string) (*Template, error)); ok {
+		return rf(ctx, projectID, name, title, postType, content, platforms)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, []string) *Template); ok {
+		r0 = rf(ctx, projectID, name, title, postType, content, platforms)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string, []string) error); ok {
+		r1 = rf(ctx, projectID, name, title, postType, content, platforms)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_CreateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTemplate'
+type MockService_CreateTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - name string
+//   - title string
+//   - postType string
+//   - content string
+//   - platforms []string
+func (_e *MockService_Expecter) CreateTemplate(ctx interface{}, projectID interface{}, name interface{}, title interface{}, postType interface{}, content interface{}, platforms interface{}) *MockService_CreateTemplate_Call {
+	return &MockService_CreateTemplate_Call{Call: _e.mock.On("CreateTemplate", ctx, projectID, name, title, postType, content, platforms)}
+}
+
+func (_c *MockService_CreateTemplate_Call) Run(run func(ctx context.Context, projectID string, name string, title string, postType string, content string, platforms []string)) *MockService_CreateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].([]string))
+	})
+	return _c
+}
+
+func (_c *MockService_CreateTemplate_Call) Return(_a0 *Template, _a1 error) *MockService_CreateTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_CreateTemplate_Call) RunAndReturn(run func(context.Context, string, string, string, string, string, []string) (*Template, error)) *MockService_CreateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSnippet provides a mock function with given fields: ctx, projectID, id
+func (_m *MockService) DeleteSnippet(ctx context.Context, projectID string, id string) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSnippet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteSnippet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSnippet'
+type MockService_DeleteSnippet_Call struct {
+	*mock.Call
+}
+
+// DeleteSnippet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+func (_e *MockService_Expecter) DeleteSnippet(ctx interface{}, projectID interface{}, id interface{}) *MockService_DeleteSnippet_Call {
+	return &MockService_DeleteSnippet_Call{Call: _e.mock.On("DeleteSnippet", ctx, projectID, id)}
+}
+
+func (_c *MockService_DeleteSnippet_Call) Run(run func(ctx context.Context, projectID string, id string)) *MockService_DeleteSnippet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteSnippet_Call) Return(_a0 error) *MockService_DeleteSnippet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteSnippet_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_DeleteSnippet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTemplate provides a mock function with given fields: ctx, projectID, id
+func (_m *MockService) DeleteTemplate(ctx context.Context, projectID string, id string) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockService_DeleteTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTemplate'
+type MockService_DeleteTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+func (_e *MockService_Expecter) DeleteTemplate(ctx interface{}, projectID interface{}, id interface{}) *MockService_DeleteTemplate_Call {
+	return &MockService_DeleteTemplate_Call{Call: _e.mock.On("DeleteTemplate", ctx, projectID, id)}
+}
+
+func (_c *MockService_DeleteTemplate_Call) Run(run func(ctx context.Context, projectID string, id string)) *MockService_DeleteTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_DeleteTemplate_Call) Return(_a0 error) *MockService_DeleteTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockService_DeleteTemplate_Call) RunAndReturn(run func(context.Context, string, string) error) *MockService_DeleteTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplate provides a mock function with given fields: ctx, projectID, id
+func (_m *MockService) GetTemplate(ctx context.Context, projectID string, id string) (*Template, error) {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplate")
+	}
+
+	var r0 *Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*Template, error)); ok {
+		return rf(ctx, projectID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *Template); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_GetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplate'
+type MockService_GetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+func (_e *MockService_Expecter) GetTemplate(ctx interface{}, projectID interface{}, id interface{}) *MockService_GetTemplate_Call {
+	return &MockService_GetTemplate_Call{Call: _e.mock.On("GetTemplate", ctx, projectID, id)}
+}
+
+func (_c *MockService_GetTemplate_Call) Run(run func(ctx context.Context, projectID string, id string)) *MockService_GetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_GetTemplate_Call) Return(_a0 *Template, _a1 error) *MockService_GetTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_GetTemplate_Call) RunAndReturn(run func(context.Context, string, string) (*Template, error)) *MockService_GetTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertSnippets provides a mock function with given fields: ctx, projectID, text
+func (_m *MockService) InsertSnippets(ctx context.Context, projectID string, text string) (string, error) {
+	ret := _m.Called(ctx, projectID, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertSnippets")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, projectID, text)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, projectID, text)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_InsertSnippets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertSnippets'
+type MockService_InsertSnippets_Call struct {
+	*mock.Call
+}
+
+// InsertSnippets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - text string
+func (_e *MockService_Expecter) InsertSnippets(ctx interface{}, projectID interface{}, text interface{}) *MockService_InsertSnippets_Call {
+	return &MockService_InsertSnippets_Call{Call: _e.mock.On("InsertSnippets", ctx, projectID, text)}
+}
+
+func (_c *MockService_InsertSnippets_Call) Run(run func(ctx context.Context, projectID string, text string)) *MockService_InsertSnippets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_InsertSnippets_Call) Return(_a0 string, _a1 error) *MockService_InsertSnippets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_InsertSnippets_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *MockService_InsertSnippets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProjectSnippets provides a mock function with given fields: ctx, projectID
+func (_m *MockService) ListProjectSnippets(ctx context.Context, projectID string) ([]*Snippet, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectSnippets")
+	}
+
+	var r0 []*Snippet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Snippet, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Snippet); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Snippet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListProjectSnippets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectSnippets'
+type MockService_ListProjectSnippets_Call struct {
+	*mock.Call
+}
+
+// ListProjectSnippets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockService_Expecter) ListProjectSnippets(ctx interface{}, projectID interface{}) *MockService_ListProjectSnippets_Call {
+	return &MockService_ListProjectSnippets_Call{Call: _e.mock.On("ListProjectSnippets", ctx, projectID)}
+}
+
+func (_c *MockService_ListProjectSnippets_Call) Run(run func(ctx context.Context, projectID string)) *MockService_ListProjectSnippets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListProjectSnippets_Call) Return(_a0 []*Snippet, _a1 error) *MockService_ListProjectSnippets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListProjectSnippets_Call) RunAndReturn(run func(context.Context, string) ([]*Snippet, error)) *MockService_ListProjectSnippets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProjectTemplates provides a mock function with given fields: ctx, projectID
+func (_m *MockService) ListProjectTemplates(ctx context.Context, projectID string) ([]*Template, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectTemplates")
+	}
+
+	var r0 []*Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*Template, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*Template); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_ListProjectTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectTemplates'
+type MockService_ListProjectTemplates_Call struct {
+	*mock.Call
+}
+
+// ListProjectTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+func (_e *MockService_Expecter) ListProjectTemplates(ctx interface{}, projectID interface{}) *MockService_ListProjectTemplates_Call {
+	return &MockService_ListProjectTemplates_Call{Call: _e.mock.On("ListProjectTemplates", ctx, projectID)}
+}
+
+func (_c *MockService_ListProjectTemplates_Call) Run(run func(ctx context.Context, projectID string)) *MockService_ListProjectTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_ListProjectTemplates_Call) Return(_a0 []*Template, _a1 error) *MockService_ListProjectTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_ListProjectTemplates_Call) RunAndReturn(run func(context.Context, string) ([]*Template, error)) *MockService_ListProjectTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSnippet provides a mock function with given fields: ctx, projectID, id, name, content
+func (_m *MockService) UpdateSnippet(ctx context.Context, projectID string, id string, name string, content string) (*Snippet, error) {
+	ret := _m.Called(ctx, projectID, id, name, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSnippet")
+	}
+
+	var r0 *Snippet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*Snippet, error)); ok {
+		return rf(ctx, projectID, id, name, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *Snippet); ok {
+		r0 = rf(ctx, projectID, id, name, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Snippet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, projectID, id, name, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UpdateSnippet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSnippet'
+type MockService_UpdateSnippet_Call struct {
+	*mock.Call
+}
+
+// UpdateSnippet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+//   - name string
+//   - content string
+func (_e *MockService_Expecter) UpdateSnippet(ctx interface{}, projectID interface{}, id interface{}, name interface{}, content interface{}) *MockService_UpdateSnippet_Call {
+	return &MockService_UpdateSnippet_Call{Call: _e.mock.On("UpdateSnippet", ctx, projectID, id, name, content)}
+}
+
+func (_c *MockService_UpdateSnippet_Call) Run(run func(ctx context.Context, projectID string, id string, name string, content string)) *MockService_UpdateSnippet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateSnippet_Call) Return(_a0 *Snippet, _a1 error) *MockService_UpdateSnippet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UpdateSnippet_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*Snippet, error)) *MockService_UpdateSnippet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTemplate provides a mock function with given fields: ctx, projectID, id, name, title, postType, content, platforms
+func (_m *MockService) UpdateTemplate(ctx context.Context, projectID string, id string, name string, title string, postType string, content string, platforms []string) (*Template, error) {
+	ret := _m.Called(ctx, projectID, id, name, title, postType, content, platforms)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTemplate")
+	}
+
+	var r0 *Template
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, string, []string) (*Template, error)); ok {
+		return rf(ctx, projectID, id, name, title, postType, content, platforms)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, string, []string) *Template); ok {
+		r0 = rf(ctx, projectID, id, name, title, postType, content, platforms)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string, string, []string) error); ok {
+		r1 = rf(ctx, projectID, id, name, title, postType, content, platforms)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockService_UpdateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTemplate'
+type MockService_UpdateTemplate_Call struct {
+	*mock.Call
+}
+
+// UpdateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - id string
+//   - name string
+//   - title string
+//   - postType string
+//   - content string
+//   - platforms []string
+func (_e *MockService_Expecter) UpdateTemplate(ctx interface{}, projectID interface{}, id interface{}, name interface{}, title interface{}, postType interface{}, content interface{}, platforms interface{}) *MockService_UpdateTemplate_Call {
+	return &MockService_UpdateTemplate_Call{Call: _e.mock.On("UpdateTemplate", ctx, projectID, id, name, title, postType, content, platforms)}
+}
+
+func (_c *MockService_UpdateTemplate_Call) Run(run func(ctx context.Context, projectID string, id string, name string, title string, postType string, content string, platforms []string)) *MockService_UpdateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].(string), args[7].([]string))
+	})
+	return _c
+}
+
+func (_c *MockService_UpdateTemplate_Call) Return(_a0 *Template, _a1 error) *MockService_UpdateTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockService_UpdateTemplate_Call) RunAndReturn(run func(context.Context, string, string, string, string, string, string, []string) (*Template, error)) *MockService_UpdateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
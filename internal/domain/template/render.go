@@ -0,0 +1,89 @@
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	placeholderRegex = regexp.MustCompile(`\{\{\s*(snippet:)?([a-zA-Z0-9_-]+)\s*\}\}`)
+	snippetNameRegex = regexp.MustCompile(`^[a-z0-9_-]{1,50}$`)
+)
+
+// ExtractVariables returns the sorted, deduplicated names of the {{variable}} placeholders in texts.
+// Snippet references are not variables.
+func ExtractVariables(texts ...string) []string {
+	variables := []string{}
+	for _, text := range texts {
+		for _, m := range placeholderRegex.FindAllStringSubmatch(text, -1) {
+			if m[1] == "" && !slices.Contains(variables, m[2]) {
+				variables = append(variables, m[2])
+			}
+		}
+	}
+	slices.Sort(variables)
+	return variables
+}
+
+// ExtractSnippetNames returns the names of the snippets referenced in texts
+func ExtractSnippetNames(texts ...string) []string {
+	names := []string{}
+	for _, text := range texts {
+		for _, m := range placeholderRegex.FindAllStringSubmatch(text, -1) {
+			if m[1] != "" && !slices.Contains(names, m[2]) {
+				names = append(names, m[2])
+			}
+		}
+	}
+	return names
+}
+
+// InsertSnippets replaces every {{snippet:name}} reference with the snippet content.
+// Snippets are not expanded recursively. It fails if a referenced snippet doesn't exist.
+func InsertSnippets(text string, snippets map[string]string) (string, error) {
+	var missing []string
+	result := placeholderRegex.ReplaceAllStringFunc(text, func(match string) string {
+		m := placeholderRegex.FindStringSubmatch(match)
+		if m[1] == "" {
+			return match
+		}
+		content, ok := snippets[m[2]]
+		if !ok {
+			missing = append(missing, m[2])
+			return match
+		}
+		return content
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrSnippetNotFound, strings.Join(missing, ", "))
+	}
+	return result, nil
+}
+
+// MissingVariables returns the variables of texts that have no value
+func MissingVariables(values map[string]string, texts ...string) []string {
+	var missing []string
+	for _, name := range ExtractVariables(texts...) {
+		if values[name] == "" {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// FillVariables replaces every {{variable}} placeholder of text with its value.
+// It fails listing every variable without a value, so the caller can ask for all of them at once.
+func FillVariables(text string, values map[string]string) (string, error) {
+	if missing := MissingVariables(values, text); len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrMissingTemplateVariables, strings.Join(missing, ", "))
+	}
+	return placeholderRegex.ReplaceAllStringFunc(text, func(match string) string {
+		m := placeholderRegex.FindStringSubmatch(match)
+		if m[1] != "" {
+			return match
+		}
+		return values[m[2]]
+	}), nil
+}
@@ -0,0 +1,18 @@
+package template
+
+import "context"
+
+type Repository interface {
+	SaveTemplate(ctx context.Context, t *Template) error
+	UpdateTemplate(ctx context.Context, t *Template) error
+	DeleteTemplate(ctx context.Context, id string) error
+	FindTemplateByID(ctx context.Context, id string) (*Template, error)
+	FindTemplatesByProjectID(ctx context.Context, projectID string) ([]*Template, error)
+	SaveSnippet(ctx context.Context, s *Snippet) error
+	UpdateSnippet(ctx context.Context, s *Snippet) error
+	DeleteSnippet(ctx context.Context, id string) error
+	FindSnippetByID(ctx context.Context, id string) (*Snippet, error)
+	FindSnippetsByProjectID(ctx context.Context, projectID string) ([]*Snippet, error)
+	// FindSnippetsByNames returns the project snippets with the given names, keyed by name
+	FindSnippetsByNames(ctx context.Context, projectID string, names []string) (map[string]string, error)
+}
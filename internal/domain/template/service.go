@@ -0,0 +1,230 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/interfaces/api/http/middlewares"
+)
+
+type Service interface {
+	CreateTemplate(ctx context.Context, projectID, name, title, postType, content string, platforms []string) (*Template, error)
+	UpdateTemplate(ctx context.Context, projectID, id, name, title, postType, content string, platforms []string) (*Template, error)
+	DeleteTemplate(ctx context.Context, projectID, id string) error
+	GetTemplate(ctx context.Context, projectID, id string) (*Template, error)
+	ListProjectTemplates(ctx context.Context, projectID string) ([]*Template, error)
+	// CreatePostFromTemplate renders the template with the given variables and creates a draft post linked to the template platforms
+	CreatePostFromTemplate(ctx context.Context, projectID, templateID string, variables map[string]string, scheduledAt time.Time) (*post.Post, error)
+	CreateSnippet(ctx context.Context, projectID, name, content string) (*Snippet, error)
+	UpdateSnippet(ctx context.Context, projectID, id, name, content string) (*Snippet, error)
+	DeleteSnippet(ctx context.Context, projectID, id string) error
+	ListProjectSnippets(ctx context.Context, projectID string) ([]*Snippet, error)
+	// InsertSnippets replaces the {{snippet:name}} references of text with the project snippets
+	InsertSnippets(ctx context.Context, projectID, text string) (string, error)
+}
+
+type service struct {
+	repo        Repository
+	postService post.Service
+}
+
+func NewService(repo Repository, postService post.Service) Service {
+	return &service{repo: repo, postService: postService}
+}
+
+func (s *service) CreateTemplate(ctx context.Context, projectID, name, title, postType, content string, platforms []string) (*Template, error) {
+	userID := ctx.Value(middlewares.UserIDKey).(string)
+
+	if !post.PostType(postType).IsValid() {
+		return nil, post.ErrInvalidPostType
+	}
+
+	t, err := NewTemplate(projectID, userID, name, title, postType, content, platforms)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SaveTemplate(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *service) UpdateTemplate(ctx context.Context, projectID, id, name, title, postType, content string, platforms []string) (*Template, error) {
+	if !post.PostType(postType).IsValid() {
+		return nil, post.ErrInvalidPostType
+	}
+
+	t, err := s.GetTemplate(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.Update(name, title, postType, content, platforms)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.UpdateTemplate(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *service) DeleteTemplate(ctx context.Context, projectID, id string) error {
+	_, err := s.GetTemplate(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteTemplate(ctx, id)
+}
+
+func (s *service) GetTemplate(ctx context.Context, projectID, id string) (*Template, error) {
+	t, err := s.repo.FindTemplateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.ProjectID != projectID {
+		return nil, ErrTemplateNotFound
+	}
+	return t, nil
+}
+
+func (s *service) ListProjectTemplates(ctx context.Context, projectID string) ([]*Template, error) {
+	return s.repo.FindTemplatesByProjectID(ctx, projectID)
+}
+
+func (s *service) CreatePostFromTemplate(
+	ctx context.Context,
+	projectID, templateID string,
+	variables map[string]string,
+	scheduledAt time.Time,
+) (*post.Post, error) {
+	t, err := s.GetTemplate(ctx, projectID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Snippets are inserted first so the variables they hold are filled as well
+	title, err := s.InsertSnippets(ctx, projectID, t.Title)
+	if err != nil {
+		return nil, err
+	}
+	content, err := s.InsertSnippets(ctx, projectID, t.TextContent)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check every variable before failing, so the caller gets the full list of missing ones
+	if missing := MissingVariables(variables, title, content); len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingTemplateVariables, strings.Join(missing, ", "))
+	}
+	title, err = FillVariables(title, variables)
+	if err != nil {
+		return nil, err
+	}
+	content, err = FillVariables(content, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// The text is saved as rendered, so the snippet references in variable values aren't expanded. The post
+	// service refuses the platforms disabled since the template was saved.
+	return s.postService.CreatePostWithPlatforms(ctx, projectID, title, t.Type, content, scheduledAt, t.Platforms)
+}
+
+func (s *service) CreateSnippet(ctx context.Context, projectID, name, content string) (*Snippet, error) {
+	sn, err := NewSnippet(projectID, name, content)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkSnippetNameAvailable(ctx, projectID, sn.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SaveSnippet(ctx, sn)
+	if err != nil {
+		return nil, err
+	}
+	return sn, nil
+}
+
+func (s *service) UpdateSnippet(ctx context.Context, projectID, id, name, content string) (*Snippet, error) {
+	sn, err := s.getProjectSnippet(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previousName := sn.Name
+	err = sn.Update(name, content)
+	if err != nil {
+		return nil, err
+	}
+
+	if previousName != sn.Name {
+		err = s.checkSnippetNameAvailable(ctx, projectID, sn.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.repo.UpdateSnippet(ctx, sn)
+	if err != nil {
+		return nil, err
+	}
+	return sn, nil
+}
+
+func (s *service) DeleteSnippet(ctx context.Context, projectID, id string) error {
+	_, err := s.getProjectSnippet(ctx, projectID, id)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteSnippet(ctx, id)
+}
+
+func (s *service) ListProjectSnippets(ctx context.Context, projectID string) ([]*Snippet, error) {
+	return s.repo.FindSnippetsByProjectID(ctx, projectID)
+}
+
+func (s *service) InsertSnippets(ctx context.Context, projectID, text string) (string, error) {
+	names := ExtractSnippetNames(text)
+	if len(names) == 0 {
+		return text, nil
+	}
+
+	snippets, err := s.repo.FindSnippetsByNames(ctx, projectID, names)
+	if err != nil {
+		return "", err
+	}
+	return InsertSnippets(text, snippets)
+}
+
+func (s *service) getProjectSnippet(ctx context.Context, projectID, id string) (*Snippet, error) {
+	sn, err := s.repo.FindSnippetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sn == nil || sn.ProjectID != projectID {
+		return nil, ErrSnippetNotFound
+	}
+	return sn, nil
+}
+
+func (s *service) checkSnippetNameAvailable(ctx context.Context, projectID, name string) error {
+	existing, err := s.repo.FindSnippetsByNames(ctx, projectID, []string{name})
+	if err != nil {
+		return err
+	}
+	if _, ok := existing[name]; ok {
+		return ErrSnippetAlreadyExists
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package template
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExtractVariables(t *testing.T) {
+	vars := ExtractVariables("Join {{ event_name }} on {{date}}", "{{event_name}} {{snippet:signature}}")
+	assert.Equal(t, []string{"date", "event_name"}, vars)
+}
+
+func TestFillVariables(t *testing.T) {
+	text, err := FillVariables("Join {{event_name}} on {{date}}", map[string]string{"event_name": "GopherCon", "date": "May 1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Join GopherCon on May 1", text)
+
+	_, err = FillVariables("Join {{event_name}} on {{date}}", map[string]string{"event_name": "GopherCon"})
+	assert.ErrorIs(t, err, ErrMissingTemplateVariables)
+	assert.Contains(t, err.Error(), "date")
+}
+
+func TestInsertSnippets(t *testing.T) {
+	text, err := InsertSnippets("Hello {{name}}\n{{snippet:signature}}", map[string]string{"signature": "-- The team"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello {{name}}\n-- The team", text)
+
+	_, err = InsertSnippets("{{snippet:hashtags}}", map[string]string{})
+	assert.ErrorIs(t, err, ErrSnippetNotFound)
+}
+
+func TestCreatePostFromTemplate(t *testing.T) {
+	ctx := context.Background()
+	tmpl := &Template{
+		ID:          "template-1",
+		ProjectID:   "project-1",
+		Title:       "{{event_name}}",
+		Type:        string(post.PostTypeText),
+		TextContent: "See you on {{date}}\n{{snippet:signature}}",
+		Platforms:   []string{"linkedin"},
+	}
+
+	t.Run("creates a draft post linked to the template platforms", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockPostSvc := post.NewMockService(t)
+		mockRepo.On("FindTemplateByID", ctx, "template-1").Return(tmpl, nil)
+		mockRepo.On("FindSnippetsByNames", ctx, "project-1", []string{"signature"}).Return(map[string]string{"signature": "-- {{team}}"}, nil)
+		mockPostSvc.On("CreatePostWithPlatforms", ctx, "project-1", "GopherCon", "text", "See you on May 1\n-- Gophers", mock.Anything, []string{"linkedin"}).
+			Return(&post.Post{ID: "post-1"}, nil)
+
+		s := NewService(mockRepo, mockPostSvc)
+		p, err := s.CreatePostFromTemplate(ctx, "project-1", "template-1", map[string]string{
+			"event_name": "GopherCon",
+			"date":       "May 1",
+			"team":       "Gophers",
+		}, time.Time{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "post-1", p.ID)
+	})
+
+	t.Run("doesn't expand snippet references in variable values", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockPostSvc := post.NewMockService(t)
+		mockRepo.On("FindTemplateByID", ctx, "template-1").Return(tmpl, nil)
+		mockRepo.On("FindSnippetsByNames", ctx, "project-1", []string{"signature"}).Return(map[string]string{"signature": "-- {{team}}"}, nil)
+		mockPostSvc.On("CreatePostWithPlatforms", ctx, "project-1", "GopherCon", "text", "See you on {{snippet:secret}}\n-- Gophers", mock.Anything, []string{"linkedin"}).
+			Return(&post.Post{ID: "post-1"}, nil)
+
+		s := NewService(mockRepo, mockPostSvc)
+		_, err := s.CreatePostFromTemplate(ctx, "project-1", "template-1", map[string]string{
+			"event_name": "GopherCon",
+			"date":       "{{snippet:secret}}",
+			"team":       "Gophers",
+		}, time.Time{})
+
+		assert.NoError(t, err)
+		mockRepo.AssertNumberOfCalls(t, "FindSnippetsByNames", 1)
+	})
+
+	t.Run("fails listing every missing variable", func(t *testing.T) {
+		mockRepo := NewMockRepository(t)
+		mockPostSvc := post.NewMockService(t)
+		mockRepo.On("FindTemplateByID", ctx, "template-1").Return(tmpl, nil)
+		mockRepo.On("FindSnippetsByNames", ctx, "project-1", []string{"signature"}).Return(map[string]string{"signature": "-- {{team}}"}, nil)
+
+		s := NewService(mockRepo, mockPostSvc)
+		_, err := s.CreatePostFromTemplate(ctx, "project-1", "template-1", map[string]string{"event_name": "GopherCon"}, time.Time{})
+
+		assert.ErrorIs(t, err, ErrMissingTemplateVariables)
+		assert.Contains(t, err.Error(), "date, team")
+		mockPostSvc.AssertNotCalled(t, "CreatePostWithPlatforms")
+	})
+}
@@ -0,0 +1,115 @@
+package template
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTemplateNotFound         = errors.New("template not found")
+	ErrTemplateNameRequired     = errors.New("template name is required")
+	ErrTemplateContentRequired  = errors.New("template title and content are required")
+	ErrMissingTemplateVariables = errors.New("missing template variables")
+	ErrSnippetNotFound          = errors.New("snippet not found")
+	ErrSnippetAlreadyExists     = errors.New("snippet already exists")
+	ErrInvalidSnippetName       = errors.New("invalid snippet name, use lowercase letters, digits, - and _")
+	ErrSnippetContentRequired   = errors.New("snippet content is required")
+)
+
+// Template is the blueprint of a post. Its title and content can hold {{variable}} placeholders
+// and {{snippet:name}} references, resolved when a post is created from it.
+type Template struct {
+	ID          string    `json:"id"`
+	ProjectID   string    `json:"project_id"`
+	Name        string    `json:"name"`
+	Title       string    `json:"title"`
+	Type        string    `json:"type"`
+	TextContent string    `json:"text_content"`
+	Platforms   []string  `json:"platforms"`
+	Variables   []string  `json:"variables"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func NewTemplate(projectID, userID, name, title, postType, content string, platforms []string) (*Template, error) {
+	if projectID == "" {
+		return nil, errors.New("projectID cannot be empty")
+	}
+	if userID == "" {
+		return nil, errors.New("userID cannot be empty")
+	}
+	t := &Template{
+		ID:        uuid.New().String(),
+		ProjectID: projectID,
+		CreatedBy: userID,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := t.Update(name, title, postType, content, platforms); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *Template) Update(name, title, postType, content string, platforms []string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrTemplateNameRequired
+	}
+	if title == "" || content == "" {
+		return ErrTemplateContentRequired
+	}
+	if platforms == nil {
+		platforms = []string{}
+	}
+	t.Name = name
+	t.Title = title
+	t.Type = postType
+	t.TextContent = content
+	t.Platforms = platforms
+	t.Variables = ExtractVariables(title, content)
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Snippet is a reusable block of text, like a signature or a hashtag block,
+// inserted in posts and templates with {{snippet:name}}
+type Snippet struct {
+	ID        string    `json:"id"`
+	ProjectID string    `json:"project_id"`
+	Name      string    `json:"name"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewSnippet(projectID, name, content string) (*Snippet, error) {
+	if projectID == "" {
+		return nil, errors.New("projectID cannot be empty")
+	}
+	s := &Snippet{
+		ID:        uuid.New().String(),
+		ProjectID: projectID,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.Update(name, content); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Snippet) Update(name, content string) error {
+	if !snippetNameRegex.MatchString(name) {
+		return ErrInvalidSnippetName
+	}
+	if content == "" {
+		return ErrSnippetContentRequired
+	}
+	s.Name = name
+	s.Content = content
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
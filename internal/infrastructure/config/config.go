@@ -10,14 +10,19 @@ import (
 )
 
 type Config struct {
-	App         AppConfig
-	DB          DBConfig
-	ObjectStore ObjectStoreConfig
-	Logger      LoggerConfig
-	SSL         SSLConfig
-	Scheduler   SchedulerConfig
-	Publisher   PublisherConfig
-	Encryption  DataEncryptionConfig
+	App             AppConfig
+	DB              DBConfig
+	ObjectStore     ObjectStoreConfig
+	Logger          LoggerConfig
+	SSL             SSLConfig
+	Scheduler       SchedulerConfig
+	Publisher       PublisherConfig
+	Encryption      DataEncryptionConfig
+	Feeds           FeedConfig
+	Trash           TrashConfig
+	MediaGC         MediaGCConfig
+	Media           MediaConfig
+	MediaProcessing MediaProcessingConfig
 }
 
 type DataEncryptionConfig struct {
@@ -46,6 +51,47 @@ type PublisherConfig struct {
 type SchedulerConfig struct {
 	Interval      time.Duration
 	ChannelBuffer int
+	// PreflightWindow is how long before their due time scheduled posts are validated again
+	PreflightWindow time.Duration
+}
+
+type FeedConfig struct {
+	Interval time.Duration
+	// PollEvery is how often each feed is fetched
+	PollEvery    time.Duration
+	BatchSize    int
+	FetchTimeout time.Duration
+	MaxFeedSize  int64
+}
+
+type TrashConfig struct {
+	Interval time.Duration
+	// Retention is how long deleted posts stay in the trash before they are purged
+	Retention time.Duration
+	BatchSize int
+}
+
+type MediaGCConfig struct {
+	Interval time.Duration
+	// GracePeriod is how old a file without metadata must be before it's deleted
+	GracePeriod time.Duration
+}
+
+// MediaConfig has the largest file accepted for each media type, in bytes
+type MediaConfig struct {
+	MaxImageSize    int64
+	MaxVideoSize    int64
+	MaxDocumentSize int64
+	// StripMetadata removes the metadata of the uploaded media, like the location of photos, before storing them
+	StripMetadata bool
+}
+
+// MediaProcessingConfig sizes the pool of workers analyzing the uploaded media
+type MediaProcessingConfig struct {
+	WorkerNum int
+	Buffer    int
+	// RescanInterval is how often the media left processing are enqueued again
+	RescanInterval time.Duration
 }
 
 type AppConfig struct {
@@ -88,6 +134,24 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	trashRetention, err := time.ParseDuration(getEnv("TRASH_RETENTION", "720h"))
+	if err != nil {
+		return nil, err
+	}
+
+	maxImageSize, err := getEnvAsMegabytes("MEDIA_MAX_IMAGE_MB", 10)
+	if err != nil {
+		return nil, err
+	}
+	maxVideoSize, err := getEnvAsMegabytes("MEDIA_MAX_VIDEO_MB", 500)
+	if err != nil {
+		return nil, err
+	}
+	maxDocumentSize, err := getEnvAsMegabytes("MEDIA_MAX_DOCUMENT_MB", 100)
+	if err != nil {
+		return nil, err
+	}
+
 	config := &Config{
 		App: AppConfig{
 			Env:    getEnv("APP_ENV", "development"),
@@ -125,8 +189,9 @@ func LoadConfig() (*Config, error) {
 			KeyPath:  getEnv("SSL_KEY_PATH", ""),
 		},
 		Scheduler: SchedulerConfig{
-			Interval:      10 * time.Second,
-			ChannelBuffer: 100,
+			Interval:        10 * time.Second,
+			ChannelBuffer:   100,
+			PreflightWindow: 30 * time.Minute,
 		},
 		Publisher: PublisherConfig{
 			WorkerNum:     5,
@@ -134,6 +199,33 @@ func LoadConfig() (*Config, error) {
 			PublishBuffer: 100,
 			RetryBuffer:   100,
 		},
+		Feeds: FeedConfig{
+			Interval:     time.Minute,
+			PollEvery:    30 * time.Minute,
+			BatchSize:    20,
+			FetchTimeout: 15 * time.Second,
+			MaxFeedSize:  5 << 20,
+		},
+		Trash: TrashConfig{
+			Interval:  time.Hour,
+			Retention: trashRetention,
+			BatchSize: 50,
+		},
+		MediaGC: MediaGCConfig{
+			Interval:    6 * time.Hour,
+			GracePeriod: 24 * time.Hour,
+		},
+		Media: MediaConfig{
+			MaxImageSize:    maxImageSize,
+			MaxVideoSize:    maxVideoSize,
+			MaxDocumentSize: maxDocumentSize,
+			StripMetadata:   getEnv("MEDIA_STRIP_METADATA", "true") == "true",
+		},
+		MediaProcessing: MediaProcessingConfig{
+			WorkerNum:      2,
+			Buffer:         100,
+			RescanInterval: 5 * time.Minute,
+		},
 	}
 
 	return config, nil
@@ -146,3 +238,16 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+// getEnvAsMegabytes reads a size given in megabytes and returns it in bytes
+func getEnvAsMegabytes(key string, defaultValue int64) (int64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue << 20, nil
+	}
+	mb, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return mb << 20, nil
+}
@@ -0,0 +1,102 @@
+// Package fetching provides the http client used to download the urls given by the users,
+// e.g. the media of the imports and the feeds.
+package fetching
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrUnsupportedScheme = errors.New("only http and https urls can be fetched")
+	ErrForbiddenAddress  = errors.New("url points to an address that can't be fetched")
+	ErrUnreachable       = errors.New("url could not be reached")
+)
+
+// blockedPrefixes are the special-purpose ranges not covered by the netip helpers
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // this network
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),   // reserved
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64, embeds any IPv4 address
+}
+
+// NewClient returns a client that only fetches http and https urls of public addresses.
+// The address is checked when dialing, once resolved, so redirects and DNS rebinding are covered too.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   control,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialed in place of the url address
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &guardedTransport{base: transport},
+	}
+}
+
+// guardedTransport checks the scheme of every request, redirects included, and hides why a request
+// failed, so the errors can't be used to probe the network.
+type guardedTransport struct {
+	base http.RoundTripper
+}
+
+func (t *guardedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return nil, ErrUnsupportedScheme
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	switch {
+	case err == nil:
+		return resp, nil
+	case req.Context().Err() != nil:
+		return nil, err
+	case errors.Is(err, ErrForbiddenAddress):
+		return nil, ErrForbiddenAddress
+	default:
+		return nil, ErrUnreachable
+	}
+}
+
+func control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !IsPublic(addr) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// IsPublic reports whether the address can be reached from the internet
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return false
+	}
+	for _, p := range blockedPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+package fetching
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.1", false},
+		{"172.16.5.4", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"100.64.0.1", false},
+		{"fc00::1", false},
+		{"fe80::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"64:ff9b::a00:1", false},
+		{"224.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPublic(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
+
+func TestClient(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer server.Close()
+
+	client := NewClient(5 * time.Second)
+
+	t.Run("refuses loopback addresses", func(t *testing.T) {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		_, err := client.Do(req)
+		assert.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+
+	t.Run("refuses names resolving to loopback addresses", func(t *testing.T) {
+		_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost:"+port, nil)
+		_, err := client.Do(req)
+		assert.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+
+	t.Run("refuses other schemes", func(t *testing.T) {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "ftp://example.com/file", nil)
+		_, err := client.Do(req)
+		assert.ErrorIs(t, err, ErrUnsupportedScheme)
+	})
+
+	assert.Zero(t, hits)
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS notifications;
+ALTER TABLE posts DROP COLUMN IF EXISTS preflight_checked_at;
+ALTER TABLE project_settings DROP COLUMN IF EXISTS preflight_mode;
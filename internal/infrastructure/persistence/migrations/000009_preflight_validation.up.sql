@@ -0,0 +1,19 @@
+ALTER TABLE project_settings
+    ADD COLUMN IF NOT EXISTS preflight_mode VARCHAR(10) NOT NULL DEFAULT 'strict';
+
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS preflight_checked_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL,
+    post_id UUID,
+    kind VARCHAR(50) NOT NULL,
+    message TEXT NOT NULL,
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+    FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_project_id ON notifications (project_id, created_at DESC);
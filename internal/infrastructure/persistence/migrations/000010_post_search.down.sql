@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_posts_project_id_created_at;
+DROP INDEX IF EXISTS idx_posts_search_vector;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE posts
+    DROP COLUMN IF EXISTS published_at;
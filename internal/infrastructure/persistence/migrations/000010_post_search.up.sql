@@ -0,0 +1,13 @@
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS published_at TIMESTAMP;
+
+UPDATE posts
+SET published_at = updated_at
+WHERE status IN ('published', 'partially_published') AND published_at IS NULL;
+
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(text_content, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_posts_search_vector ON posts USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_posts_project_id_created_at ON posts (project_id, created_at DESC, id DESC);
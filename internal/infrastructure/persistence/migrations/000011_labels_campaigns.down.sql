@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS campaign_posts;
+DROP TABLE IF EXISTS campaigns;
+DROP TABLE IF EXISTS post_labels;
+DROP TABLE IF EXISTS labels;
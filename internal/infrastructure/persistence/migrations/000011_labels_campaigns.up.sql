@@ -0,0 +1,45 @@
+CREATE TABLE IF NOT EXISTS labels (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    color VARCHAR(7) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_labels_project_id_name ON labels (project_id, LOWER(name));
+
+CREATE TABLE IF NOT EXISTS post_labels (
+    post_id UUID NOT NULL,
+    label_id UUID NOT NULL,
+    PRIMARY KEY (post_id, label_id),
+    FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE,
+    FOREIGN KEY (label_id) REFERENCES labels (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_labels_label_id ON post_labels (label_id);
+
+CREATE TABLE IF NOT EXISTS campaigns (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    goal TEXT NOT NULL DEFAULT '',
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_campaigns_project_id ON campaigns (project_id, start_date);
+
+CREATE TABLE IF NOT EXISTS campaign_posts (
+    campaign_id UUID NOT NULL,
+    post_id UUID NOT NULL,
+    PRIMARY KEY (campaign_id, post_id),
+    FOREIGN KEY (campaign_id) REFERENCES campaigns (id) ON DELETE CASCADE,
+    FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_campaign_posts_post_id ON campaign_posts (post_id);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS post_templates (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    title TEXT NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    text_content TEXT NOT NULL,
+    platforms TEXT[] NOT NULL DEFAULT '{}',
+    variables TEXT[] NOT NULL DEFAULT '{}',
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_templates_project_id ON post_templates (project_id);
+
+CREATE TABLE IF NOT EXISTS snippets (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (project_id, name),
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_comments_post_id;
+DROP TABLE IF EXISTS idea_votes;
+DROP INDEX IF EXISTS idx_posts_idea_id;
+ALTER TABLE posts DROP COLUMN IF EXISTS idea_id;
@@ -0,0 +1,15 @@
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS idea_id UUID REFERENCES posts (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_posts_idea_id ON posts (idea_id) WHERE idea_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS idea_votes (
+    post_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (post_id, user_id),
+    FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_post_id ON comments (post_id, created_at);
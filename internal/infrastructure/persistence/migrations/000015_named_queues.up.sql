@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS project_queues (
+    project_id UUID NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    post_queue UUID[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (project_id, name),
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
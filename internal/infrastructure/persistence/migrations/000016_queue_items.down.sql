@@ -0,0 +1,24 @@
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS post_queue UUID[] NOT NULL DEFAULT '{}';
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS idea_queue UUID[] NOT NULL DEFAULT '{}';
+ALTER TABLE project_queues ADD COLUMN IF NOT EXISTS post_queue UUID[] NOT NULL DEFAULT '{}';
+
+UPDATE projects p
+SET post_queue = ARRAY(
+        SELECT post_id FROM queue_items
+        WHERE project_id = p.id AND kind = 'post' AND queue = 'default'
+        ORDER BY position
+    ),
+    idea_queue = ARRAY(
+        SELECT post_id FROM queue_items
+        WHERE project_id = p.id AND kind = 'idea'
+        ORDER BY position
+    );
+
+UPDATE project_queues pq
+SET post_queue = ARRAY(
+    SELECT post_id FROM queue_items
+    WHERE project_id = pq.project_id AND kind = 'post' AND queue = pq.name
+    ORDER BY position
+);
+
+DROP TABLE IF EXISTS queue_items;
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS queue_items (
+    post_id UUID PRIMARY KEY,
+    project_id UUID NOT NULL,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('post', 'idea')),
+    queue VARCHAR(50) NOT NULL DEFAULT 'default',
+    position DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE,
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_queue_items_queue ON queue_items (project_id, kind, queue, position);
+
+INSERT INTO queue_items (post_id, project_id, kind, queue, position)
+SELECT q.post_id, p.id, 'post', 'default', q.ord * 1024
+FROM projects p, unnest(p.post_queue) WITH ORDINALITY AS q(post_id, ord)
+WHERE EXISTS (SELECT 1 FROM posts WHERE id = q.post_id)
+ON CONFLICT (post_id) DO NOTHING;
+
+INSERT INTO queue_items (post_id, project_id, kind, queue, position)
+SELECT q.post_id, pq.project_id, 'post', pq.name, q.ord * 1024
+FROM project_queues pq, unnest(pq.post_queue) WITH ORDINALITY AS q(post_id, ord)
+WHERE EXISTS (SELECT 1 FROM posts WHERE id = q.post_id)
+ON CONFLICT (post_id) DO NOTHING;
+
+INSERT INTO queue_items (post_id, project_id, kind, queue, position)
+SELECT q.post_id, p.id, 'idea', 'default', q.ord * 1024
+FROM projects p, unnest(p.idea_queue) WITH ORDINALITY AS q(post_id, ord)
+WHERE EXISTS (SELECT 1 FROM posts WHERE id = q.post_id)
+ON CONFLICT (post_id) DO NOTHING;
+
+ALTER TABLE project_queues DROP COLUMN IF EXISTS post_queue;
+ALTER TABLE projects DROP COLUMN IF EXISTS post_queue;
+ALTER TABLE projects DROP COLUMN IF EXISTS idea_queue;
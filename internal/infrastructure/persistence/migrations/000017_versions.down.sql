@@ -0,0 +1,3 @@
+ALTER TABLE project_settings DROP COLUMN IF EXISTS schedule_version;
+ALTER TABLE projects DROP COLUMN IF EXISTS version;
+ALTER TABLE posts DROP COLUMN IF EXISTS version;
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_post_platform_media_media_id;
+DROP INDEX IF EXISTS idx_media_library_tags;
+DROP INDEX IF EXISTS idx_media_library_file_name;
+
+DELETE FROM media WHERE post_id IS NULL;
+ALTER TABLE media ALTER COLUMN post_id SET NOT NULL;
+ALTER TABLE media DROP COLUMN IF EXISTS tags;
+ALTER TABLE media DROP COLUMN IF EXISTS folder;
+ALTER TABLE media DROP CONSTRAINT IF EXISTS media_project_id_fkey;
+ALTER TABLE media DROP COLUMN IF EXISTS project_id;
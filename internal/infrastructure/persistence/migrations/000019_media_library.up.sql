@@ -0,0 +1,13 @@
+ALTER TABLE media ADD COLUMN IF NOT EXISTS project_id UUID;
+UPDATE media m SET project_id = p.project_id FROM posts p WHERE p.id = m.post_id;
+ALTER TABLE media ALTER COLUMN project_id SET NOT NULL;
+ALTER TABLE media ADD CONSTRAINT media_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE;
+
+-- Media without a post belong to the project library
+ALTER TABLE media ALTER COLUMN post_id DROP NOT NULL;
+ALTER TABLE media ADD COLUMN IF NOT EXISTS folder VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE media ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_media_library_file_name ON media (project_id, file_name) WHERE post_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_media_library_tags ON media USING GIN (tags) WHERE post_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_post_platform_media_media_id ON post_platform_media (media_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_media_uploads_expires_at;
+DROP TABLE IF EXISTS media_uploads;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS media_uploads (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL,
+    post_id UUID,
+    file_name VARCHAR(255) NOT NULL,
+    alt_text TEXT NOT NULL DEFAULT '',
+    folder VARCHAR(255) NOT NULL DEFAULT '',
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    size BIGINT NOT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+    FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_media_uploads_expires_at ON media_uploads (expires_at);
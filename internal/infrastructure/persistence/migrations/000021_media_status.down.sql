@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_media_processing;
+ALTER TABLE media DROP COLUMN IF EXISTS processing_error;
+ALTER TABLE media DROP COLUMN IF EXISTS status;
@@ -0,0 +1,4 @@
+ALTER TABLE media ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'ready';
+ALTER TABLE media ADD COLUMN IF NOT EXISTS processing_error TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_media_processing ON media (created_at) WHERE status = 'processing';
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS media_renditions (
+    id UUID PRIMARY KEY,
+    media_id UUID NOT NULL,
+    platform_id VARCHAR(10) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    length INT NOT NULL DEFAULT 0,
+    size BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (media_id, platform_id),
+    FOREIGN KEY (media_id) REFERENCES media (id) ON DELETE CASCADE,
+    FOREIGN KEY (platform_id) REFERENCES platforms (id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+ALTER TABLE media DROP COLUMN IF EXISTS focal_y;
+ALTER TABLE media DROP COLUMN IF EXISTS focal_x;
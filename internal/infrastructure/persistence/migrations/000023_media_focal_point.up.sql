@@ -0,0 +1,2 @@
+ALTER TABLE media ADD COLUMN IF NOT EXISTS focal_x DOUBLE PRECISION NOT NULL DEFAULT 0.5;
+ALTER TABLE media ADD COLUMN IF NOT EXISTS focal_y DOUBLE PRECISION NOT NULL DEFAULT 0.5;
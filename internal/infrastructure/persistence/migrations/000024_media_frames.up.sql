@@ -0,0 +1 @@
+ALTER TABLE media ADD COLUMN IF NOT EXISTS frames INTEGER NOT NULL DEFAULT 0;
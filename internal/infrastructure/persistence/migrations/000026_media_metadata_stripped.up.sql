@@ -0,0 +1 @@
+ALTER TABLE media ADD COLUMN IF NOT EXISTS metadata_stripped BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+ALTER TABLE post_platforms DROP COLUMN IF EXISTS apply_branding;
+DROP TABLE IF EXISTS project_branding;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS project_branding (
+    project_id UUID PRIMARY KEY,
+    logo_media_id UUID NOT NULL,
+    position VARCHAR(20) NOT NULL,
+    opacity DOUBLE PRECISION NOT NULL,
+    scale DOUBLE PRECISION NOT NULL,
+    text TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+ALTER TABLE post_platforms ADD COLUMN IF NOT EXISTS apply_branding BOOLEAN NOT NULL DEFAULT FALSE;
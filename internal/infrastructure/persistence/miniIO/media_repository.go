@@ -1,15 +1,19 @@
 package minioS3
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
+	"strings"
 	"time"
 
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/infrastructure/config"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -42,12 +46,12 @@ func NewS3Client(cfg *config.ObjectStoreConfig) (*S3Client, error) {
 	}, nil
 }
 
-func (c *S3Client) UploadFile(ctx context.Context, projectID, postID, fileName string, data []byte, metadata *media.MetaData) error {
+func (c *S3Client) UploadFile(ctx context.Context, projectID, postID, fileName string, body io.ReadSeeker, metadata *media.MetaData) error {
 	key := c.getKey(projectID, postID, fileName)
 	_, err := c.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
 		Bucket: aws.String(c.cfg.Bucket),
 		Key:    aws.String(key),
-		Body:   bytes.NewReader(data),
+		Body:   body,
 	})
 	if err != nil {
 		return err
@@ -73,26 +77,157 @@ func (c *S3Client) GetSignedURL(ctx context.Context, projectID, postID, fileName
 	return url, nil
 }
 
-func (c *S3Client) GetFile(ctx context.Context, projectID, postID, fileName string) ([]byte, error) {
+func (c *S3Client) GetSignedUploadURL(ctx context.Context, uploadID string, expiry time.Duration) (string, error) {
+	req, _ := c.client.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String(c.cfg.Bucket),
+		Key:    aws.String(c.getUploadKey(uploadID)),
+	})
+
+	url, err := req.Presign(expiry)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign upload url: %w", err)
+	}
+
+	return url, nil
+}
+
+func (c *S3Client) GetFile(ctx context.Context, projectID, postID, fileName string) (io.ReadCloser, error) {
 	key := c.getKey(projectID, postID, fileName)
 	result, err := c.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(c.cfg.Bucket),
 		Key:    aws.String(key),
 	})
 	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, media.ErrFileNotFound
+		}
+		return nil, err
+	}
+	return result.Body, nil
+}
+
+func (c *S3Client) GetStagedFile(ctx context.Context, uploadID string) (io.ReadCloser, error) {
+	result, err := c.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.cfg.Bucket),
+		Key:    aws.String(c.getUploadKey(uploadID)),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, media.ErrFileNotFound
+		}
 		return nil, err
 	}
-	defer result.Body.Close()
+	return result.Body, nil
+}
+
+func (c *S3Client) DeleteStagedFile(ctx context.Context, uploadID string) error {
+	_, err := c.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.cfg.Bucket),
+		Key:    aws.String(c.getUploadKey(uploadID)),
+	})
+	return err
+}
 
-	buf := new(bytes.Buffer)
-	buf.ReadFrom(result.Body)
-	return buf.Bytes(), nil
+func (c *S3Client) StatFile(ctx context.Context, projectID, postID, fileName string) (*media.ObjectInfo, error) {
+	key := c.getKey(projectID, postID, fileName)
+	result, err := c.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.cfg.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		// HEAD responses have no body, so a missing key comes back as a bare NotFound
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && (aerr.Code() == "NotFound" || aerr.Code() == s3.ErrCodeNoSuchKey) {
+			return nil, media.ErrFileNotFound
+		}
+		return nil, err
+	}
+	return &media.ObjectInfo{
+		ProjectID:    projectID,
+		PostID:       postID,
+		FileName:     fileName,
+		Size:         aws.Int64Value(result.ContentLength),
+		LastModified: aws.TimeValue(result.LastModified),
+	}, nil
 }
 
+const (
+	// libraryFolder holds the files of the project library, which don't belong to a post
+	libraryFolder = "library"
+	// blobFolder holds the files of the media stored by content hash
+	blobFolder = "blobs"
+	// uploadFolder holds the files sent to direct uploads, outside the project folders until they are completed
+	uploadFolder = "uploads"
+)
+
 func (c *S3Client) getKey(projectID, postID, fileName string) string {
+	if postID == "" {
+		return fmt.Sprintf("project-%s/%s/%s", projectID, libraryFolder, fileName)
+	}
 	return fmt.Sprintf("project-%s/post-%s/%s", projectID, postID, fileName)
 }
 
+func (c *S3Client) getBlobKey(projectID, hash string) string {
+	return fmt.Sprintf("project-%s/%s/%s", projectID, blobFolder, hash)
+}
+
+func (c *S3Client) getUploadKey(uploadID string) string {
+	return fmt.Sprintf("%s/%s", uploadFolder, uploadID)
+}
+
+// parseKey is the reverse of getKey and getBlobKey. It returns false for keys that weren't made by them.
+func parseKey(key string) (info media.ObjectInfo, ok bool) {
+	parts := strings.SplitN(key, "/", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return media.ObjectInfo{}, false
+	}
+	projectID, okProject := strings.CutPrefix(parts[0], "project-")
+	if !okProject || projectID == "" {
+		return media.ObjectInfo{}, false
+	}
+	switch parts[1] {
+	case libraryFolder:
+		return media.ObjectInfo{ProjectID: projectID, FileName: parts[2]}, true
+	case blobFolder:
+		return media.ObjectInfo{ProjectID: projectID, ContentHash: parts[2]}, true
+	}
+	postID, okPost := strings.CutPrefix(parts[1], "post-")
+	if !okPost || postID == "" {
+		return media.ObjectInfo{}, false
+	}
+	return media.ObjectInfo{ProjectID: projectID, PostID: postID, FileName: parts[2]}, true
+}
+
+func (c *S3Client) ListFiles(ctx context.Context, fn func(files []*media.ObjectInfo) error) error {
+	var fnErr error
+	err := c.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(c.cfg.Bucket),
+		Prefix: aws.String("project-"),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		files := make([]*media.ObjectInfo, 0, len(page.Contents))
+		for _, obj := range page.Contents {
+			info, ok := parseKey(aws.StringValue(obj.Key))
+			if !ok {
+				continue
+			}
+			info.Size = aws.Int64Value(obj.Size)
+			info.LastModified = aws.TimeValue(obj.LastModified)
+			files = append(files, &info)
+		}
+		if len(files) == 0 {
+			return true
+		}
+		fnErr = fn(files)
+		return fnErr == nil
+	})
+	if err != nil {
+		return err
+	}
+	return fnErr
+}
+
 func (c *S3Client) DeleteFile(ctx context.Context, projectID, postID, fileName string) error {
 	key := c.getKey(projectID, postID, fileName)
 	_, err := c.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
@@ -104,3 +239,71 @@ func (c *S3Client) DeleteFile(ctx context.Context, projectID, postID, fileName s
 	}
 	return nil
 }
+
+func (c *S3Client) UploadBlob(ctx context.Context, projectID, hash string, body io.ReadSeeker) error {
+	_, err := c.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(c.cfg.Bucket),
+		Key:    aws.String(c.getBlobKey(projectID, hash)),
+		Body:   body,
+	})
+	return err
+}
+
+// GetSignedBlobURL signs a download of the blob named as the media, since its key is only a hash
+func (c *S3Client) GetSignedBlobURL(ctx context.Context, projectID, hash, fileName string) (string, error) {
+	req, _ := c.client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket:                     aws.String(c.cfg.Bucket),
+		Key:                        aws.String(c.getBlobKey(projectID, hash)),
+		ResponseContentDisposition: aws.String(mime.FormatMediaType("inline", map[string]string{"filename": fileName})),
+	})
+
+	url, err := req.Presign(15 * time.Minute)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign url: %w", err)
+	}
+
+	return url, nil
+}
+
+func (c *S3Client) GetBlob(ctx context.Context, projectID, hash string) (io.ReadCloser, error) {
+	result, err := c.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.cfg.Bucket),
+		Key:    aws.String(c.getBlobKey(projectID, hash)),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, media.ErrFileNotFound
+		}
+		return nil, err
+	}
+	return result.Body, nil
+}
+
+func (c *S3Client) StatBlob(ctx context.Context, projectID, hash string) (*media.ObjectInfo, error) {
+	result, err := c.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.cfg.Bucket),
+		Key:    aws.String(c.getBlobKey(projectID, hash)),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && (aerr.Code() == "NotFound" || aerr.Code() == s3.ErrCodeNoSuchKey) {
+			return nil, media.ErrFileNotFound
+		}
+		return nil, err
+	}
+	return &media.ObjectInfo{
+		ProjectID:    projectID,
+		ContentHash:  hash,
+		Size:         aws.Int64Value(result.ContentLength),
+		LastModified: aws.TimeValue(result.LastModified),
+	}, nil
+}
+
+func (c *S3Client) DeleteBlob(ctx context.Context, projectID, hash string) error {
+	_, err := c.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.cfg.Bucket),
+		Key:    aws.String(c.getBlobKey(projectID, hash)),
+	})
+	return err
+}
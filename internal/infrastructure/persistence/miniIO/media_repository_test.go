@@ -0,0 +1,185 @@
+package minioS3
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeObjectStore is an in memory stand-in for MinIO, serving path style keys without checking signatures
+type fakeObjectStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *fakeObjectStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		s.objects[r.URL.Path] = data
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		data, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodHead:
+		data, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(s.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestClient(t *testing.T) *S3Client {
+	server := httptest.NewServer(&fakeObjectStore{objects: make(map[string][]byte)})
+	t.Cleanup(server.Close)
+
+	client, err := NewS3Client(&config.ObjectStoreConfig{
+		Endpoint:         server.URL,
+		AccessKey:        "minio",
+		SecretAccessKey:  "secret",
+		Bucket:           "post-media",
+		Region:           "us-east-1",
+		S3ForcePathStyle: true,
+	})
+	assert.NoError(t, err)
+	return client
+}
+
+func TestDirectUpload(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	url, err := client.GetSignedUploadURL(ctx, "upload-1", time.Hour)
+	assert.NoError(t, err)
+	assert.Contains(t, url, "/post-media/uploads/upload-1")
+
+	req, err := http.NewRequest(http.MethodPut, url, strings.NewReader("video"))
+	assert.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := client.GetStagedFile(ctx, "upload-1")
+	assert.NoError(t, err)
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, "video", string(data))
+
+	// The staged file isn't where the media of the post are stored
+	_, err = client.GetFile(ctx, "project-1", "post-1", "clip.mp4")
+	assert.ErrorIs(t, err, media.ErrFileNotFound)
+
+	assert.NoError(t, client.DeleteStagedFile(ctx, "upload-1"))
+	_, err = client.GetStagedFile(ctx, "upload-1")
+	assert.ErrorIs(t, err, media.ErrFileNotFound)
+}
+
+func TestGetFileNotFound(t *testing.T) {
+	client := newTestClient(t)
+
+	_, err := client.GetFile(context.Background(), "project-1", "", "missing.png")
+
+	assert.ErrorIs(t, err, media.ErrFileNotFound)
+}
+
+func TestStatFile(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	err := client.UploadFile(ctx, "project-1", "post-1", "photo.png", strings.NewReader("image"), nil)
+	assert.NoError(t, err)
+
+	info, err := client.StatFile(ctx, "project-1", "post-1", "photo.png")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), info.Size)
+	assert.Equal(t, "photo.png", info.FileName)
+
+	_, err = client.StatFile(ctx, "project-1", "post-1", "missing.png")
+	assert.ErrorIs(t, err, media.ErrFileNotFound)
+}
+
+func TestBlob(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	_, err := client.StatBlob(ctx, "project-1", "hash-1")
+	assert.ErrorIs(t, err, media.ErrFileNotFound)
+
+	err = client.UploadBlob(ctx, "project-1", "hash-1", strings.NewReader("image"))
+	assert.NoError(t, err)
+
+	info, err := client.StatBlob(ctx, "project-1", "hash-1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), info.Size)
+	assert.Equal(t, "hash-1", info.ContentHash)
+
+	body, err := client.GetBlob(ctx, "project-1", "hash-1")
+	assert.NoError(t, err)
+	data, err := io.ReadAll(body)
+	body.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, "image", string(data))
+
+	url, err := client.GetSignedBlobURL(ctx, "project-1", "hash-1", "photo.png")
+	assert.NoError(t, err)
+	assert.Contains(t, url, "/post-media/project-project-1/blobs/hash-1")
+	assert.Contains(t, url, "photo.png")
+
+	err = client.DeleteBlob(ctx, "project-1", "hash-1")
+	assert.NoError(t, err)
+	_, err = client.GetBlob(ctx, "project-1", "hash-1")
+	assert.ErrorIs(t, err, media.ErrFileNotFound)
+}
+
+func TestParseKey(t *testing.T) {
+	info, ok := parseKey("project-p1/post-p2/photo.png")
+	assert.True(t, ok)
+	assert.Equal(t, media.ObjectInfo{ProjectID: "p1", PostID: "p2", FileName: "photo.png"}, info)
+
+	info, ok = parseKey("project-p1/library/logo.png")
+	assert.True(t, ok)
+	assert.Equal(t, media.ObjectInfo{ProjectID: "p1", FileName: "logo.png"}, info)
+
+	info, ok = parseKey("project-p1/blobs/hash-1")
+	assert.True(t, ok)
+	assert.Equal(t, media.ObjectInfo{ProjectID: "p1", ContentHash: "hash-1"}, info)
+
+	_, ok = parseKey("other/photo.png")
+	assert.False(t, ok)
+
+	_, ok = parseKey("uploads/upload-1")
+	assert.False(t, ok)
+}
@@ -0,0 +1,417 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redplanettribe/social-media-manager/internal/domain/archive"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/project"
+)
+
+type ArchiveRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewArchiveRepository(db *pgxpool.Pool) *ArchiveRepository {
+	return &ArchiveRepository{db: db}
+}
+
+func (r *ArchiveRepository) FindProject(ctx context.Context, projectID string) (*archive.ProjectData, error) {
+	var (
+		p        archive.ProjectData
+		schedule string
+	)
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT p.name, p.description, %s, %s, ps.schedule, ps.preflight_mode,
+			ARRAY(SELECT platform_id FROM %s WHERE project_id = p.id ORDER BY platform_id)
+		FROM %s p
+		JOIN %s ps ON ps.project_id = p.id
+		WHERE p.id = $1
+	`, projectPostQueueSQL, projectIdeaQueueSQL, ProjectPlatforms, Projects, ProjectSettings), projectID).Scan(
+		&p.Name, &p.Description, &p.PostQueue, &p.IdeaQueue, &schedule, &p.PreflightMode, &p.Platforms,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	p.Schedule, err = project.DecodeSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT pq.name, ARRAY(
+			SELECT qi.post_id::text FROM %s qi
+			WHERE qi.project_id = pq.project_id AND qi.kind = $2 AND qi.queue = pq.name
+			ORDER BY qi.position, qi.post_id
+		)
+		FROM %s pq
+		WHERE pq.project_id = $1
+	`, QueueItems, ProjectQueues), projectID, postQueueKind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			name  string
+			queue []string
+		)
+		err = rows.Scan(&name, &queue)
+		if err != nil {
+			return nil, err
+		}
+		if p.Queues == nil {
+			p.Queues = make(map[string][]string)
+		}
+		p.Queues[name] = queue
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *ArchiveRepository) FindLabels(ctx context.Context, projectID string) ([]*archive.LabelData, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT id, name, color
+		FROM %s
+		WHERE project_id = $1
+		ORDER BY created_at
+	`, Labels), projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labels := []*archive.LabelData{}
+	for rows.Next() {
+		l := &archive.LabelData{}
+		err = rows.Scan(&l.ID, &l.Name, &l.Color)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, l)
+	}
+	return labels, nil
+}
+
+func (r *ArchiveRepository) FindPosts(ctx context.Context, projectID string) ([]*archive.PostData, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT p.id, p.title, p.type, p.text_content, p.is_idea, p.status, p.scheduled_at, p.published_at, p.idea_id, p.created_at, p.updated_at,
+			ARRAY(SELECT label_id::text FROM %s WHERE post_id = p.id ORDER BY label_id)
+		FROM %s p
+		WHERE p.project_id = $1 AND p.deleted_at IS NULL
+		ORDER BY p.created_at
+	`, PostLabels, Posts), projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	posts := []*archive.PostData{}
+	byID := make(map[string]*archive.PostData)
+	for rows.Next() {
+		p := &archive.PostData{Platforms: []*archive.PostPlatformData{}}
+		var updatedAt *time.Time
+		err = rows.Scan(&p.ID, &p.Title, &p.Type, &p.TextContent, &p.IsIdea, &p.Status, &p.ScheduledAt, &p.PublishedAt, &p.IdeaID, &p.CreatedAt, &updatedAt, &p.LabelIDs)
+		if err != nil {
+			return nil, err
+		}
+		if updatedAt != nil {
+			p.UpdatedAt = *updatedAt
+		}
+		posts = append(posts, p)
+		byID[p.ID] = p
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	platformRows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT pp.post_id, pp.platform_id, pp.status, pp.profile_tags
+		FROM %s pp
+		JOIN %s p ON p.id = pp.post_id
+		WHERE p.project_id = $1
+		ORDER BY pp.platform_id
+	`, PostPlatforms, Posts), projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer platformRows.Close()
+
+	for platformRows.Next() {
+		var postID string
+		pp := &archive.PostPlatformData{}
+		err = platformRows.Scan(&postID, &pp.PlatformID, &pp.Status, &pp.ProfileTags)
+		if err != nil {
+			return nil, err
+		}
+		if p, ok := byID[postID]; ok {
+			p.Platforms = append(p.Platforms, pp)
+		}
+	}
+	return posts, nil
+}
+
+func (r *ArchiveRepository) FindMedia(ctx context.Context, projectID string) ([]*archive.MediaData, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT m.id, COALESCE(m.post_id::text, ''), m.file_name, m.media_type, m.format, m.width, m.height, m.length, m.size, COALESCE(m.alt_text, ''),
+			m.created_at, m.folder, m.tags, m.content_hash
+		FROM %s m
+		LEFT JOIN %s p ON p.id = m.post_id
+		WHERE m.project_id = $1 AND (m.post_id IS NULL OR p.deleted_at IS NULL)
+		ORDER BY m.created_at
+	`, Media, Posts), projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mediaData := []*archive.MediaData{}
+	for rows.Next() {
+		md := &archive.MediaData{}
+		err = rows.Scan(&md.ID, &md.PostID, &md.Filename, &md.Type, &md.Format, &md.Width, &md.Height, &md.Length, &md.Size, &md.AltText,
+			&md.CreatedAt, &md.Folder, &md.Tags, &md.ContentHash)
+		if err != nil {
+			return nil, err
+		}
+		mediaData = append(mediaData, md)
+	}
+	return mediaData, rows.Err()
+}
+
+func (r *ArchiveRepository) FindMediaLinks(ctx context.Context, projectID string) ([]*archive.MediaLinkData, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT ppm.media_id, ppm.post_id, ppm.platform_id
+		FROM %s ppm
+		JOIN %s p ON p.id = ppm.post_id
+		JOIN %s m ON m.id = ppm.media_id
+		LEFT JOIN %s mp ON mp.id = m.post_id
+		WHERE p.project_id = $1 AND p.deleted_at IS NULL AND (m.post_id IS NULL OR mp.deleted_at IS NULL)
+		ORDER BY ppm.post_id, ppm.platform_id, ppm.media_id
+	`, PostPlatformMedia, Posts, Media, Posts), projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := []*archive.MediaLinkData{}
+	for rows.Next() {
+		l := &archive.MediaLinkData{}
+		err = rows.Scan(&l.MediaID, &l.PostID, &l.PlatformID)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, l)
+	}
+	return links, rows.Err()
+}
+
+func (r *ArchiveRepository) DoesProjectNameExist(ctx context.Context, name, userID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT EXISTS(
+			SELECT 1
+			FROM %s p
+			JOIN %s tm ON tm.project_id = p.id
+			WHERE p.name = $1 AND tm.user_id = $2
+		)
+	`, Projects, TeamMembers), name, userID).Scan(&exists)
+	return exists, err
+}
+
+func (r *ArchiveRepository) GetPlatformIDs(ctx context.Context) ([]string, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT id
+		FROM %s
+	`, Platforms))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (r *ArchiveRepository) SaveProject(ctx context.Context, p *project.Project, m *archive.Manifest) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, name, description, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, Projects), p.ID, p.Name, p.Description, p.CreatedBy, p.CreatedAt, p.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert project: %w", err)
+	}
+
+	schedule, err := m.Project.Schedule.Encode()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (project_id, schedule, preflight_mode)
+		VALUES ($1, $2, $3)
+	`, ProjectSettings), p.ID, schedule, m.Project.PreflightMode)
+	if err != nil {
+		return fmt.Errorf("failed to insert project settings: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (project_id, user_id, added_at)
+		VALUES ($1, $2, $3)
+	`, TeamMembers), p.ID, p.CreatedBy, p.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert owner: %w", err)
+	}
+	for _, roleID := range []project.TeamRoleID{project.OwnerRoleID, project.ManagerRoleID, project.MemberRoleID} {
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+			INSERT INTO %s (project_id, team_role_id, user_id)
+			VALUES ($1, $2, $3)
+		`, TeamMembersRoles), p.ID, roleID, p.CreatedBy)
+		if err != nil {
+			return fmt.Errorf("failed to insert owner roles: %w", err)
+		}
+	}
+
+	for _, platformID := range m.Project.Platforms {
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+			INSERT INTO %s (project_id, platform_id)
+			VALUES ($1, $2)
+		`, ProjectPlatforms), p.ID, platformID)
+		if err != nil {
+			return fmt.Errorf("failed to enable platform: %w", err)
+		}
+	}
+
+	for name := range m.Project.Queues {
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+			INSERT INTO %s (project_id, name, created_at)
+			VALUES ($1, $2, $3)
+		`, ProjectQueues), p.ID, name, p.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert queue: %w", err)
+		}
+	}
+
+	for _, l := range m.Labels {
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+			INSERT INTO %s (id, project_id, name, color, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, Labels), l.ID, p.ID, l.Name, l.Color, p.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert label: %w", err)
+		}
+	}
+
+	for _, ps := range m.Posts {
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+			INSERT INTO %s (id, project_id, title, type, text_content, is_idea, status, scheduled_at, published_at, created_by, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		`, Posts), ps.ID, p.ID, ps.Title, ps.Type, ps.TextContent, ps.IsIdea, ps.Status, ps.ScheduledAt, ps.PublishedAt, p.CreatedBy, ps.CreatedAt, ps.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert post: %w", err)
+		}
+		for _, pp := range ps.Platforms {
+			profileTags := pp.ProfileTags
+			if profileTags == nil {
+				profileTags = []string{}
+			}
+			_, err = tx.Exec(ctx, fmt.Sprintf(`
+				INSERT INTO %s (post_id, platform_id, status, profile_tags)
+				VALUES ($1, $2, $3, $4)
+			`, PostPlatforms), ps.ID, pp.PlatformID, pp.Status, profileTags)
+			if err != nil {
+				return fmt.Errorf("failed to insert post platform: %w", err)
+			}
+		}
+		for _, labelID := range ps.LabelIDs {
+			_, err = tx.Exec(ctx, fmt.Sprintf(`
+				INSERT INTO %s (post_id, label_id)
+				VALUES ($1, $2)
+			`, PostLabels), ps.ID, labelID)
+			if err != nil {
+				return fmt.Errorf("failed to insert post label: %w", err)
+			}
+		}
+	}
+
+	// Queues are filled once every post exists
+	err = appendToQueue(ctx, tx, p.ID, postQueueKind, post.DefaultQueue, p.PostQueue)
+	if err != nil {
+		return fmt.Errorf("failed to fill post queue: %w", err)
+	}
+	err = appendToQueue(ctx, tx, p.ID, ideaQueueKind, post.DefaultQueue, p.IdeaQueue)
+	if err != nil {
+		return fmt.Errorf("failed to fill idea queue: %w", err)
+	}
+	for name, queue := range m.Project.Queues {
+		err = appendToQueue(ctx, tx, p.ID, postQueueKind, name, queue)
+		if err != nil {
+			return fmt.Errorf("failed to fill queue: %w", err)
+		}
+	}
+
+	// Links to ideas are set once every post exists
+	for _, ps := range m.Posts {
+		if ps.IdeaID == nil {
+			continue
+		}
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+			UPDATE %s
+			SET idea_id = $2
+			WHERE id = $1
+		`, Posts), ps.ID, *ps.IdeaID)
+		if err != nil {
+			return fmt.Errorf("failed to link post to idea: %w", err)
+		}
+	}
+
+	for _, md := range m.Media {
+		tags := md.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+			INSERT INTO %s (id, project_id, post_id, file_name, media_type, format, width, height, length, size, alt_text, added_by, created_at, content_hash,
+				folder, tags)
+			VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		`, Media), md.ID, p.ID, md.PostID, md.Filename, md.Type, md.Format, md.Width, md.Height, md.Length, md.Size, md.AltText, p.CreatedBy, md.CreatedAt,
+			md.ContentHash, md.Folder, tags)
+		if err != nil {
+			return fmt.Errorf("failed to insert media: %w", err)
+		}
+	}
+	for _, l := range m.MediaLinks {
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+			INSERT INTO %s (post_id, media_id, platform_id)
+			VALUES ($1, $2, $3)
+		`, PostPlatformMedia), l.PostID, l.MediaID, l.PlatformID)
+		if err != nil {
+			return fmt.Errorf("failed to link media: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
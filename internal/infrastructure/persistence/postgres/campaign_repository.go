@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redplanettribe/social-media-manager/internal/domain/campaign"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+)
+
+type CampaignRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCampaignRepository(db *pgxpool.Pool) *CampaignRepository {
+	return &CampaignRepository{db: db}
+}
+
+func (r *CampaignRepository) Save(ctx context.Context, c *campaign.Campaign) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, project_id, name, goal, start_date, end_date, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, Campaigns), c.ID, c.ProjectID, c.Name, c.Goal, c.StartDate, c.EndDate, c.CreatedBy, c.CreatedAt, c.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *CampaignRepository) Update(ctx context.Context, c *campaign.Campaign) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET name = $2, goal = $3, start_date = $4, end_date = $5, updated_at = $6
+		WHERE id = $1
+	`, Campaigns), c.ID, c.Name, c.Goal, c.StartDate, c.EndDate, c.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *CampaignRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE id = $1
+	`, Campaigns), id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *CampaignRepository) FindByID(ctx context.Context, id string) (*campaign.Campaign, error) {
+	c := &campaign.Campaign{}
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT c.id, c.project_id, c.name, c.goal, c.start_date, c.end_date, c.created_by, c.created_at, c.updated_at,
+			(SELECT COUNT(*) FROM %s cp WHERE cp.campaign_id = c.id)
+		FROM %s c
+		WHERE c.id = $1
+	`, CampaignPosts, Campaigns), id).Scan(&c.ID, &c.ProjectID, &c.Name, &c.Goal, &c.StartDate, &c.EndDate, &c.CreatedBy, &c.CreatedAt, &c.UpdatedAt, &c.PostsCount)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	} else if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return c, nil
+}
+
+func (r *CampaignRepository) FindByProjectID(ctx context.Context, projectID string) ([]*campaign.Campaign, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT c.id, c.project_id, c.name, c.goal, c.start_date, c.end_date, c.created_by, c.created_at, c.updated_at,
+			(SELECT COUNT(*) FROM %s cp WHERE cp.campaign_id = c.id)
+		FROM %s c
+		WHERE c.project_id = $1
+		ORDER BY c.start_date DESC
+	`, CampaignPosts, Campaigns), projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var campaigns []*campaign.Campaign
+	for rows.Next() {
+		c := &campaign.Campaign{}
+		err = rows.Scan(&c.ID, &c.ProjectID, &c.Name, &c.Goal, &c.StartDate, &c.EndDate, &c.CreatedBy, &c.CreatedAt, &c.UpdatedAt, &c.PostsCount)
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, c)
+	}
+	return campaigns, nil
+}
+
+func (r *CampaignRepository) IsPostInProject(ctx context.Context, projectID, postID string) (bool, error) {
+	return isPostInProject(ctx, r.db, projectID, postID)
+}
+
+func (r *CampaignRepository) AddPost(ctx context.Context, campaignID, postID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (campaign_id, post_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, CampaignPosts), campaignID, postID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *CampaignRepository) RemovePost(ctx context.Context, campaignID, postID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE campaign_id = $1 AND post_id = $2
+	`, CampaignPosts), campaignID, postID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *CampaignRepository) CountPostsByStatus(ctx context.Context, campaignID string) (map[string]int, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT p.status, COUNT(*)
+		FROM %s cp
+		JOIN %s p ON p.id = cp.post_id
+		WHERE cp.campaign_id = $1 AND p.deleted_at IS NULL
+		GROUP BY p.status
+	`, CampaignPosts, Posts), campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var status string
+		var count int
+		err = rows.Scan(&status, &count)
+		if err != nil {
+			return nil, err
+		}
+		counts[status] = count
+	}
+	return counts, nil
+}
+
+func (r *CampaignRepository) GetPlatformSummaries(ctx context.Context, campaignID string) ([]*campaign.PlatformSummary, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT pp.platform_id,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE pp.status = $2),
+			COUNT(*) FILTER (WHERE pp.status = $3),
+			COUNT(*) FILTER (WHERE pp.status = $4),
+			COUNT(*) FILTER (WHERE pp.status = $5)
+		FROM %s cp
+		JOIN %s p ON p.id = cp.post_id
+		JOIN %s pp ON pp.post_id = cp.post_id
+		WHERE cp.campaign_id = $1 AND p.deleted_at IS NULL
+		GROUP BY pp.platform_id
+		ORDER BY pp.platform_id
+	`, CampaignPosts, Posts, PostPlatforms),
+		campaignID,
+		post.PublisherPostStatusReady,
+		post.PublisherPostStatusProcessing,
+		post.PublisherPostStatusPublished,
+		post.PublisherPostStatusFailed,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []*campaign.PlatformSummary
+	for rows.Next() {
+		s := &campaign.PlatformSummary{}
+		err = rows.Scan(&s.PlatformID, &s.Total, &s.Ready, &s.Processing, &s.Published, &s.Failed)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, s)
+	}
+	return summaries, nil
+}
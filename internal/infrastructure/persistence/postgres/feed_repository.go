@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redplanettribe/social-media-manager/internal/domain/feed"
+)
+
+type FeedRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewFeedRepository(db *pgxpool.Pool) *FeedRepository {
+	return &FeedRepository{db: db}
+}
+
+const feedColumns = "id, project_id, url, title, template_id, last_fetched_at, last_error, created_by, created_at, updated_at"
+
+func (r *FeedRepository) Save(ctx context.Context, f *feed.Feed) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (%s)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`, Feeds, feedColumns), f.ID, f.ProjectID, f.URL, f.Title, f.TemplateID, f.LastFetchedAt, f.LastError, f.CreatedBy, f.CreatedAt, f.UpdatedAt)
+	return err
+}
+
+func (r *FeedRepository) Update(ctx context.Context, f *feed.Feed) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET title = $2, template_id = $3, last_fetched_at = $4, last_error = $5, updated_at = $6
+		WHERE id = $1
+	`, Feeds), f.ID, f.Title, f.TemplateID, f.LastFetchedAt, f.LastError, f.UpdatedAt)
+	return err
+}
+
+func (r *FeedRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE id = $1
+	`, Feeds), id)
+	return err
+}
+
+func (r *FeedRepository) FindByID(ctx context.Context, id string) (*feed.Feed, error) {
+	f := &feed.Feed{}
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE id = $1
+	`, feedColumns, Feeds), id).Scan(
+		&f.ID, &f.ProjectID, &f.URL, &f.Title, &f.TemplateID, &f.LastFetchedAt, &f.LastError, &f.CreatedBy, &f.CreatedAt, &f.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+func (r *FeedRepository) FindByProjectID(ctx context.Context, projectID string) ([]*feed.Feed, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE project_id = $1
+		ORDER BY created_at
+	`, feedColumns, Feeds), projectID)
+	if err != nil {
+		return nil, err
+	}
+	return scanFeeds(rows)
+}
+
+func (r *FeedRepository) ExistsByURL(ctx context.Context, projectID, url string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT EXISTS(SELECT 1 FROM %s WHERE project_id = $1 AND url = $2)
+	`, Feeds), projectID, url).Scan(&exists)
+	return exists, err
+}
+
+func (r *FeedRepository) FindDueFeeds(ctx context.Context, fetchedBefore time.Time, limit int) ([]*feed.Feed, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE last_fetched_at IS NULL OR last_fetched_at < $1
+		ORDER BY last_fetched_at NULLS FIRST
+		LIMIT $2
+	`, feedColumns, Feeds), fetchedBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanFeeds(rows)
+}
+
+func (r *FeedRepository) FindSeenGUIDs(ctx context.Context, feedID string, guids []string) ([]string, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT guid
+		FROM %s
+		WHERE feed_id = $1 AND guid = ANY($2)
+	`, FeedItems), feedID, guids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seen []string
+	for rows.Next() {
+		var guid string
+		err = rows.Scan(&guid)
+		if err != nil {
+			return nil, err
+		}
+		seen = append(seen, guid)
+	}
+	return seen, nil
+}
+
+func (r *FeedRepository) SaveItem(ctx context.Context, feedID, guid, postID string) error {
+	var post *string
+	if postID != "" {
+		post = &postID
+	}
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (feed_id, guid, post_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`, FeedItems), feedID, guid, post)
+	return err
+}
+
+func scanFeeds(rows pgx.Rows) ([]*feed.Feed, error) {
+	defer rows.Close()
+
+	feeds := []*feed.Feed{}
+	for rows.Next() {
+		f := &feed.Feed{}
+		err := rows.Scan(&f.ID, &f.ProjectID, &f.URL, &f.Title, &f.TemplateID, &f.LastFetchedAt, &f.LastError, &f.CreatedBy, &f.CreatedAt, &f.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		feeds = append(feeds, f)
+	}
+	return feeds, nil
+}
@@ -0,0 +1,246 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redplanettribe/social-media-manager/internal/domain/idea"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+)
+
+type IdeaRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewIdeaRepository(db *pgxpool.Pool) *IdeaRepository {
+	return &IdeaRepository{db: db}
+}
+
+func (r *IdeaRepository) FindIdea(ctx context.Context, projectID, ideaID string) (*post.Post, error) {
+	p := &post.Post{}
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT id, project_id, title, type, text_content, is_idea, status, scheduled_at, published_at, idea_id, version, created_by, created_at, updated_at
+		FROM %s
+		WHERE id = $1 AND project_id = $2 AND deleted_at IS NULL
+	`, Posts), ideaID, projectID).Scan(
+		&p.ID, &p.ProjectID, &p.Title, &p.Type, &p.TextContent, &p.IsIdea, &p.Status, &p.ScheduledAt, &p.PublishedAt, &p.IdeaID, &p.Version, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *IdeaRepository) ListIdeas(ctx context.Context, projectID, userID string) ([]*idea.Idea, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT p.id, p.project_id, p.title, p.type, p.text_content, p.is_idea, p.status, p.scheduled_at, p.published_at, p.idea_id, p.version, p.created_by, p.created_at, p.updated_at,
+			(SELECT COUNT(*) FROM %s v WHERE v.post_id = p.id),
+			EXISTS(SELECT 1 FROM %s v WHERE v.post_id = p.id AND v.user_id = $2),
+			(SELECT COUNT(*) FROM %s c WHERE c.post_id = p.id)
+		FROM %s p
+		LEFT JOIN %s qi ON qi.post_id = p.id AND qi.kind = $4
+		WHERE p.project_id = $1 AND p.is_idea = TRUE AND p.status <> $3 AND p.deleted_at IS NULL
+		ORDER BY qi.position NULLS LAST, p.created_at
+	`, IdeaVotes, IdeaVotes, Comments, Posts, QueueItems), projectID, userID, post.PostStatusArchived, ideaQueueKind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ideas := []*idea.Idea{}
+	for rows.Next() {
+		i := &idea.Idea{Post: &post.Post{}}
+		p := i.Post
+		err = rows.Scan(
+			&p.ID, &p.ProjectID, &p.Title, &p.Type, &p.TextContent, &p.IsIdea, &p.Status, &p.ScheduledAt, &p.PublishedAt, &p.IdeaID, &p.Version, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt,
+			&i.Votes, &i.VotedByMe, &i.CommentsCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		ideas = append(ideas, i)
+	}
+	return ideas, nil
+}
+
+// UpdateIdeaQueue replaces the idea queue of the project with the given order
+func (r *IdeaRepository) UpdateIdeaQueue(ctx context.Context, projectID string, queue []string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = lockProjectQueues(ctx, tx, projectID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE project_id = $1 AND kind = $2
+	`, QueueItems), projectID, ideaQueueKind)
+	if err != nil {
+		return err
+	}
+	err = appendToQueue(ctx, tx, projectID, ideaQueueKind, post.DefaultQueue, queue)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *IdeaRepository) AddVote(ctx context.Context, ideaID, userID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (post_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, IdeaVotes), ideaID, userID)
+	return err
+}
+
+func (r *IdeaRepository) RemoveVote(ctx context.Context, ideaID, userID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE post_id = $1 AND user_id = $2
+	`, IdeaVotes), ideaID, userID)
+	return err
+}
+
+func (r *IdeaRepository) SaveComment(ctx context.Context, c *idea.Comment) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, post_id, user_id, content, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, Comments), c.ID, c.PostID, c.UserID, c.Content, c.CreatedAt, c.UpdatedAt)
+	return err
+}
+
+func (r *IdeaRepository) FindComment(ctx context.Context, ideaID, commentID string) (*idea.Comment, error) {
+	c := &idea.Comment{}
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT id, post_id, user_id, content, created_at, updated_at
+		FROM %s
+		WHERE id = $1 AND post_id = $2
+	`, Comments), commentID, ideaID).Scan(&c.ID, &c.PostID, &c.UserID, &c.Content, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *IdeaRepository) ListComments(ctx context.Context, ideaID string) ([]*idea.Comment, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT id, post_id, user_id, content, created_at, updated_at
+		FROM %s
+		WHERE post_id = $1
+		ORDER BY created_at
+	`, Comments), ideaID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := []*idea.Comment{}
+	for rows.Next() {
+		c := &idea.Comment{}
+		err = rows.Scan(&c.ID, &c.PostID, &c.UserID, &c.Content, &c.CreatedAt, &c.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, c)
+	}
+	return comments, nil
+}
+
+func (r *IdeaRepository) DeleteComment(ctx context.Context, commentID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE id = $1
+	`, Comments), commentID)
+	return err
+}
+
+func (r *IdeaRepository) FindPromotedPostID(ctx context.Context, ideaID string) (string, error) {
+	var postID string
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT id
+		FROM %s
+		WHERE idea_id = $1
+		LIMIT 1
+	`, Posts), ideaID).Scan(&postID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return postID, nil
+}
+
+func (r *IdeaRepository) PromoteIdea(ctx context.Context, i, draft *post.Post) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// The idea is archived first, its row lock makes concurrent promotions of the same idea wait and find it archived
+	tag, err := tx.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET status = $2, updated_at = NOW()
+		WHERE id = $1 AND status <> $2
+	`, Posts), i.ID, post.PostStatusArchived)
+	if err != nil {
+		return fmt.Errorf("failed to archive idea: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return idea.ErrIdeaAlreadyPromoted
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, project_id, title, type, text_content, is_idea, status, scheduled_at, idea_id, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`, Posts), draft.ID, draft.ProjectID, draft.Title, draft.Type, draft.TextContent, draft.IsIdea, draft.Status, draft.ScheduledAt, draft.IdeaID,
+		draft.CreatedBy, draft.CreatedAt, draft.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert draft: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (post_id, platform_id, status)
+		SELECT $1, platform_id, $3
+		FROM %s
+		WHERE post_id = $2
+	`, PostPlatforms, PostPlatforms), draft.ID, i.ID, post.PublisherPostStatusReady)
+	if err != nil {
+		return fmt.Errorf("failed to copy platforms: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (post_id, label_id)
+		SELECT $1, label_id
+		FROM %s
+		WHERE post_id = $2
+	`, PostLabels, PostLabels), draft.ID, i.ID)
+	if err != nil {
+		return fmt.Errorf("failed to copy labels: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE project_id = $1 AND post_id = $2
+	`, QueueItems), i.ProjectID, i.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update idea queue: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
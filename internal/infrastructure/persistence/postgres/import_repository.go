@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redplanettribe/social-media-manager/internal/domain/importer"
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+)
+
+type ImportRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewImportRepository(db *pgxpool.Pool) *ImportRepository {
+	return &ImportRepository{db: db}
+}
+
+func (r *ImportRepository) GetEnabledPlatforms(ctx context.Context, projectID string) ([]string, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT platform_id
+		FROM %s
+		WHERE project_id = $1
+	`, ProjectPlatforms), projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var platforms []string
+	for rows.Next() {
+		var platformID string
+		err = rows.Scan(&platformID)
+		if err != nil {
+			return nil, err
+		}
+		platforms = append(platforms, platformID)
+	}
+	return platforms, nil
+}
+
+func (r *ImportRepository) FindProjectMedia(ctx context.Context, projectID string, mediaIDs []string) ([]*media.MetaData, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s m
+		LEFT JOIN %s p ON p.id = m.post_id
+		WHERE m.project_id = $1 AND p.deleted_at IS NULL AND m.id::text = ANY($2)
+	`, metadataColumns, Media, Posts), projectID, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var metadata []*media.MetaData
+	for rows.Next() {
+		m := &media.MetaData{}
+		err = scanMetadata(rows, m)
+		if err != nil {
+			return nil, err
+		}
+		metadata = append(metadata, m)
+	}
+	return metadata, nil
+}
+
+func (r *ImportRepository) SavePosts(ctx context.Context, projectID string, posts []*importer.PostToSave) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	insertPost := fmt.Sprintf(`
+		INSERT INTO %s (id, project_id, title, type, text_content, is_idea, status, scheduled_at, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, Posts)
+	insertPlatform := fmt.Sprintf(`
+		INSERT INTO %s (post_id, platform_id, status)
+		VALUES ($1, $2, $3)
+	`, PostPlatforms)
+	insertMedia := fmt.Sprintf(`
+		INSERT INTO %s (id, project_id, post_id, file_name, media_type, format, width, height, length, size, alt_text, added_by, created_at, content_hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`, Media)
+	linkMedia := fmt.Sprintf(`
+		INSERT INTO %s (post_id, media_id, platform_id)
+		VALUES ($1, $2, $3)
+	`, PostPlatformMedia)
+
+	for _, ps := range posts {
+		p := ps.Post
+		_, err = tx.Exec(ctx, insertPost, p.ID, p.ProjectID, p.Title, p.Type, p.TextContent, p.IsIdea, p.Status, p.ScheduledAt, p.CreatedBy, p.CreatedAt, p.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert post: %w", err)
+		}
+		for _, platformID := range ps.Platforms {
+			_, err = tx.Exec(ctx, insertPlatform, p.ID, platformID, post.PublisherPostStatusReady)
+			if err != nil {
+				return fmt.Errorf("failed to insert post platform: %w", err)
+			}
+		}
+		for _, m := range ps.Media {
+			_, err = tx.Exec(ctx, insertMedia, m.ID, p.ProjectID, m.PostID, m.Filename, m.Type, m.Format, m.Width, m.Height, m.Length, m.Size, m.AltText, m.AddedBy, m.CreatedAt, m.ContentHash)
+			if err != nil {
+				return fmt.Errorf("failed to insert media: %w", err)
+			}
+		}
+		for _, mediaID := range ps.LinkedMediaIDs {
+			for _, platformID := range ps.Platforms {
+				_, err = tx.Exec(ctx, linkMedia, p.ID, mediaID, platformID)
+				if err != nil {
+					return fmt.Errorf("failed to link media: %w", err)
+				}
+			}
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redplanettribe/social-media-manager/internal/domain/label"
+)
+
+type LabelRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewLabelRepository(db *pgxpool.Pool) *LabelRepository {
+	return &LabelRepository{db: db}
+}
+
+func (r *LabelRepository) Save(ctx context.Context, l *label.Label) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, project_id, name, color, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, Labels), l.ID, l.ProjectID, l.Name, l.Color, l.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *LabelRepository) Update(ctx context.Context, l *label.Label) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET name = $2, color = $3
+		WHERE id = $1
+	`, Labels), l.ID, l.Name, l.Color)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *LabelRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE id = $1
+	`, Labels), id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *LabelRepository) FindByID(ctx context.Context, id string) (*label.Label, error) {
+	l := &label.Label{}
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT id, project_id, name, color, created_at
+		FROM %s
+		WHERE id = $1
+	`, Labels), id).Scan(&l.ID, &l.ProjectID, &l.Name, &l.Color, &l.CreatedAt)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	} else if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return l, nil
+}
+
+func (r *LabelRepository) FindByProjectID(ctx context.Context, projectID string) ([]*label.Label, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT id, project_id, name, color, created_at
+		FROM %s
+		WHERE project_id = $1
+		ORDER BY name
+	`, Labels), projectID)
+	if err != nil {
+		return nil, err
+	}
+	return scanLabels(rows)
+}
+
+func (r *LabelRepository) ExistsByName(ctx context.Context, projectID, name string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT EXISTS (
+			SELECT 1 FROM %s
+			WHERE project_id = $1 AND LOWER(name) = LOWER($2)
+		)
+	`, Labels), projectID, name).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *LabelRepository) IsPostInProject(ctx context.Context, projectID, postID string) (bool, error) {
+	return isPostInProject(ctx, r.db, projectID, postID)
+}
+
+func (r *LabelRepository) AddLabelToPost(ctx context.Context, postID, labelID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (post_id, label_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, PostLabels), postID, labelID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *LabelRepository) RemoveLabelFromPost(ctx context.Context, postID, labelID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE post_id = $1 AND label_id = $2
+	`, PostLabels), postID, labelID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *LabelRepository) FindByPostID(ctx context.Context, postID string) ([]*label.Label, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT l.id, l.project_id, l.name, l.color, l.created_at
+		FROM %s l
+		JOIN %s pl ON pl.label_id = l.id
+		WHERE pl.post_id = $1
+		ORDER BY l.name
+	`, Labels, PostLabels), postID)
+	if err != nil {
+		return nil, err
+	}
+	return scanLabels(rows)
+}
+
+func scanLabels(rows pgx.Rows) ([]*label.Label, error) {
+	defer rows.Close()
+
+	var labels []*label.Label
+	for rows.Next() {
+		l := &label.Label{}
+		err := rows.Scan(&l.ID, &l.ProjectID, &l.Name, &l.Color, &l.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, l)
+	}
+	return labels, nil
+}
+
+// isPostInProject is shared by the repositories that attach posts to other project entities
+func isPostInProject(ctx context.Context, db *pgxpool.Pool, projectID, postID string) (bool, error) {
+	var exists bool
+	err := db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT EXISTS (
+			SELECT 1 FROM %s
+			WHERE id = $1 AND project_id = $2 AND deleted_at IS NULL
+		)
+	`, Posts), postID, projectID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
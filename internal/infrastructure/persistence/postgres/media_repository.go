@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -11,63 +13,172 @@ import (
 )
 
 type MediaRepository struct {
-	db *pgxpool.Pool
+	pool *pgxpool.Pool
+	// db is the pool, or the connection holding a content lock
+	db mediaDB
+}
+
+// mediaDB is implemented by both the pool and a connection acquired from it
+type mediaDB interface {
+	dbtx
+	Begin(ctx context.Context) (pgx.Tx, error)
 }
 
 func NewMediaRepository(db *pgxpool.Pool) *MediaRepository {
-	return &MediaRepository{db: db}
+	return &MediaRepository{pool: db, db: db}
+}
+
+// metadataColumns are the columns scanned by scanMetadata, for a media table aliased m.
+// Library media have no post, they are read with an empty post id.
+const metadataColumns = `m.id, m.project_id, COALESCE(m.post_id::text, ''), m.file_name, m.media_type, m.format, m.width, m.height, m.length, m.size,
+	COALESCE(m.alt_text, ''), m.added_by, m.folder, m.tags, m.created_at, m.status, m.processing_error,
+	m.focal_x, m.focal_y, m.frames, m.pages, m.metadata_stripped, m.content_hash`
+
+func scanMetadata(row pgx.Row, m *media.MetaData, extra ...any) error {
+	return row.Scan(append([]any{
+		&m.ID, &m.ProjectID, &m.PostID, &m.Filename, &m.Type, &m.Format, &m.Width, &m.Height, &m.Length, &m.Size,
+		&m.AltText, &m.AddedBy, &m.Folder, &m.Tags, &m.CreatedAt, &m.Status, &m.ProcessingError,
+		&m.FocalX, &m.FocalY, &m.Frames, &m.Pages, &m.MetadataStripped, &m.ContentHash,
+	}, extra...)...)
 }
 
 func (r *MediaRepository) SaveMetadata(ctx context.Context, m *media.MetaData) (*media.MetaData, error) {
+	tags := m.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	status := m.Status
+	if status == "" {
+		status = media.MediaStatusReady
+	}
 	_, err := r.db.Exec(ctx, fmt.Sprintf(`
         INSERT INTO %s (
-            id, post_id, file_name, media_type, format, width, height, length, size, alt_text, added_by, created_at
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+            id, project_id, post_id, file_name, media_type, format, width, height, length, size, alt_text, added_by, folder, tags, created_at,
+            status, processing_error, focal_x, focal_y, frames, pages, metadata_stripped, content_hash
+        ) VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)
     `, Media),
-		m.ID, m.PostID, m.Filename, m.Type, m.Format, m.Width, m.Height, m.Length, m.Size, m.AltText, m.AddedBy, m.CreatedAt)
+		m.ID, m.ProjectID, m.PostID, m.Filename, m.Type, m.Format, m.Width, m.Height, m.Length, m.Size, m.AltText, m.AddedBy, m.Folder, tags, m.CreatedAt,
+		status, m.ProcessingError, m.FocalX, m.FocalY, m.Frames, m.Pages, m.MetadataStripped, m.ContentHash)
 	if err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (r *MediaRepository) GetMetadata(ctx context.Context, postID, fileName string) (*media.MetaData, error) {
+func (r *MediaRepository) GetMetadata(ctx context.Context, projectID, postID, fileName string) (*media.MetaData, error) {
 	var m media.MetaData
-	err := r.db.QueryRow(ctx, fmt.Sprintf(`
-		SELECT id, post_id, file_name, media_type, format, width, height, length, size, alt_text, added_by, created_at
-		FROM %s
-		WHERE post_id = $1 AND file_name = $2
-	`, Media), postID, fileName).Scan(
-		&m.ID, &m.PostID, &m.Filename, &m.Type, &m.Format, &m.Width, &m.Height, &m.Length, &m.Size, &m.AltText, &m.AddedBy, &m.CreatedAt,
-	)
+	err := scanMetadata(r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s m
+		WHERE m.project_id = $1 AND m.post_id IS NOT DISTINCT FROM NULLIF($2, '')::uuid AND m.file_name = $3
+	`, metadataColumns, Media), projectID, postID, fileName), &m)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &m, nil
 }
 
-func (r *MediaRepository) GetMediaFileNamesForPublishPost(ctx context.Context, postID, platformID string) ([]string, error) {
+func (r *MediaRepository) GetMetadataByID(ctx context.Context, mediaID string) (*media.MetaData, error) {
+	var m media.MetaData
+	err := scanMetadata(r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s m
+		WHERE m.id = $1
+	`, metadataColumns, Media), mediaID), &m)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *MediaRepository) UpdateMetadata(ctx context.Context, md *media.MetaData) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET media_type = $2, format = $3, width = $4, height = $5, length = $6, size = $7, status = $8, processing_error = $9,
+			frames = $10, pages = $11, metadata_stripped = $12, content_hash = $13
+		WHERE id = $1
+	`, Media), md.ID, md.Type, md.Format, md.Width, md.Height, md.Length, md.Size, md.Status, md.ProcessingError, md.Frames, md.Pages,
+		md.MetadataStripped, md.ContentHash)
+	return err
+}
+
+func (r *MediaRepository) FindProcessingMedia(ctx context.Context, createdBefore time.Time, limit int) ([]string, error) {
 	rows, err := r.db.Query(ctx, fmt.Sprintf(`
-		SELECT file_name
+		SELECT id
+		FROM %s
+		WHERE status = $1 AND created_at < $2
+		ORDER BY created_at
+		LIMIT $3
+	`, Media), media.MediaStatusProcessing, createdBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *MediaRepository) FindProcessingStatus(ctx context.Context, projectID string, mediaIDs []string) ([]*media.ProcessingStatus, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT id, status, processing_error
+		FROM %s
+		WHERE project_id = $1 AND id = ANY($2::uuid[])
+	`, Media), projectID, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statuses := []*media.ProcessingStatus{}
+	for rows.Next() {
+		status := &media.ProcessingStatus{}
+		err = rows.Scan(&status.ID, &status.Status, &status.Error)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, rows.Err()
+}
+
+func (r *MediaRepository) GetMediaForPublishPost(ctx context.Context, postID, platformID string) ([]*media.MetaData, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT %s
 		FROM %s m
 		JOIN %s ppm ON m.id = ppm.media_id
 		WHERE ppm.post_id = $1 AND ppm.platform_id = $2
-	`, Media, PostPlatformMedia), postID, platformID)
+		ORDER BY m.created_at
+	`, metadataColumns, Media, PostPlatformMedia), postID, platformID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var fileNames []string
+	var mds []*media.MetaData
 	for rows.Next() {
-		var fileName string
-		err := rows.Scan(&fileName)
+		m := &media.MetaData{}
+		err = scanMetadata(rows, m)
 		if err != nil {
 			return nil, err
 		}
-		fileNames = append(fileNames, fileName)
+		mds = append(mds, m)
 	}
-	return fileNames, nil
+	return mds, rows.Err()
 }
 
 func (r *MediaRepository) LinkMediaToPublishPost(ctx context.Context, postID, mediaID, platformID string) error {
@@ -97,7 +208,7 @@ func (r *MediaRepository) DoesPostBelongToProject(ctx context.Context, projectID
 	err := r.db.QueryRow(ctx, fmt.Sprintf(`
 		SELECT COUNT(*)
 		FROM %s
-		WHERE id = $1 AND project_id = $2
+		WHERE id = $1 AND project_id = $2 AND deleted_at IS NULL
 	`, Posts), postID, projectID).Scan(&count)
 	if err != nil {
 		return false, err
@@ -118,6 +229,121 @@ func (r *MediaRepository) DoesMediaBelongToPost(ctx context.Context, postID, med
 	return count > 0, nil
 }
 
+func (r *MediaRepository) IsMediaInLibrary(ctx context.Context, projectID, mediaID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT EXISTS (
+			SELECT 1 FROM %s
+			WHERE id = $1 AND project_id = $2 AND post_id IS NULL
+		)
+	`, Media), mediaID, projectID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *MediaRepository) FindLibraryMedia(ctx context.Context, projectID string, filter *media.LibraryFilter) ([]*media.LibraryMedia, error) {
+	args := []any{projectID}
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conditions := []string{"m.project_id = $1", "m.post_id IS NULL", "m.file_name NOT LIKE 'thumbnail_%'"}
+	if filter.Folder != "" {
+		conditions = append(conditions, "m.folder = "+arg(filter.Folder))
+	}
+	if filter.Tag != "" {
+		conditions = append(conditions, arg(filter.Tag)+" = ANY(m.tags)")
+	}
+	if filter.Query != "" {
+		q := arg("%" + escapeLike(filter.Query) + "%")
+		conditions = append(conditions, fmt.Sprintf("(m.file_name ILIKE %s OR m.alt_text ILIKE %s)", q, q))
+	}
+
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT %s,
+			(SELECT COUNT(*) FROM %s ppm WHERE ppm.media_id = m.id)
+		FROM %s m
+		WHERE %s
+		ORDER BY m.folder, m.file_name
+	`, metadataColumns, PostPlatformMedia, Media, strings.Join(conditions, " AND ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*media.LibraryMedia{}
+	for rows.Next() {
+		item := &media.LibraryMedia{MetaData: &media.MetaData{}}
+		err = scanMetadata(rows, item.MetaData, &item.UsageCount)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func (r *MediaRepository) FindLibraryMediaByID(ctx context.Context, projectID, mediaID string) (*media.LibraryMedia, error) {
+	item := &media.LibraryMedia{MetaData: &media.MetaData{}}
+	err := scanMetadata(r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT %s,
+			(SELECT COUNT(*) FROM %s ppm WHERE ppm.media_id = m.id)
+		FROM %s m
+		WHERE m.id = $1 AND m.project_id = $2 AND m.post_id IS NULL
+	`, metadataColumns, PostPlatformMedia, Media), mediaID, projectID), item.MetaData, &item.UsageCount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return item, nil
+}
+
+func (r *MediaRepository) UpdateLibraryMedia(ctx context.Context, md *media.MetaData) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET alt_text = $3, folder = $4, tags = $5
+		WHERE id = $1 AND project_id = $2 AND post_id IS NULL
+	`, Media), md.ID, md.ProjectID, md.AltText, md.Folder, md.Tags)
+	return err
+}
+
+func (r *MediaRepository) DeleteLibraryMedia(ctx context.Context, projectID, mediaID, thumbnailName string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// The media is only deleted if no post or branding uses it, which also holds off new links until the
+	// transaction ends
+	tag, err := tx.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s m
+		WHERE m.id = $1 AND m.project_id = $2 AND m.post_id IS NULL
+		AND NOT EXISTS (SELECT 1 FROM %s ppm WHERE ppm.media_id = m.id)
+		AND NOT EXISTS (SELECT 1 FROM %s b WHERE b.logo_media_id = m.id)
+	`, Media, PostPlatformMedia, ProjectBranding), mediaID, projectID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return media.ErrMediaInUse
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE project_id = $1 AND post_id IS NULL AND file_name = $2
+	`, Media), projectID, thumbnailName)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
 func (r *MediaRepository) IsPlatformEnabledForProject(ctx context.Context, projectID, platformID string) (bool, error) {
 	row := r.db.QueryRow(ctx, fmt.Sprintf(`
 		SELECT COUNT(*)
@@ -201,13 +427,359 @@ func (r *MediaRepository) GetMediaFileName(ctx context.Context, mediaID string)
 	return fileName, nil
 }
 
-func (r *MediaRepository) DeleteMetadata(ctx context.Context, mediaID string) error {
-	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+func (r *MediaRepository) FindPostMediaByID(ctx context.Context, projectID, postID, mediaID string) (*media.MetaData, error) {
+	var m media.MetaData
+	err := scanMetadata(r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s m
+		WHERE m.id = $1 AND m.project_id = $2 AND m.post_id = $3
+	`, metadataColumns, Media), mediaID, projectID, postID), &m)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *MediaRepository) DeletePostMedia(ctx context.Context, projectID, postID, mediaID, thumbnailName string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// The links to the platforms of its own post go with the media, the ones of other posts keep it
+	tag, err := tx.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s m
+		WHERE m.id = $1 AND m.project_id = $2 AND m.post_id = $3
+		AND NOT EXISTS (SELECT 1 FROM %s ppm WHERE ppm.media_id = m.id AND ppm.post_id <> m.post_id)
+		AND NOT EXISTS (SELECT 1 FROM %s b WHERE b.logo_media_id = m.id)
+	`, Media, PostPlatformMedia, ProjectBranding), mediaID, projectID, postID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return media.ErrMediaInUse
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
 		DELETE FROM %s
-		WHERE id = $1
-	`, Media), mediaID)
+		WHERE project_id = $1 AND post_id = $2 AND file_name = $3
+	`, Media), projectID, postID, thumbnailName)
 	if err != nil {
 		return err
 	}
-	return nil
+	return tx.Commit(ctx)
+}
+
+// LockContent holds a session-level advisory lock on the content while fn runs. The lock is taken on a connection of
+// its own, out of any transaction, and fn is given a repository querying through that connection so it doesn't wait
+// for another one of the pool while holding it.
+func (r *MediaRepository) LockContent(ctx context.Context, projectID, hash string, fn func(repo media.Repository) error) error {
+	conn, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	key := projectID + "/" + hash
+	_, err = conn.Exec(ctx, `SELECT pg_advisory_lock(hashtextextended($1, 0))`, key)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, err := conn.Exec(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock(hashtextextended($1, 0))`, key)
+		if err != nil {
+			// The connection would go back to the pool still holding the lock, it's closed instead
+			conn.Conn().Close(context.WithoutCancel(ctx))
+		}
+	}()
+
+	return fn(&MediaRepository{pool: r.pool, db: conn})
+}
+
+func (r *MediaRepository) IsContentReferenced(ctx context.Context, projectID, hash string) (bool, error) {
+	var referenced bool
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT EXISTS (
+			SELECT 1 FROM %s
+			WHERE project_id = $1 AND content_hash = $2
+		)
+	`, Media), projectID, hash).Scan(&referenced)
+	return referenced, err
+}
+
+func (r *MediaRepository) FindUnsharedContent(ctx context.Context, projectID, postID string) ([]string, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT DISTINCT m.content_hash
+		FROM %[1]s m
+		WHERE m.project_id = $1 AND m.post_id = $2 AND m.content_hash <> ''
+		AND NOT EXISTS (
+			SELECT 1 FROM %[1]s o
+			WHERE o.project_id = m.project_id
+			AND o.content_hash = m.content_hash
+			AND o.post_id IS DISTINCT FROM m.post_id
+		)
+	`, Media), projectID, postID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hashes []string
+	for rows.Next() {
+		var hash string
+		err = rows.Scan(&hash)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, rows.Err()
+}
+
+func (r *MediaRepository) HasMetadata(ctx context.Context, files []*media.ObjectInfo) ([]bool, error) {
+	projectIDs := make([]string, len(files))
+	postIDs := make([]string, len(files))
+	fileNames := make([]string, len(files))
+	hashes := make([]string, len(files))
+	for i, f := range files {
+		projectIDs[i] = f.ProjectID
+		postIDs[i] = f.PostID
+		fileNames[i] = f.FileName
+		hashes[i] = f.ContentHash
+	}
+
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT CASE WHEN t.content_hash <> '' THEN EXISTS (
+			SELECT 1 FROM %[1]s m
+			WHERE m.project_id::text = t.project_id
+			AND m.content_hash = t.content_hash
+		) ELSE EXISTS (
+			SELECT 1 FROM %[1]s m
+			WHERE m.project_id::text = t.project_id
+			AND COALESCE(m.post_id::text, '') = t.post_id
+			AND m.file_name = t.file_name
+			AND m.content_hash = ''
+		) OR EXISTS (
+			SELECT 1 FROM %[2]s mr
+			JOIN %[1]s m ON m.id = mr.media_id
+			WHERE m.project_id::text = t.project_id
+			AND COALESCE(m.post_id::text, '') = t.post_id
+			AND mr.file_name = t.file_name
+		) END
+		FROM unnest($1::text[], $2::text[], $3::text[], $4::text[]) WITH ORDINALITY AS t(project_id, post_id, file_name, content_hash, ord)
+		ORDER BY t.ord
+	`, Media, MediaRenditions), projectIDs, postIDs, fileNames, hashes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	known := make([]bool, 0, len(files))
+	for rows.Next() {
+		var exists bool
+		err = rows.Scan(&exists)
+		if err != nil {
+			return nil, err
+		}
+		known = append(known, exists)
+	}
+	return known, rows.Err()
+}
+
+func (r *MediaRepository) FindMetadataCreatedBefore(ctx context.Context, createdBefore time.Time, afterID string, limit int) ([]*media.MetaData, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s m
+		WHERE m.created_at < $1 AND m.id::text > $2
+		ORDER BY m.id::text
+		LIMIT $3
+	`, metadataColumns, Media), createdBefore, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mds []*media.MetaData
+	for rows.Next() {
+		m := &media.MetaData{}
+		err = scanMetadata(rows, m)
+		if err != nil {
+			return nil, err
+		}
+		mds = append(mds, m)
+	}
+	return mds, rows.Err()
+}
+
+func (r *MediaRepository) SaveRendition(ctx context.Context, rendition *media.Rendition) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, media_id, platform_id, file_name, format, width, height, length, size, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (media_id, platform_id) DO UPDATE
+		SET file_name = EXCLUDED.file_name, format = EXCLUDED.format, width = EXCLUDED.width, height = EXCLUDED.height,
+			length = EXCLUDED.length, size = EXCLUDED.size, created_at = EXCLUDED.created_at
+	`, MediaRenditions), rendition.ID, rendition.MediaID, rendition.PlatformID, rendition.FileName, rendition.Format,
+		rendition.Width, rendition.Height, rendition.Length, rendition.Size, rendition.CreatedAt)
+	return err
+}
+
+func (r *MediaRepository) FindRendition(ctx context.Context, mediaID, platformID string) (*media.Rendition, error) {
+	var rendition media.Rendition
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT id, media_id, platform_id, file_name, format, width, height, length, size, created_at
+		FROM %s
+		WHERE media_id = $1 AND platform_id = $2
+	`, MediaRenditions), mediaID, platformID).Scan(
+		&rendition.ID, &rendition.MediaID, &rendition.PlatformID, &rendition.FileName, &rendition.Format,
+		&rendition.Width, &rendition.Height, &rendition.Length, &rendition.Size, &rendition.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rendition, nil
+}
+
+func (r *MediaRepository) UpdateFocalPoint(ctx context.Context, mediaID string, x, y float64) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s SET focal_x = $2, focal_y = $3 WHERE id = $1
+	`, Media), mediaID, x, y)
+	return err
+}
+
+const brandingColumns = `b.project_id, b.logo_media_id, b.position, b.opacity, b.scale, b.text, b.updated_at`
+
+func scanBranding(row pgx.Row) (*media.Branding, error) {
+	var b media.Branding
+	err := row.Scan(&b.ProjectID, &b.LogoMediaID, &b.Position, &b.Opacity, &b.Scale, &b.Text, &b.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (r *MediaRepository) FindBranding(ctx context.Context, projectID string) (*media.Branding, error) {
+	return scanBranding(r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s b
+		WHERE b.project_id = $1
+	`, brandingColumns, ProjectBranding), projectID))
+}
+
+func (r *MediaRepository) SaveBranding(ctx context.Context, b *media.Branding) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (project_id, logo_media_id, position, opacity, scale, text, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (project_id) DO UPDATE
+		SET logo_media_id = $2, position = $3, opacity = $4, scale = $5, text = $6, updated_at = $7
+	`, ProjectBranding), b.ProjectID, b.LogoMediaID, b.Position, b.Opacity, b.Scale, b.Text, b.UpdatedAt)
+	return err
+}
+
+func (r *MediaRepository) DeleteBranding(ctx context.Context, projectID string) (bool, error) {
+	tag, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s WHERE project_id = $1
+	`, ProjectBranding), projectID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *MediaRepository) FindPublishBranding(ctx context.Context, postID, platformID string) (*media.Branding, error) {
+	return scanBranding(r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s pp
+		INNER JOIN %s p ON p.id = pp.post_id
+		INNER JOIN %s b ON b.project_id = p.project_id
+		WHERE pp.post_id = $1 AND pp.platform_id = $2 AND pp.apply_branding
+	`, brandingColumns, PostPlatforms, Posts, ProjectBranding), postID, platformID))
+}
+
+func (r *MediaRepository) SetApplyBranding(ctx context.Context, postID, platformID string, apply bool) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s SET apply_branding = $3 WHERE post_id = $1 AND platform_id = $2
+	`, PostPlatforms), postID, platformID, apply)
+	return err
+}
+
+func (r *MediaRepository) DeleteRenditions(ctx context.Context, mediaID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s WHERE media_id = $1
+	`, MediaRenditions), mediaID)
+	return err
+}
+
+const uploadColumns = `id, project_id, COALESCE(post_id::text, ''), file_name, alt_text, folder, tags, size, created_by, created_at, expires_at`
+
+func scanUpload(row pgx.Row, u *media.Upload) error {
+	return row.Scan(&u.ID, &u.ProjectID, &u.PostID, &u.FileName, &u.AltText, &u.Folder, &u.Tags, &u.Size, &u.CreatedBy, &u.CreatedAt, &u.ExpiresAt)
+}
+
+func (r *MediaRepository) SaveUpload(ctx context.Context, u *media.Upload) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, project_id, post_id, file_name, alt_text, folder, tags, size, created_by, created_at, expires_at)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, MediaUploads), u.ID, u.ProjectID, u.PostID, u.FileName, u.AltText, u.Folder, u.Tags, u.Size, u.CreatedBy, u.CreatedAt, u.ExpiresAt)
+	return err
+}
+
+func (r *MediaRepository) FindUpload(ctx context.Context, projectID, uploadID string) (*media.Upload, error) {
+	u := &media.Upload{}
+	err := scanUpload(r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE id = $1 AND project_id = $2
+	`, uploadColumns, MediaUploads), uploadID, projectID), u)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+func (r *MediaRepository) DeleteUpload(ctx context.Context, uploadID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s WHERE id = $1
+	`, MediaUploads), uploadID)
+	return err
+}
+
+func (r *MediaRepository) DeleteExpiredUploads(ctx context.Context, now time.Time) ([]*media.Upload, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE expires_at <= $1
+		RETURNING %s
+	`, MediaUploads, uploadColumns), now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var uploads []*media.Upload
+	for rows.Next() {
+		u := &media.Upload{}
+		err = scanUpload(rows, u)
+		if err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, u)
+	}
+	return uploads, rows.Err()
+}
+
+// escapeLike escapes the wildcards of a text searched with LIKE
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
 }
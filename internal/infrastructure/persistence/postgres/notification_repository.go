@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redplanettribe/social-media-manager/internal/domain/notification"
+)
+
+type NotificationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewNotificationRepository(db *pgxpool.Pool) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) Save(ctx context.Context, n *notification.Notification) error {
+	var postID *string
+	if n.PostID != "" {
+		postID = &n.PostID
+	}
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, project_id, post_id, kind, message, is_read, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, Notifications), n.ID, n.ProjectID, postID, n.Kind, n.Message, n.IsRead, n.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *NotificationRepository) FindByProjectID(ctx context.Context, projectID string, unreadOnly bool) ([]*notification.Notification, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT id, project_id, COALESCE(post_id::text, ''), kind, message, is_read, created_at
+		FROM %s
+		WHERE project_id = $1
+		AND ($2 = FALSE OR is_read = FALSE)
+		ORDER BY created_at DESC
+	`, Notifications), projectID, unreadOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*notification.Notification
+	for rows.Next() {
+		n := &notification.Notification{}
+		err = rows.Scan(&n.ID, &n.ProjectID, &n.PostID, &n.Kind, &n.Message, &n.IsRead, &n.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+
+	return notifications, nil
+}
+
+func (r *NotificationRepository) MarkAsRead(ctx context.Context, projectID, id string) (bool, error) {
+	tag, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET is_read = TRUE
+		WHERE id = $1 AND project_id = $2
+	`, Notifications), id, projectID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
 )
 
@@ -31,27 +33,94 @@ func (r *PostRepository) Save(ctx context.Context, p *post.Post) error {
 	return nil
 }
 
+func (r *PostRepository) SaveWithPlatforms(ctx context.Context, p *post.Post, platformIDs []string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, project_id, title, type, text_content, is_idea, status, scheduled_at, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, Posts), p.ID, p.ProjectID, p.Title, p.Type, p.TextContent, p.IsIdea, p.Status, p.ScheduledAt, p.CreatedBy, time.Now().UTC(), time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to insert post: %w", err)
+	}
+
+	for _, platformID := range platformIDs {
+		_, err = tx.Exec(ctx, fmt.Sprintf(`
+			INSERT INTO %s (post_id, platform_id, status)
+			VALUES ($1, $2, $3)
+		`, PostPlatforms), p.ID, platformID, post.PublisherPostStatusReady)
+		if err != nil {
+			return fmt.Errorf("failed to insert post platform: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Update saves the post if it wasn't modified since it was read, and increments its version.
+// The edited post is checked again before it's due.
 func (r *PostRepository) Update(ctx context.Context, p *post.Post) error {
-	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
 		UPDATE %s
-		SET title = $2, type = $3, text_content = $4, is_idea = $5, status = $6, scheduled_at = $7, updated_at = $8
-		WHERE id = $1
-	`, Posts), p.ID, p.Title, p.Type, p.TextContent, p.IsIdea, p.Status, p.ScheduledAt, time.Now().UTC())
+		SET title = $2, type = $3, text_content = $4, is_idea = $5, status = $6, scheduled_at = $7, updated_at = $8,
+			published_at = CASE
+				WHEN published_at IS NULL AND $6 IN ($9, $10) THEN $8
+				ELSE published_at
+			END,
+			version = version + 1,
+			preflight_checked_at = NULL
+		WHERE id = $1 AND version = $11
+		RETURNING version
+	`, Posts), p.ID, p.Title, p.Type, p.TextContent, p.IsIdea, p.Status, p.ScheduledAt, time.Now().UTC(),
+		post.PostStatusPublished, post.PostStatusPartialyPublished, p.Version).Scan(&p.Version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return post.ErrVersionConflict
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *PostRepository) UpdateStatus(ctx context.Context, id, status string) error {
+	return updateStatus(ctx, r.db, id, status)
+}
+
+// updateStatus sets the status of a post without checking its version, the publisher and the queues move the
+// status of posts that may be edited meanwhile
+func updateStatus(ctx context.Context, db dbtx, id, status string) error {
+	tag, err := db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET status = $2, updated_at = $3,
+			published_at = CASE
+				WHEN published_at IS NULL AND $2 IN ($4, $5) THEN $3
+				ELSE published_at
+			END,
+			version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL
+	`, Posts), id, status, time.Now().UTC(), post.PostStatusPublished, post.PostStatusPartialyPublished)
 	if err != nil {
 		return err
 	}
+	if tag.RowsAffected() == 0 {
+		return post.ErrPostNotFound
+	}
 	return nil
 }
 
 func (r *PostRepository) FindByID(ctx context.Context, id string) (*post.Post, error) {
 	row := r.db.QueryRow(ctx, fmt.Sprintf(`
-		SELECT id, project_id, title, type, text_content, is_idea, status, scheduled_at, created_by, created_at, updated_at
+		SELECT id, project_id, title, type, text_content, is_idea, status, scheduled_at, published_at, idea_id, version, created_by, created_at, updated_at
 		FROM %s
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`, Posts), id)
 
 	p := &post.Post{}
-	err := row.Scan(&p.ID, &p.ProjectID, &p.Title, &p.Type, &p.TextContent, &p.IsIdea, &p.Status, &p.ScheduledAt, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
+	err := row.Scan(&p.ID, &p.ProjectID, &p.Title, &p.Type, &p.TextContent, &p.IsIdea, &p.Status, &p.ScheduledAt, &p.PublishedAt, &p.IdeaID, &p.Version, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return nil, err
 	} else if errors.Is(err, pgx.ErrNoRows) {
@@ -63,9 +132,9 @@ func (r *PostRepository) FindByID(ctx context.Context, id string) (*post.Post, e
 
 func (r *PostRepository) FindByProjectID(ctx context.Context, projectID string) ([]*post.Post, error) {
 	rows, err := r.db.Query(ctx, fmt.Sprintf(`
-		SELECT id, project_id, title, type, text_content, is_idea, status, scheduled_at, created_by, created_at, updated_at
+		SELECT id, project_id, title, type, text_content, is_idea, status, scheduled_at, published_at, idea_id, version, created_by, created_at, updated_at
 		FROM %s
-		WHERE project_id = $1
+		WHERE project_id = $1 AND deleted_at IS NULL
 	`, Posts), projectID)
 	if err != nil {
 		return nil, err
@@ -75,7 +144,7 @@ func (r *PostRepository) FindByProjectID(ctx context.Context, projectID string)
 	var posts []*post.Post
 	for rows.Next() {
 		p := &post.Post{}
-		err = rows.Scan(&p.ID, &p.ProjectID, &p.Title, &p.Type, &p.TextContent, &p.IsIdea, &p.Status, &p.ScheduledAt, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
+		err = rows.Scan(&p.ID, &p.ProjectID, &p.Title, &p.Type, &p.TextContent, &p.IsIdea, &p.Status, &p.ScheduledAt, &p.PublishedAt, &p.IdeaID, &p.Version, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -85,6 +154,131 @@ func (r *PostRepository) FindByProjectID(ctx context.Context, projectID string)
 	return posts, nil
 }
 
+// postSortColumns maps the sort fields to the expression used in ORDER BY and in the cursor comparison.
+// Nullable columns are coalesced so the row comparison never sees a NULL.
+var postSortColumns = map[post.SortField]string{
+	post.SortByCreatedAt:   "p.created_at",
+	post.SortByUpdatedAt:   "COALESCE(p.updated_at, p.created_at)",
+	post.SortByScheduledAt: "COALESCE(p.scheduled_at, 'epoch'::timestamp)",
+	post.SortByPublishedAt: "COALESCE(p.published_at, 'epoch'::timestamp)",
+	post.SortByTitle:       "p.title",
+}
+
+func (r *PostRepository) SearchByProjectID(ctx context.Context, projectID string, filter *post.PostFilter) ([]*post.Post, int, error) {
+	sortColumn, ok := postSortColumns[filter.SortBy]
+	if !ok {
+		return nil, 0, post.ErrInvalidSortField
+	}
+
+	args := []any{projectID}
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conditions := []string{"p.project_id = $1", "p.deleted_at IS NULL"}
+	if filter.Status != "" {
+		conditions = append(conditions, "p.status = "+arg(filter.Status))
+	}
+	if filter.Type != "" {
+		conditions = append(conditions, "p.type = "+arg(filter.Type))
+	}
+	if filter.CreatedBy != "" {
+		conditions = append(conditions, "p.created_by = "+arg(filter.CreatedBy))
+	}
+	if filter.IsIdea != nil {
+		conditions = append(conditions, "p.is_idea = "+arg(*filter.IsIdea))
+	}
+	if filter.PlatformID != "" {
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s pp WHERE pp.post_id = p.id AND pp.platform_id = %s)",
+			PostPlatforms, arg(filter.PlatformID),
+		))
+	}
+	if filter.LabelID != "" {
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s pl WHERE pl.post_id = p.id AND pl.label_id = %s)",
+			PostLabels, arg(filter.LabelID),
+		))
+	}
+	if filter.CampaignID != "" {
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s cp WHERE cp.post_id = p.id AND cp.campaign_id = %s)",
+			CampaignPosts, arg(filter.CampaignID),
+		))
+	}
+	if filter.Query != "" {
+		conditions = append(conditions, "p.search_vector @@ websearch_to_tsquery('simple', "+arg(filter.Query)+")")
+	}
+	ranges := []struct {
+		column string
+		tr     post.TimeRange
+	}{
+		{"p.created_at", filter.Created},
+		{"p.scheduled_at", filter.Scheduled},
+		{"p.published_at", filter.Published},
+	}
+	for _, rg := range ranges {
+		if rg.tr.From != nil {
+			conditions = append(conditions, rg.column+" >= "+arg(rg.tr.From.UTC()))
+		}
+		if rg.tr.To != nil {
+			conditions = append(conditions, rg.column+" <= "+arg(rg.tr.To.UTC()))
+		}
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM %s p
+		WHERE %s
+	`, Posts, where), args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	direction, comparison := "DESC", "<"
+	if filter.SortOrder == post.SortOrderAsc {
+		direction, comparison = "ASC", ">"
+	}
+	if c := filter.After(); c != nil {
+		var value any = c.Value
+		if filter.SortBy != post.SortByTitle {
+			t, err := c.TimeValue()
+			if err != nil {
+				return nil, 0, err
+			}
+			value = t
+		}
+		where += fmt.Sprintf(" AND (%s, p.id) %s (%s, %s)", sortColumn, comparison, arg(value), arg(c.ID))
+	}
+
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT p.id, p.project_id, p.title, p.type, p.text_content, p.is_idea, p.status, p.scheduled_at, p.published_at, p.idea_id, p.version, p.created_by, p.created_at, p.updated_at
+		FROM %s p
+		WHERE %s
+		ORDER BY %s %s, p.id %s
+		LIMIT %s
+	`, Posts, where, sortColumn, direction, direction, arg(filter.Limit+1)), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var posts []*post.Post
+	for rows.Next() {
+		p := &post.Post{}
+		err = rows.Scan(&p.ID, &p.ProjectID, &p.Title, &p.Type, &p.TextContent, &p.IsIdea, &p.Status, &p.ScheduledAt, &p.PublishedAt, &p.IdeaID, &p.Version, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+		posts = append(posts, p)
+	}
+
+	return posts, total, rows.Err()
+}
+
 func (r *PostRepository) ArchivePost(ctx context.Context, id string) error {
 	_, err := r.db.Exec(ctx, fmt.Sprintf(`
 		UPDATE %s
@@ -109,14 +303,93 @@ func (r *PostRepository) RestorePost(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r *PostRepository) DeletePost(ctx context.Context, id string) error {
+func (r *PostRepository) TrashPost(ctx context.Context, id string, deletedAt time.Time) error {
 	_, err := r.db.Exec(ctx, fmt.Sprintf(`
-		DELETE FROM %s
+		UPDATE %s
+		SET deleted_at = $2,
+			updated_at = $2,
+			scheduled_at = CASE WHEN status = $3 THEN $6 ELSE scheduled_at END,
+			status = CASE WHEN status IN ($3, $4) THEN $5 ELSE status END
+		WHERE id = $1 AND deleted_at IS NULL
+	`, Posts), id, deletedAt, post.PostStatusScheduled, post.PostStatusQueued, post.PostStatusDraft, time.Time{})
+	return err
+}
+
+func (r *PostRepository) FindTrashedPost(ctx context.Context, projectID, postID string) (*post.TrashedPost, error) {
+	p := &post.TrashedPost{}
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT id, project_id, title, type, status, deleted_at
+		FROM %s
+		WHERE id = $1 AND project_id = $2 AND deleted_at IS NOT NULL
+	`, Posts), postID, projectID).Scan(&p.ID, &p.ProjectID, &p.Title, &p.Type, &p.Status, &p.DeletedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *PostRepository) FindTrash(ctx context.Context, projectID string) ([]*post.TrashedPost, error) {
+	return r.findTrashedPosts(ctx, fmt.Sprintf(`
+		SELECT id, project_id, title, type, status, deleted_at
+		FROM %s
+		WHERE project_id = $1 AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC
+	`, Posts), projectID)
+}
+
+func (r *PostRepository) RestoreFromTrash(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET deleted_at = NULL, updated_at = $2
 		WHERE id = $1
-	`, Posts), id)
+	`, Posts), id, time.Now().UTC())
+	return err
+}
+
+func (r *PostRepository) FindExpiredTrash(ctx context.Context, deletedBefore time.Time, offset, limit int) ([]*post.TrashedPost, error) {
+	return r.findTrashedPosts(ctx, fmt.Sprintf(`
+		SELECT id, project_id, title, type, status, deleted_at
+		FROM %s
+		WHERE deleted_at < $1
+		ORDER BY deleted_at, id
+		OFFSET $2
+		LIMIT $3
+	`, Posts), deletedBefore, offset, limit)
+}
+
+func (r *PostRepository) findTrashedPosts(ctx context.Context, query string, args ...any) ([]*post.TrashedPost, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	posts := []*post.TrashedPost{}
+	for rows.Next() {
+		p := &post.TrashedPost{}
+		err = rows.Scan(&p.ID, &p.ProjectID, &p.Title, &p.Type, &p.Status, &p.DeletedAt)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, p)
+	}
+	return posts, rows.Err()
+}
+
+func (r *PostRepository) DeletePost(ctx context.Context, id string, deletedBefore time.Time) error {
+	tag, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE id = $1 AND deleted_at IS NOT NULL AND deleted_at < $2
+	`, Posts), id, deletedBefore)
 	if err != nil {
 		return err
 	}
+	if tag.RowsAffected() == 0 {
+		return post.ErrPostNotInTrash
+	}
 	return nil
 }
 
@@ -273,10 +546,60 @@ func (r *PostRepository) FindScheduledReadyPosts(ctx context.Context, offset, ch
 	return posts, nil
 }
 
+func (r *PostRepository) FindPostsDueForPreflight(ctx context.Context, before time.Time, offset, chunksize int) ([]*post.Post, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT id, project_id, title, type, text_content, is_idea, status, scheduled_at, created_by, created_at, updated_at
+		FROM %s
+		WHERE status = $1
+		AND scheduled_at < $2
+		AND preflight_checked_at IS NULL
+		ORDER BY scheduled_at
+		LIMIT $3 OFFSET $4
+	`, Posts), post.PostStatusScheduled, before.UTC(), chunksize, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var posts []*post.Post
+	for rows.Next() {
+		p := &post.Post{}
+		err = rows.Scan(&p.ID, &p.ProjectID, &p.Title, &p.Type, &p.TextContent, &p.IsIdea, &p.Status, &p.ScheduledAt, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, p)
+	}
+
+	return posts, nil
+}
+
+func (r *PostRepository) MarkPreflightChecked(ctx context.Context, postID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET preflight_checked_at = $2
+		WHERE id = $1
+	`, Posts), postID, time.Now().UTC())
+	return err
+}
+
+func (r *PostRepository) HasProcessingMedia(ctx context.Context, postID string) (bool, error) {
+	var processing bool
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM %s m
+			WHERE m.status = $2
+			AND (m.post_id = $1 OR m.id IN (SELECT ppm.media_id FROM %s ppm WHERE ppm.post_id = $1))
+		)
+	`, Media, PostPlatformMedia), postID, media.MediaStatusProcessing).Scan(&processing)
+	return processing, err
+}
+
 func (r *PostRepository) SchedulePost(ctx context.Context, id string, scheduledAt time.Time) error {
 	_, err := r.db.Exec(ctx, fmt.Sprintf(`
 		UPDATE %s
-		SET scheduled_at = $2, status = $3, updated_at = $4
+		SET scheduled_at = $2, status = $3, updated_at = $4, preflight_checked_at = NULL
 		WHERE id = $1
 	`, Posts), id, scheduledAt, post.PostStatusScheduled, time.Now().UTC())
 	if err != nil {
@@ -313,110 +636,226 @@ func (r *PostRepository) IsPublisherPlatformEnabledForProject(ctx context.Contex
 	return count > 0, nil
 }
 
-func (r *PostRepository) GetProjectPostQueue(ctx context.Context, projectID string) (*post.Queue, error) {
-	row := r.db.QueryRow(ctx, fmt.Sprintf(`
-		SELECT post_queue
-		FROM %s
-		WHERE id = $1
-	`, Projects), projectID)
+// GetProjectPostQueue returns the posts of a queue of the project, or nil if the named queue doesn't exist.
+func (r *PostRepository) GetProjectPostQueue(ctx context.Context, projectID, queue string) (*post.Queue, error) {
+	if queue != post.DefaultQueue {
+		var exists bool
+		err := r.db.QueryRow(ctx, fmt.Sprintf(`
+			SELECT EXISTS(SELECT 1 FROM %s WHERE project_id = $1 AND name = $2)
+		`, ProjectQueues), projectID, queue).Scan(&exists)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, nil
+		}
+	}
+
+	return findQueue(ctx, r.db, projectID, postQueueKind, queue)
+}
+
+func (r *PostRepository) MovePostInProjectQueue(ctx context.Context, projectID, queue string, from, to int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
 
-	var queue *post.Queue
-	err := row.Scan(&queue)
+	err = moveInQueue(ctx, tx, projectID, postQueueKind, queue, from, to)
 	if err != nil {
-		return queue, err
+		return err
 	}
+	return tx.Commit(ctx)
+}
+
+func (r *PostRepository) ShiftProjectQueue(ctx context.Context, projectID, queue string) (string, error) {
+	return shiftQueue(ctx, r.db, projectID, postQueueKind, queue)
+}
 
-	return queue, nil
+// FindPostQueue returns the name of the queue the post is in, or an empty string if it isn't queued
+func (r *PostRepository) FindPostQueue(ctx context.Context, projectID, postID string) (string, error) {
+	var name string
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT queue
+		FROM %s
+		WHERE project_id = $1 AND post_id = $2 AND kind = $3
+	`, QueueItems), projectID, postID, postQueueKind).Scan(&name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return name, nil
 }
 
-func (r *PostRepository) UpdateProjectPostQueue(ctx context.Context, projectID string, queue []string) error {
+func (r *PostRepository) SaveQueue(ctx context.Context, projectID string, q *post.NamedQueue) error {
 	_, err := r.db.Exec(ctx, fmt.Sprintf(`
-		UPDATE %s
-		SET post_queue = $2
-		WHERE id = $1
-	`, Projects), projectID, queue)
+		INSERT INTO %s (project_id, name, created_at)
+		VALUES ($1, $2, $3)
+	`, ProjectQueues), projectID, q.Name, q.CreatedAt)
+	return err
+}
+
+// FindQueues returns the default queue followed by the named queues of the project
+func (r *PostRepository) FindQueues(ctx context.Context, projectID string) ([]*post.NamedQueue, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT q.name, q.created_at,
+			(SELECT COUNT(*) FROM %[1]s qi WHERE qi.project_id = $1 AND qi.kind = $3 AND qi.queue = q.name)
+		FROM (
+			SELECT $2::text AS name, created_at, 0 AS o FROM %[2]s WHERE id = $1
+			UNION ALL
+			SELECT name, created_at, 1 AS o FROM %[3]s WHERE project_id = $1
+		) q
+		ORDER BY q.o, q.created_at
+	`, QueueItems, Projects, ProjectQueues), projectID, post.DefaultQueue, postQueueKind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var queues []*post.NamedQueue
+	for rows.Next() {
+		var q post.NamedQueue
+		err = rows.Scan(&q.Name, &q.CreatedAt, &q.Size)
+		if err != nil {
+			return nil, err
+		}
+		queues = append(queues, &q)
+	}
+	return queues, rows.Err()
+}
+
+// DeleteQueue deletes a named queue, moving its posts to the end of the default queue
+func (r *PostRepository) DeleteQueue(ctx context.Context, projectID, queue string) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return err
 	}
-	return nil
+	defer tx.Rollback(ctx)
+
+	err = lockProjectQueues(ctx, tx, projectID)
+	if err != nil {
+		return err
+	}
+
+	q, err := findQueue(ctx, tx, projectID, postQueueKind, queue)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE project_id = $1 AND kind = $2 AND queue = $3
+	`, QueueItems), projectID, postQueueKind, queue)
+	if err != nil {
+		return err
+	}
+	err = appendToQueue(ctx, tx, projectID, postQueueKind, post.DefaultQueue, q.Arr())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE project_id = $1 AND name = $2
+	`, ProjectQueues), projectID, queue)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 func (r *PostRepository) GetProjectIdeaQueue(ctx context.Context, projectID string) (*post.Queue, error) {
-	row := r.db.QueryRow(ctx, fmt.Sprintf(`
-		SELECT idea_queue
-		FROM %s
-		WHERE id = $1
-	`, Projects), projectID)
+	return findQueue(ctx, r.db, projectID, ideaQueueKind, post.DefaultQueue)
+}
 
-	var queue *post.Queue
-	err := row.Scan(&queue)
+func (r *PostRepository) MoveIdeaInProjectQueue(ctx context.Context, projectID string, from, to int) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return queue, err
+		return err
 	}
+	defer tx.Rollback(ctx)
 
-	return queue, nil
+	err = moveInQueue(ctx, tx, projectID, ideaQueueKind, post.DefaultQueue, from, to)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
-func (r *PostRepository) UpdateProjectIdeaQueue(ctx context.Context, projectID string, queue []string) error {
-	_, err := r.db.Exec(ctx, fmt.Sprintf(`
-		UPDATE %s
-		SET idea_queue = $2
-		WHERE id = $1
-	`, Projects), projectID, queue)
+func (r *PostRepository) AddToProjectQueue(ctx context.Context, projectID, queue, postID string) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return err
 	}
-	return nil
-}
+	defer tx.Rollback(ctx)
 
-func (r *PostRepository) AddToProjectQueue(ctx context.Context, projectID, postID string) error {
-	_, err := r.db.Exec(ctx, fmt.Sprintf(`
-		UPDATE %s
-		SET post_queue = array_append(post_queue, $2)
-		WHERE id = $1
-	`, Projects), projectID, postID)
+	err = lockProjectQueues(ctx, tx, projectID)
 	if err != nil {
 		return err
 	}
-	return nil
+	err = appendToQueue(ctx, tx, projectID, postQueueKind, queue, []string{postID})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
+// RemoveFromProjectQueue removes the post from whichever post queue of the project it is in
 func (r *PostRepository) RemoveFromProjectQueue(ctx context.Context, projectID, postID string) error {
 	_, err := r.db.Exec(ctx, fmt.Sprintf(`
-		UPDATE %s
-		SET post_queue = array_remove(post_queue, $2)
-		WHERE id = $1
-	`, Projects), projectID, postID)
+		DELETE FROM %s
+		WHERE project_id = $1 AND post_id = $2 AND kind = $3
+	`, QueueItems), projectID, postID, postQueueKind)
+	return err
+}
+
+func (r *PostRepository) UnqueuePost(ctx context.Context, projectID, postID string) error {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return err
 	}
-	return nil
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE project_id = $1 AND post_id = $2 AND kind = $3
+	`, QueueItems), projectID, postID, postQueueKind)
+	if err != nil {
+		return err
+	}
+	err = updateStatus(ctx, tx, postID, string(post.PostStatusDraft))
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
 func (r *PostRepository) AddToProjectIdeaQueue(ctx context.Context, projectID, postID string) error {
-	_, err := r.db.Exec(ctx, fmt.Sprintf(`
-		UPDATE %s
-		SET idea_queue = array_append(idea_queue, $2)
-		WHERE id = $1
-	`, Projects), projectID, postID)
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback(ctx)
 
-	return nil
-}
-
-func (r *PostRepository) RemoveFromProjectIdeaQueue(ctx context.Context, projectID, postID string) error {
-	_, err := r.db.Exec(ctx, fmt.Sprintf(`
-		UPDATE %s
-		SET idea_queue = array_remove(idea_queue, $2)
-		WHERE id = $1
-	`, Projects), projectID, postID)
+	err = lockProjectQueues(ctx, tx, projectID)
 	if err != nil {
 		return err
 	}
+	err = appendToQueue(ctx, tx, projectID, ideaQueueKind, post.DefaultQueue, []string{postID})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
 
-	return nil
+func (r *PostRepository) RemoveFromProjectIdeaQueue(ctx context.Context, projectID, postID string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE project_id = $1 AND post_id = $2 AND kind = $3
+	`, QueueItems), projectID, postID, ideaQueueKind)
+	return err
 }
 
 func (r *PostRepository) GetProjectQueuedPosts(ctx context.Context, projectID string, postIDs []string) ([]*post.Post, error) {
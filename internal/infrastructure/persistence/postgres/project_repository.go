@@ -8,6 +8,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
 	"github.com/redplanettribe/social-media-manager/internal/domain/project"
 )
 
@@ -15,15 +16,21 @@ type ProjectRepository struct {
 	db *pgxpool.Pool
 }
 
+// Default post queue and idea queue of the project aliased p
+var (
+	projectPostQueueSQL = queueArraySQL("p.id", postQueueKind, post.DefaultQueue)
+	projectIdeaQueueSQL = queueArraySQL("p.id", ideaQueueKind, post.DefaultQueue)
+)
+
 func NewProjectRepository(db *pgxpool.Pool) *ProjectRepository {
 	return &ProjectRepository{db: db}
 }
 
 func (r *ProjectRepository) Save(ctx context.Context, p *project.Project) (*project.Project, error) {
 	_, err := r.db.Exec(ctx, fmt.Sprintf(`
-		INSERT INTO %s (id, name, description, post_queue, idea_queue, created_by, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-	`, Projects), p.ID, p.Name, p.Description, p.PostQueue, p.IdeaQueue, p.CreatedBy, time.Now().UTC(), time.Now().UTC())
+		INSERT INTO %s (id, name, description, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, Projects), p.ID, p.Name, p.Description, p.CreatedBy, time.Now().UTC(), time.Now().UTC())
 	if err != nil {
 		return &project.Project{}, err
 	}
@@ -31,14 +38,18 @@ func (r *ProjectRepository) Save(ctx context.Context, p *project.Project) (*proj
 	return p, nil
 }
 
+// Update saves the project if it wasn't modified since it was read, and increments its version
 func (r *ProjectRepository) Update(ctx context.Context, p *project.Project) (*project.Project, error) {
-	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
 		UPDATE %s
-		SET name = $1, description = $2, updated_at = $3
-		WHERE id = $4
-		RETURNING id, name, description, post_queue, idea_queue, created_by, created_at, updated_at
-	`, Projects), p.Name, p.Description, time.Now().UTC(), p.ID)
+		SET name = $1, description = $2, updated_at = $3, version = version + 1
+		WHERE id = $4 AND version = $5
+		RETURNING version, updated_at
+	`, Projects), p.Name, p.Description, time.Now().UTC(), p.ID, p.Version).Scan(&p.Version, &p.UpdatedAt)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, project.ErrVersionConflict
+		}
 		return &project.Project{}, err
 	}
 
@@ -59,11 +70,11 @@ func (r *ProjectRepository) Delete(ctx context.Context, projectID string) error
 
 func (r *ProjectRepository) ListByUserID(ctx context.Context, userID string) ([]*project.Project, error) {
 	rows, err := r.db.Query(ctx, fmt.Sprintf(`
-		SELECT p.id, p.name, p.description, p.post_queue, p.idea_queue, p.created_by, p.created_at, p.updated_at
+		SELECT p.id, p.name, p.description, %s, %s, p.version, p.created_by, p.created_at, p.updated_at
 		FROM %s p
 		INNER JOIN %s tm ON p.id = tm.project_id
 		WHERE tm.user_id = $1
-	`, Projects, TeamMembers), userID)
+	`, projectPostQueueSQL, projectIdeaQueueSQL, Projects, TeamMembers), userID)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +83,7 @@ func (r *ProjectRepository) ListByUserID(ctx context.Context, userID string) ([]
 	var projects []*project.Project
 	for rows.Next() {
 		p := &project.Project{}
-		err = rows.Scan(&p.ID, &p.Name, &p.Description, &p.PostQueue, &p.IdeaQueue, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
+		err = rows.Scan(&p.ID, &p.Name, &p.Description, &p.PostQueue, &p.IdeaQueue, &p.Version, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -191,13 +202,13 @@ func (r *ProjectRepository) RemoveUserRole(ctx context.Context, projectID, userI
 
 func (r *ProjectRepository) FindProjectByID(ctx context.Context, projectID string) (*project.Project, error) {
 	row := r.db.QueryRow(ctx, fmt.Sprintf(`
-		SELECT id, name, description, post_queue, idea_queue, created_by, created_at, updated_at
-		FROM %s
-		WHERE id = $1
-	`, Projects), projectID)
+		SELECT p.id, p.name, p.description, %s, %s, p.version, p.created_by, p.created_at, p.updated_at
+		FROM %s p
+		WHERE p.id = $1
+	`, projectPostQueueSQL, projectIdeaQueueSQL, Projects), projectID)
 
 	p := &project.Project{}
-	err := row.Scan(&p.ID, &p.Name, &p.Description, &p.PostQueue, &p.IdeaQueue, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
+	err := row.Scan(&p.ID, &p.Name, &p.Description, &p.PostQueue, &p.IdeaQueue, &p.Version, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -465,13 +476,16 @@ func (r *ProjectRepository) DeleteProject(ctx context.Context, projectID string)
 
 func (r *ProjectRepository) GetProjectSchedule(ctx context.Context, projectID string) (*project.WeeklyPostSchedule, error) {
 	row := r.db.QueryRow(ctx, fmt.Sprintf(`
-		SELECT schedule
+		SELECT schedule, schedule_version
 		FROM %s
 		WHERE project_id = $1
 	`, ProjectSettings), projectID)
 
-	var encoded string
-	err := row.Scan(&encoded)
+	var (
+		encoded string
+		version int
+	)
+	err := row.Scan(&encoded, &version)
 	if err != nil {
 		return nil, err
 	}
@@ -480,21 +494,27 @@ func (r *ProjectRepository) GetProjectSchedule(ctx context.Context, projectID st
 	if err != nil {
 		return nil, err
 	}
+	schedule.Version = version
 
 	return schedule, nil
 }
 
+// SaveSchedule saves the schedule if it wasn't modified since it was read, and increments its version
 func (r *ProjectRepository) SaveSchedule(ctx context.Context, projectID string, schedule *project.WeeklyPostSchedule) error {
 	encoded, err := schedule.Encode()
 	if err != nil {
 		return err
 	}
 
-	_, err = r.db.Exec(ctx, fmt.Sprintf(`
+	err = r.db.QueryRow(ctx, fmt.Sprintf(`
 		UPDATE %s
-		SET schedule = $1
-		WHERE project_id = $2
-	`, ProjectSettings), encoded, projectID)
+		SET schedule = $1, schedule_version = schedule_version + 1
+		WHERE project_id = $2 AND schedule_version = $3
+		RETURNING schedule_version
+	`, ProjectSettings), encoded, projectID, schedule.Version).Scan(&schedule.Version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return project.ErrVersionConflict
+	}
 
 	return err
 }
@@ -515,13 +535,12 @@ func (r *ProjectRepository) CreateProjectSettings(ctx context.Context, projectID
 
 func (r *ProjectRepository) FindActiveProjectsChunk(ctx context.Context, offset, chunkSize int) ([]*project.Project, error) {
 	rows, err := r.db.Query(ctx, fmt.Sprintf(`
-		SELECT id, name, description, post_queue, idea_queue, created_by, created_at, updated_at
-		FROM %s
-		WHERE post_queue IS NOT NULL
-		AND cardinality(post_queue) > 0
-		ORDER BY created_at DESC
+		SELECT p.id, p.name, p.description, %s, %s, p.version, p.created_by, p.created_at, p.updated_at
+		FROM %s p
+		WHERE EXISTS (SELECT 1 FROM %s qi WHERE qi.project_id = p.id AND qi.kind = $3)
+		ORDER BY p.created_at DESC
 		LIMIT $1 OFFSET $2
-	`, Projects), chunkSize, offset)
+	`, projectPostQueueSQL, projectIdeaQueueSQL, Projects, QueueItems), chunkSize, offset, postQueueKind)
 	if err != nil {
 		return nil, err
 	}
@@ -530,7 +549,7 @@ func (r *ProjectRepository) FindActiveProjectsChunk(ctx context.Context, offset,
 	var projects []*project.Project
 	for rows.Next() {
 		p := &project.Project{}
-		err = rows.Scan(&p.ID, &p.Name, &p.Description, &p.PostQueue, &p.IdeaQueue, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
+		err = rows.Scan(&p.ID, &p.Name, &p.Description, &p.PostQueue, &p.IdeaQueue, &p.Version, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -607,3 +626,51 @@ func (r *ProjectRepository) GetPlatformInfo(ctx context.Context, userID, platfor
 
 	return pInfo, nil
 }
+
+func (r *ProjectRepository) GetPreflightMode(ctx context.Context, projectID string) (project.PreflightMode, error) {
+	return getPreflightMode(ctx, r.db, projectID)
+}
+
+// getPreflightMode is shared by the project settings and the publisher, so both read the same mode
+func getPreflightMode(ctx context.Context, db *pgxpool.Pool, projectID string) (project.PreflightMode, error) {
+	var mode project.PreflightMode
+	err := db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT preflight_mode
+		FROM %s
+		WHERE project_id = $1
+	`, ProjectSettings), projectID).Scan(&mode)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", err
+	} else if errors.Is(err, pgx.ErrNoRows) {
+		// Projects without settings refuse invalid posts
+		return project.PreflightModeStrict, nil
+	}
+
+	return mode, nil
+}
+
+func (r *ProjectRepository) SetPreflightMode(ctx context.Context, projectID string, mode project.PreflightMode) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET preflight_mode = $1, updated_at = $2
+		WHERE project_id = $3
+	`, ProjectSettings), mode, time.Now().UTC(), projectID)
+
+	return err
+}
+
+func (r *ProjectRepository) DoesQueueExist(ctx context.Context, projectID, queue string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT EXISTS(
+			SELECT 1
+			FROM %s
+			WHERE project_id = $1 AND name = $2
+		)
+	`, ProjectQueues), projectID, queue).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
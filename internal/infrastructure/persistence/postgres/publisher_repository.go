@@ -8,6 +8,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redplanettribe/social-media-manager/internal/domain/project"
 	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 )
 
@@ -171,3 +172,7 @@ func (r *PublisherRepository) AddProfileTag(ctx context.Context, platformID, pos
     `, PostPlatforms), platformID, postID, tag)
 	return err
 }
+
+func (r *PublisherRepository) GetPreflightMode(ctx context.Context, projectID string) (project.PreflightMode, error) {
+	return getPreflightMode(ctx, r.db, projectID)
+}
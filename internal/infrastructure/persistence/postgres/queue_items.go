@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+)
+
+// Kinds of queues stored in the queue_items table
+const (
+	postQueueKind = "post"
+	ideaQueueKind = "idea"
+)
+
+// queuePositionGap is the space left between two consecutive items, so most moves only update one row
+const queuePositionGap = 1024.0
+
+// minQueuePositionGap is the smallest space between two items before the queue is renumbered
+const minQueuePositionGap = 1e-6
+
+// dbtx is implemented by both the pool and a transaction
+type dbtx interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// lockProjectQueues serializes the changes to the queues of a project until the transaction ends
+func lockProjectQueues(ctx context.Context, tx pgx.Tx, projectID string) error {
+	_, err := tx.Exec(ctx, fmt.Sprintf(`
+		SELECT 1 FROM %s WHERE id = $1 FOR UPDATE
+	`, Projects), projectID)
+	return err
+}
+
+type queueItem struct {
+	postID   string
+	position float64
+}
+
+func findQueueItems(ctx context.Context, db dbtx, projectID, kind, queue string) ([]queueItem, error) {
+	rows, err := db.Query(ctx, fmt.Sprintf(`
+		SELECT post_id, position
+		FROM %s
+		WHERE project_id = $1 AND kind = $2 AND queue = $3
+		ORDER BY position, post_id
+	`, QueueItems), projectID, kind, queue)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []queueItem
+	for rows.Next() {
+		var item queueItem
+		err = rows.Scan(&item.postID, &item.position)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func findQueue(ctx context.Context, db dbtx, projectID, kind, queue string) (*post.Queue, error) {
+	items, err := findQueueItems(ctx, db, projectID, kind, queue)
+	if err != nil {
+		return nil, err
+	}
+	q := make(post.Queue, 0, len(items))
+	for _, item := range items {
+		q.Add(item.postID)
+	}
+	return &q, nil
+}
+
+// appendToQueue adds the posts at the end of a queue. Posts that are already queued are left where they are.
+func appendToQueue(ctx context.Context, db dbtx, projectID, kind, queue string, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	_, err := db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %[1]s (post_id, project_id, kind, queue, position)
+		SELECT t.post_id, $1, $2, $3,
+			COALESCE((SELECT MAX(position) FROM %[1]s WHERE project_id = $1 AND kind = $2 AND queue = $3), 0) + t.ord * $5
+		FROM unnest($4::uuid[]) WITH ORDINALITY AS t(post_id, ord)
+		ON CONFLICT (post_id) DO NOTHING
+	`, QueueItems), projectID, kind, queue, postIDs, queuePositionGap)
+	return err
+}
+
+// renumberQueue spreads the positions of the given posts again, in the given order
+func renumberQueue(ctx context.Context, db dbtx, postIDs []string) error {
+	_, err := db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s qi
+		SET position = t.ord * $2
+		FROM unnest($1::uuid[]) WITH ORDINALITY AS t(post_id, ord)
+		WHERE qi.post_id = t.post_id
+	`, QueueItems), postIDs, queuePositionGap)
+	return err
+}
+
+// moveInQueue moves the post at index from to index to, with the same bounds as post.Queue.Move.
+// Only the moved row changes unless its neighbours are too close, then the whole queue is renumbered.
+func moveInQueue(ctx context.Context, tx pgx.Tx, projectID, kind, queue string, from, to int) error {
+	err := lockProjectQueues(ctx, tx, projectID)
+	if err != nil {
+		return err
+	}
+	items, err := findQueueItems(ctx, tx, projectID, kind, queue)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	positions := make(map[string]float64, len(items))
+	q := make(post.Queue, 0, len(items))
+	for _, item := range items {
+		positions[item.postID] = item.position
+		q.Add(item.postID)
+	}
+	if from >= q.Len() {
+		from = q.Len() - 1
+	}
+	postID := q.Get(from)
+	q.Move(from, to)
+
+	index := 0
+	for i, id := range q.Arr() {
+		if id == postID {
+			index = i
+			break
+		}
+	}
+
+	var position float64
+	switch {
+	case q.Len() == 1:
+		return nil
+	case index == 0:
+		position = positions[q.Get(1)] - queuePositionGap
+	case index == q.Len()-1:
+		position = positions[q.Get(index-1)] + queuePositionGap
+	default:
+		prev, next := positions[q.Get(index-1)], positions[q.Get(index+1)]
+		if next-prev < minQueuePositionGap {
+			return renumberQueue(ctx, tx, q.Arr())
+		}
+		position = (prev + next) / 2
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s SET position = $2 WHERE post_id = $1
+	`, QueueItems), postID, position)
+	return err
+}
+
+// shiftQueue removes the first post of a queue and returns it, or an empty string if the queue is empty
+func shiftQueue(ctx context.Context, db dbtx, projectID, kind, queue string) (string, error) {
+	var postID string
+	err := db.QueryRow(ctx, fmt.Sprintf(`
+		DELETE FROM %[1]s
+		WHERE post_id = (
+			SELECT post_id
+			FROM %[1]s
+			WHERE project_id = $1 AND kind = $2 AND queue = $3
+			ORDER BY position, post_id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING post_id
+	`, QueueItems), projectID, kind, queue).Scan(&postID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return postID, nil
+}
+
+// queueArraySQL selects the ordered post ids of a queue of the project in the column named by projectColumn.
+// kind and queue are written in the query, so they must be constants.
+func queueArraySQL(projectColumn, kind, queue string) string {
+	return fmt.Sprintf(`ARRAY(
+			SELECT qi.post_id::text FROM %s qi
+			WHERE qi.project_id = %s AND qi.kind = '%s' AND qi.queue = '%s'
+			ORDER BY qi.position, qi.post_id
+		)`, QueueItems, projectColumn, kind, queue)
+}
@@ -17,4 +17,19 @@ const (
 	Comments          TableNames = "comments"
 	ProjectSettings   TableNames = "project_settings"
 	UserPlatforms     TableNames = "user_platforms"
+	Notifications     TableNames = "notifications"
+	Labels            TableNames = "labels"
+	PostLabels        TableNames = "post_labels"
+	Campaigns         TableNames = "campaigns"
+	CampaignPosts     TableNames = "campaign_posts"
+	PostTemplates     TableNames = "post_templates"
+	Snippets          TableNames = "snippets"
+	IdeaVotes         TableNames = "idea_votes"
+	Feeds             TableNames = "feeds"
+	FeedItems         TableNames = "feed_items"
+	ProjectQueues     TableNames = "project_queues"
+	QueueItems        TableNames = "queue_items"
+	MediaUploads      TableNames = "media_uploads"
+	MediaRenditions   TableNames = "media_renditions"
+	ProjectBranding   TableNames = "project_branding"
 )
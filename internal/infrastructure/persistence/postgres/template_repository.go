@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redplanettribe/social-media-manager/internal/domain/template"
+)
+
+type TemplateRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTemplateRepository(db *pgxpool.Pool) *TemplateRepository {
+	return &TemplateRepository{db: db}
+}
+
+func (r *TemplateRepository) SaveTemplate(ctx context.Context, t *template.Template) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, project_id, name, title, type, text_content, platforms, variables, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, PostTemplates), t.ID, t.ProjectID, t.Name, t.Title, t.Type, t.TextContent, t.Platforms, t.Variables, t.CreatedBy, t.CreatedAt, t.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *TemplateRepository) UpdateTemplate(ctx context.Context, t *template.Template) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET name = $2, title = $3, type = $4, text_content = $5, platforms = $6, variables = $7, updated_at = $8
+		WHERE id = $1
+	`, PostTemplates), t.ID, t.Name, t.Title, t.Type, t.TextContent, t.Platforms, t.Variables, t.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *TemplateRepository) DeleteTemplate(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE id = $1
+	`, PostTemplates), id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *TemplateRepository) FindTemplateByID(ctx context.Context, id string) (*template.Template, error) {
+	t := &template.Template{}
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT id, project_id, name, title, type, text_content, platforms, variables, created_by, created_at, updated_at
+		FROM %s
+		WHERE id = $1
+	`, PostTemplates), id).Scan(&t.ID, &t.ProjectID, &t.Name, &t.Title, &t.Type, &t.TextContent, &t.Platforms, &t.Variables, &t.CreatedBy, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	} else if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return t, nil
+}
+
+func (r *TemplateRepository) FindTemplatesByProjectID(ctx context.Context, projectID string) ([]*template.Template, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT id, project_id, name, title, type, text_content, platforms, variables, created_by, created_at, updated_at
+		FROM %s
+		WHERE project_id = $1
+		ORDER BY name
+	`, PostTemplates), projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []*template.Template
+	for rows.Next() {
+		t := &template.Template{}
+		err = rows.Scan(&t.ID, &t.ProjectID, &t.Name, &t.Title, &t.Type, &t.TextContent, &t.Platforms, &t.Variables, &t.CreatedBy, &t.CreatedAt, &t.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, t)
+	}
+	return templates, nil
+}
+
+func (r *TemplateRepository) SaveSnippet(ctx context.Context, s *template.Snippet) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		INSERT INTO %s (id, project_id, name, content, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, Snippets), s.ID, s.ProjectID, s.Name, s.Content, s.CreatedAt, s.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *TemplateRepository) UpdateSnippet(ctx context.Context, s *template.Snippet) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET name = $2, content = $3, updated_at = $4
+		WHERE id = $1
+	`, Snippets), s.ID, s.Name, s.Content, s.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *TemplateRepository) DeleteSnippet(ctx context.Context, id string) error {
+	_, err := r.db.Exec(ctx, fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE id = $1
+	`, Snippets), id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *TemplateRepository) FindSnippetByID(ctx context.Context, id string) (*template.Snippet, error) {
+	s := &template.Snippet{}
+	err := r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT id, project_id, name, content, created_at, updated_at
+		FROM %s
+		WHERE id = $1
+	`, Snippets), id).Scan(&s.ID, &s.ProjectID, &s.Name, &s.Content, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	} else if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return s, nil
+}
+
+func (r *TemplateRepository) FindSnippetsByProjectID(ctx context.Context, projectID string) ([]*template.Snippet, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT id, project_id, name, content, created_at, updated_at
+		FROM %s
+		WHERE project_id = $1
+		ORDER BY name
+	`, Snippets), projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var snippets []*template.Snippet
+	for rows.Next() {
+		s := &template.Snippet{}
+		err = rows.Scan(&s.ID, &s.ProjectID, &s.Name, &s.Content, &s.CreatedAt, &s.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		snippets = append(snippets, s)
+	}
+	return snippets, nil
+}
+
+func (r *TemplateRepository) FindSnippetsByNames(ctx context.Context, projectID string, names []string) (map[string]string, error) {
+	rows, err := r.db.Query(ctx, fmt.Sprintf(`
+		SELECT name, content
+		FROM %s
+		WHERE project_id = $1 AND name = ANY($2)
+	`, Snippets), projectID, names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	snippets := make(map[string]string)
+	for rows.Next() {
+		var name, content string
+		err = rows.Scan(&name, &content)
+		if err != nil {
+			return nil, err
+		}
+		snippets[name] = content
+	}
+	return snippets, nil
+}
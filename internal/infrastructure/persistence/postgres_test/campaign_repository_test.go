@@ -0,0 +1,43 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/campaign"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/persistence/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCampaignRepository_GetPlatformSummaries_SkipsTrash(t *testing.T) {
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	postRepo := postgres.NewPostRepository(dbPool)
+	campaignRepo := postgres.NewCampaignRepository(dbPool)
+
+	start := time.Now()
+	c, err := campaign.NewCampaign(projectID, userID, "Launch", "", start, start.Add(24*time.Hour))
+	assert.NoError(t, err)
+	assert.NoError(t, campaignRepo.Save(ctx, c))
+
+	for _, title := range []string{"Kept", "Trashed"} {
+		p, err := post.NewPost(projectID, userID, title, "", "Content", false, time.Time{})
+		assert.NoError(t, err)
+		assert.NoError(t, postRepo.Save(ctx, p))
+		assert.NoError(t, postRepo.AddSocialMediaPublisher(ctx, p.ID, "linkedin"))
+		assert.NoError(t, campaignRepo.AddPost(ctx, c.ID, p.ID))
+		if title == "Trashed" {
+			assert.NoError(t, postRepo.TrashPost(ctx, p.ID, time.Now()))
+		}
+	}
+
+	summaries, err := campaignRepo.GetPlatformSummaries(ctx, c.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, summaries, 1) {
+		assert.Equal(t, "linkedin", summaries[0].PlatformID)
+		assert.Equal(t, 1, summaries[0].Total)
+		assert.Equal(t, 1, summaries[0].Ready)
+	}
+}
@@ -0,0 +1,49 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// requireDB skips the test when the database of the configuration can't be reached. The tests expect its schema
+// to be migrated.
+func requireDB(t *testing.T) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := dbPool.Ping(ctx); err != nil {
+		t.Skipf("database not available: %v", err)
+	}
+}
+
+// newTestProject creates a user and a project of theirs, removed with everything in it when the test ends.
+// It returns the ids of the project and of the user.
+func newTestProject(t *testing.T) (projectID, userID string) {
+	t.Helper()
+	requireDB(t)
+	ctx := context.Background()
+	userID, projectID = uuid.NewString(), uuid.NewString()
+
+	_, err := dbPool.Exec(ctx, `
+		INSERT INTO users (id, username, first_name, last_name, email, password_hash, salt)
+		VALUES ($1, 'tester', 'Test', 'User', $2, 'hash', 'salt')
+	`, userID, userID+"@example.com")
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	_, err = dbPool.Exec(ctx, `
+		INSERT INTO projects (id, name, description, created_by)
+		VALUES ($1, $2, '', $3)
+	`, projectID, "project "+projectID, userID)
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = dbPool.Exec(context.Background(), `DELETE FROM users WHERE id = $1`, userID)
+	})
+	return projectID, userID
+}
@@ -0,0 +1,71 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/idea"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/persistence/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdeaRepository_PromoteIdea(t *testing.T) {
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	postRepo := postgres.NewPostRepository(dbPool)
+	ideaRepo := postgres.NewIdeaRepository(dbPool)
+
+	i, err := post.NewPost(projectID, userID, "Idea", "", "Some idea", true, time.Time{})
+	assert.NoError(t, err)
+	assert.NoError(t, postRepo.Save(ctx, i))
+	draft := idea.NewDraftFromIdea(i)
+
+	err = ideaRepo.PromoteIdea(ctx, i, draft)
+	assert.NoError(t, err)
+
+	// The draft is read like any other post
+	got, err := postRepo.FindByID(ctx, draft.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.True(t, got.ScheduledAt.IsZero())
+		assert.Equal(t, i.ID, *got.IdeaID)
+	}
+	posts, err := postRepo.FindByProjectID(ctx, projectID)
+	assert.NoError(t, err)
+	assert.Len(t, posts, 2)
+}
+
+func TestIdeaRepository_PromoteIdea_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	postRepo := postgres.NewPostRepository(dbPool)
+	ideaRepo := postgres.NewIdeaRepository(dbPool)
+
+	i, err := post.NewPost(projectID, userID, "Idea", "", "Some idea", true, time.Time{})
+	assert.NoError(t, err)
+	assert.NoError(t, postRepo.Save(ctx, i))
+
+	const promotions = 5
+	errs := make(chan error, promotions)
+	for range promotions {
+		go func() {
+			errs <- ideaRepo.PromoteIdea(ctx, i, idea.NewDraftFromIdea(i))
+		}()
+	}
+	var promoted int
+	for range promotions {
+		err := <-errs
+		if err == nil {
+			promoted++
+			continue
+		}
+		assert.ErrorIs(t, err, idea.ErrIdeaAlreadyPromoted)
+	}
+	assert.Equal(t, 1, promoted)
+
+	posts, err := postRepo.FindByProjectID(ctx, projectID)
+	assert.NoError(t, err)
+	assert.Len(t, posts, 2)
+}
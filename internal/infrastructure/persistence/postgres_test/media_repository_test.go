@@ -0,0 +1,77 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/persistence/postgres"
+)
+
+func TestMediaRepository_LockContent(t *testing.T) {
+	projectID, _ := newTestProject(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// With a single connection, fn can only query through the one holding the lock
+	conf := dbPool.Config()
+	conf.MaxConns = 1
+	conf.MinConns = 0
+	pool, err := pgxpool.NewWithConfig(ctx, conf)
+	assert.NoError(t, err)
+	defer pool.Close()
+	repo := postgres.NewMediaRepository(pool)
+
+	err = repo.LockContent(ctx, projectID, "hash-1", func(repo media.Repository) error {
+		referenced, err := repo.IsContentReferenced(ctx, projectID, "hash-1")
+		assert.False(t, referenced)
+		return err
+	})
+	assert.NoError(t, err)
+
+	// The lock is released with the connection
+	err = repo.LockContent(ctx, projectID, "hash-1", func(media.Repository) error { return nil })
+	assert.NoError(t, err)
+}
+
+func TestMediaRepository_DeletePostMedia(t *testing.T) {
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	p, err := post.NewPost(projectID, userID, "Title", "", "Caption", false, time.Time{})
+	assert.NoError(t, err)
+	assert.NoError(t, postgres.NewPostRepository(dbPool).Save(ctx, p))
+	repo := postgres.NewMediaRepository(dbPool)
+	info := &media.MediaInfo{Type: media.MediaTypeImage, Format: "png", Width: 1, Height: 1}
+	save := func(postID, fileName string) *media.MetaData {
+		md, err := media.NewMetadata(projectID, postID, userID, fileName, "", info)
+		assert.NoError(t, err)
+		_, err = repo.SaveMetadata(ctx, md)
+		assert.NoError(t, err)
+		return md
+	}
+	photo := save(p.ID, "photo.png")
+	save(p.ID, "thumbnail_photo.png")
+	logo := save("", "logo.png")
+
+	t.Run("doesn't find library media through a post", func(t *testing.T) {
+		got, err := repo.FindPostMediaByID(ctx, projectID, p.ID, logo.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("deletes the media of the post with its thumbnail", func(t *testing.T) {
+		got, err := repo.FindPostMediaByID(ctx, projectID, p.ID, photo.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, got)
+
+		assert.NoError(t, repo.DeletePostMedia(ctx, projectID, p.ID, photo.ID, "thumbnail_photo.png"))
+		thumbnail, err := repo.GetMetadata(ctx, projectID, p.ID, "thumbnail_photo.png")
+		assert.NoError(t, err)
+		assert.Nil(t, thumbnail)
+	})
+}
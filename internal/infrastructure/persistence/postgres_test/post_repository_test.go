@@ -9,8 +9,11 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
 
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
 	"github.com/redplanettribe/social-media-manager/internal/infrastructure/config"
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/persistence/postgres"
 )
 
 var dbPool *pgxpool.Pool
@@ -66,3 +69,152 @@ func TestMain(m *testing.M) {
 // 	assert.NoError(t, err)
 // 	assert.NotEmpty(t, posts)
 // }
+
+func TestPostRepository_SaveWithPlatforms(t *testing.T) {
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	repo := postgres.NewPostRepository(dbPool)
+	p, err := post.NewPost(projectID, userID, "Title", "", "Caption", false, time.Time{})
+	assert.NoError(t, err)
+
+	// The platform doesn't exist, the post isn't kept without it
+	err = repo.SaveWithPlatforms(ctx, p, []string{"unknown"})
+	assert.Error(t, err)
+
+	got, err := repo.FindByID(ctx, p.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestPostRepository_Update_VersionConflict(t *testing.T) {
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	repo := postgres.NewPostRepository(dbPool)
+	p, err := post.NewPost(projectID, userID, "Title", "", "Caption", false, time.Time{})
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, p))
+
+	first, err := repo.FindByID(ctx, p.ID)
+	assert.NoError(t, err)
+	second, err := repo.FindByID(ctx, p.ID)
+	assert.NoError(t, err)
+
+	first.TextContent = "Edited caption"
+	assert.NoError(t, repo.Update(ctx, first))
+	// The second edit is based on the version replaced by the first one, it's answered with a 412
+	second.TextContent = "Other caption"
+	err = repo.Update(ctx, second)
+	assert.ErrorIs(t, err, post.ErrVersionConflict)
+}
+
+func TestPostRepository_UpdateStatus(t *testing.T) {
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	repo := postgres.NewPostRepository(dbPool)
+	p, err := post.NewPost(projectID, userID, "Title", "", "Caption", false, time.Time{})
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, p))
+
+	t.Run("keeps the edits made meanwhile", func(t *testing.T) {
+		edited, err := repo.FindByID(ctx, p.ID)
+		assert.NoError(t, err)
+		edited.TextContent = "Edited caption"
+		assert.NoError(t, repo.Update(ctx, edited))
+
+		err = repo.UpdateStatus(ctx, p.ID, string(post.PostStatusPublished))
+		assert.NoError(t, err)
+
+		got, err := repo.FindByID(ctx, p.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, string(post.PostStatusPublished), got.Status)
+		assert.Equal(t, "Edited caption", got.TextContent)
+		assert.Equal(t, edited.Version+1, got.Version)
+		assert.NotNil(t, got.PublishedAt)
+	})
+
+	t.Run("fails for missing posts", func(t *testing.T) {
+		err := repo.UpdateStatus(ctx, "00000000-0000-0000-0000-000000000000", string(post.PostStatusFailed))
+		assert.ErrorIs(t, err, post.ErrPostNotFound)
+	})
+}
+
+func TestPostRepository_UnqueuePost(t *testing.T) {
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	repo := postgres.NewPostRepository(dbPool)
+	p, err := post.NewPost(projectID, userID, "Title", "", "Caption", false, time.Time{})
+	assert.NoError(t, err)
+	p.Status = string(post.PostStatusQueued)
+	assert.NoError(t, repo.Save(ctx, p))
+	assert.NoError(t, repo.AddToProjectQueue(ctx, projectID, post.DefaultQueue, p.ID))
+
+	err = repo.UnqueuePost(ctx, projectID, p.ID)
+	assert.NoError(t, err)
+
+	queue, err := repo.FindPostQueue(ctx, projectID, p.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, queue)
+	got, err := repo.FindByID(ctx, p.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, string(post.PostStatusDraft), got.Status)
+}
+
+func TestPostRepository_DeletePost(t *testing.T) {
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	repo := postgres.NewPostRepository(dbPool)
+	p, err := post.NewPost(projectID, userID, "Title", "", "Caption", false, time.Time{})
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, p))
+	deletedAt := time.Now().Add(-48 * time.Hour)
+
+	t.Run("keeps posts out of the trash", func(t *testing.T) {
+		err := repo.DeletePost(ctx, p.ID, time.Now())
+		assert.ErrorIs(t, err, post.ErrPostNotInTrash)
+	})
+
+	t.Run("keeps posts trashed after the cutoff", func(t *testing.T) {
+		assert.NoError(t, repo.TrashPost(ctx, p.ID, deletedAt))
+		err := repo.DeletePost(ctx, p.ID, deletedAt.Add(-time.Hour))
+		assert.ErrorIs(t, err, post.ErrPostNotInTrash)
+	})
+
+	t.Run("keeps posts restored meanwhile", func(t *testing.T) {
+		assert.NoError(t, repo.RestoreFromTrash(ctx, p.ID))
+		err := repo.DeletePost(ctx, p.ID, time.Now())
+		assert.ErrorIs(t, err, post.ErrPostNotInTrash)
+	})
+
+	t.Run("deletes expired posts", func(t *testing.T) {
+		assert.NoError(t, repo.TrashPost(ctx, p.ID, deletedAt))
+		assert.NoError(t, repo.DeletePost(ctx, p.ID, time.Now()))
+		trashed, err := repo.FindTrashedPost(ctx, projectID, p.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, trashed)
+	})
+}
+
+func TestPostRepository_Update_ResetsPreflight(t *testing.T) {
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	repo := postgres.NewPostRepository(dbPool)
+	p, err := post.NewPost(projectID, userID, "Title", "", "Caption", false, time.Time{})
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Save(ctx, p))
+	scheduledAt := time.Now().Add(time.Hour)
+	assert.NoError(t, repo.SchedulePost(ctx, p.ID, scheduledAt))
+	assert.NoError(t, repo.MarkPreflightChecked(ctx, p.ID))
+
+	edited, err := repo.FindByID(ctx, p.ID)
+	assert.NoError(t, err)
+	edited.TextContent = "Edited caption"
+	assert.NoError(t, repo.Update(ctx, edited))
+
+	due, err := repo.FindPostsDueForPreflight(ctx, scheduledAt.Add(time.Minute), 0, 100)
+	assert.NoError(t, err)
+	var ids []string
+	for _, d := range due {
+		ids = append(ids, d.ID)
+	}
+	assert.Contains(t, ids, p.ID, "the edited post is checked again")
+}
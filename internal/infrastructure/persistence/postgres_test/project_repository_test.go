@@ -0,0 +1,41 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/project"
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/persistence/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPreflightMode_WithoutSettings(t *testing.T) {
+	ctx := context.Background()
+	projectID, _ := newTestProject(t)
+
+	// The project settings and the publisher read the same default
+	mode, err := postgres.NewProjectRepository(dbPool).GetPreflightMode(ctx, projectID)
+	assert.NoError(t, err)
+	assert.Equal(t, project.PreflightModeStrict, mode)
+
+	mode, err = postgres.NewPublisherRepository(dbPool).GetPreflightMode(ctx, projectID)
+	assert.NoError(t, err)
+	assert.Equal(t, project.PreflightModeStrict, mode)
+}
+
+func TestProjectRepository_SaveSchedule_VersionConflict(t *testing.T) {
+	ctx := context.Background()
+	projectID, _ := newTestProject(t)
+	repo := postgres.NewProjectRepository(dbPool)
+	assert.NoError(t, repo.CreateProjectSettings(ctx, projectID, project.NewWeeklyPostSchedule(nil)))
+
+	first, err := repo.GetProjectSchedule(ctx, projectID)
+	assert.NoError(t, err)
+	second, err := repo.GetProjectSchedule(ctx, projectID)
+	assert.NoError(t, err)
+
+	assert.NoError(t, repo.SaveSchedule(ctx, projectID, first))
+	// The second change is based on the version replaced by the first one, it's answered with a 412
+	err = repo.SaveSchedule(ctx, projectID, second)
+	assert.ErrorIs(t, err, project.ErrVersionConflict)
+}
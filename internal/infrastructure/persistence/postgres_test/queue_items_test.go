@@ -0,0 +1,176 @@
+package postgres_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/infrastructure/persistence/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+// newQueuedPosts creates n posts in a new project and appends them to its default queue, in order
+func newQueuedPosts(t *testing.T, n int) (projectID string, postIDs []string) {
+	t.Helper()
+	ctx := context.Background()
+	projectID, userID := newTestProject(t)
+	repo := postgres.NewPostRepository(dbPool)
+	for range n {
+		p, err := post.NewPost(projectID, userID, "Queued", "", "Content", false, time.Time{})
+		assert.NoError(t, err)
+		assert.NoError(t, repo.Save(ctx, p))
+		assert.NoError(t, repo.AddToProjectQueue(ctx, projectID, post.DefaultQueue, p.ID))
+		postIDs = append(postIDs, p.ID)
+	}
+	return projectID, postIDs
+}
+
+func queuePositions(t *testing.T, projectID string) map[string]float64 {
+	t.Helper()
+	rows, err := dbPool.Query(context.Background(), `
+		SELECT post_id, position FROM queue_items WHERE project_id = $1
+	`, projectID)
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	positions := make(map[string]float64)
+	for rows.Next() {
+		var (
+			postID   string
+			position float64
+		)
+		assert.NoError(t, rows.Scan(&postID, &position))
+		positions[postID] = position
+	}
+	assert.NoError(t, rows.Err())
+	return positions
+}
+
+func TestPostRepository_MovePostInProjectQueue(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewPostRepository(dbPool)
+
+	tests := []struct {
+		name     string
+		from, to int
+	}{
+		{"moves to the head", 3, 0},
+		{"moves to the tail", 0, 3},
+		{"moves to the middle", 0, 2},
+		{"moves back to the middle", 3, 1},
+		{"clamps the target to the tail", 1, 10},
+		{"clamps the target to the head", 2, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectID, postIDs := newQueuedPosts(t, 4)
+			before := queuePositions(t, projectID)
+			want := post.Queue(append([]string(nil), postIDs...))
+			want.Move(tt.from, tt.to)
+
+			err := repo.MovePostInProjectQueue(ctx, projectID, post.DefaultQueue, tt.from, tt.to)
+			assert.NoError(t, err)
+
+			// The queue is ordered as post.Queue.Move orders it
+			got, err := repo.GetProjectPostQueue(ctx, projectID, post.DefaultQueue)
+			assert.NoError(t, err)
+			assert.Equal(t, want.Arr(), got.Arr())
+
+			// Only the moved post changes position
+			after := queuePositions(t, projectID)
+			for _, id := range postIDs {
+				if id != postIDs[tt.from] {
+					assert.Equal(t, before[id], after[id])
+				}
+			}
+		})
+	}
+}
+
+func TestPostRepository_MovePostInProjectQueue_Renumbers(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewPostRepository(dbPool)
+	projectID, postIDs := newQueuedPosts(t, 3)
+
+	// The first two posts are brought closer than the smallest gap a post can be moved into
+	_, err := dbPool.Exec(ctx, `
+		UPDATE queue_items SET position = 1024.0000000001 WHERE post_id = $1
+	`, postIDs[1])
+	assert.NoError(t, err)
+
+	err = repo.MovePostInProjectQueue(ctx, projectID, post.DefaultQueue, 2, 1)
+	assert.NoError(t, err)
+
+	want := []string{postIDs[0], postIDs[2], postIDs[1]}
+	got, err := repo.GetProjectPostQueue(ctx, projectID, post.DefaultQueue)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got.Arr())
+
+	positions := queuePositions(t, projectID)
+	for i, id := range want {
+		assert.Equal(t, float64(i+1)*1024, positions[id])
+	}
+}
+
+func TestPostRepository_QueueAppendAndShift(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewPostRepository(dbPool)
+	projectID, postIDs := newQueuedPosts(t, 3)
+
+	// A post already queued is left where it is
+	assert.NoError(t, repo.AddToProjectQueue(ctx, projectID, post.DefaultQueue, postIDs[0]))
+	got, err := repo.GetProjectPostQueue(ctx, projectID, post.DefaultQueue)
+	assert.NoError(t, err)
+	assert.Equal(t, postIDs, got.Arr())
+
+	for _, want := range postIDs {
+		postID, err := repo.ShiftProjectQueue(ctx, projectID, post.DefaultQueue)
+		assert.NoError(t, err)
+		assert.Equal(t, want, postID)
+	}
+
+	postID, err := repo.ShiftProjectQueue(ctx, projectID, post.DefaultQueue)
+	assert.NoError(t, err)
+	assert.Empty(t, postID)
+}
+
+func TestMigration_QueueItems(t *testing.T) {
+	ctx := context.Background()
+	projectID, postIDs := newQueuedPosts(t, 3)
+	down, err := os.ReadFile("../migrations/000016_queue_items.down.sql")
+	assert.NoError(t, err)
+	up, err := os.ReadFile("../migrations/000016_queue_items.up.sql")
+	assert.NoError(t, err)
+
+	// The migration is run again in a transaction that is rolled back
+	tx, err := dbPool.Begin(ctx)
+	assert.NoError(t, err)
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, string(down))
+	assert.NoError(t, err)
+	// The queue had a post that was deleted since, it isn't migrated
+	queue := []string{postIDs[2], "00000000-0000-0000-0000-000000000000", postIDs[0], postIDs[1]}
+	_, err = tx.Exec(ctx, `UPDATE projects SET post_queue = $2 WHERE id = $1`, projectID, queue)
+	assert.NoError(t, err)
+	_, err = tx.Exec(ctx, string(up))
+	assert.NoError(t, err)
+
+	rows, err := tx.Query(ctx, `
+		SELECT post_id::text FROM queue_items
+		WHERE project_id = $1 AND kind = 'post' AND queue = 'default'
+		ORDER BY position
+	`, projectID)
+	assert.NoError(t, err)
+	var got []string
+	for rows.Next() {
+		var postID string
+		assert.NoError(t, rows.Scan(&postID))
+		got = append(got, postID)
+	}
+	rows.Close()
+	assert.Equal(t, []string{postIDs[2], postIDs[0], postIDs[1]}, got)
+}
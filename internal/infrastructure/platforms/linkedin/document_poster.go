@@ -12,6 +12,7 @@ import (
 
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 )
 
 type DocumentPoster struct {
@@ -28,27 +29,29 @@ func NewDocumentPoster(s Secrets) *DocumentPoster {
 	}
 }
 
-func (dp *DocumentPoster) Validate(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) error {
+func (dp *DocumentPoster) Validate(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) publisher.ValidationIssues {
 	if pp == nil {
-		return errors.New("publish post is nil")
-	}
-	if dp.secrets.AccessToken == "" {
-		return errors.New("user access token is not set")
-	}
-	if dp.authorURN == "" {
-		return errors.New("user URN is not set")
+		return publisher.ValidationIssues{missingPostIssue}
 	}
+	issues := validateCommon(pp, dp.secrets)
 	if len(mediaList) == 0 {
-		return errors.New("no document to upload")
+		issues.AddError(publisher.IssueCodeMissingMedia, "media", "no document to upload")
+		return issues
 	}
 	if len(mediaList) > 1 {
-		return errors.New("single document upload only")
+		issues.AddError(publisher.IssueCodeTooManyMedia, "media", "single document upload only")
 	}
 	d := mediaList[0]
 	if pp.Type == post.PostTypeCarousel && d.Format != "pdf" {
-		return errors.New("carousel post requires a PDF document")
+		issues.AddError(publisher.IssueCodeInvalidMediaFormat, "media", "carousel post requires a PDF document")
 	}
-	return nil
+	if d.Size > maxDocumentSize {
+		issues.AddError(publisher.IssueCodeMediaTooLarge, "media", "document file size is too large")
+	}
+	if d.Pages > maxDocumentPages {
+		issues.AddError(publisher.IssueCodeTooManyPages, "media", fmt.Sprintf("document has more than %d pages", maxDocumentPages))
+	}
+	return issues
 }
 
 // initDocUploadReq and initDocUploadResp mirror LinkedIn’s document upload initialization process.
@@ -68,7 +71,7 @@ type initDocUploadResp struct {
 
 // Post uploads a document and creates a LinkedIn post referring to it.
 func (dp *DocumentPoster) Post(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) error {
-	if err := dp.Validate(ctx, pp, mediaList); err != nil {
+	if err := dp.Validate(ctx, pp, mediaList).Err(); err != nil {
 		return err
 	}
 	// Step 1: Initialize document upload
@@ -107,7 +110,7 @@ func (dp *DocumentPoster) Post(ctx context.Context, pp *post.PublishPost, mediaL
 
 	// Step 2: Upload the document as binary
 	doc := mediaList[0]
-	uploadReq, err := http.NewRequestWithContext(ctx, http.MethodPut, initRes.Value.UploadUrl, bytes.NewBuffer(doc.Data))
+	uploadReq, err := newUploadRequest(ctx, initRes.Value.UploadUrl, doc.File.Reader())
 	if err != nil {
 		return fmt.Errorf("failed to create document upload request: %w", err)
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 )
 
 // ImagePoster only deals with image uploads.
@@ -29,23 +30,20 @@ func NewImagePoster(s Secrets) *ImagePoster {
 	}
 }
 
-func (ip *ImagePoster) Validate(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) error {
+func (ip *ImagePoster) Validate(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) publisher.ValidationIssues {
 	if pp == nil {
-		return errors.New("publish post is nil")
-	}
-	if ip.secrets.AccessToken == "" {
-		return errors.New("user access token is not set")
-	}
-	if ip.authorURN == "" {
-		return errors.New("user URN is not set")
+		return publisher.ValidationIssues{missingPostIssue}
 	}
+	issues := validateCommon(pp, ip.secrets)
 	if len(mediaList) == 0 {
-		return errors.New("no images to upload")
+		issues.AddError(publisher.IssueCodeMissingMedia, "media", "no images to upload")
 	}
 	if len(mediaList) > 1 {
-		return errors.New("single image upload only")
+		issues.AddError(publisher.IssueCodeTooManyMedia, "media", "single image upload only")
 	}
-	return nil
+	validateImages(&issues, mediaList)
+	validateAltText(&issues, mediaList)
+	return issues
 }
 
 // initUploadRequest contains the request body for initializing an image upload.
@@ -65,7 +63,7 @@ type initUploadResponse struct {
 }
 
 func (ip *ImagePoster) Post(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) error {
-	if err := ip.Validate(ctx, pp, mediaList); err != nil {
+	if err := ip.Validate(ctx, pp, mediaList).Err(); err != nil {
 		return err
 	}
 
@@ -109,7 +107,7 @@ func (ip *ImagePoster) Post(ctx context.Context, pp *post.PublishPost, mediaList
 	}
 
 	// 2. Upload the image binary to the returned uploadUrl
-	uploadReq, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, bytes.NewBuffer(m.Data))
+	uploadReq, err := newUploadRequest(ctx, uploadURL, m.File.Reader())
 	if err != nil {
 		return fmt.Errorf("failed to create image upload request: %w", err)
 	}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 	"github.com/redplanettribe/social-media-manager/internal/infrastructure/encrypting"
 )
 
@@ -52,11 +53,13 @@ func (l *Linkedin) MemberLookup(ctx context.Context, username string) (string, e
 	return "", ErrNotImplemented
 }
 
-func (l *Linkedin) ValidatePost(ctx context.Context, pp *post.PublishPost, media []*media.Media) error {
+func (l *Linkedin) ValidatePost(ctx context.Context, pp *post.PublishPost, media []*media.Media) publisher.ValidationIssues {
 	posterFactory := NewLinkedinPosterFactory()
 	poster, err := posterFactory.NewPoster(pp, l.userSecrets)
 	if err != nil {
-		return err
+		var issues publisher.ValidationIssues
+		issues.AddError(publisher.IssueCodeUnsupportedPostType, "type", err.Error())
+		return issues
 	}
 	return poster.Validate(ctx, pp, media)
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 )
 
 type MultiImagePoster struct {
@@ -29,23 +30,20 @@ func NewMultiImagePoster(s Secrets) *MultiImagePoster {
 	}
 }
 
-func (mp *MultiImagePoster) Validate(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) error {
+func (mp *MultiImagePoster) Validate(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) publisher.ValidationIssues {
 	if pp == nil {
-		return errors.New("publish post is nil")
-	}
-	if mp.secrets.AccessToken == "" {
-		return errors.New("user access token is not set")
-	}
-	if mp.authorURN == "" {
-		return errors.New("user URN is not set")
+		return publisher.ValidationIssues{missingPostIssue}
 	}
+	issues := validateCommon(pp, mp.secrets)
 	if len(mediaList) < 2 {
-		return errors.New("multi-image post requires at least 2 images")
+		issues.AddError(publisher.IssueCodeTooFewMedia, "media", "multi-image post requires at least 2 images")
 	}
 	if !onlyImages(mediaList) {
-		return errors.New("multi-image post only supports images")
+		issues.AddError(publisher.IssueCodeInvalidMediaType, "media", "multi-image post only supports images")
 	}
-	return nil
+	validateImages(&issues, mediaList)
+	validateAltText(&issues, mediaList)
+	return issues
 }
 
 type initUploadRequestMulti struct {
@@ -63,7 +61,7 @@ type initUploadResponseMulti struct {
 }
 
 func (mp *MultiImagePoster) Post(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) error {
-	if err := mp.Validate(ctx, pp, mediaList); err != nil {
+	if err := mp.Validate(ctx, pp, mediaList).Err(); err != nil {
 		return err
 	}
 
@@ -117,7 +115,7 @@ func (mp *MultiImagePoster) Post(ctx context.Context, pp *post.PublishPost, medi
 				return
 			}
 
-			uploadReq, err := http.NewRequestWithContext(ctx, http.MethodPut, initResp.Value.UploadUrl, bytes.NewBuffer(file.Data))
+			uploadReq, err := newUploadRequest(ctx, initResp.Value.UploadUrl, file.File.Reader())
 			if err != nil {
 				setError(&mu, &upErr, fmt.Errorf("failed to create upload req: %w", err))
 				return
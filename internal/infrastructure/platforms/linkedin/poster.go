@@ -4,15 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"slices"
+	"time"
+	"unicode/utf8"
 
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 )
 
 type LinkedinPoster interface {
 	Post(ctx context.Context, post *post.PublishPost, media []*media.Media) error
-	Validate(ctx context.Context, post *post.PublishPost, media []*media.Media) error
+	Validate(ctx context.Context, post *post.PublishPost, media []*media.Media) publisher.ValidationIssues
 }
 
 type LinkedinPosterFactory interface {
@@ -45,6 +50,82 @@ func (pf *posterFactory) NewPoster(p *post.PublishPost, secrets Secrets) (Linked
 	}
 }
 
+// LinkedIn rejects commentaries longer than this
+const maxCommentaryLength = 3000
+
+// LinkedIn rejects images outside of these bounds
+const (
+	maxImageSize        = 10 << 20
+	minImageAspectRatio = 1 / 1.91
+	maxImageAspectRatio = 1.91
+)
+
+// LinkedIn rejects documents outside of these bounds
+const (
+	maxDocumentSize  = 100 << 20
+	maxDocumentPages = 300
+)
+
+// LinkedIn accepts these image encodings, as named by the image package
+var imageFormats = []string{"jpeg", "png", "gif"}
+
+var missingPostIssue = publisher.ValidationIssue{
+	Code:     publisher.IssueCodeMissingPost,
+	Severity: publisher.SeverityError,
+	Message:  "publish post is nil",
+}
+
+// validateCommon runs the checks shared by every LinkedIn post type
+func validateCommon(pp *post.PublishPost, secrets Secrets) publisher.ValidationIssues {
+	var issues publisher.ValidationIssues
+	if secrets.AccessToken == "" {
+		issues.AddError(publisher.IssueCodeMissingAccessToken, "", "user access token is not set")
+	}
+	if secrets.URN == "" {
+		issues.AddError(publisher.IssueCodeMissingAccountID, "", "user URN is not set")
+	}
+	if !secrets.TokenExpiresAt.IsZero() && !time.Now().Before(secrets.TokenExpiresAt) {
+		issues.AddError(publisher.IssueCodeTokenExpired, "", "user access token expired, the account must be connected again")
+	}
+	if utf8.RuneCountInString(pp.TextContent) > maxCommentaryLength {
+		issues.AddError(publisher.IssueCodeTextTooLong, "text_content", fmt.Sprintf("text content exceeds %d characters", maxCommentaryLength))
+	}
+	return issues
+}
+
+// validateAltText warns about images without alt text, they are still published but are not accessible
+func validateAltText(issues *publisher.ValidationIssues, mediaList []*media.Media) {
+	for _, m := range mediaList {
+		if m.IsImage() && m.AltText == "" {
+			issues.AddWarning(publisher.IssueCodeMissingAltText, "alt_text", fmt.Sprintf("image %s has no alt text", m.Filename))
+		}
+	}
+}
+
+// validateImages checks the images against the limits of LinkedIn. The images are usually renditions cropped and
+// resized to fit, so this only fails for images that couldn't be made to fit.
+func validateImages(issues *publisher.ValidationIssues, mediaList []*media.Media) {
+	for _, m := range mediaList {
+		if !m.IsImage() {
+			continue
+		}
+		if m.Size > maxImageSize {
+			issues.AddError(publisher.IssueCodeMediaTooLarge, "media", fmt.Sprintf("image %s file size is too large", m.Filename))
+		}
+		// Images still processing are not known yet
+		if m.Width == 0 || m.Height == 0 {
+			continue
+		}
+		if !slices.Contains(imageFormats, m.Format) {
+			issues.AddError(publisher.IssueCodeInvalidMediaFormat, "media", fmt.Sprintf("image %s format %s is not supported", m.Filename, m.Format))
+		}
+		ratio := float64(m.Width) / float64(m.Height)
+		if ratio < minImageAspectRatio || ratio > maxImageAspectRatio {
+			issues.AddError(publisher.IssueCodeInvalidAspectRatio, "media", fmt.Sprintf("image %s aspect ratio must be between 1:1.91 and 1.91:1", m.Filename))
+		}
+	}
+}
+
 // LinkedInPost represents the JSON structure required by LinkedIn's API for creating a post.
 type LinkedInPost struct {
 	Author                    string       `json:"author"`
@@ -69,6 +150,16 @@ func setHeaders(req *http.Request, accessToken string) {
 	req.Header.Set("Content-Type", "application/json")
 }
 
+// newUploadRequest makes a PUT request streaming body, with its length set so it isn't sent chunked
+func newUploadRequest(ctx context.Context, url string, body *io.SectionReader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = body.Size()
+	return req, nil
+}
+
 func setBinaryHeaders(req *http.Request, accessToken string) {
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
 	req.Header.Set("X-Restli-Protocol-Version", "2.0.0")
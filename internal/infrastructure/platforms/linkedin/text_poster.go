@@ -4,13 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 )
 
 type TextPoster struct {
@@ -23,26 +23,20 @@ func NewTextPoster(s Secrets) *TextPoster {
 	}
 }
 
-func (tp *TextPoster) Validate(ctx context.Context, pp *post.PublishPost, _ []*media.Media) error {
+func (tp *TextPoster) Validate(ctx context.Context, pp *post.PublishPost, _ []*media.Media) publisher.ValidationIssues {
 	if pp == nil {
-		return errors.New("publish post is nil")
-	}
-	if tp.secrets.AccessToken == "" {
-		return errors.New("user access token is not set")
-	}
-	if tp.secrets.URN == "" {
-		return errors.New("user URN is not set")
+		return publisher.ValidationIssues{missingPostIssue}
 	}
+	issues := validateCommon(pp, tp.secrets)
 	if pp.TextContent == "" {
-		return errors.New("text content is empty")
+		issues.AddError(publisher.IssueCodeEmptyText, "text_content", "text content is empty")
 	}
-	return nil
+	return issues
 }
 
 func (tp *TextPoster) Post(ctx context.Context, pp *post.PublishPost, _ []*media.Media) error {
 
-	err := tp.Validate(ctx, pp, nil)
-	if err != nil {
+	if err := tp.Validate(ctx, pp, nil).Err(); err != nil {
 		return err
 	}
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 )
 
 type VideoPoster struct {
@@ -28,38 +29,38 @@ func NewVideoPoster(s Secrets) *VideoPoster {
 	}
 }
 
-func (vp *VideoPoster) Validate(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) error {
+func (vp *VideoPoster) Validate(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) publisher.ValidationIssues {
 	if pp == nil {
-		return errors.New("publish post is nil")
+		return publisher.ValidationIssues{missingPostIssue}
 	}
-	if vp.secrets.AccessToken == "" {
-		return errors.New("user access token is not set")
-	}
-	if vp.authorURN == "" {
-		return errors.New("user URN is not set")
-	}
-	if len(mediaList) != 1 {
-		return errors.New("exactly one video is required")
+	issues := validateCommon(pp, vp.secrets)
+	switch {
+	case len(mediaList) == 0:
+		issues.AddError(publisher.IssueCodeMissingMedia, "media", "exactly one video is required")
+		return issues
+	case len(mediaList) > 1:
+		issues.AddError(publisher.IssueCodeTooManyMedia, "media", "exactly one video is required")
 	}
 
 	m := mediaList[0]
 	if !m.IsVideo() {
-		return errors.New("provided media is not a video")
+		issues.AddError(publisher.IssueCodeInvalidMediaType, "media", "provided media is not a video")
+		return issues
 	}
 	if m.Size > 500*1024*1024 {
-		return errors.New("video file size is too large")
+		issues.AddError(publisher.IssueCodeMediaTooLarge, "media", "video file size is too large")
 	}
 	if m.Length > 30*60 {
-		return errors.New("video length is too long")
+		issues.AddError(publisher.IssueCodeMediaTooLong, "media", "video length is too long")
 	}
 	if m.Length < 3 {
-		return errors.New("video length is too short")
+		issues.AddError(publisher.IssueCodeMediaTooShort, "media", "video length is too short")
 	}
 	if m.Format != "mp4" {
-		return errors.New("video format is not mp4")
+		issues.AddError(publisher.IssueCodeInvalidMediaFormat, "media", "video format is not mp4")
 	}
 
-	return nil
+	return issues
 }
 
 type initVideoUploadReq struct {
@@ -96,14 +97,14 @@ type finalizeVideoUploadReq struct {
 }
 
 func (vp *VideoPoster) Post(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) error {
-	if err := vp.Validate(ctx, pp, mediaList); err != nil {
+	if err := vp.Validate(ctx, pp, mediaList).Err(); err != nil {
 		return err
 	}
 	m := mediaList[0]
 	// Step 1: Initialize video upload
 	initReqBody := initVideoUploadReq{}
 	initReqBody.InitializeUploadRequest.Owner = vp.authorURN
-	initReqBody.InitializeUploadRequest.FileSizeBytes = int64(len(m.Data))
+	initReqBody.InitializeUploadRequest.FileSizeBytes = m.File.Size()
 	initReqBody.InitializeUploadRequest.UploadCaptions = false
 	initReqBody.InitializeUploadRequest.UploadThumbnail = (m.Thumbnail != nil)
 
@@ -142,9 +143,9 @@ func (vp *VideoPoster) Post(ctx context.Context, pp *post.PublishPost, mediaList
 	// Step 2: Upload video chunks
 	uploadedPartIds := make([]string, 0, len(initRes.Value.UploadInstructions))
 	for _, instr := range initRes.Value.UploadInstructions {
-		chunk := m.Data[instr.FirstByte : instr.LastByte+1]
+		chunk := io.NewSectionReader(m.File, instr.FirstByte, instr.LastByte-instr.FirstByte+1)
 
-		uploadReq, err := http.NewRequestWithContext(ctx, http.MethodPut, instr.UploadUrl, bytes.NewBuffer(chunk))
+		uploadReq, err := newUploadRequest(ctx, instr.UploadUrl, chunk)
 		if err != nil {
 			return fmt.Errorf("failed to create chunk upload request: %w", err)
 		}
@@ -174,7 +175,7 @@ func (vp *VideoPoster) Post(ctx context.Context, pp *post.PublishPost, mediaList
 
 	// Step 2b: Upload thumbnail if present
 	if m.Thumbnail != nil && initRes.Value.ThumbnailUploadInstruction.UploadUrl != "" {
-		thumbReq, err := http.NewRequestWithContext(ctx, http.MethodPut, initRes.Value.ThumbnailUploadInstruction.UploadUrl, bytes.NewBuffer(m.Thumbnail.Data))
+		thumbReq, err := newUploadRequest(ctx, initRes.Value.ThumbnailUploadInstruction.UploadUrl, m.Thumbnail.File.Reader())
 		if err != nil {
 			return fmt.Errorf("failed to create thumbnail upload request: %w", err)
 		}
@@ -13,13 +13,16 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 )
 
 // processingInfo represents asynchronous processing data returned during FINALIZE and STATUS.
@@ -43,7 +46,7 @@ func NewMediaPoster(s Secrets) *MediaPoster {
 
 // Post orchestrates media upload (INIT → APPEND → FINALIZE → STATUS) then creates a tweet with the returned media IDs.
 func (ip *MediaPoster) Post(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) error {
-	if err := ip.Validate(ctx, pp, mediaList); err != nil {
+	if err := ip.Validate(ctx, pp, mediaList).Err(); err != nil {
 		return err
 	}
 	var mediaIDs []string
@@ -58,17 +61,61 @@ func (ip *MediaPoster) Post(ctx context.Context, pp *post.PublishPost, mediaList
 }
 
 // Validate verifies required fields.
-func (ip *MediaPoster) Validate(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) error {
+func (ip *MediaPoster) Validate(ctx context.Context, pp *post.PublishPost, mediaList []*media.Media) publisher.ValidationIssues {
 	if pp == nil {
-		return fmt.Errorf("publish post is nil")
+		return publisher.ValidationIssues{missingPostIssue}
 	}
+	var issues publisher.ValidationIssues
 	if ip.secrets.Token == "" {
-		return fmt.Errorf("user access token not set")
+		issues.AddError(publisher.IssueCodeMissingAccessToken, "", "user access token not set")
+	}
+	validateTokenExpiry(&issues, ip.secrets)
+	if utf8.RuneCountInString(pp.TextContent) > maxTweetLength {
+		issues.AddError(publisher.IssueCodeTextTooLong, "text_content", fmt.Sprintf("text content exceeds %d characters", maxTweetLength))
 	}
 	if len(mediaList) == 0 {
-		return fmt.Errorf("no media to upload")
+		issues.AddError(publisher.IssueCodeMissingMedia, "media", "no media to upload")
+	}
+	for _, m := range mediaList {
+		switch {
+		case m.IsImage():
+			validateImage(&issues, m)
+		case m.IsAnimatedGIF():
+			validateGIF(&issues, m, len(mediaList))
+		default:
+			continue
+		}
+		if m.AltText == "" {
+			issues.AddWarning(publisher.IssueCodeMissingAltText, "alt_text", fmt.Sprintf("image %s has no alt text", m.Filename))
+		}
+	}
+	return issues
+}
+
+func validateImage(issues *publisher.ValidationIssues, m *media.Media) {
+	if m.Size > maxImageSize {
+		issues.AddError(publisher.IssueCodeMediaTooLarge, "media", fmt.Sprintf("image %s file size is too large", m.Filename))
+	}
+	// Images still processing have no format yet
+	if m.Format != "" && !slices.Contains(imageFormats, m.Format) {
+		issues.AddError(publisher.IssueCodeInvalidMediaFormat, "media", fmt.Sprintf("image %s format %s is not supported", m.Filename, m.Format))
+	}
+}
+
+// validateGIF checks an animated GIF against the limits of X, which publishes them alone
+func validateGIF(issues *publisher.ValidationIssues, m *media.Media, mediaCount int) {
+	if mediaCount > 1 {
+		issues.AddError(publisher.IssueCodeTooManyMedia, "media", "an animated GIF can't be posted with other media")
+	}
+	if m.Size > maxGIFSize {
+		issues.AddError(publisher.IssueCodeMediaTooLarge, "media", fmt.Sprintf("GIF %s file size is too large", m.Filename))
+	}
+	if m.Width > maxGIFWidth || m.Height > maxGIFHeight {
+		issues.AddError(publisher.IssueCodeMediaTooLarge, "media", fmt.Sprintf("GIF %s exceeds %dx%d", m.Filename, maxGIFWidth, maxGIFHeight))
+	}
+	if m.Frames > maxGIFFrames {
+		issues.AddError(publisher.IssueCodeMediaTooLong, "media", fmt.Sprintf("GIF %s has more than %d frames", m.Filename, maxGIFFrames))
 	}
-	return nil
 }
 
 // uploadMedia performs the complete media upload workflow.
@@ -108,17 +155,20 @@ func (ip *MediaPoster) initUpload(ctx context.Context, m *media.Media) (string,
 
 	// Set INIT required fields.
 	_ = writer.WriteField("command", "INIT")
-	_ = writer.WriteField("total_bytes", fmt.Sprintf("%d", len(m.Data)))
+	_ = writer.WriteField("total_bytes", fmt.Sprintf("%d", m.File.Size()))
 	// Set media type based on the file's format.
 	mediaType := "image/" + m.Format
 	if m.IsVideo() {
 		mediaType = "video/" + m.Format
 	}
 	_ = writer.WriteField("media_type", mediaType)
-	// For videos, use amplify_video; for images, use tweet_image.
+	// For videos, use amplify_video; for animated GIFs, tweet_gif; for images, use tweet_image.
 	category := "tweet_image"
-	if m.IsVideo() {
+	switch {
+	case m.IsVideo():
 		category = "amplify_video"
+	case m.IsAnimatedGIF():
+		category = "tweet_gif"
 	}
 	_ = writer.WriteField("media_category", category)
 	writer.Close()
@@ -161,17 +211,17 @@ func (ip *MediaPoster) appendUpload(ctx context.Context, mediaID string, m *medi
 	fmt.Println("Appending media for", m.Filename)
 	urlStr := "https://api.x.com/2/media/upload"
 
-	// If video, split file into 4 MB chunks.
-	if m.IsVideo() {
+	// If video or animated GIF, which can be larger than a single request, split file into chunks.
+	if m.IsVideo() || m.IsAnimatedGIF() {
 		const chunkSize = 3 * 1024 * 1024 // 3 MB
-		totalSize := len(m.Data)
+		totalSize := m.File.Size()
 		segmentIndex = 0
-		for offset := 0; offset < totalSize; offset += chunkSize {
+		for offset := int64(0); offset < totalSize; offset += chunkSize {
 			end := offset + chunkSize
 			if end > totalSize {
 				end = totalSize
 			}
-			chunk := m.Data[offset:end]
+			chunk := io.NewSectionReader(m.File, offset, end-offset)
 			if err := ip.sendAppendRequest(ctx, urlStr, mediaID, m.Filename, segmentIndex, chunk); err != nil {
 				return err
 			}
@@ -180,7 +230,7 @@ func (ip *MediaPoster) appendUpload(ctx context.Context, mediaID string, m *medi
 		}
 	} else {
 		// For non-videos, one APPEND is sufficient.
-		if err := ip.sendAppendRequest(ctx, urlStr, mediaID, m.Filename, 0, m.Data); err != nil {
+		if err := ip.sendAppendRequest(ctx, urlStr, mediaID, m.Filename, 0, m.File.Reader()); err != nil {
 			return err
 		}
 	}
@@ -188,7 +238,7 @@ func (ip *MediaPoster) appendUpload(ctx context.Context, mediaID string, m *medi
 }
 
 // sendAppendRequest sends an individual APPEND request for a file chunk.
-func (ip *MediaPoster) sendAppendRequest(ctx context.Context, urlStr, mediaID, filename string, segmentIndex int, data []byte) error {
+func (ip *MediaPoster) sendAppendRequest(ctx context.Context, urlStr, mediaID, filename string, segmentIndex int, data io.Reader) error {
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 
@@ -199,7 +249,7 @@ func (ip *MediaPoster) sendAppendRequest(ctx context.Context, urlStr, mediaID, f
 	if err != nil {
 		return fmt.Errorf("failed to create media file field: %w", err)
 	}
-	if _, err := io.Copy(part, bytes.NewReader(data)); err != nil {
+	if _, err := io.Copy(part, data); err != nil {
 		return fmt.Errorf("failed to write media data: %w", err)
 	}
 	writer.Close()
@@ -3,20 +3,45 @@ package x
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 )
 
 type XPoster interface {
 	Post(ctx context.Context, post *post.PublishPost, media []*media.Media) error
-	Validate(ctx context.Context, post *post.PublishPost, media []*media.Media) error
+	Validate(ctx context.Context, post *post.PublishPost, media []*media.Media) publisher.ValidationIssues
 }
 
 type XPosterFactory interface {
 	NewPoster(p *post.PublishPost, userSecrets Secrets) (XPoster, error)
 }
 
+// X rejects tweets longer than this
+const maxTweetLength = 280
+
+// X rejects images larger than this
+const maxImageSize = 5 << 20
+
+// X rejects animated GIFs outside of these bounds
+const (
+	maxGIFSize   = 15 << 20
+	maxGIFWidth  = 1280
+	maxGIFHeight = 1080
+	maxGIFFrames = 350
+)
+
+// X accepts these image encodings, as named by the image package
+var imageFormats = []string{"jpeg", "png", "gif", "webp"}
+
+var missingPostIssue = publisher.ValidationIssue{
+	Code:     publisher.IssueCodeMissingPost,
+	Severity: publisher.SeverityError,
+	Message:  "publish post is nil",
+}
+
 type posterFactory struct{}
 
 func NewXPosterFactory() XPosterFactory {
@@ -33,3 +58,10 @@ func (pf *posterFactory) NewPoster(p *post.PublishPost, secrets Secrets) (XPoste
 		return nil, errors.New("invalid post type")
 	}
 }
+
+// validateTokenExpiry reports a token whose auth TTL has passed, X refuses it until the account is connected again
+func validateTokenExpiry(issues *publisher.ValidationIssues, secrets Secrets) {
+	if !secrets.TokenExpiresAt.IsZero() && !time.Now().Before(secrets.TokenExpiresAt) {
+		issues.AddError(publisher.IssueCodeTokenExpired, "", "user access token expired, the account must be connected again")
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,10 +14,12 @@ import (
 	"os"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 )
 
 type TextPoster struct {
@@ -31,30 +32,35 @@ func NewTextPoster(s Secrets) *TextPoster {
 	}
 }
 
-func (tp *TextPoster) Validate(ctx context.Context, pp *post.PublishPost, m []*media.Media) error {
+func (tp *TextPoster) Validate(ctx context.Context, pp *post.PublishPost, m []*media.Media) publisher.ValidationIssues {
 	if pp == nil {
-		return errors.New("publish post is nil")
+		return publisher.ValidationIssues{missingPostIssue}
 	}
+	var issues publisher.ValidationIssues
 	if tp.secrets.Token == "" {
-		return errors.New("user access token is not set")
+		issues.AddError(publisher.IssueCodeMissingAccessToken, "", "user access token is not set")
 	}
 	if tp.secrets.TokenSecret == "" {
-		return errors.New("user token verifier is not set")
+		issues.AddError(publisher.IssueCodeMissingAccessToken, "", "user token verifier is not set")
 	}
+	validateTokenExpiry(&issues, tp.secrets)
 	if pp.TextContent == "" {
-		return errors.New("text content is empty")
+		issues.AddError(publisher.IssueCodeEmptyText, "text_content", "text content is empty")
+	}
+	if utf8.RuneCountInString(pp.TextContent) > maxTweetLength {
+		issues.AddError(publisher.IssueCodeTextTooLong, "text_content", fmt.Sprintf("text content exceeds %d characters", maxTweetLength))
 	}
 	for _, media := range m {
 		if media.Size > 5242880 {
-			return errors.New(fmt.Sprintf("media size exceeds 5MB: %s", media.Filename))
+			issues.AddError(publisher.IssueCodeMediaTooLarge, "media", fmt.Sprintf("media size exceeds 5MB: %s", media.Filename))
 		}
 	}
 
-	return nil
+	return issues
 }
 
 func (tp *TextPoster) Post(ctx context.Context, pp *post.PublishPost, _ []*media.Media) error {
-	if err := tp.Validate(ctx, pp, nil); err != nil {
+	if err := tp.Validate(ctx, pp, nil).Err(); err != nil {
 		return err
 	}
 
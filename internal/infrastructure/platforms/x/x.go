@@ -18,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 	"github.com/redplanettribe/social-media-manager/internal/infrastructure/encrypting"
 )
 
@@ -28,6 +29,8 @@ var (
 type Secrets struct {
 	Token       string `json:"access_token"`
 	TokenSecret string `json:"token_verifier"`
+	// TokenExpiresAt is the auth TTL the token was saved with, zero for the tokens saved before it was kept here
+	TokenExpiresAt time.Time `json:"token_expires_at,omitempty"`
 }
 
 type X struct {
@@ -163,10 +166,14 @@ func (x *X) Authenticate(ctx context.Context, params any) (string, time.Time, er
 		return "", time.Time{}, fmt.Errorf("failed to parse response: %w", err)
 	}
 
+	// X tokens don't expire, so we return a far future time
+	expiresAt := time.Now().AddDate(100, 0, 0).UTC()
+
 	// Save secrets
 	x.userSecrets = Secrets{
-		Token:       values.Get("oauth_token"),
-		TokenSecret: values.Get("oauth_token_secret"),
+		Token:          values.Get("oauth_token"),
+		TokenSecret:    values.Get("oauth_token_secret"),
+		TokenExpiresAt: expiresAt,
 	}
 
 	// Encrypt secrets
@@ -175,9 +182,6 @@ func (x *X) Authenticate(ctx context.Context, params any) (string, time.Time, er
 		return "", time.Time{}, fmt.Errorf("failed to encrypt secrets: %w", err)
 	}
 
-	// X tokens don't expire, so we return a far future time
-	expiresAt := time.Now().AddDate(100, 0, 0)
-
 	return secretStr, expiresAt, nil
 }
 
@@ -204,11 +208,13 @@ func createSignatureBaseString(method, baseURL string, params map[string]string)
 	)
 }
 
-func (x *X) ValidatePost(ctx context.Context, pp *post.PublishPost, media []*media.Media) error {
+func (x *X) ValidatePost(ctx context.Context, pp *post.PublishPost, media []*media.Media) publisher.ValidationIssues {
 	posterFactory := NewXPosterFactory()
 	poster, err := posterFactory.NewPoster(pp, x.userSecrets)
 	if err != nil {
-		return err
+		var issues publisher.ValidationIssues
+		issues.AddError(publisher.IssueCodeUnsupportedPostType, "type", err.Error())
+		return issues
 	}
 	return poster.Validate(ctx, pp, media)
 }
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/archive"
+	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
+)
+
+// maxArchiveSize is the largest project archive accepted by the import
+const maxArchiveSize = 2 << 30
+
+type ArchiveHandler struct {
+	Service archive.Service
+}
+
+func NewArchiveHandler(service archive.Service) *ArchiveHandler {
+	return &ArchiveHandler{Service: service}
+}
+
+// ExportProject godoc
+// @Summary Export a project
+// @Description Download a zip archive with a manifest.json describing the project (posts, queues, schedule, labels, enabled platforms and media metadata) and its media files. Platform secrets and users are not exported.
+// @Tags projects
+// @Produce application/zip
+// @Param project_id path string true "Project ID"
+// @Success 200 {file} file "Project archive"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 410 {object} errors.APIError "Project not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /projects/{project_id}/export [get]
+func (h *ArchiveHandler) ExportProject(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	// The archive is built in a temporary file so errors can still be reported before anything is sent
+	f, err := os.CreateTemp("", "project-export-*.zip")
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to create archive"))
+		return
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	err = h.Service.ExportProject(r.Context(), params["project_id"], f)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to read archive"))
+		return
+	}
+
+	fileName := fmt.Sprintf("project-%s-%s.zip", params["project_id"], time.Now().UTC().Format("20060102150405"))
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(w, f)
+}
+
+// ImportProject godoc
+// @Summary Import a project
+// @Description Create a new project from an archive made by the export, sent as the request body. Posts, labels and media get new ids and the current user becomes the owner. Platforms have to be authenticated again.
+// @Tags projects
+// @Accept application/zip
+// @Produce json
+// @Param name query string false "Name of the new project. Defaults to the name in the archive"
+// @Success 201 {object} project.Project
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 409 {object} errors.APIError "Project already exists"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /projects/import [post]
+func (h *ArchiveHandler) ImportProject(w http.ResponseWriter, r *http.Request) {
+	f, err := os.CreateTemp("", "project-import-*.zip")
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to store archive"))
+		return
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	size, err := io.Copy(f, http.MaxBytesReader(w, r.Body, maxArchiveSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			e.WriteHttpError(w, e.NewValidationError("Archive too large", map[string]string{
+				"file": "too large",
+			}))
+			return
+		}
+		e.WriteHttpError(w, e.NewInternalError("Failed to store archive"))
+		return
+	}
+
+	p, err := h.Service.ImportProject(r.Context(), f, size, r.URL.Query().Get("name"))
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(p)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
@@ -0,0 +1,325 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/campaign"
+	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
+)
+
+type CampaignHandler struct {
+	Service campaign.Service
+}
+
+func NewCampaignHandler(service campaign.Service) *CampaignHandler {
+	return &CampaignHandler{Service: service}
+}
+
+type campaignRequest struct {
+	Name      string    `json:"name"`
+	Goal      string    `json:"goal"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+func (r campaignRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+	if r.Name == "" {
+		errors["name"] = "Name is required"
+	}
+	if r.StartDate.IsZero() {
+		errors["start_date"] = "required"
+	}
+	if r.EndDate.IsZero() {
+		errors["end_date"] = "required"
+	}
+	return errors
+}
+
+// CreateCampaign godoc
+// @Summary Create a campaign
+// @Description Create a campaign to group the posts of a project working toward the same goal
+// @Tags campaigns
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param campaign body campaignRequest true "Campaign creation request"
+// @Success 201 {object} campaign.Campaign
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /campaigns/{project_id} [post]
+func (h *CampaignHandler) CreateCampaign(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[campaignRequest](w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.Service.CreateCampaign(r.Context(), params["project_id"], req.Name, req.Goal, req.StartDate, req.EndDate)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(c)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// UpdateCampaign godoc
+// @Summary Update a campaign
+// @Description Update the name, goal and dates of a campaign
+// @Tags campaigns
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param campaign_id path string true "Campaign ID"
+// @Param campaign body campaignRequest true "Campaign update request"
+// @Success 200 {object} campaign.Campaign
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Campaign not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /campaigns/{project_id}/{campaign_id} [patch]
+func (h *CampaignHandler) UpdateCampaign(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"campaign_id": r.PathValue("campaign_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[campaignRequest](w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.Service.UpdateCampaign(r.Context(), params["project_id"], params["campaign_id"], req.Name, req.Goal, req.StartDate, req.EndDate)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(c)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// DeleteCampaign godoc
+// @Summary Delete a campaign
+// @Description Delete a campaign. Its posts are kept
+// @Tags campaigns
+// @Param project_id path string true "Project ID"
+// @Param campaign_id path string true "Campaign ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Campaign not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /campaigns/{project_id}/{campaign_id} [delete]
+func (h *CampaignHandler) DeleteCampaign(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"campaign_id": r.PathValue("campaign_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.DeleteCampaign(r.Context(), params["project_id"], params["campaign_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetCampaign godoc
+// @Summary Get a campaign
+// @Description Get a campaign by its id
+// @Tags campaigns
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param campaign_id path string true "Campaign ID"
+// @Success 200 {object} campaign.Campaign
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Campaign not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /campaigns/{project_id}/{campaign_id} [get]
+func (h *CampaignHandler) GetCampaign(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"campaign_id": r.PathValue("campaign_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	c, err := h.Service.GetCampaign(r.Context(), params["project_id"], params["campaign_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(c)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// ListProjectCampaigns godoc
+// @Summary List project campaigns
+// @Description List the campaigns of a project, most recent first
+// @Tags campaigns
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Success 200 {array} campaign.Campaign
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /campaigns/{project_id} [get]
+func (h *CampaignHandler) ListProjectCampaigns(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	campaigns, err := h.Service.ListProjectCampaigns(r.Context(), params["project_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(campaigns)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// AddPostToCampaign godoc
+// @Summary Add a post to a campaign
+// @Description Add a post of the project to a campaign
+// @Tags campaigns
+// @Param project_id path string true "Project ID"
+// @Param campaign_id path string true "Campaign ID"
+// @Param post_id path string true "Post ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Post not in project"
+// @Failure 410 {object} errors.APIError "Campaign not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /campaigns/{project_id}/{campaign_id}/posts/{post_id} [post]
+func (h *CampaignHandler) AddPostToCampaign(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"campaign_id": r.PathValue("campaign_id"),
+		"post_id":     r.PathValue("post_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.AddPostToCampaign(r.Context(), params["project_id"], params["campaign_id"], params["post_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemovePostFromCampaign godoc
+// @Summary Remove a post from a campaign
+// @Description Remove a post from a campaign. The post is kept
+// @Tags campaigns
+// @Param project_id path string true "Project ID"
+// @Param campaign_id path string true "Campaign ID"
+// @Param post_id path string true "Post ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Post not in project"
+// @Failure 410 {object} errors.APIError "Campaign not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /campaigns/{project_id}/{campaign_id}/posts/{post_id} [delete]
+func (h *CampaignHandler) RemovePostFromCampaign(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"campaign_id": r.PathValue("campaign_id"),
+		"post_id":     r.PathValue("post_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.RemovePostFromCampaign(r.Context(), params["project_id"], params["campaign_id"], params["post_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetCampaignSummary godoc
+// @Summary Get the publish summary of a campaign
+// @Description Count the campaign posts by status, and by publish status on each platform
+// @Tags campaigns
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param campaign_id path string true "Campaign ID"
+// @Success 200 {object} campaign.Summary
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Campaign not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /campaigns/{project_id}/{campaign_id}/summary [get]
+func (h *CampaignHandler) GetCampaignSummary(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"campaign_id": r.PathValue("campaign_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	summary, err := h.Service.GetCampaignSummary(r.Context(), params["project_id"], params["campaign_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(summary)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
@@ -3,10 +3,18 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/redplanettribe/social-media-manager/internal/domain/archive"
+	"github.com/redplanettribe/social-media-manager/internal/domain/campaign"
+	"github.com/redplanettribe/social-media-manager/internal/domain/feed"
+	"github.com/redplanettribe/social-media-manager/internal/domain/idea"
+	"github.com/redplanettribe/social-media-manager/internal/domain/importer"
+	"github.com/redplanettribe/social-media-manager/internal/domain/label"
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
+	"github.com/redplanettribe/social-media-manager/internal/domain/notification"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
 	"github.com/redplanettribe/social-media-manager/internal/domain/project"
 	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
+	"github.com/redplanettribe/social-media-manager/internal/domain/template"
 	"github.com/redplanettribe/social-media-manager/internal/domain/user"
 	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
 )
@@ -24,6 +32,41 @@ func mapErrorToAPIError(err error) *e.APIError {
 		media.ErrInvalidMedia,
 		media.ErrPostDoesNotBelongToProject,
 		media.ErrMediaNotLinkedToPost,
+		project.ErrInvalidPreflightMode,
+		post.ErrInvalidSortField,
+		post.ErrInvalidSortOrder,
+		post.ErrInvalidPostStatus,
+		post.ErrInvalidCursor,
+		label.ErrLabelNameRequired,
+		label.ErrInvalidLabelColor,
+		campaign.ErrCampaignNameRequired,
+		campaign.ErrInvalidCampaignDates,
+		template.ErrTemplateNameRequired,
+		template.ErrTemplateContentRequired,
+		template.ErrInvalidSnippetName,
+		template.ErrSnippetContentRequired,
+		importer.ErrUnsupportedFormat,
+		importer.ErrInvalidImportFile,
+		importer.ErrEmptyImport,
+		importer.ErrTooManyRows,
+		archive.ErrInvalidArchive,
+		archive.ErrUnsupportedArchiveVersion,
+		archive.ErrUnknownPlatform,
+		idea.ErrInvalidIdeaSort,
+		idea.ErrCommentContentRequired,
+		feed.ErrInvalidFeedURL,
+		feed.ErrInvalidFeed,
+		post.ErrInvalidQueueName,
+		media.ErrInvalidFolder,
+		media.ErrInvalidTags,
+		media.ErrInvalidFileSize,
+		media.ErrTooManyMediaIDs,
+		media.ErrInvalidFocalPoint,
+		media.ErrInvalidBrandingPosition,
+		media.ErrInvalidBrandingOpacity,
+		media.ErrInvalidBrandingScale,
+		media.ErrBrandingTextTooLong,
+		media.ErrBrandingLogoNotInLibrary,
 	):
 		return &e.APIError{
 			Status:  http.StatusBadRequest,
@@ -43,6 +86,9 @@ func mapErrorToAPIError(err error) *e.APIError {
 		user.ErrInvalidPassword,
 		post.ErrPostNotInProject,
 		project.ErrInsufficientPermissions,
+		label.ErrPostNotInProject,
+		campaign.ErrPostNotInProject,
+		idea.ErrNotCommentAuthor,
 	):
 		return &e.APIError{
 			Status:  http.StatusForbidden,
@@ -59,6 +105,12 @@ func mapErrorToAPIError(err error) *e.APIError {
 		project.ErrUserAlreadyInProject,
 		user.ErrExistingUser,
 		media.ErrFileAlreadyExists,
+		idea.ErrIdeaAlreadyPromoted,
+		feed.ErrFeedAlreadyExists,
+		post.ErrQueueAlreadyExists,
+		label.ErrLabelAlreadyExists,
+		template.ErrSnippetAlreadyExists,
+		media.ErrMediaInUse,
 	):
 		return &e.APIError{
 			Status:  http.StatusConflict,
@@ -66,17 +118,46 @@ func mapErrorToAPIError(err error) *e.APIError {
 			Message: err.Error(),
 		}
 
+	// Status 412 Precondition Failed
+	case e.MatchError(err,
+		post.ErrVersionConflict,
+		project.ErrVersionConflict,
+	):
+		return &e.APIError{
+			Status:  http.StatusPreconditionFailed,
+			Code:    e.ErrCodePrecondition,
+			Message: err.Error(),
+		}
+
+	// Status 413 Request Entity Too Large
+	case e.MatchError(err,
+		media.ErrFileTooLarge,
+	):
+		return &e.APIError{
+			Status:  http.StatusRequestEntityTooLarge,
+			Code:    e.ErrCodeTooLarge,
+			Message: err.Error(),
+		}
+
 	// Status 422 Unprocessable Entity
 	case e.MatchError(err,
 		publisher.ErrPlatformSecretsNotSet,
 		publisher.ErrUserSecretsNotSet,
 		post.ErrPostNotDraft,
+		media.ErrUploadIncomplete,
+		media.ErrFailedToAnalyzeMedia,
+		media.ErrNotAnImage,
+		media.ErrBrandingLogoNotAnImage,
 		post.ErrPostNotLinkedToAnyPlatform,
+		post.ErrPostMediaProcessing,
 		publisher.ErrNoPublishersAssigned,
+		publisher.ErrPostValidationFailed,
 		post.ErrPostNotScheduled,
 		post.ErrPostIsIdea,
 		post.ErrPostIsNotIdea,
 		post.ErrPostNotArchived,
+		template.ErrMissingTemplateVariables,
+		template.ErrSnippetNotFound,
 		project.ErrSocialPlatformNotEnabled,
 		project.ErrBasicRoleCannotBeRemoved,
 		project.ErrUserNotInProject,
@@ -91,10 +172,28 @@ func mapErrorToAPIError(err error) *e.APIError {
 	// Status 404 Gone
 	case e.MatchError(err,
 		post.ErrPostNotFound,
+		post.ErrPostNotInTrash,
 		post.ErrProjectNotFound,
+		project.ErrProjectNotFound,
+		notification.ErrNotificationNotFound,
+		label.ErrLabelNotFound,
+		campaign.ErrCampaignNotFound,
+		template.ErrTemplateNotFound,
+		idea.ErrIdeaNotFound,
+		idea.ErrCommentNotFound,
+		feed.ErrFeedNotFound,
+		post.ErrQueueNotFound,
+		project.ErrQueueNotFound,
+		project.ErrSlotNotFound,
 		publisher.ErrSocialPlatformNotFound,
 		project.ErrUserNotFound,
 		user.ErrUserNotFound,
+		media.ErrLibraryMediaNotFound,
+		media.ErrUploadNotFound,
+		media.ErrUploadExpired,
+		media.ErrFileNotFound,
+		media.ErrMediaNotFound,
+		media.ErrBrandingNotFound,
 	):
 		return &e.APIError{
 			Status:  http.StatusGone,
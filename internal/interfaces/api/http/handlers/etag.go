@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
+)
+
+// setETag sets the version of the returned resource as its entity tag
+func setETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(version)))
+}
+
+// ifMatchVersion reads the version the client expects from the If-Match header.
+// It returns 0 when there is no header or it is "*", so the update isn't conditional.
+// A header that holds no version can never match, so a 412 is written and false returned.
+func ifMatchVersion(w http.ResponseWriter, r *http.Request) (int, bool) {
+	tag := strings.TrimSpace(r.Header.Get("If-Match"))
+	if tag == "" || tag == "*" {
+		return 0, true
+	}
+	tag = strings.TrimPrefix(tag, "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err == nil {
+		tag = unquoted
+	}
+	version, err := strconv.Atoi(tag)
+	if err != nil || version < 1 {
+		e.WriteHttpError(w, e.NewPreconditionFailedError("If-Match does not hold a version of the resource"))
+		return 0, false
+	}
+	return version, true
+}
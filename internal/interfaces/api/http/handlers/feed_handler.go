@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/feed"
+	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
+)
+
+type FeedHandler struct {
+	Service feed.Service
+}
+
+func NewFeedHandler(service feed.Service) *FeedHandler {
+	return &FeedHandler{Service: service}
+}
+
+type subscribeFeedRequest struct {
+	URL        string  `json:"url"`
+	TemplateID *string `json:"template_id"` // Optional template to auto-draft the items, it can use {{title}}, {{link}} and {{summary}}
+}
+
+func (r subscribeFeedRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+	if r.URL == "" {
+		errors["url"] = "required"
+	}
+	return errors
+}
+
+type updateFeedRequest struct {
+	TemplateID *string `json:"template_id"` // null turns the items into ideas again
+}
+
+func (r updateFeedRequest) Validate() map[string]string {
+	return nil
+}
+
+type refreshFeedResponse struct {
+	Created int `json:"created"`
+}
+
+// Subscribe godoc
+// @Summary Subscribe to a feed
+// @Description Subscribe a project to an RSS or Atom feed. New items become ideas, or drafts when a template is set. On the first fetch only the newest items are ingested.
+// @Tags feeds
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param feed body subscribeFeedRequest true "Feed subscription request"
+// @Success 201 {object} feed.Feed
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 409 {object} errors.APIError "Feed already exists"
+// @Failure 410 {object} errors.APIError "Template not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /feeds/{project_id} [post]
+func (h *FeedHandler) Subscribe(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[subscribeFeedRequest](w, r)
+	if !ok {
+		return
+	}
+
+	f, err := h.Service.Subscribe(r.Context(), params["project_id"], req.URL, req.TemplateID)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(f)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// UpdateFeed godoc
+// @Summary Update a feed subscription
+// @Description Set or remove the template used to auto-draft the feed items
+// @Tags feeds
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param feed_id path string true "Feed ID"
+// @Param feed body updateFeedRequest true "Feed update request"
+// @Success 200 {object} feed.Feed
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Feed not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /feeds/{project_id}/{feed_id} [patch]
+func (h *FeedHandler) UpdateFeed(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"feed_id":    r.PathValue("feed_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[updateFeedRequest](w, r)
+	if !ok {
+		return
+	}
+
+	f, err := h.Service.UpdateFeed(r.Context(), params["project_id"], params["feed_id"], req.TemplateID)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(f)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// Unsubscribe godoc
+// @Summary Unsubscribe from a feed
+// @Description Remove a feed subscription. The posts created from it are kept.
+// @Tags feeds
+// @Param project_id path string true "Project ID"
+// @Param feed_id path string true "Feed ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Feed not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /feeds/{project_id}/{feed_id} [delete]
+func (h *FeedHandler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"feed_id":    r.PathValue("feed_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.Unsubscribe(r.Context(), params["project_id"], params["feed_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListProjectFeeds godoc
+// @Summary List project feeds
+// @Description List the feed subscriptions of a project with the outcome of their last fetch
+// @Tags feeds
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Success 200 {array} feed.Feed
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /feeds/{project_id} [get]
+func (h *FeedHandler) ListProjectFeeds(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	feeds, err := h.Service.ListProjectFeeds(r.Context(), params["project_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(feeds)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// RefreshFeed godoc
+// @Summary Refresh a feed
+// @Description Fetch a feed right away instead of waiting for the next poll
+// @Tags feeds
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param feed_id path string true "Feed ID"
+// @Success 200 {object} refreshFeedResponse
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Feed not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /feeds/{project_id}/{feed_id}/refresh [post]
+func (h *FeedHandler) RefreshFeed(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"feed_id":    r.PathValue("feed_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	created, err := h.Service.RefreshFeed(r.Context(), params["project_id"], params["feed_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(refreshFeedResponse{Created: created})
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
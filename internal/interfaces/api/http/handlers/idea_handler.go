@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/idea"
+	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
+)
+
+type IdeaHandler struct {
+	Service idea.Service
+}
+
+func NewIdeaHandler(service idea.Service) *IdeaHandler {
+	return &IdeaHandler{Service: service}
+}
+
+type commentRequest struct {
+	Content string `json:"content"`
+}
+
+func (r commentRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+	if r.Content == "" {
+		errors["content"] = "required"
+	}
+	return errors
+}
+
+// ListIdeas godoc
+// @Summary List the idea board
+// @Description List the ideas of a project with their votes and comments count. Promoted ideas are not listed.
+// @Tags ideas
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param sort query string false "queue (default) or votes"
+// @Success 200 {array} idea.Idea
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /ideas/{project_id} [get]
+func (h *IdeaHandler) ListIdeas(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	ideas, err := h.Service.ListIdeas(r.Context(), params["project_id"], idea.Sort(r.URL.Query().Get("sort")))
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(ideas)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// SortQueueByVotes godoc
+// @Summary Sort the idea queue by votes
+// @Description Reorder the project idea queue putting the most voted ideas first. Ties keep their order.
+// @Tags ideas
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Success 200 {array} idea.Idea
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /ideas/{project_id}/sort-by-votes [post]
+func (h *IdeaHandler) SortQueueByVotes(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	ideas, err := h.Service.SortQueueByVotes(r.Context(), params["project_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(ideas)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// Vote godoc
+// @Summary Upvote an idea
+// @Description Add the vote of the current user to an idea. Voting twice has no effect.
+// @Tags ideas
+// @Param project_id path string true "Project ID"
+// @Param idea_id path string true "Idea ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 409 {object} errors.APIError "Idea already promoted"
+// @Failure 410 {object} errors.APIError "Idea not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /ideas/{project_id}/{idea_id}/vote [post]
+func (h *IdeaHandler) Vote(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"idea_id":    r.PathValue("idea_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.Vote(r.Context(), params["project_id"], params["idea_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveVote godoc
+// @Summary Remove a vote from an idea
+// @Description Remove the vote of the current user from an idea
+// @Tags ideas
+// @Param project_id path string true "Project ID"
+// @Param idea_id path string true "Idea ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Idea not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /ideas/{project_id}/{idea_id}/vote [delete]
+func (h *IdeaHandler) RemoveVote(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"idea_id":    r.PathValue("idea_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.RemoveVote(r.Context(), params["project_id"], params["idea_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddComment godoc
+// @Summary Comment an idea
+// @Description Add a comment to an idea
+// @Tags ideas
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param idea_id path string true "Idea ID"
+// @Param comment body commentRequest true "Comment"
+// @Success 201 {object} idea.Comment
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Idea not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /ideas/{project_id}/{idea_id}/comments [post]
+func (h *IdeaHandler) AddComment(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"idea_id":    r.PathValue("idea_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[commentRequest](w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.Service.AddComment(r.Context(), params["project_id"], params["idea_id"], req.Content)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(c)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// ListComments godoc
+// @Summary List the comments of an idea
+// @Description List the comments of an idea, oldest first
+// @Tags ideas
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param idea_id path string true "Idea ID"
+// @Success 200 {array} idea.Comment
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Idea not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /ideas/{project_id}/{idea_id}/comments [get]
+func (h *IdeaHandler) ListComments(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"idea_id":    r.PathValue("idea_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	comments, err := h.Service.ListComments(r.Context(), params["project_id"], params["idea_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(comments)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// DeleteComment godoc
+// @Summary Delete a comment
+// @Description Delete a comment of an idea. Only its author can delete it.
+// @Tags ideas
+// @Param project_id path string true "Project ID"
+// @Param idea_id path string true "Idea ID"
+// @Param comment_id path string true "Comment ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Not the comment author"
+// @Failure 410 {object} errors.APIError "Comment not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /ideas/{project_id}/{idea_id}/comments/{comment_id} [delete]
+func (h *IdeaHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"idea_id":    r.PathValue("idea_id"),
+		"comment_id": r.PathValue("comment_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.DeleteComment(r.Context(), params["project_id"], params["idea_id"], params["comment_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PromoteIdea godoc
+// @Summary Promote an idea to a draft
+// @Description Create a draft post from an idea. The draft keeps the idea author, content, platforms and labels and links back to the idea with idea_id. The idea is archived and removed from the idea queue; media stays with the idea.
+// @Tags ideas
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param idea_id path string true "Idea ID"
+// @Success 201 {object} post.Post
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 409 {object} errors.APIError "Idea already promoted"
+// @Failure 410 {object} errors.APIError "Idea not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /ideas/{project_id}/{idea_id}/promote [post]
+func (h *IdeaHandler) PromoteIdea(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"idea_id":    r.PathValue("idea_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	p, err := h.Service.PromoteIdea(r.Context(), params["project_id"], params["idea_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(p)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/importer"
+	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
+)
+
+// maxImportFileSize is the largest import file accepted, media downloads aside
+const maxImportFileSize = 5 << 20
+
+type ImportHandler struct {
+	Service importer.Service
+}
+
+func NewImportHandler(service importer.Service) *ImportHandler {
+	return &ImportHandler{Service: service}
+}
+
+// ImportPosts godoc
+// @Summary Import posts from a CSV or JSON file
+// @Description Create posts in bulk from a CSV or JSON file sent as the request body. Each row has a title, content, type, platforms, scheduled_at (RFC3339, "queue" or empty for a draft), media_urls and media_ids. In CSV files list columns are separated with "|".
+// @Description Either every row is imported or none is. With dry_run=true nothing is created and the report tells what would be. Invalid files return 422 with the row-level errors.
+// @Tags imports
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param format query string false "File format, csv or json. Defaults to the Content-Type"
+// @Param dry_run query bool false "Validate the file without creating anything"
+// @Success 200 {object} importer.Report "Dry run report"
+// @Success 201 {object} importer.Report "Import report"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 422 {object} importer.Report "Invalid rows"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /imports/{project_id} [post]
+func (h *ImportHandler) ImportPosts(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	format := importer.Format(r.URL.Query().Get("format"))
+	if format == "" {
+		format = formatFromContentType(r.Header.Get("Content-Type"))
+	}
+	if !format.IsValid() {
+		e.WriteHttpError(w, e.NewValidationError("Invalid import format", map[string]string{
+			"format": "must be csv or json",
+		}))
+		return
+	}
+
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			e.WriteHttpError(w, e.NewValidationError("Invalid dry_run", map[string]string{
+				"dry_run": "must be a boolean",
+			}))
+			return
+		}
+	}
+
+	body := http.MaxBytesReader(w, r.Body, maxImportFileSize)
+	report, err := h.Service.ImportPosts(r.Context(), params["project_id"], format, body, dryRun)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			e.WriteHttpError(w, e.NewValidationError("Import file too large", map[string]string{
+				"file": "too large",
+			}))
+			return
+		}
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	status := http.StatusOK
+	switch {
+	case !report.Valid:
+		status = http.StatusUnprocessableEntity
+	case report.Committed:
+		status = http.StatusCreated
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err = json.NewEncoder(w).Encode(report)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+func formatFromContentType(contentType string) importer.Format {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	switch mediaType {
+	case "text/csv", "application/csv":
+		return importer.FormatCSV
+	case "application/json":
+		return importer.FormatJSON
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/label"
+	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
+)
+
+type LabelHandler struct {
+	Service label.Service
+}
+
+func NewLabelHandler(service label.Service) *LabelHandler {
+	return &LabelHandler{Service: service}
+}
+
+type labelRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"` // #RRGGBB, defaults to grey
+}
+
+func (r labelRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+	if r.Name == "" {
+		errors["name"] = "Name is required"
+	}
+	return errors
+}
+
+// CreateLabel godoc
+// @Summary Create a label
+// @Description Create a project label that can be attached to posts
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param label body labelRequest true "Label creation request"
+// @Success 201 {object} label.Label
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 409 {object} errors.APIError "Label already exists"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /labels/{project_id} [post]
+func (h *LabelHandler) CreateLabel(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[labelRequest](w, r)
+	if !ok {
+		return
+	}
+
+	l, err := h.Service.CreateLabel(r.Context(), params["project_id"], req.Name, req.Color)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(l)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// UpdateLabel godoc
+// @Summary Update a label
+// @Description Update the name and color of a label
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param label_id path string true "Label ID"
+// @Param label body labelRequest true "Label update request"
+// @Success 200 {object} label.Label
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 409 {object} errors.APIError "Label already exists"
+// @Failure 410 {object} errors.APIError "Label not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /labels/{project_id}/{label_id} [patch]
+func (h *LabelHandler) UpdateLabel(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"label_id":   r.PathValue("label_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[labelRequest](w, r)
+	if !ok {
+		return
+	}
+
+	l, err := h.Service.UpdateLabel(r.Context(), params["project_id"], params["label_id"], req.Name, req.Color)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(l)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// DeleteLabel godoc
+// @Summary Delete a label
+// @Description Delete a label and detach it from every post
+// @Tags labels
+// @Param project_id path string true "Project ID"
+// @Param label_id path string true "Label ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Label not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /labels/{project_id}/{label_id} [delete]
+func (h *LabelHandler) DeleteLabel(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"label_id":   r.PathValue("label_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.DeleteLabel(r.Context(), params["project_id"], params["label_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListProjectLabels godoc
+// @Summary List project labels
+// @Description List the labels of a project
+// @Tags labels
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Success 200 {array} label.Label
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /labels/{project_id} [get]
+func (h *LabelHandler) ListProjectLabels(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	labels, err := h.Service.ListProjectLabels(r.Context(), params["project_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(labels)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// AddLabelToPost godoc
+// @Summary Add a label to a post
+// @Description Attach a project label to a post
+// @Tags labels
+// @Param project_id path string true "Project ID"
+// @Param post_id path string true "Post ID"
+// @Param label_id path string true "Label ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Post not in project"
+// @Failure 410 {object} errors.APIError "Label not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /labels/{project_id}/posts/{post_id}/{label_id} [post]
+func (h *LabelHandler) AddLabelToPost(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"post_id":    r.PathValue("post_id"),
+		"label_id":   r.PathValue("label_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.AddLabelToPost(r.Context(), params["project_id"], params["post_id"], params["label_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveLabelFromPost godoc
+// @Summary Remove a label from a post
+// @Description Detach a label from a post
+// @Tags labels
+// @Param project_id path string true "Project ID"
+// @Param post_id path string true "Post ID"
+// @Param label_id path string true "Label ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Post not in project"
+// @Failure 410 {object} errors.APIError "Label not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /labels/{project_id}/posts/{post_id}/{label_id} [delete]
+func (h *LabelHandler) RemoveLabelFromPost(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"post_id":    r.PathValue("post_id"),
+		"label_id":   r.PathValue("label_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.RemoveLabelFromPost(r.Context(), params["project_id"], params["post_id"], params["label_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetPostLabels godoc
+// @Summary Get the labels of a post
+// @Description Get the labels attached to a post
+// @Tags labels
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param post_id path string true "Post ID"
+// @Success 200 {array} label.Label
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Post not in project"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /labels/{project_id}/posts/{post_id} [get]
+func (h *LabelHandler) GetPostLabels(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"post_id":    r.PathValue("post_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	labels, err := h.Service.GetPostLabels(r.Context(), params["project_id"], params["post_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(labels)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
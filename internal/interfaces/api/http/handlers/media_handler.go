@@ -2,27 +2,73 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/google/uuid"
 	"github.com/redplanettribe/social-media-manager/internal/domain/media"
 	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
 )
 
+const (
+	// uploadFormMemory is how much of an upload form is kept in memory, the rest goes to temporary files
+	uploadFormMemory = 1 << 20
+	// uploadFormOverhead leaves room for the form fields and boundaries next to the file
+	uploadFormOverhead = 1 << 20
+)
+
 type MediaHandler struct {
 	Service media.Service
+	// maxUploadSize is the largest file accepted for any media type
+	maxUploadSize int64
 }
 
-func NewMediaHandler(service media.Service) *MediaHandler {
+func NewMediaHandler(service media.Service, maxUploadSize int64) *MediaHandler {
 	return &MediaHandler{
-		Service: service,
+		Service:       service,
+		maxUploadSize: maxUploadSize,
 	}
 }
 
+// readUploadForm parses an upload form without holding the file in memory and returns its file.
+// Requests bigger than the largest media are refused while being read. The caller must close the file
+// and remove the form files with r.MultipartForm.RemoveAll.
+func (h *MediaHandler) readUploadForm(w http.ResponseWriter, r *http.Request) (multipart.File, *multipart.FileHeader, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxUploadSize+uploadFormOverhead)
+	err := r.ParseMultipartForm(uploadFormMemory)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			e.WriteHttpError(w, e.NewPayloadTooLargeError("File too large"))
+			return nil, nil, false
+		}
+		e.WriteHttpError(w, e.NewValidationError("Invalid form", map[string]string{
+			"file": "invalid",
+		}))
+		return nil, nil, false
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		_ = r.MultipartForm.RemoveAll()
+		e.WriteHttpError(w, e.NewValidationError("Invalid file", map[string]string{
+			"file": "invalid",
+		}))
+		return nil, nil, false
+	}
+	return file, header, true
+}
+
 // UploadMedia godoc
 // @Summary Upload media
-// @Description Upload media
+// @Description Upload media. The media may be returned with the processing status, its thumbnail and details are available once it's ready.
 // @Tags media
 // @Accept mpfd
 // @Param project_id path string true "Project ID"
@@ -34,6 +80,7 @@ func NewMediaHandler(service media.Service) *MediaHandler {
 // @Failure 401 {object} errors.APIError
 // @Failure 403 {object} errors.APIError
 // @Failure 404 {object} errors.APIError
+// @Failure 413 {object} errors.APIError "File too large for its media type"
 // @Failure 500 {object} errors.APIError
 // @Router /media/{project_id}/{post_id} [post]
 func (h *MediaHandler) UploadMedia(w http.ResponseWriter, r *http.Request) {
@@ -47,24 +94,16 @@ func (h *MediaHandler) UploadMedia(w http.ResponseWriter, r *http.Request) {
 	projectID := r.PathValue("project_id")
 	postID := r.PathValue("post_id")
 
-	altText := r.FormValue("alt_text")
-
-	file, header, err := r.FormFile("file")
-	if err != nil {
-		e.WriteHttpError(w, e.NewValidationError("Invalid file", map[string]string{
-			"file": "invalid",
-		}))
-		return
-	}
-
-	data, err := io.ReadAll(file)
-	if err != nil {
-		http.Error(w, "failed to read file", http.StatusInternalServerError)
+	file, header, ok := h.readUploadForm(w, r)
+	if !ok {
 		return
 	}
+	defer r.MultipartForm.RemoveAll()
 	defer file.Close()
 
-	downloadMetadata, err := h.Service.UploadMedia(r.Context(), projectID, postID, header.Filename, altText, data)
+	altText := r.FormValue("alt_text")
+
+	downloadMetadata, err := h.Service.UploadMedia(r.Context(), projectID, postID, header.Filename, altText, file)
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
@@ -112,14 +151,13 @@ func (h *MediaHandler) GetMediaFile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	defer m.Close()
+
 	w.Header().Set("Content-Type", fmt.Sprintf("%s/%s", m.Type, m.Format))
 	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
-	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(m.Data)))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", m.File.Size()))
 	w.WriteHeader(http.StatusOK)
-	_, err = w.Write(m.Data)
-	if err != nil {
-		e.WriteHttpError(w, e.NewInternalError("Failed to write response"))
-	}
+	_, _ = io.Copy(w, m.File.Reader())
 }
 
 // LinkMediaToPublishPost godoc
@@ -282,6 +320,7 @@ func (h *MediaHandler) GetDownloadMetadataDataForPost(w http.ResponseWriter, r *
 // @Failure 401 {object} errors.APIError
 // @Failure 403 {object} errors.APIError
 // @Failure 404 {object} errors.APIError
+// @Failure 409 {object} errors.APIError "Media is still used by other posts or by the project branding"
 // @Failure 500 {object} errors.APIError
 // @Router /media/{project_id}/{post_id}/{file_name} [delete]
 func (h *MediaHandler) DeleteMedia(w http.ResponseWriter, r *http.Request) {
@@ -305,3 +344,672 @@ func (h *MediaHandler) DeleteMedia(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// CollectGarbage godoc
+// @Summary Reconcile the object store with the media metadata
+// @Description Find the files of the object store without metadata, older than the grace period, and the metadata whose file is missing. Orphaned files are only deleted when dry_run is false.
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param dry_run query bool false "Only report, without deleting anything" default(true)
+// @Param grace query string false "Grace period for files without metadata, as a Go duration" default(24h)
+// @Success 200 {object} media.GCReport
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/gc [post]
+func (h *MediaHandler) CollectGarbage(w http.ResponseWriter, r *http.Request) {
+	dryRun := true
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			e.WriteHttpError(w, e.NewValidationError("Invalid dry_run", map[string]string{
+				"dry_run": "must be a boolean",
+			}))
+			return
+		}
+	}
+
+	grace := media.DefaultGCGracePeriod
+	if v := r.URL.Query().Get("grace"); v != "" {
+		var err error
+		grace, err = time.ParseDuration(v)
+		if err != nil || grace < 0 {
+			e.WriteHttpError(w, e.NewValidationError("Invalid grace", map[string]string{
+				"grace": "must be a positive duration, like 24h",
+			}))
+			return
+		}
+	}
+
+	report, err := h.Service.CollectGarbage(r.Context(), time.Now().UTC().Add(-grace), dryRun)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(report)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// UploadLibraryMedia godoc
+// @Summary Upload media to the project library
+// @Description Upload a media once to the project library. It can then be attached to any post of the project with the link endpoint.
+// @Tags media
+// @Accept mpfd
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param file formData file true "File"
+// @Param alt_text formData string false "Alt text"
+// @Param folder formData string false "Folder, like brand/logos"
+// @Param tags formData string false "Comma separated tags"
+// @Success 201 {object} media.DownloadMetaData
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 409 {object} errors.APIError "File already exists"
+// @Failure 413 {object} errors.APIError "File too large for its media type"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/library [post]
+func (h *MediaHandler) UploadLibraryMedia(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	file, header, ok := h.readUploadForm(w, r)
+	if !ok {
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+	defer file.Close()
+
+	downloadMetadata, err := h.Service.UploadLibraryMedia(
+		r.Context(),
+		params["project_id"],
+		header.Filename,
+		r.FormValue("alt_text"),
+		r.FormValue("folder"),
+		strings.Split(r.FormValue("tags"), ","),
+		file,
+	)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(downloadMetadata)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// ListLibraryMedia godoc
+// @Summary List the project media library
+// @Description List the media of the project library, with how many post platforms use each of them
+// @Tags media
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param folder query string false "Only media in this folder"
+// @Param tag query string false "Only media with this tag"
+// @Param q query string false "Search in file names and alt texts"
+// @Success 200 {array} media.LibraryMedia
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/library [get]
+func (h *MediaHandler) ListLibraryMedia(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	query := r.URL.Query()
+	items, err := h.Service.ListLibraryMedia(r.Context(), params["project_id"], &media.LibraryFilter{
+		Folder: query.Get("folder"),
+		Tag:    query.Get("tag"),
+		Query:  query.Get("q"),
+	})
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(items)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+type updateLibraryMediaRequest struct {
+	AltText string   `json:"alt_text"`
+	Folder  string   `json:"folder"`
+	Tags    []string `json:"tags"`
+}
+
+func (req updateLibraryMediaRequest) Validate() map[string]string {
+	return make(map[string]string)
+}
+
+// UpdateLibraryMedia godoc
+// @Summary Update a library media
+// @Description Change the alt text, folder and tags of a media of the project library
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param media_id path string true "Media ID"
+// @Param media body updateLibraryMediaRequest true "Library media update request"
+// @Success 200 {object} media.LibraryMedia
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 410 {object} errors.APIError "Media not found in library"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/library/{media_id} [patch]
+func (h *MediaHandler) UpdateLibraryMedia(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"media_id":   r.PathValue("media_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[updateLibraryMediaRequest](w, r)
+	if !ok {
+		return
+	}
+
+	item, err := h.Service.UpdateLibraryMedia(r.Context(), params["project_id"], params["media_id"], req.AltText, req.Folder, req.Tags)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(item)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// DeleteLibraryMedia godoc
+// @Summary Delete a library media
+// @Description Delete a media of the project library. Media still attached to a post, or used as the logo of the project branding, can't be deleted.
+// @Tags media
+// @Param project_id path string true "Project ID"
+// @Param media_id path string true "Media ID"
+// @Success 204
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 409 {object} errors.APIError "Media is still used by posts or by the project branding"
+// @Failure 410 {object} errors.APIError "Media not found in library"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/library/{media_id} [delete]
+func (h *MediaHandler) DeleteLibraryMedia(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"media_id":   r.PathValue("media_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.DeleteLibraryMedia(r.Context(), params["project_id"], params["media_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type initiateUploadRequest struct {
+	FileName string   `json:"file_name"`
+	Size     int64    `json:"size"`
+	AltText  string   `json:"alt_text"`
+	Folder   string   `json:"folder"`
+	Tags     []string `json:"tags"`
+}
+
+func (req initiateUploadRequest) Validate() map[string]string {
+	errs := make(map[string]string)
+	if req.FileName == "" {
+		errs["file_name"] = "required"
+	}
+	if req.Size <= 0 {
+		errs["size"] = "must be positive"
+	}
+	return errs
+}
+
+// InitiateUpload godoc
+// @Summary Initiate a direct upload
+// @Description Get a presigned URL where the file is sent with a PUT request, straight to the object store. The upload must then be completed before it expires. Folder and tags only apply to the library.
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param post_id path string true "Post ID"
+// @Param upload body initiateUploadRequest true "Upload request"
+// @Success 201 {object} media.Upload
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 409 {object} errors.APIError "File already exists"
+// @Failure 413 {object} errors.APIError "File too large for its media type"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/{post_id}/uploads [post]
+// @Router /media/{project_id}/library/uploads [post]
+func (h *MediaHandler) InitiateUpload(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[initiateUploadRequest](w, r)
+	if !ok {
+		return
+	}
+
+	// The library route has no post
+	upload, err := h.Service.InitiateUpload(
+		r.Context(),
+		params["project_id"],
+		r.PathValue("post_id"),
+		req.FileName,
+		req.AltText,
+		req.Folder,
+		req.Tags,
+		req.Size,
+	)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(upload)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// CompleteUpload godoc
+// @Summary Complete a direct upload
+// @Description Save the file sent to the URL of an upload as a media. The media may be returned with the processing status, its thumbnail and details are available once it's ready. Files that can't be used are removed and the upload has to be initiated again.
+// @Tags media
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param upload_id path string true "Upload ID"
+// @Success 201 {object} media.DownloadMetaData
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 409 {object} errors.APIError "File already exists"
+// @Failure 410 {object} errors.APIError "Upload not found or expired"
+// @Failure 413 {object} errors.APIError "File bigger than announced"
+// @Failure 422 {object} errors.APIError "File not uploaded or not a valid media"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/uploads/{upload_id}/complete [post]
+func (h *MediaHandler) CompleteUpload(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"upload_id":  r.PathValue("upload_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	downloadMetadata, err := h.Service.CompleteUpload(r.Context(), params["project_id"], params["upload_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(downloadMetadata)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// GetProcessingStatus godoc
+// @Summary Get the processing status of media
+// @Description Get whether uploaded media are still processing, ready or failed. Unknown ids are left out.
+// @Tags media
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param ids query string true "Comma separated media IDs"
+// @Success 200 {array} media.ProcessingStatus
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/status [get]
+func (h *MediaHandler) GetProcessingStatus(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	ids := strings.Split(r.URL.Query().Get("ids"), ",")
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			e.WriteHttpError(w, e.NewValidationError("Invalid media id", map[string]string{
+				"ids": "must be comma separated uuids",
+			}))
+			return
+		}
+	}
+
+	statuses, err := h.Service.GetProcessingStatus(r.Context(), params["project_id"], ids)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(statuses)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+type setFocalPointRequest struct {
+	// X and Y are fractions of the width and height of the image, from its top left corner
+	X *float64 `json:"x"`
+	Y *float64 `json:"y"`
+}
+
+func (req setFocalPointRequest) Validate() map[string]string {
+	errs := make(map[string]string)
+	if msg := validateFraction(req.X); msg != "" {
+		errs["x"] = msg
+	}
+	if msg := validateFraction(req.Y); msg != "" {
+		errs["y"] = msg
+	}
+	return errs
+}
+
+func validateFraction(v *float64) string {
+	switch {
+	case v == nil:
+		return "required"
+	case *v < 0 || *v > 1:
+		return "must be between 0 and 1"
+	}
+	return ""
+}
+
+// SetFocalPoint godoc
+// @Summary Set the focal point of an image
+// @Description Set the point of an image kept in view when it's cropped to the aspect ratio of a platform. The renditions are made again with it when the image is published.
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param media_id path string true "Media ID"
+// @Param focal_point body setFocalPointRequest true "Focal point"
+// @Success 200 {object} media.MetaData
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 410 {object} errors.APIError "Media not found"
+// @Failure 422 {object} errors.APIError "Media is not an image"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/{media_id}/focal-point [put]
+func (h *MediaHandler) SetFocalPoint(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"media_id":   r.PathValue("media_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[setFocalPointRequest](w, r)
+	if !ok {
+		return
+	}
+
+	md, err := h.Service.SetFocalPoint(r.Context(), params["project_id"], params["media_id"], *req.X, *req.Y)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(md)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// GetBranding godoc
+// @Summary Get the project branding
+// @Description Get the logo, and its placement, stamped on the images of the posts that opt in
+// @Tags media
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Success 200 {object} media.Branding
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 410 {object} errors.APIError "Branding not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/branding [get]
+func (h *MediaHandler) GetBranding(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	b, err := h.Service.GetBranding(r.Context(), params["project_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(b)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+type setBrandingRequest struct {
+	// LogoMediaID is an image of the project library
+	LogoMediaID string `json:"logo_media_id"`
+	// Position is top_left, top_right, bottom_left, bottom_right or center
+	Position string `json:"position"`
+	// Opacity goes from 0, invisible, to 1, opaque
+	Opacity *float64 `json:"opacity"`
+	// Scale is the width of the logo as a fraction of the width of the image
+	Scale *float64 `json:"scale"`
+	// Text is written under the logo, optional
+	Text string `json:"text"`
+}
+
+func (req setBrandingRequest) Validate() map[string]string {
+	errs := make(map[string]string)
+	if _, err := uuid.Parse(req.LogoMediaID); err != nil {
+		errs["logo_media_id"] = "must be a uuid"
+	}
+	if !media.BrandingPosition(req.Position).IsValid() {
+		errs["position"] = "must be top_left, top_right, bottom_left, bottom_right or center"
+	}
+	if msg := validateFraction(req.Opacity); msg != "" {
+		errs["opacity"] = msg
+	}
+	switch {
+	case req.Scale == nil:
+		errs["scale"] = "required"
+	case *req.Scale <= 0 || *req.Scale > 1:
+		errs["scale"] = "must be greater than 0 and at most 1"
+	}
+	if utf8.RuneCountInString(req.Text) > media.MaxBrandingTextLength {
+		errs["text"] = fmt.Sprintf("must be at most %d characters", media.MaxBrandingTextLength)
+	}
+	return errs
+}
+
+// SetBranding godoc
+// @Summary Set the project branding
+// @Description Set the logo, and its placement, stamped on the images of the posts that opt in when they are published. The media stay as they are, only the files sent to the platforms are stamped.
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param branding body setBrandingRequest true "Branding"
+// @Success 200 {object} media.Branding
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 422 {object} errors.APIError "Logo is not an image"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/branding [put]
+func (h *MediaHandler) SetBranding(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[setBrandingRequest](w, r)
+	if !ok {
+		return
+	}
+
+	b, err := h.Service.SetBranding(r.Context(), &media.Branding{
+		ProjectID:   params["project_id"],
+		LogoMediaID: req.LogoMediaID,
+		Position:    media.BrandingPosition(req.Position),
+		Opacity:     *req.Opacity,
+		Scale:       *req.Scale,
+		Text:        req.Text,
+	})
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(b)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// DeleteBranding godoc
+// @Summary Delete the project branding
+// @Description Delete the project branding. The posts that opted in are published without it.
+// @Tags media
+// @Param project_id path string true "Project ID"
+// @Success 204
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Forbidden"
+// @Failure 410 {object} errors.APIError "Branding not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/branding [delete]
+func (h *MediaHandler) DeleteBranding(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.DeleteBranding(r.Context(), params["project_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type setPostBrandingRequest struct {
+	Apply *bool `json:"apply"`
+}
+
+func (req setPostBrandingRequest) Validate() map[string]string {
+	errs := make(map[string]string)
+	if req.Apply == nil {
+		errs["apply"] = "required"
+	}
+	return errs
+}
+
+// SetPostBranding godoc
+// @Summary Opt a post in or out of the project branding
+// @Description Set whether the project branding is stamped on the images of a post when it's published to a platform
+// @Tags media
+// @Accept json
+// @Param project_id path string true "Project ID"
+// @Param post_id path string true "Post ID"
+// @Param platform_id path string true "Platform ID"
+// @Param branding body setPostBrandingRequest true "Whether to apply the branding"
+// @Success 204
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 403 {object} errors.APIError "Post not linked to platform"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /media/{project_id}/{post_id}/{platform_id}/branding [put]
+func (h *MediaHandler) SetPostBranding(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"post_id":     r.PathValue("post_id"),
+		"platform_id": r.PathValue("platform_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[setPostBrandingRequest](w, r)
+	if !ok {
+		return
+	}
+
+	err := h.Service.SetPostBranding(r.Context(), params["project_id"], params["post_id"], params["platform_id"], *req.Apply)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/notification"
+	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
+)
+
+type NotificationHandler struct {
+	Service notification.Service
+}
+
+func NewNotificationHandler(service notification.Service) *NotificationHandler {
+	return &NotificationHandler{Service: service}
+}
+
+// ListProjectNotifications godoc
+// @Summary List project notifications
+// @Description List the notifications sent to the project team, newest first
+// @Tags notifications
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param unread query bool false "Only return unread notifications"
+// @Success 200 {array} notification.Notification
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /notifications/{project_id} [get]
+func (h *NotificationHandler) ListProjectNotifications(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": "required",
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	projectID := r.PathValue("project_id")
+	unreadOnly := r.URL.Query().Get("unread") == "true"
+
+	notifications, err := h.Service.ListProjectNotifications(r.Context(), projectID, unreadOnly)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(notifications)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// MarkNotificationAsRead godoc
+// @Summary Mark a notification as read
+// @Description Mark a notification as read
+// @Tags notifications
+// @Param project_id path string true "Project ID"
+// @Param notification_id path string true "Notification ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Notification not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /notifications/{project_id}/{notification_id}/read [patch]
+func (h *NotificationHandler) MarkNotificationAsRead(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":      "required",
+		"notification_id": "required",
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	projectID := r.PathValue("project_id")
+	notificationID := r.PathValue("notification_id")
+
+	err := h.Service.MarkAsRead(r.Context(), projectID, notificationID)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -3,9 +3,13 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/redplanettribe/social-media-manager/internal/domain/post"
+	"github.com/redplanettribe/social-media-manager/internal/domain/publisher"
 	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
 )
 
@@ -96,10 +100,13 @@ func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 // @Param project_id path string true "Project ID"
 // @Param post_id path string true "Post ID"
 // @Param post body createPostRequest true "Post update request"
-// @Success 204 "No content"
+// @Param If-Match header string false "ETag of the post the update is based on"
+// @Success 200 {object} post.Post
+// @Header 200 {string} ETag "Version of the post"
 // @Failure 400 {object} errors.APIError "Validation error"
 // @Failure 401 {object} errors.APIError "Unauthorized"
 // @Failure 410 {object} errors.APIError "Post not found"
+// @Failure 412 {object} errors.APIError "Post was modified since the given version"
 // @Failure 500 {object} errors.APIError "Internal server error"
 // @Security ApiKeyAuth
 // @Router /posts/{project_id}/{post_id} [patch]
@@ -115,6 +122,10 @@ func (h *PostHandler) UpdatePost(w http.ResponseWriter, r *http.Request) {
 	if !requirePathParams(w, params) {
 		return
 	}
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
+	}
 
 	postID := r.PathValue("post_id")
 	projectID := r.PathValue("project_id")
@@ -126,12 +137,14 @@ func (h *PostHandler) UpdatePost(w http.ResponseWriter, r *http.Request) {
 		req.Type,
 		req.TextContent,
 		req.IsIdea,
+		version,
 	)
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
 	}
 
+	setETag(w, p.Version)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	err = json.NewEncoder(w).Encode(p)
@@ -148,6 +161,7 @@ func (h *PostHandler) UpdatePost(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param post_id path string true "Post ID"
 // @Success 200 {object} post.PostResponse
+// @Header 200 {string} ETag "Version of the post"
 // @Failure 400 {object} errors.APIError "Validation error"
 // @Failure 401 {object} errors.APIError "Unauthorized"
 // @Failure 410 {object} errors.APIError "Post not found"
@@ -169,6 +183,7 @@ func (h *PostHandler) GetPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setETag(w, post.Version)
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(post)
 	if err != nil {
@@ -177,13 +192,31 @@ func (h *PostHandler) GetPost(w http.ResponseWriter, r *http.Request) {
 }
 
 // ListProjectPosts godoc
-// @Summary List all posts of a project
-// @Description List all posts of a project by its id
+// @Summary Search the posts of a project
+// @Description Search, filter and paginate the posts of a project. Filtering on a scheduled date range gives the calendar view. Results are paginated with an opaque cursor: pass the next_cursor of a page to get the following one, keeping the same filters and sort.
 // @Tags posts
 // @Accept json
 // @Produce json
 // @Param project_id path string true "Project ID"
-// @Success 200 {array} post.Post
+// @Param status query string false "Post status" Enums(draft, queued, scheduled, published, partially_published, failed, archived)
+// @Param type query string false "Post type"
+// @Param platform query string false "Only posts linked to this platform"
+// @Param author query string false "ID of the user who created the post"
+// @Param is_idea query bool false "Only ideas, or only non ideas"
+// @Param label query string false "Only posts with this label ID"
+// @Param campaign query string false "Only posts of this campaign ID"
+// @Param q query string false "Free-text search over title and content"
+// @Param created_from query string false "Created at or after (RFC3339)"
+// @Param created_to query string false "Created at or before (RFC3339)"
+// @Param scheduled_from query string false "Scheduled at or after (RFC3339)"
+// @Param scheduled_to query string false "Scheduled at or before (RFC3339)"
+// @Param published_from query string false "Published at or after (RFC3339)"
+// @Param published_to query string false "Published at or before (RFC3339)"
+// @Param sort query string false "Sort field" Enums(created_at, updated_at, scheduled_at, published_at, title) default(created_at)
+// @Param order query string false "Sort order" Enums(asc, desc) default(desc)
+// @Param limit query int false "Page size, max 100" default(20)
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Success 200 {object} post.PostPage
 // @Failure 400 {object} errors.APIError "Validation error"
 // @Failure 401 {object} errors.APIError "Unauthorized"
 // @Failure 410 {object} errors.APIError "Project not found"
@@ -199,19 +232,86 @@ func (h *PostHandler) ListProjectPosts(w http.ResponseWriter, r *http.Request) {
 	}
 	projectID := r.PathValue("project_id")
 
-	posts, err := h.Service.ListProjectPosts(r.Context(), projectID)
+	filter, validationErrors := parsePostFilter(r.URL.Query())
+	if len(validationErrors) > 0 {
+		e.WriteHttpError(w, e.NewValidationError("Invalid query parameters", validationErrors))
+		return
+	}
+
+	page, err := h.Service.ListProjectPosts(r.Context(), projectID, filter)
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(w).Encode(posts)
+	err = json.NewEncoder(w).Encode(page)
 	if err != nil {
 		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
 	}
 }
 
+func parsePostFilter(q url.Values) (*post.PostFilter, map[string]string) {
+	errors := make(map[string]string)
+	filter := &post.PostFilter{
+		Status:     post.PostStatus(q.Get("status")),
+		Type:       post.PostType(q.Get("type")),
+		PlatformID: q.Get("platform"),
+		CreatedBy:  q.Get("author"),
+		LabelID:    q.Get("label"),
+		CampaignID: q.Get("campaign"),
+		Query:      q.Get("q"),
+		SortBy:     post.SortField(q.Get("sort")),
+		SortOrder:  post.SortOrder(q.Get("order")),
+		Cursor:     q.Get("cursor"),
+	}
+
+	// The ids are compared with uuid columns, an invalid one would fail the query
+	for field, v := range map[string]string{"author": filter.CreatedBy, "label": filter.LabelID, "campaign": filter.CampaignID} {
+		if _, err := uuid.Parse(v); v != "" && err != nil {
+			errors[field] = "must be a uuid"
+		}
+	}
+	if filter.PlatformID != "" && !publisher.PlatformID(filter.PlatformID).IsValid() {
+		errors["platform"] = "must be a known platform id"
+	}
+
+	if v := q.Get("is_idea"); v != "" {
+		isIdea, err := strconv.ParseBool(v)
+		if err != nil {
+			errors["is_idea"] = "must be a boolean"
+		} else {
+			filter.IsIdea = &isIdea
+		}
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			errors["limit"] = "must be a positive integer"
+		} else {
+			filter.Limit = limit
+		}
+	}
+
+	parseTime := func(field string) *time.Time {
+		v := q.Get(field)
+		if v == "" {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			errors[field] = "must be an RFC3339 date"
+			return nil
+		}
+		return &t
+	}
+	filter.Created = post.TimeRange{From: parseTime("created_from"), To: parseTime("created_to")}
+	filter.Scheduled = post.TimeRange{From: parseTime("scheduled_from"), To: parseTime("scheduled_to")}
+	filter.Published = post.TimeRange{From: parseTime("published_from"), To: parseTime("published_to")}
+
+	return filter, errors
+}
+
 // ArchivePost godoc
 // @Summary Archive a post
 // @Description Archive a post by its id
@@ -284,7 +384,7 @@ func (h *PostHandler) RestorePost(w http.ResponseWriter, r *http.Request) {
 
 // DeletePost godoc
 // @Summary Delete a post
-// @Description Delete a post by its id. We might or might not want to implement pagination and filtering. For the time being, we will keep it simple.
+// @Description Move a post to the trash of its project. It can be restored until the trash retention expires, then it is removed for good with its media.
 // @Tags posts
 // @Accept json
 // @Produce json
@@ -314,6 +414,73 @@ func (h *PostHandler) DeletePost(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// ListTrash godoc
+// @Summary List the trash of a project
+// @Description List the deleted posts of a project that can still be restored, most recently deleted first
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Success 200 {array} post.TrashedPost
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /posts/{project_id}/trash [get]
+func (h *PostHandler) ListTrash(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	projectID := r.PathValue("project_id")
+
+	posts, err := h.Service.ListTrash(r.Context(), projectID)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(posts)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// RestoreFromTrash godoc
+// @Summary Restore a deleted post
+// @Description Take a post out of the trash. Posts that were scheduled or queued when deleted come back as drafts.
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param post_id path string true "Post ID"
+// @Success 204 "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Post not in trash"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /posts/{project_id}/trash/{post_id}/restore [patch]
+func (h *PostHandler) RestoreFromTrash(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"post_id":    r.PathValue("post_id"),
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.RestoreFromTrash(r.Context(), params["project_id"], params["post_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // AddSocialMediaPublisherPlatform godoc
 // @Summary Add a social media publisher platform to a post
 // @Description Add a social media publisher platform to a post by its id
@@ -482,10 +649,11 @@ func (h *PostHandler) UnschedulePost(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param post_id path string true "Post ID"
 // @Param project_id path string true "Project ID"
+// @Param queue query string false "Queue name, the default queue if empty"
 // @Success 204 "No content"
 // @Failure 400 {object} errors.APIError "Validation error"
 // @Failure 401 {object} errors.APIError "Unauthorized"
-// @Failure 410 {object} errors.APIError "Post not found"
+// @Failure 410 {object} errors.APIError "Post or queue not found"
 // @Failure 500 {object} errors.APIError "Internal server error"
 // @Security ApiKeyAuth
 // @Router /posts/{project_id}/{post_id}/enqueue [patch]
@@ -501,7 +669,7 @@ func (h *PostHandler) AddPostToProjectQueue(w http.ResponseWriter, r *http.Reque
 	postID := r.PathValue("post_id")
 	projectID := r.PathValue("project_id")
 
-	err := h.Service.AddToProjectQueue(r.Context(), projectID, postID)
+	err := h.Service.AddToProjectQueue(r.Context(), projectID, postID, r.URL.Query().Get("queue"))
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
@@ -550,10 +718,11 @@ func (h *PostHandler) RemovePostFromProjectQueue(w http.ResponseWriter, r *http.
 // @Accept json
 // @Produce json
 // @Param project_id path string true "Project ID"
+// @Param queue query string false "Queue name, the default queue if empty"
 // @Success 200 {array} post.Post
 // @Failure 400 {object} errors.APIError "Validation error"
 // @Failure 401 {object} errors.APIError "Unauthorized"
-// @Failure 410 {object} errors.APIError "Project not found"
+// @Failure 410 {object} errors.APIError "Project or queue not found"
 // @Failure 500 {object} errors.APIError "Internal server error"
 // @Security ApiKeyAuth
 // @Router /posts/{project_id}/queue [get]
@@ -566,7 +735,7 @@ func (h *PostHandler) GetProjectQueuedPosts(w http.ResponseWriter, r *http.Reque
 	}
 	projectID := r.PathValue("project_id")
 
-	posts, err := h.Service.GetProjectQueuedPosts(r.Context(), projectID)
+	posts, err := h.Service.GetProjectQueuedPosts(r.Context(), projectID, r.URL.Query().Get("queue"))
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
@@ -580,8 +749,9 @@ func (h *PostHandler) GetProjectQueuedPosts(w http.ResponseWriter, r *http.Reque
 }
 
 type moveInQueueRequest struct {
-	CurrentIndex int `json:"current_index"`
-	NewIndex     int `json:"new_index"`
+	Queue        string `json:"queue,omitempty"` // Only used for the post queues, the default queue if empty
+	CurrentIndex int    `json:"current_index"`
+	NewIndex     int    `json:"new_index"`
 }
 
 func (req moveInQueueRequest) Validate() map[string]string {
@@ -625,12 +795,134 @@ func (h *PostHandler) MovePostInQueue(w http.ResponseWriter, r *http.Request) {
 	}
 	projectID := r.PathValue("project_id")
 
-	err := h.Service.MovePostInQueue(r.Context(), projectID, req.CurrentIndex, req.NewIndex)
+	err := h.Service.MovePostInQueue(r.Context(), projectID, req.Queue, req.CurrentIndex, req.NewIndex)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type createQueueRequest struct {
+	Name string `json:"name"`
+}
+
+func (req createQueueRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+	if req.Name == "" {
+		errors["name"] = "required"
+	}
+	return errors
+}
+
+// CreateQueue godoc
+// @Summary Create a named post queue
+// @Description Create a named queue, e.g. "tips" or "promotions", that schedule slots can draw posts from
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param queue body createQueueRequest true "Queue"
+// @Success 201 {object} post.NamedQueue
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 409 {object} errors.APIError "Queue already exists"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /posts/{project_id}/queues [post]
+func (h *PostHandler) CreateQueue(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	projectID := r.PathValue("project_id")
+
+	req, ok := validateRequestBody[createQueueRequest](w, r)
+	if !ok {
+		return
+	}
+
+	q, err := h.Service.CreateQueue(r.Context(), projectID, req.Name)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(q)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// ListQueues godoc
+// @Summary List the post queues of a project
+// @Description List the default queue and the named queues of a project with their size
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Success 200 {array} post.NamedQueue
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /posts/{project_id}/queues [get]
+func (h *PostHandler) ListQueues(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	projectID := r.PathValue("project_id")
+
+	queues, err := h.Service.ListQueues(r.Context(), projectID)
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(queues)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// DeleteQueue godoc
+// @Summary Delete a named post queue
+// @Description Delete a named queue. Its posts are moved to the end of the default queue
+// @Tags posts
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param queue path string true "Queue name"
+// @Success 204 "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Queue not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /posts/{project_id}/queues/{queue} [delete]
+func (h *PostHandler) DeleteQueue(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"queue":      r.PathValue("queue"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.DeleteQueue(r.Context(), r.PathValue("project_id"), r.PathValue("queue"))
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
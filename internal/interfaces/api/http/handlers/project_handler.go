@@ -72,10 +72,13 @@ func (h *ProjectHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param project_id path string true "Project ID"
 // @Param project body createProjectRequest true "Project update request"
+// @Param If-Match header string false "ETag of the project the update is based on"
 // @Success 200 {object} project.Project
+// @Header 200 {string} ETag "Version of the project"
 // @Failure 400 {object} errors.APIError "Validation error"
 // @Failure 401 {object} errors.APIError "Unauthorized"
 // @Failure 410 {object} errors.APIError "Project not found"
+// @Failure 412 {object} errors.APIError "Project was modified since the given version"
 // @Failure 500 {object} errors.APIError "Internal server error"
 // @Security ApiKeyAuth
 // @Router /projects/{project_id} [patch]
@@ -91,13 +94,18 @@ func (h *ProjectHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	projectID := r.PathValue("project_id")
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
+	}
 
-	p, err := h.Service.UpdateProject(r.Context(), projectID, req.Name, req.Description)
+	p, err := h.Service.UpdateProject(r.Context(), projectID, req.Name, req.Description, version)
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
 	}
 
+	setETag(w, p.Version)
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(p)
 	if err != nil {
@@ -171,6 +179,7 @@ func (h *ProjectHandler) ListProjects(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param project_id path string true "Project ID"
 // @Success 200 {object} project.Project
+// @Header 200 {string} ETag "Version of the project"
 // @Failure 400 {object} errors.APIError "Validation error"
 // @Failure 401 {object} errors.APIError "Unauthorized"
 // @Failure 410 {object} errors.APIError "Project not found"
@@ -192,6 +201,7 @@ func (h *ProjectHandler) GetProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setETag(w, p.Project.Version)
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(p)
 	if err != nil {
@@ -523,9 +533,10 @@ func (h *ProjectHandler) GetEnabledSocialPlatforms(w http.ResponseWriter, r *htt
 }
 
 type addTimeSlotRequest struct {
-	DayOfWeek int `json:"day_of_week"` // time.Weekday
-	Hour      int `json:"hour"`
-	Minute    int `json:"minute"`
+	DayOfWeek int    `json:"day_of_week"` // time.Weekday
+	Hour      int    `json:"hour"`
+	Minute    int    `json:"minute"`
+	Queue     string `json:"queue,omitempty"` // Queue the slot draws posts from, the default queue if empty
 }
 
 func (r addTimeSlotRequest) Validate() map[string]string {
@@ -544,16 +555,19 @@ func (r addTimeSlotRequest) Validate() map[string]string {
 
 // AddTimeSlot godoc
 // @Summary Add a time slot to a project
-// @Description Add a time slot to a project
+// @Description Add a time slot to a project, or assign an existing one to another queue
 // @Tags projects
 // @Accept json
 // @Produce json
 // @Param project_id path string true "Project ID"
 // @Param time_slot body addTimeSlotRequest true "Time slot request"
+// @Param If-Match header string false "ETag of the schedule the change is based on"
 // @Success 204 {string} string "No content"
+// @Header 204 {string} ETag "Version of the schedule"
 // @Failure 400 {object} errors.APIError "Validation error"
 // @Failure 401 {object} errors.APIError "Unauthorized"
 // @Failure 410 {object} errors.APIError "Project not found"
+// @Failure 412 {object} errors.APIError "Schedule was modified since the given version"
 // @Failure 500 {object} errors.APIError "Internal server error"
 // @Security ApiKeyAuth
 // @Router /projects/{project_id}/add-time-slot [patch]
@@ -570,13 +584,18 @@ func (h *ProjectHandler) AddTimeSlot(w http.ResponseWriter, r *http.Request) {
 	if !ok {
 		return
 	}
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
+	}
 
-	err := h.Service.AddTimeSlot(r.Context(), projectID, time.Weekday(req.DayOfWeek), req.Hour, req.Minute)
+	schedule, err := h.Service.AddTimeSlot(r.Context(), projectID, time.Weekday(req.DayOfWeek), req.Hour, req.Minute, req.Queue, version)
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
 	}
 
+	setETag(w, schedule.Version)
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -588,10 +607,13 @@ func (h *ProjectHandler) AddTimeSlot(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param project_id path string true "Project ID"
 // @Param time_slot body addTimeSlotRequest true "Time slot request"
+// @Param If-Match header string false "ETag of the schedule the change is based on"
 // @Success 204 {object} nil "No Content"
+// @Header 204 {string} ETag "Version of the schedule"
 // @Failure 400 {object} errors.APIError "Validation error"
 // @Failure 401 {object} errors.APIError "Unauthorized"
 // @Failure 410 {object} errors.APIError "Project not found"
+// @Failure 412 {object} errors.APIError "Schedule was modified since the given version"
 // @Failure 500 {object} errors.APIError "Internal server error"
 // @Security ApiKeyAuth
 // @Router /projects/{project_id}/remove-time-slot [patch]
@@ -608,13 +630,18 @@ func (h *ProjectHandler) RemoveTimeSlot(w http.ResponseWriter, r *http.Request)
 	if !ok {
 		return
 	}
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
+	}
 
-	err := h.Service.RemoveTimeSlot(r.Context(), projectID, time.Weekday(req.DayOfWeek), req.Hour, req.Minute)
+	schedule, err := h.Service.RemoveTimeSlot(r.Context(), projectID, time.Weekday(req.DayOfWeek), req.Hour, req.Minute, version)
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
 	}
 
+	setETag(w, schedule.Version)
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -626,6 +653,7 @@ func (h *ProjectHandler) RemoveTimeSlot(w http.ResponseWriter, r *http.Request)
 // @Produce json
 // @Param project_id path string true "Project ID"
 // @Success 200 {object} project.WeeklyPostSchedule
+// @Header 200 {string} ETag "Version of the schedule"
 // @Failure 400 {object} errors.APIError "Validation error"
 // @Failure 401 {object} errors.APIError "Unauthorized"
 // @Failure 410 {object} errors.APIError "Project not found"
@@ -647,6 +675,7 @@ func (h *ProjectHandler) GetProjectSchedule(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	setETag(w, schedule.Version)
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(schedule)
 	if err != nil {
@@ -727,3 +756,91 @@ func (h *ProjectHandler) GetDefaultUserPlatformInfo(w http.ResponseWriter, r *ht
 		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
 	}
 }
+
+type setPreflightModeRequest struct {
+	Mode string `json:"mode"` // strict or warn
+}
+
+func (r setPreflightModeRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+	if !project.PreflightMode(r.Mode).IsValid() {
+		errors["mode"] = "Mode must be strict or warn"
+	}
+	return errors
+}
+
+type preflightModeResponse struct {
+	Mode project.PreflightMode `json:"mode"`
+}
+
+// GetPreflightMode godoc
+// @Summary Get the project preflight mode
+// @Description Get what happens when a post fails validation while being scheduled or queued. strict refuses the post, warn accepts it and notifies the team
+// @Tags projects
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Success 200 {object} preflightModeResponse
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Project not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /projects/{project_id}/preflight-mode [get]
+func (h *ProjectHandler) GetPreflightMode(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": "required",
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	projectID := r.PathValue("project_id")
+
+	mode, err := h.Service.GetPreflightMode(r.Context(), projectID)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(preflightModeResponse{Mode: mode})
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// SetPreflightMode godoc
+// @Summary Set the project preflight mode
+// @Description Set what happens when a post fails validation while being scheduled or queued. strict refuses the post, warn accepts it and notifies the team
+// @Tags projects
+// @Accept json
+// @Param project_id path string true "Project ID"
+// @Param mode body setPreflightModeRequest true "Preflight mode request"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Project not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /projects/{project_id}/preflight-mode [patch]
+func (h *ProjectHandler) SetPreflightMode(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": "required",
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	projectID := r.PathValue("project_id")
+
+	req, ok := validateRequestBody[setPreflightModeRequest](w, r)
+	if !ok {
+		return
+	}
+
+	err := h.Service.SetPreflightMode(r.Context(), projectID, project.PreflightMode(req.Mode))
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
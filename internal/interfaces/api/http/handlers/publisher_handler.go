@@ -156,13 +156,13 @@ func (h *PublisherHandler) Authenticate(w http.ResponseWriter, r *http.Request)
 
 // ValidatePostForAllAssignedSocialNetworks godoc
 // @Summary Validate post for all assigned social networks
-// @Description Validate post for all assigned social networks
+// @Description Validate post for all assigned social networks. Returns one report per platform with every issue found, so they can all be fixed before scheduling
 // @Tags publishers
 // @Accept json
 // @Produce json
 // @Param project_id path string true "Project ID"
 // @Param post_id path string true "Post ID"
-// @Success 200
+// @Success 200 {array} publisher.ValidationReport
 // @Failure 400 {object} errors.APIError "Bad request"
 // @Failure 500 {object} errors.APIError "Internal server error"
 // @Security ApiKeyAuth
@@ -179,25 +179,30 @@ func (h *PublisherHandler) ValidatePostForAssignedSocialNetworks(w http.Response
 	projectID := r.PathValue("project_id")
 	postID := r.PathValue("post_id")
 
-	err := h.Service.ValidatePostForAssignedSocialNetworks(r.Context(), projectID, postID)
+	reports, err := h.Service.ValidatePostForAssignedSocialNetworks(r.Context(), projectID, postID)
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(reports)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
 }
 
 // ValidatePostForSocialNetwork godoc
 // @Summary Validate post for social network
-// @Description Validate post for social network
+// @Description Validate post for social network. Returns every issue found for the platform, errors block publishing while warnings don't
 // @Tags publishers
 // @Accept json
 // @Produce json
 // @Param project_id path string true "Project ID"
 // @Param post_id path string true "Post ID"
-// @Param social_network_id path string true "Social Network ID"
-// @Success 200
+// @Param platform_id path string true "Platform ID"
+// @Success 200 {object} publisher.ValidationReport
 // @Failure 400 {object} errors.APIError "Bad request"
 // @Failure 500 {object} errors.APIError "Internal server error"
 // @Security ApiKeyAuth
@@ -216,13 +221,18 @@ func (h *PublisherHandler) ValidatePostForSocialNetwork(w http.ResponseWriter, r
 	postID := r.PathValue("post_id")
 	platformID := r.PathValue("platform_id")
 
-	err := h.Service.ValidatePostForSocialNetwork(r.Context(), projectID, postID, platformID)
+	report, err := h.Service.ValidatePostForSocialNetwork(r.Context(), projectID, postID, platformID)
 	if err != nil {
 		e.WriteBusinessError(w, err, mapErrorToAPIError)
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(report)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
 }
 
 // GetPublishPostInfo godoc
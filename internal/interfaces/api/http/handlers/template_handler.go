@@ -0,0 +1,442 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/redplanettribe/social-media-manager/internal/domain/template"
+	e "github.com/redplanettribe/social-media-manager/internal/utils/errors"
+)
+
+type TemplateHandler struct {
+	Service template.Service
+}
+
+func NewTemplateHandler(service template.Service) *TemplateHandler {
+	return &TemplateHandler{Service: service}
+}
+
+type templateRequest struct {
+	Name        string   `json:"name"`
+	Title       string   `json:"title"`
+	Type        string   `json:"type"`
+	TextContent string   `json:"text_content"`
+	Platforms   []string `json:"platforms"`
+}
+
+func (r templateRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+	if r.Name == "" {
+		errors["name"] = "required"
+	}
+	if r.Title == "" {
+		errors["title"] = "required"
+	}
+	if r.Type == "" {
+		errors["type"] = "required"
+	}
+	if r.TextContent == "" {
+		errors["text_content"] = "required"
+	}
+	return errors
+}
+
+type createPostFromTemplateRequest struct {
+	Variables   map[string]string `json:"variables"`
+	ScheduledAt time.Time         `json:"scheduled_at"`
+}
+
+func (r createPostFromTemplateRequest) Validate() map[string]string {
+	return nil
+}
+
+type snippetRequest struct {
+	Name    string `json:"name"` // lowercase letters, digits, - and _
+	Content string `json:"content"`
+}
+
+func (r snippetRequest) Validate() map[string]string {
+	errors := make(map[string]string)
+	if r.Name == "" {
+		errors["name"] = "required"
+	}
+	if r.Content == "" {
+		errors["content"] = "required"
+	}
+	return errors
+}
+
+// CreateTemplate godoc
+// @Summary Create a post template
+// @Description Create a project post template. The title and content can hold {{variable}} placeholders and {{snippet:name}} references
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param template body templateRequest true "Template creation request"
+// @Success 201 {object} template.Template
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /templates/{project_id} [post]
+func (h *TemplateHandler) CreateTemplate(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[templateRequest](w, r)
+	if !ok {
+		return
+	}
+
+	t, err := h.Service.CreateTemplate(r.Context(), params["project_id"], req.Name, req.Title, req.Type, req.TextContent, req.Platforms)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(t)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// UpdateTemplate godoc
+// @Summary Update a post template
+// @Description Update a project post template
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param template_id path string true "Template ID"
+// @Param template body templateRequest true "Template update request"
+// @Success 200 {object} template.Template
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Template not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /templates/{project_id}/{template_id} [patch]
+func (h *TemplateHandler) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"template_id": r.PathValue("template_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[templateRequest](w, r)
+	if !ok {
+		return
+	}
+
+	t, err := h.Service.UpdateTemplate(r.Context(), params["project_id"], params["template_id"], req.Name, req.Title, req.Type, req.TextContent, req.Platforms)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(t)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// DeleteTemplate godoc
+// @Summary Delete a post template
+// @Description Delete a project post template. Posts created from it are kept
+// @Tags templates
+// @Param project_id path string true "Project ID"
+// @Param template_id path string true "Template ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Template not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /templates/{project_id}/{template_id} [delete]
+func (h *TemplateHandler) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"template_id": r.PathValue("template_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.DeleteTemplate(r.Context(), params["project_id"], params["template_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetTemplate godoc
+// @Summary Get a post template
+// @Description Get a project post template and the variables it needs
+// @Tags templates
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param template_id path string true "Template ID"
+// @Success 200 {object} template.Template
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Template not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /templates/{project_id}/{template_id} [get]
+func (h *TemplateHandler) GetTemplate(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"template_id": r.PathValue("template_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	t, err := h.Service.GetTemplate(r.Context(), params["project_id"], params["template_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(t)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// ListProjectTemplates godoc
+// @Summary List project post templates
+// @Description List the post templates of a project
+// @Tags templates
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Success 200 {array} template.Template
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /templates/{project_id} [get]
+func (h *TemplateHandler) ListProjectTemplates(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	templates, err := h.Service.ListProjectTemplates(r.Context(), params["project_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(templates)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// CreatePostFromTemplate godoc
+// @Summary Create a post from a template
+// @Description Render the template with the given variables and create a draft post linked to the template platforms. Every variable of the template must be provided
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param template_id path string true "Template ID"
+// @Param post body createPostFromTemplateRequest true "Template variables"
+// @Success 201 {object} post.Post
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Template not found"
+// @Failure 422 {object} errors.APIError "Missing variables or snippets"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /templates/{project_id}/{template_id}/posts [post]
+func (h *TemplateHandler) CreatePostFromTemplate(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id":  r.PathValue("project_id"),
+		"template_id": r.PathValue("template_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[createPostFromTemplateRequest](w, r)
+	if !ok {
+		return
+	}
+
+	p, err := h.Service.CreatePostFromTemplate(r.Context(), params["project_id"], params["template_id"], req.Variables, req.ScheduledAt)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(p)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// CreateSnippet godoc
+// @Summary Create a snippet
+// @Description Create a reusable block of text, inserted in posts and templates with {{snippet:name}}
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param snippet body snippetRequest true "Snippet creation request"
+// @Success 201 {object} template.Snippet
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 409 {object} errors.APIError "Snippet already exists"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /snippets/{project_id} [post]
+func (h *TemplateHandler) CreateSnippet(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[snippetRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s, err := h.Service.CreateSnippet(r.Context(), params["project_id"], req.Name, req.Content)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(s)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// UpdateSnippet godoc
+// @Summary Update a snippet
+// @Description Update the name and content of a snippet
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Param snippet_id path string true "Snippet ID"
+// @Param snippet body snippetRequest true "Snippet update request"
+// @Success 200 {object} template.Snippet
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 409 {object} errors.APIError "Snippet already exists"
+// @Failure 410 {object} errors.APIError "Snippet not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /snippets/{project_id}/{snippet_id} [patch]
+func (h *TemplateHandler) UpdateSnippet(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"snippet_id": r.PathValue("snippet_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+	req, ok := validateRequestBody[snippetRequest](w, r)
+	if !ok {
+		return
+	}
+
+	s, err := h.Service.UpdateSnippet(r.Context(), params["project_id"], params["snippet_id"], req.Name, req.Content)
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(s)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
+
+// DeleteSnippet godoc
+// @Summary Delete a snippet
+// @Description Delete a snippet. Posts where it was inserted are kept
+// @Tags templates
+// @Param project_id path string true "Project ID"
+// @Param snippet_id path string true "Snippet ID"
+// @Success 204 {string} string "No content"
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 410 {object} errors.APIError "Snippet not found"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /snippets/{project_id}/{snippet_id} [delete]
+func (h *TemplateHandler) DeleteSnippet(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+		"snippet_id": r.PathValue("snippet_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	err := h.Service.DeleteSnippet(r.Context(), params["project_id"], params["snippet_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListProjectSnippets godoc
+// @Summary List project snippets
+// @Description List the snippets of a project
+// @Tags templates
+// @Produce json
+// @Param project_id path string true "Project ID"
+// @Success 200 {array} template.Snippet
+// @Failure 400 {object} errors.APIError "Validation error"
+// @Failure 401 {object} errors.APIError "Unauthorized"
+// @Failure 500 {object} errors.APIError "Internal server error"
+// @Security ApiKeyAuth
+// @Router /snippets/{project_id} [get]
+func (h *TemplateHandler) ListProjectSnippets(w http.ResponseWriter, r *http.Request) {
+	params := map[string]string{
+		"project_id": r.PathValue("project_id"),
+	}
+	if !requirePathParams(w, params) {
+		return
+	}
+
+	snippets, err := h.Service.ListProjectSnippets(r.Context(), params["project_id"])
+	if err != nil {
+		e.WriteBusinessError(w, err, mapErrorToAPIError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(snippets)
+	if err != nil {
+		e.WriteHttpError(w, e.NewInternalError("Failed to encode response"))
+	}
+}
@@ -33,6 +33,14 @@ func NewRouter(
 	postHandler *handlers.PostHandler,
 	platformHandler *handlers.PublisherHandler,
 	mediaHandler *handlers.MediaHandler,
+	notificationHandler *handlers.NotificationHandler,
+	labelHandler *handlers.LabelHandler,
+	campaignHandler *handlers.CampaignHandler,
+	templateHandler *handlers.TemplateHandler,
+	importHandler *handlers.ImportHandler,
+	archiveHandler *handlers.ArchiveHandler,
+	ideaHandler *handlers.IdeaHandler,
+	feedHandler *handlers.FeedHandler,
 	authenticator authentication.Authenticator,
 	appAuthorizer authorization.AppAuthorizer,
 	projectAuthorizer authorization.ProjectAuthorizer,
@@ -64,6 +72,14 @@ func NewRouter(
 	r.setupPostRoutes(postHandler)
 	r.setupPublisherRoutes(platformHandler)
 	r.setupMediaRoutes(mediaHandler)
+	r.setupNotificationRoutes(notificationHandler)
+	r.setupLabelRoutes(labelHandler)
+	r.setupCampaignRoutes(campaignHandler)
+	r.setupTemplateRoutes(templateHandler)
+	r.setupImportRoutes(importHandler)
+	r.setupArchiveRoutes(archiveHandler)
+	r.setupIdeaRoutes(ideaHandler)
+	r.setupFeedRoutes(feedHandler)
 	r.setupSupportRoutes(supportHandler)
 
 	return r
@@ -178,6 +194,12 @@ func (r *Router) setupProjectRoutes(h *handlers.ProjectHandler) {
 	r.Handle("GET /projects/{project_id}/default-user-platform-info/{platform_id}", r.projectPermissions("read:projects").Chain(
 		http.HandlerFunc(h.GetDefaultUserPlatformInfo),
 	))
+	r.Handle("GET /projects/{project_id}/preflight-mode", r.projectPermissions("read:projects").Chain(
+		http.HandlerFunc(h.GetPreflightMode),
+	))
+	r.Handle("PATCH /projects/{project_id}/preflight-mode", r.projectPermissions("write:projects").Chain(
+		http.HandlerFunc(h.SetPreflightMode),
+	))
 }
 
 /*POST ROUTES*/
@@ -227,12 +249,27 @@ func (r *Router) setupPostRoutes(h *handlers.PostHandler) {
 	r.Handle("GET /posts/{project_id}/queue", r.projectPermissions("read:posts").Chain(
 		http.HandlerFunc(h.GetProjectQueuedPosts),
 	))
+	r.Handle("GET /posts/{project_id}/queues", r.projectPermissions("read:posts").Chain(
+		http.HandlerFunc(h.ListQueues),
+	))
+	r.Handle("POST /posts/{project_id}/queues", r.projectPermissions("write:posts").Chain(
+		http.HandlerFunc(h.CreateQueue),
+	))
+	r.Handle("DELETE /posts/{project_id}/queues/{queue}", r.projectPermissions("delete:posts").Chain(
+		http.HandlerFunc(h.DeleteQueue),
+	))
 	r.Handle("GET /posts", r.appPermissions("read:posts").Chain(
 		http.HandlerFunc(h.GetAvailablePostTypes),
 	))
 	r.Handle("DELETE /posts/{project_id}/{post_id}", r.projectPermissions("delete:posts").Chain(
 		http.HandlerFunc(h.DeletePost),
 	))
+	r.Handle("GET /posts/{project_id}/trash", r.projectPermissions("read:posts").Chain(
+		http.HandlerFunc(h.ListTrash),
+	))
+	r.Handle("PATCH /posts/{project_id}/trash/{post_id}/restore", r.projectPermissions("delete:posts").Chain(
+		http.HandlerFunc(h.RestoreFromTrash),
+	))
 }
 
 /*PUBLISHER ROUTES*/
@@ -286,7 +323,209 @@ func (r *Router) setupMediaRoutes(h *handlers.MediaHandler) {
 	r.Handle("DELETE /media/{project_id}/{post_id}/{file_name}", r.projectPermissions("delete:media").Chain(
 		http.HandlerFunc(h.DeleteMedia),
 	))
+	r.Handle("POST /media/{project_id}/library", r.projectPermissions("write:media").Chain(
+		http.HandlerFunc(h.UploadLibraryMedia),
+	))
+	r.Handle("GET /media/{project_id}/library", r.projectPermissions("read:media").Chain(
+		http.HandlerFunc(h.ListLibraryMedia),
+	))
+	r.Handle("PATCH /media/{project_id}/library/{media_id}", r.projectPermissions("write:media").Chain(
+		http.HandlerFunc(h.UpdateLibraryMedia),
+	))
+	r.Handle("DELETE /media/{project_id}/library/{media_id}", r.projectPermissions("delete:media").Chain(
+		http.HandlerFunc(h.DeleteLibraryMedia),
+	))
+	r.Handle("POST /media/{project_id}/{post_id}/uploads", r.projectPermissions("write:media").Chain(
+		http.HandlerFunc(h.InitiateUpload),
+	))
+	r.Handle("POST /media/{project_id}/library/uploads", r.projectPermissions("write:media").Chain(
+		http.HandlerFunc(h.InitiateUpload),
+	))
+	r.Handle("POST /media/{project_id}/uploads/{upload_id}/complete", r.projectPermissions("write:media").Chain(
+		http.HandlerFunc(h.CompleteUpload),
+	))
+	r.Handle("GET /media/{project_id}/status", r.projectPermissions("read:media").Chain(
+		http.HandlerFunc(h.GetProcessingStatus),
+	))
+	r.Handle("PUT /media/{project_id}/{media_id}/focal-point", r.projectPermissions("write:media").Chain(
+		http.HandlerFunc(h.SetFocalPoint),
+	))
+	r.Handle("GET /media/{project_id}/branding", r.projectPermissions("read:media").Chain(
+		http.HandlerFunc(h.GetBranding),
+	))
+	r.Handle("PUT /media/{project_id}/branding", r.projectPermissions("write:media").Chain(
+		http.HandlerFunc(h.SetBranding),
+	))
+	r.Handle("DELETE /media/{project_id}/branding", r.projectPermissions("delete:media").Chain(
+		http.HandlerFunc(h.DeleteBranding),
+	))
+	r.Handle("PUT /media/{project_id}/{post_id}/{platform_id}/branding", r.projectPermissions("write:media").Chain(
+		http.HandlerFunc(h.SetPostBranding),
+	))
+	r.Handle("POST /media/gc", r.appPermissions("delete:media").Chain(
+		http.HandlerFunc(h.CollectGarbage),
+	))
+}
+
+/*NOTIFICATION ROUTES*/
+func (r *Router) setupNotificationRoutes(h *handlers.NotificationHandler) {
+	r.Handle("GET /notifications/{project_id}", r.projectPermissions("read:notifications").Chain(
+		http.HandlerFunc(h.ListProjectNotifications),
+	))
+	r.Handle("PATCH /notifications/{project_id}/{notification_id}/read", r.projectPermissions("write:notifications").Chain(
+		http.HandlerFunc(h.MarkNotificationAsRead),
+	))
+}
+
+/*LABEL ROUTES*/
+func (r *Router) setupLabelRoutes(h *handlers.LabelHandler) {
+	r.Handle("POST /labels/{project_id}", r.projectPermissions("write:labels").Chain(
+		http.HandlerFunc(h.CreateLabel),
+	))
+	r.Handle("GET /labels/{project_id}", r.projectPermissions("read:labels").Chain(
+		http.HandlerFunc(h.ListProjectLabels),
+	))
+	r.Handle("PATCH /labels/{project_id}/{label_id}", r.projectPermissions("write:labels").Chain(
+		http.HandlerFunc(h.UpdateLabel),
+	))
+	r.Handle("DELETE /labels/{project_id}/{label_id}", r.projectPermissions("delete:labels").Chain(
+		http.HandlerFunc(h.DeleteLabel),
+	))
+	r.Handle("GET /labels/{project_id}/posts/{post_id}", r.projectPermissions("read:labels").Chain(
+		http.HandlerFunc(h.GetPostLabels),
+	))
+	r.Handle("POST /labels/{project_id}/posts/{post_id}/{label_id}", r.projectPermissions("write:labels").Chain(
+		http.HandlerFunc(h.AddLabelToPost),
+	))
+	r.Handle("DELETE /labels/{project_id}/posts/{post_id}/{label_id}", r.projectPermissions("write:labels").Chain(
+		http.HandlerFunc(h.RemoveLabelFromPost),
+	))
+}
 
+/*CAMPAIGN ROUTES*/
+func (r *Router) setupCampaignRoutes(h *handlers.CampaignHandler) {
+	r.Handle("POST /campaigns/{project_id}", r.projectPermissions("write:campaigns").Chain(
+		http.HandlerFunc(h.CreateCampaign),
+	))
+	r.Handle("GET /campaigns/{project_id}", r.projectPermissions("read:campaigns").Chain(
+		http.HandlerFunc(h.ListProjectCampaigns),
+	))
+	r.Handle("GET /campaigns/{project_id}/{campaign_id}", r.projectPermissions("read:campaigns").Chain(
+		http.HandlerFunc(h.GetCampaign),
+	))
+	r.Handle("PATCH /campaigns/{project_id}/{campaign_id}", r.projectPermissions("write:campaigns").Chain(
+		http.HandlerFunc(h.UpdateCampaign),
+	))
+	r.Handle("DELETE /campaigns/{project_id}/{campaign_id}", r.projectPermissions("delete:campaigns").Chain(
+		http.HandlerFunc(h.DeleteCampaign),
+	))
+	r.Handle("GET /campaigns/{project_id}/{campaign_id}/summary", r.projectPermissions("read:campaigns").Chain(
+		http.HandlerFunc(h.GetCampaignSummary),
+	))
+	r.Handle("POST /campaigns/{project_id}/{campaign_id}/posts/{post_id}", r.projectPermissions("write:campaigns").Chain(
+		http.HandlerFunc(h.AddPostToCampaign),
+	))
+	r.Handle("DELETE /campaigns/{project_id}/{campaign_id}/posts/{post_id}", r.projectPermissions("write:campaigns").Chain(
+		http.HandlerFunc(h.RemovePostFromCampaign),
+	))
+}
+
+/*TEMPLATE ROUTES*/
+func (r *Router) setupTemplateRoutes(h *handlers.TemplateHandler) {
+	r.Handle("POST /templates/{project_id}", r.projectPermissions("write:templates").Chain(
+		http.HandlerFunc(h.CreateTemplate),
+	))
+	r.Handle("GET /templates/{project_id}", r.projectPermissions("read:templates").Chain(
+		http.HandlerFunc(h.ListProjectTemplates),
+	))
+	r.Handle("GET /templates/{project_id}/{template_id}", r.projectPermissions("read:templates").Chain(
+		http.HandlerFunc(h.GetTemplate),
+	))
+	r.Handle("PATCH /templates/{project_id}/{template_id}", r.projectPermissions("write:templates").Chain(
+		http.HandlerFunc(h.UpdateTemplate),
+	))
+	r.Handle("DELETE /templates/{project_id}/{template_id}", r.projectPermissions("delete:templates").Chain(
+		http.HandlerFunc(h.DeleteTemplate),
+	))
+	r.Handle("POST /templates/{project_id}/{template_id}/posts", r.projectPermissions("write:posts").Chain(
+		http.HandlerFunc(h.CreatePostFromTemplate),
+	))
+	r.Handle("POST /snippets/{project_id}", r.projectPermissions("write:templates").Chain(
+		http.HandlerFunc(h.CreateSnippet),
+	))
+	r.Handle("GET /snippets/{project_id}", r.projectPermissions("read:templates").Chain(
+		http.HandlerFunc(h.ListProjectSnippets),
+	))
+	r.Handle("PATCH /snippets/{project_id}/{snippet_id}", r.projectPermissions("write:templates").Chain(
+		http.HandlerFunc(h.UpdateSnippet),
+	))
+	r.Handle("DELETE /snippets/{project_id}/{snippet_id}", r.projectPermissions("delete:templates").Chain(
+		http.HandlerFunc(h.DeleteSnippet),
+	))
+}
+
+/*IMPORT ROUTES*/
+func (r *Router) setupImportRoutes(h *handlers.ImportHandler) {
+	r.Handle("POST /imports/{project_id}", r.projectPermissions("write:posts").Chain(
+		http.HandlerFunc(h.ImportPosts),
+	))
+}
+
+/*ARCHIVE ROUTES*/
+func (r *Router) setupArchiveRoutes(h *handlers.ArchiveHandler) {
+	r.Handle("GET /projects/{project_id}/export", r.projectPermissions("read:archives").Chain(
+		http.HandlerFunc(h.ExportProject),
+	))
+	r.Handle("POST /projects/import", r.appPermissions("write:projects").Chain(
+		http.HandlerFunc(h.ImportProject),
+	))
+}
+
+/*IDEA ROUTES*/
+func (r *Router) setupIdeaRoutes(h *handlers.IdeaHandler) {
+	r.Handle("GET /ideas/{project_id}", r.projectPermissions("read:posts").Chain(
+		http.HandlerFunc(h.ListIdeas),
+	))
+	r.Handle("POST /ideas/{project_id}/sort-by-votes", r.projectPermissions("write:posts").Chain(
+		http.HandlerFunc(h.SortQueueByVotes),
+	))
+	r.Handle("POST /ideas/{project_id}/{idea_id}/vote", r.projectPermissions("write:posts").Chain(
+		http.HandlerFunc(h.Vote),
+	))
+	r.Handle("DELETE /ideas/{project_id}/{idea_id}/vote", r.projectPermissions("write:posts").Chain(
+		http.HandlerFunc(h.RemoveVote),
+	))
+	r.Handle("GET /ideas/{project_id}/{idea_id}/comments", r.projectPermissions("read:posts").Chain(
+		http.HandlerFunc(h.ListComments),
+	))
+	r.Handle("POST /ideas/{project_id}/{idea_id}/comments", r.projectPermissions("write:posts").Chain(
+		http.HandlerFunc(h.AddComment),
+	))
+	r.Handle("DELETE /ideas/{project_id}/{idea_id}/comments/{comment_id}", r.projectPermissions("write:posts").Chain(
+		http.HandlerFunc(h.DeleteComment),
+	))
+	r.Handle("POST /ideas/{project_id}/{idea_id}/promote", r.projectPermissions("write:posts").Chain(
+		http.HandlerFunc(h.PromoteIdea),
+	))
+}
+
+/*FEED ROUTES*/
+func (r *Router) setupFeedRoutes(h *handlers.FeedHandler) {
+	r.Handle("POST /feeds/{project_id}", r.projectPermissions("write:feeds").Chain(
+		http.HandlerFunc(h.Subscribe),
+	))
+	r.Handle("GET /feeds/{project_id}", r.projectPermissions("read:feeds").Chain(
+		http.HandlerFunc(h.ListProjectFeeds),
+	))
+	r.Handle("PATCH /feeds/{project_id}/{feed_id}", r.projectPermissions("write:feeds").Chain(
+		http.HandlerFunc(h.UpdateFeed),
+	))
+	r.Handle("DELETE /feeds/{project_id}/{feed_id}", r.projectPermissions("delete:feeds").Chain(
+		http.HandlerFunc(h.Unsubscribe),
+	))
+	r.Handle("POST /feeds/{project_id}/{feed_id}/refresh", r.projectPermissions("write:feeds").Chain(
+		http.HandlerFunc(h.RefreshFeed),
+	))
 }
 
 /*SUPPORT ROUTES*/
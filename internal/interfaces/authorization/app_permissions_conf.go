@@ -13,5 +13,6 @@ func GetAppPermissions() *Permissions {
 		AddRole("admin").Inherit("user").
 		/* */ Read("roles").
 		/* */ Write("roles").
-		/* */ Delete("roles")
+		/* */ Delete("roles").
+		/* */ Delete("media")
 }
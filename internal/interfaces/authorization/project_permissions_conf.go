@@ -12,8 +12,23 @@ func GetTeamPermissions() *Permissions {
 		/* */ Write("media").
 		/* */ Read("media").
 		/* */ Delete("media").
+		/* */ Read("notifications").
+		/* */ Write("notifications").
+		/* */ Read("labels").
+		/* */ Write("labels").
+		/* */ Read("campaigns").
+		/* */ Write("campaigns").
+		/* */ Read("templates").
+		/* */ Write("templates").
+		/* */ Read("feeds").
+		/* */ Write("feeds").
 		AddRole("manager").Inherit("member").
 		/* */ Write("projects").
+		/* */ Read("archives").
 		/* */ Delete("posts").
+		/* */ Delete("labels").
+		/* */ Delete("campaigns").
+		/* */ Delete("templates").
+		/* */ Delete("feeds").
 		AddRole("owner").Inherit("manager")
 }
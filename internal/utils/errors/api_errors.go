@@ -26,6 +26,8 @@ const (
     ErrCodeConflict       = "CONFLICT"
     ErrCodeInternal       = "INTERNAL_ERROR"
     ErrCodeBadRequest     = "BAD_REQUEST"
+    ErrCodePrecondition   = "PRECONDITION_FAILED"
+    ErrCodeTooLarge       = "PAYLOAD_TOO_LARGE"
 )
 
 func NewValidationError(message string, details any) *APIError {
@@ -69,6 +71,22 @@ func NewConflictError(message string) *APIError {
 	}
 }
 
+func NewPreconditionFailedError(message string) *APIError {
+	return &APIError{
+		Status:  http.StatusPreconditionFailed,
+		Code:    ErrCodePrecondition,
+		Message: message,
+	}
+}
+
+func NewPayloadTooLargeError(message string) *APIError {
+	return &APIError{
+		Status:  http.StatusRequestEntityTooLarge,
+		Code:    ErrCodeTooLarge,
+		Message: message,
+	}
+}
+
 func NewInternalError(message string) *APIError {
 	return &APIError{
 		Status:  http.StatusInternalServerError,